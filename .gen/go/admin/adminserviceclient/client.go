@@ -36,23 +36,83 @@ import (
 
 // Interface is a client for the AdminService service.
 type Interface interface {
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShard(
+		ctx context.Context,
+		Request *shared.DescribeShardRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeShardResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	DropDLQTask(
+		ctx context.Context,
+		Request *shared.DropDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetWorkflowExecutionRawHistory(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	RebuildMutableState(
+		ctx context.Context,
+		Request *shared.RebuildMutableStateRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.RebuildMutableStateResponse, error)
+
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *shared.RefreshWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ReloadShard(
+		ctx context.Context,
+		Request *shared.ReloadShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RemoveShardQueueTask(
+		ctx context.Context,
+		Request *shared.RemoveShardQueueTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RetryDLQTask(
+		ctx context.Context,
+		Request *shared.RetryDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -79,6 +139,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) CloseShard(
+	ctx context.Context,
+	_Request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_CloseShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CloseShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_CloseShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -102,6 +185,29 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeShard(
+	ctx context.Context,
+	_Request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeShardResponse, err error) {
+
+	args := admin.AdminService_DescribeShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -125,6 +231,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) DropDLQTask(
+	ctx context.Context,
+	_Request *shared.DropDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_DropDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DropDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_DropDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetWorkflowExecutionRawHistory(
 	ctx context.Context,
 	_GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
@@ -147,3 +276,164 @@ func (c client) GetWorkflowExecutionRawHistory(
 	success, err = admin.AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ListDLQTasks(
+	ctx context.Context,
+	_Request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDLQTasksResponse, err error) {
+
+	args := admin.AdminService_ListDLQTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListDLQTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListDLQTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListShardQueueTasks(
+	ctx context.Context,
+	_Request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListShardQueueTasksResponse, err error) {
+
+	args := admin.AdminService_ListShardQueueTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListShardQueueTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListShardQueueTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RebuildMutableState(
+	ctx context.Context,
+	_Request *shared.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RebuildMutableStateResponse, err error) {
+
+	args := admin.AdminService_RebuildMutableState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RebuildMutableState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_RebuildMutableState_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RefreshWorkflowTasks(
+	ctx context.Context,
+	_Request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RefreshWorkflowTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RefreshWorkflowTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReloadShard(
+	ctx context.Context,
+	_Request *shared.ReloadShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_ReloadShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ReloadShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_ReloadShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RemoveShardQueueTask(
+	ctx context.Context,
+	_Request *shared.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RemoveShardQueueTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RemoveShardQueueTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RemoveShardQueueTask_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RetryDLQTask(
+	ctx context.Context,
+	_Request *shared.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RetryDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RetryDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RetryDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
@@ -34,20 +34,70 @@ import (
 
 // Interface is the server-side interface for the AdminService service.
 type Interface interface {
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShard(
+		ctx context.Context,
+		Request *shared.DescribeShardRequest,
+	) (*shared.DescribeShardResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
 	) (*admin.DescribeWorkflowExecutionResponse, error)
 
+	DropDLQTask(
+		ctx context.Context,
+		Request *shared.DropDLQTaskRequest,
+	) error
+
 	GetWorkflowExecutionRawHistory(
 		ctx context.Context,
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	RebuildMutableState(
+		ctx context.Context,
+		Request *shared.RebuildMutableStateRequest,
+	) (*shared.RebuildMutableStateResponse, error)
+
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *shared.RefreshWorkflowTasksRequest,
+	) error
+
+	ReloadShard(
+		ctx context.Context,
+		Request *shared.ReloadShardRequest,
+	) error
+
+	RemoveShardQueueTask(
+		ctx context.Context,
+		Request *shared.RemoveShardQueueTaskRequest,
+	) error
+
+	RetryDLQTask(
+		ctx context.Context,
+		Request *shared.RetryDLQTaskRequest,
+	) error
 }
 
 // New prepares an implementation of the AdminService service for
@@ -61,6 +111,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "AdminService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "CloseShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CloseShard),
+				},
+				Signature:    "CloseShard(Request *shared.CloseShardRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -72,6 +133,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShard),
+				},
+				Signature:    "DescribeShard(Request *shared.DescribeShardRequest) (*shared.DescribeShardResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -83,6 +155,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DropDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DropDLQTask),
+				},
+				Signature:    "DropDLQTask(Request *shared.DropDLQTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetWorkflowExecutionRawHistory",
 				HandlerSpec: thrift.HandlerSpec{
@@ -93,16 +176,112 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				Signature:    "GetWorkflowExecutionRawHistory(GetRequest *admin.GetWorkflowExecutionRawHistoryRequest) (*admin.GetWorkflowExecutionRawHistoryResponse)",
 				ThriftModule: admin.ThriftModule,
 			},
+
+			thrift.Method{
+				Name: "ListDLQTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListDLQTasks),
+				},
+				Signature:    "ListDLQTasks(Request *shared.ListDLQTasksRequest) (*shared.ListDLQTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListShardQueueTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListShardQueueTasks),
+				},
+				Signature:    "ListShardQueueTasks(Request *shared.ListShardQueueTasksRequest) (*shared.ListShardQueueTasksResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RebuildMutableState",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RebuildMutableState),
+				},
+				Signature:    "RebuildMutableState(Request *shared.RebuildMutableStateRequest) (*shared.RebuildMutableStateResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RefreshWorkflowTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RefreshWorkflowTasks),
+				},
+				Signature:    "RefreshWorkflowTasks(Request *shared.RefreshWorkflowTasksRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReloadShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReloadShard),
+				},
+				Signature:    "ReloadShard(Request *shared.ReloadShardRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RemoveShardQueueTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RemoveShardQueueTask),
+				},
+				Signature:    "RemoveShardQueueTask(Request *shared.RemoveShardQueueTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RetryDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RetryDLQTask),
+				},
+				Signature:    "RetryDLQTask(Request *shared.RetryDLQTaskRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 3)
+	procedures := make([]transport.Procedure, 0, 13)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_CloseShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_CloseShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -122,6 +301,25 @@ func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thri
 	return response, err
 }
 
+func (h handler) DescribeShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DescribeShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DescribeShard_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -141,6 +339,25 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) DropDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_DropDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DropDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_DropDLQTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_GetWorkflowExecutionRawHistory_Args
 	if err := args.FromWire(body); err != nil {
@@ -159,3 +376,136 @@ func (h handler) GetWorkflowExecutionRawHistory(ctx context.Context, body wire.V
 	}
 	return response, err
 }
+
+func (h handler) ListDLQTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListDLQTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListDLQTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListDLQTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListShardQueueTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ListShardQueueTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListShardQueueTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ListShardQueueTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RebuildMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RebuildMutableState_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.RebuildMutableState(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RebuildMutableState_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshWorkflowTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RefreshWorkflowTasks_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReloadShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ReloadShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ReloadShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_ReloadShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RemoveShardQueueTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RemoveShardQueueTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RemoveShardQueueTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RemoveShardQueueTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RetryDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RetryDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RetryDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := admin.AdminService_RetryDLQTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
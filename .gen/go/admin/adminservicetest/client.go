@@ -63,6 +63,37 @@ func (m *MockClient) EXPECT() *_MockClientRecorder {
 	return m.recorder
 }
 
+// CloseShard responds to a CloseShard call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().CloseShard(gomock.Any(), ...).Return(...)
+// 	... := client.CloseShard(...)
+func (m *MockClient) CloseShard(
+	ctx context.Context,
+	_Request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "CloseShard", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) CloseShard(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "CloseShard", args...)
+}
+
 // DescribeHistoryHost responds to a DescribeHistoryHost call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -96,6 +127,39 @@ func (mr *_MockClientRecorder) DescribeHistoryHost(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeHistoryHost", args...)
 }
 
+// DescribeShard responds to a DescribeShard call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DescribeShard(gomock.Any(), ...).Return(...)
+// 	... := client.DescribeShard(...)
+func (m *MockClient) DescribeShard(
+	ctx context.Context,
+	_Request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeShardResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DescribeShard", args...)
+	success, _ = ret[i].(*shared.DescribeShardResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DescribeShard(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeShard", args...)
+}
+
 // DescribeWorkflowExecution responds to a DescribeWorkflowExecution call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -129,6 +193,37 @@ func (mr *_MockClientRecorder) DescribeWorkflowExecution(
 	return mr.mock.ctrl.RecordCall(mr.mock, "DescribeWorkflowExecution", args...)
 }
 
+// DropDLQTask responds to a DropDLQTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().DropDLQTask(gomock.Any(), ...).Return(...)
+// 	... := client.DropDLQTask(...)
+func (m *MockClient) DropDLQTask(
+	ctx context.Context,
+	_Request *shared.DropDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "DropDLQTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) DropDLQTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "DropDLQTask", args...)
+}
+
 // GetWorkflowExecutionRawHistory responds to a GetWorkflowExecutionRawHistory call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -161,3 +256,226 @@ func (mr *_MockClientRecorder) GetWorkflowExecutionRawHistory(
 	args := append([]interface{}{ctx, _GetRequest}, opts...)
 	return mr.mock.ctrl.RecordCall(mr.mock, "GetWorkflowExecutionRawHistory", args...)
 }
+
+// ListDLQTasks responds to a ListDLQTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListDLQTasks(gomock.Any(), ...).Return(...)
+// 	... := client.ListDLQTasks(...)
+func (m *MockClient) ListDLQTasks(
+	ctx context.Context,
+	_Request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDLQTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListDLQTasks", args...)
+	success, _ = ret[i].(*shared.ListDLQTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListDLQTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListDLQTasks", args...)
+}
+
+// ListShardQueueTasks responds to a ListShardQueueTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ListShardQueueTasks(gomock.Any(), ...).Return(...)
+// 	... := client.ListShardQueueTasks(...)
+func (m *MockClient) ListShardQueueTasks(
+	ctx context.Context,
+	_Request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListShardQueueTasksResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ListShardQueueTasks", args...)
+	success, _ = ret[i].(*shared.ListShardQueueTasksResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ListShardQueueTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ListShardQueueTasks", args...)
+}
+
+// RebuildMutableState responds to a RebuildMutableState call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RebuildMutableState(gomock.Any(), ...).Return(...)
+// 	... := client.RebuildMutableState(...)
+func (m *MockClient) RebuildMutableState(
+	ctx context.Context,
+	_Request *shared.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RebuildMutableStateResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RebuildMutableState", args...)
+	success, _ = ret[i].(*shared.RebuildMutableStateResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RebuildMutableState(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RebuildMutableState", args...)
+}
+
+// RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RefreshWorkflowTasks(gomock.Any(), ...).Return(...)
+// 	... := client.RefreshWorkflowTasks(...)
+func (m *MockClient) RefreshWorkflowTasks(
+	ctx context.Context,
+	_Request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RefreshWorkflowTasks", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RefreshWorkflowTasks(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RefreshWorkflowTasks", args...)
+}
+
+// ReloadShard responds to a ReloadShard call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().ReloadShard(gomock.Any(), ...).Return(...)
+// 	... := client.ReloadShard(...)
+func (m *MockClient) ReloadShard(
+	ctx context.Context,
+	_Request *shared.ReloadShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ReloadShard", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ReloadShard(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ReloadShard", args...)
+}
+
+// RemoveShardQueueTask responds to a RemoveShardQueueTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RemoveShardQueueTask(gomock.Any(), ...).Return(...)
+// 	... := client.RemoveShardQueueTask(...)
+func (m *MockClient) RemoveShardQueueTask(
+	ctx context.Context,
+	_Request *shared.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RemoveShardQueueTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RemoveShardQueueTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RemoveShardQueueTask", args...)
+}
+
+// RetryDLQTask responds to a RetryDLQTask call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+// 	client.EXPECT().RetryDLQTask(gomock.Any(), ...).Return(...)
+// 	... := client.RetryDLQTask(...)
+func (m *MockClient) RetryDLQTask(
+	ctx context.Context,
+	_Request *shared.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RetryDLQTask", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RetryDLQTask(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RetryDLQTask", args...)
+}
@@ -59,6 +59,11 @@ type Interface interface {
 		GetRequest *shared.GetWorkflowExecutionHistoryRequest,
 	) (*shared.GetWorkflowExecutionHistoryResponse, error)
 
+	ListArchivedWorkflowExecutions(
+		ctx context.Context,
+		ListRequest *shared.ListArchivedWorkflowExecutionsRequest,
+	) (*shared.ListArchivedWorkflowExecutionsResponse, error)
+
 	ListClosedWorkflowExecutions(
 		ctx context.Context,
 		ListRequest *shared.ListClosedWorkflowExecutionsRequest,
@@ -256,6 +261,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: cadence.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListArchivedWorkflowExecutions",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListArchivedWorkflowExecutions),
+				},
+				Signature:    "ListArchivedWorkflowExecutions(ListRequest *shared.ListArchivedWorkflowExecutionsRequest) (*shared.ListArchivedWorkflowExecutionsResponse)",
+				ThriftModule: cadence.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ListClosedWorkflowExecutions",
 				HandlerSpec: thrift.HandlerSpec{
@@ -544,7 +560,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 31)
+	procedures := make([]transport.Procedure, 0, 32)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -646,6 +662,25 @@ func (h handler) GetWorkflowExecutionHistory(ctx context.Context, body wire.Valu
 	return response, err
 }
 
+func (h handler) ListArchivedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args cadence.WorkflowService_ListArchivedWorkflowExecutions_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListArchivedWorkflowExecutions(ctx, args.ListRequest)
+
+	hadError := err != nil
+	result, err := cadence.WorkflowService_ListArchivedWorkflowExecutions_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ListClosedWorkflowExecutions(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args cadence.WorkflowService_ListClosedWorkflowExecutions_Args
 	if err := args.FromWire(body); err != nil {
@@ -26,16 +26,454 @@ package health
 import (
 	errors "errors"
 	fmt "fmt"
+	multierr "go.uber.org/multierr"
 	wire "go.uber.org/thriftrw/wire"
 	zapcore "go.uber.org/zap/zapcore"
 	strings "strings"
 )
 
+type DependencyStatus struct {
+	Name         string            `json:"name,required"`
+	Ok           bool              `json:"ok,required"`
+	Msg          *string           `json:"msg,omitempty"`
+	LatencyNanos *int64            `json:"latencyNanos,omitempty"`
+	Details      map[string]string `json:"details,omitempty"`
+}
+
+type _Map_String_String_MapItemList map[string]string
+
+func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := wire.NewValueString(v), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_String_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_String_MapItemList) Close() {}
+
+// ToWire translates a DependencyStatus struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DependencyStatus) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	w, err = wire.NewValueString(v.Name), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = wire.NewValueBool(v.Ok), error(nil)
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Msg != nil {
+		w, err = wire.NewValueString(*(v.Msg)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.LatencyNanos != nil {
+		w, err = wire.NewValueI64(*(v.LatencyNanos)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Details != nil {
+		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Details)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := x.Value.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a DependencyStatus struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DependencyStatus struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DependencyStatus
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DependencyStatus) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	okIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = field.Value.GetString(), error(nil)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBool {
+				v.Ok, err = field.Value.GetBool(), error(nil)
+				if err != nil {
+					return err
+				}
+				okIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Msg = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.LatencyNanos = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.Details, err = _Map_String_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of DependencyStatus is required")
+	}
+
+	if !okIsSet {
+		return errors.New("field Ok of DependencyStatus is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DependencyStatus
+// struct.
+func (v *DependencyStatus) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Ok: %v", v.Ok)
+	i++
+	if v.Msg != nil {
+		fields[i] = fmt.Sprintf("Msg: %v", *(v.Msg))
+		i++
+	}
+	if v.LatencyNanos != nil {
+		fields[i] = fmt.Sprintf("LatencyNanos: %v", *(v.LatencyNanos))
+		i++
+	}
+	if v.Details != nil {
+		fields[i] = fmt.Sprintf("Details: %v", v.Details)
+		i++
+	}
+
+	return fmt.Sprintf("DependencyStatus{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Map_String_String_Equals(lhs, rhs map[string]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this DependencyStatus match the
+// provided DependencyStatus.
+//
+// This function performs a deep comparison.
+func (v *DependencyStatus) Equals(rhs *DependencyStatus) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !(v.Ok == rhs.Ok) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Msg, rhs.Msg) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.LatencyNanos, rhs.LatencyNanos) {
+		return false
+	}
+	if !((v.Details == nil && rhs.Details == nil) || (v.Details != nil && rhs.Details != nil && _Map_String_String_Equals(v.Details, rhs.Details))) {
+		return false
+	}
+
+	return true
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DependencyStatus.
+func (v *DependencyStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	enc.AddString("name", v.Name)
+	enc.AddBool("ok", v.Ok)
+	if v.Msg != nil {
+		enc.AddString("msg", *v.Msg)
+	}
+	if v.LatencyNanos != nil {
+		enc.AddInt64("latencyNanos", *v.LatencyNanos)
+	}
+	if v.Details != nil {
+		err = multierr.Append(err, enc.AddObject("details", (_Map_String_String_Zapper)(v.Details)))
+	}
+	return err
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+func (v *DependencyStatus) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetOk returns the value of Ok if it is set or its
+// zero value if it is unset.
+func (v *DependencyStatus) GetOk() (o bool) {
+	if v != nil {
+		o = v.Ok
+	}
+	return
+}
+
+// GetMsg returns the value of Msg if it is set or its
+// zero value if it is unset.
+func (v *DependencyStatus) GetMsg() (o string) {
+	if v != nil && v.Msg != nil {
+		return *v.Msg
+	}
+
+	return
+}
+
+// IsSetMsg returns true if Msg is not nil.
+func (v *DependencyStatus) IsSetMsg() bool {
+	return v != nil && v.Msg != nil
+}
+
+// GetLatencyNanos returns the value of LatencyNanos if it is set or its
+// zero value if it is unset.
+func (v *DependencyStatus) GetLatencyNanos() (o int64) {
+	if v != nil && v.LatencyNanos != nil {
+		return *v.LatencyNanos
+	}
+
+	return
+}
+
+// IsSetLatencyNanos returns true if LatencyNanos is not nil.
+func (v *DependencyStatus) IsSetLatencyNanos() bool {
+	return v != nil && v.LatencyNanos != nil
+}
+
+// GetDetails returns the value of Details if it is set or its
+// zero value if it is unset.
+func (v *DependencyStatus) GetDetails() (o map[string]string) {
+	if v != nil && v.Details != nil {
+		return v.Details
+	}
+
+	return
+}
+
+// IsSetDetails returns true if Details is not nil.
+func (v *DependencyStatus) IsSetDetails() bool {
+	return v != nil && v.Details != nil
+}
+
 type HealthStatus struct {
-	Ok  bool    `json:"ok,required"`
-	Msg *string `json:"msg,omitempty"`
+	Ok           bool                `json:"ok,required"`
+	Msg          *string             `json:"msg,omitempty"`
+	Dependencies []*DependencyStatus `json:"dependencies,omitempty"`
+}
+
+type _List_DependencyStatus_ValueList []*DependencyStatus
+
+func (v _List_DependencyStatus_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
+func (v _List_DependencyStatus_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DependencyStatus_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DependencyStatus_ValueList) Close() {}
+
 // ToWire translates a HealthStatus struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -53,7 +491,7 @@ type HealthStatus struct {
 //   }
 func (v *HealthStatus) ToWire() (wire.Value, error) {
 	var (
-		fields [2]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -73,10 +511,42 @@ func (v *HealthStatus) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.Dependencies != nil {
+		w, err = wire.NewValueList(_List_DependencyStatus_ValueList(v.Dependencies)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
+func _DependencyStatus_Read(w wire.Value) (*DependencyStatus, error) {
+	var v DependencyStatus
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DependencyStatus_Read(l wire.ValueList) ([]*DependencyStatus, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*DependencyStatus, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DependencyStatus_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a HealthStatus struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -118,6 +588,14 @@ func (v *HealthStatus) FromWire(w wire.Value) error {
 					return err
 				}
 
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Dependencies, err = _List_DependencyStatus_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
 			}
 		}
 	}
@@ -136,7 +614,7 @@ func (v *HealthStatus) String() string {
 		return "<nil>"
 	}
 
-	var fields [2]string
+	var fields [3]string
 	i := 0
 	fields[i] = fmt.Sprintf("Ok: %v", v.Ok)
 	i++
@@ -144,18 +622,27 @@ func (v *HealthStatus) String() string {
 		fields[i] = fmt.Sprintf("Msg: %v", *(v.Msg))
 		i++
 	}
+	if v.Dependencies != nil {
+		fields[i] = fmt.Sprintf("Dependencies: %v", v.Dependencies)
+		i++
+	}
 
 	return fmt.Sprintf("HealthStatus{%v}", strings.Join(fields[:i], ", "))
 }
 
-func _String_EqualsPtr(lhs, rhs *string) bool {
-	if lhs != nil && rhs != nil {
+func _List_DependencyStatus_Equals(lhs, rhs []*DependencyStatus) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
-	return lhs == nil && rhs == nil
+
+	return true
 }
 
 // Equals returns true if all the fields of this HealthStatus match the
@@ -174,10 +661,24 @@ func (v *HealthStatus) Equals(rhs *HealthStatus) bool {
 	if !_String_EqualsPtr(v.Msg, rhs.Msg) {
 		return false
 	}
+	if !((v.Dependencies == nil && rhs.Dependencies == nil) || (v.Dependencies != nil && rhs.Dependencies != nil && _List_DependencyStatus_Equals(v.Dependencies, rhs.Dependencies))) {
+		return false
+	}
 
 	return true
 }
 
+type _List_DependencyStatus_Zapper []*DependencyStatus
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DependencyStatus_Zapper.
+func (l _List_DependencyStatus_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
 // fast logging of HealthStatus.
 func (v *HealthStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
@@ -188,6 +689,9 @@ func (v *HealthStatus) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v.Msg != nil {
 		enc.AddString("msg", *v.Msg)
 	}
+	if v.Dependencies != nil {
+		err = multierr.Append(err, enc.AddArray("dependencies", (_List_DependencyStatus_Zapper)(v.Dependencies)))
+	}
 	return err
 }
 
@@ -214,3 +718,18 @@ func (v *HealthStatus) GetMsg() (o string) {
 func (v *HealthStatus) IsSetMsg() bool {
 	return v != nil && v.Msg != nil
 }
+
+// GetDependencies returns the value of Dependencies if it is set or its
+// zero value if it is unset.
+func (v *HealthStatus) GetDependencies() (o []*DependencyStatus) {
+	if v != nil && v.Dependencies != nil {
+		return v.Dependencies
+	}
+
+	return
+}
+
+// IsSetDependencies returns true if Dependencies is not nil.
+func (v *HealthStatus) IsSetDependencies() bool {
+	return v != nil && v.Dependencies != nil
+}
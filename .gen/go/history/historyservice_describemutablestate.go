@@ -458,12 +458,6 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 	return &v, err
 }
 
-func _ShardOwnershipLostError_Read(w wire.Value) (*ShardOwnershipLostError, error) {
-	var v ShardOwnershipLostError
-	err := v.FromWire(w)
-	return &v, err
-}
-
 func _LimitExceededError_Read(w wire.Value) (*shared.LimitExceededError, error) {
 	var v shared.LimitExceededError
 	err := v.FromWire(w)
@@ -36,6 +36,12 @@ import (
 
 // Interface is a client for the HistoryService service.
 type Interface interface {
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -48,18 +54,48 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.DescribeMutableStateResponse, error)
 
+	DescribeShard(
+		ctx context.Context,
+		Request *shared.DescribeShardRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeShardResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	DropDLQTask(
+		ctx context.Context,
+		Request *shared.DropDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	GetMutableState(
 		ctx context.Context,
 		GetRequest *history.GetMutableStateRequest,
 		opts ...yarpc.CallOption,
 	) (*history.GetMutableStateResponse, error)
 
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	RebuildMutableState(
+		ctx context.Context,
+		Request *history.RebuildMutableStateRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.RebuildMutableStateResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -84,6 +120,24 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *history.RefreshWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	ReloadShard(
+		ctx context.Context,
+		Request *shared.ReloadShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RemoveShardQueueTask(
+		ctx context.Context,
+		Request *shared.RemoveShardQueueTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -150,6 +204,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RetryDLQTask(
+		ctx context.Context,
+		Request *shared.RetryDLQTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -217,6 +277,29 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) CloseShard(
+	ctx context.Context,
+	_Request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_CloseShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_CloseShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_CloseShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -263,6 +346,29 @@ func (c client) DescribeMutableState(
 	return
 }
 
+func (c client) DescribeShard(
+	ctx context.Context,
+	_Request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeShardResponse, err error) {
+
+	args := history.HistoryService_DescribeShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DescribeShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_DescribeShard_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_DescribeRequest *history.DescribeWorkflowExecutionRequest,
@@ -286,6 +392,29 @@ func (c client) DescribeWorkflowExecution(
 	return
 }
 
+func (c client) DropDLQTask(
+	ctx context.Context,
+	_Request *shared.DropDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_DropDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_DropDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_DropDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) GetMutableState(
 	ctx context.Context,
 	_GetRequest *history.GetMutableStateRequest,
@@ -309,6 +438,75 @@ func (c client) GetMutableState(
 	return
 }
 
+func (c client) ListDLQTasks(
+	ctx context.Context,
+	_Request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListDLQTasksResponse, err error) {
+
+	args := history.HistoryService_ListDLQTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ListDLQTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ListDLQTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ListShardQueueTasks(
+	ctx context.Context,
+	_Request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListShardQueueTasksResponse, err error) {
+
+	args := history.HistoryService_ListShardQueueTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ListShardQueueTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_ListShardQueueTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RebuildMutableState(
+	ctx context.Context,
+	_Request *history.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.RebuildMutableStateResponse, err error) {
+
+	args := history.HistoryService_RebuildMutableState_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RebuildMutableState_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = history.HistoryService_RebuildMutableState_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	_HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -401,6 +599,75 @@ func (c client) RecordDecisionTaskStarted(
 	return
 }
 
+func (c client) RefreshWorkflowTasks(
+	ctx context.Context,
+	_Request *history.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RefreshWorkflowTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RefreshWorkflowTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RefreshWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) ReloadShard(
+	ctx context.Context,
+	_Request *shared.ReloadShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_ReloadShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_ReloadShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_ReloadShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RemoveShardQueueTask(
+	ctx context.Context,
+	_Request *shared.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RemoveShardQueueTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RemoveShardQueueTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RemoveShardQueueTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RemoveSignalMutableState(
 	ctx context.Context,
 	_RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -654,6 +921,29 @@ func (c client) RespondDecisionTaskFailed(
 	return
 }
 
+func (c client) RetryDLQTask(
+	ctx context.Context,
+	_Request *shared.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := history.HistoryService_RetryDLQTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result history.HistoryService_RetryDLQTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = history.HistoryService_RetryDLQTask_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) ScheduleDecisionTask(
 	ctx context.Context,
 	_ScheduleRequest *history.ScheduleDecisionTaskRequest,
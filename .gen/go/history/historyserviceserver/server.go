@@ -34,6 +34,11 @@ import (
 
 // Interface is the server-side interface for the HistoryService service.
 type Interface interface {
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+	) error
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
@@ -44,16 +49,41 @@ type Interface interface {
 		Request *history.DescribeMutableStateRequest,
 	) (*history.DescribeMutableStateResponse, error)
 
+	DescribeShard(
+		ctx context.Context,
+		Request *shared.DescribeShardRequest,
+	) (*shared.DescribeShardResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		DescribeRequest *history.DescribeWorkflowExecutionRequest,
 	) (*shared.DescribeWorkflowExecutionResponse, error)
 
+	DropDLQTask(
+		ctx context.Context,
+		Request *shared.DropDLQTaskRequest,
+	) error
+
 	GetMutableState(
 		ctx context.Context,
 		GetRequest *history.GetMutableStateRequest,
 	) (*history.GetMutableStateResponse, error)
 
+	ListDLQTasks(
+		ctx context.Context,
+		Request *shared.ListDLQTasksRequest,
+	) (*shared.ListDLQTasksResponse, error)
+
+	ListShardQueueTasks(
+		ctx context.Context,
+		Request *shared.ListShardQueueTasksRequest,
+	) (*shared.ListShardQueueTasksResponse, error)
+
+	RebuildMutableState(
+		ctx context.Context,
+		Request *history.RebuildMutableStateRequest,
+	) (*shared.RebuildMutableStateResponse, error)
+
 	RecordActivityTaskHeartbeat(
 		ctx context.Context,
 		HeartbeatRequest *history.RecordActivityTaskHeartbeatRequest,
@@ -74,6 +104,21 @@ type Interface interface {
 		AddRequest *history.RecordDecisionTaskStartedRequest,
 	) (*history.RecordDecisionTaskStartedResponse, error)
 
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *history.RefreshWorkflowTasksRequest,
+	) error
+
+	ReloadShard(
+		ctx context.Context,
+		Request *shared.ReloadShardRequest,
+	) error
+
+	RemoveShardQueueTask(
+		ctx context.Context,
+		Request *shared.RemoveShardQueueTaskRequest,
+	) error
+
 	RemoveSignalMutableState(
 		ctx context.Context,
 		RemoveRequest *history.RemoveSignalMutableStateRequest,
@@ -129,6 +174,11 @@ type Interface interface {
 		FailedRequest *history.RespondDecisionTaskFailedRequest,
 	) error
 
+	RetryDLQTask(
+		ctx context.Context,
+		Request *shared.RetryDLQTaskRequest,
+	) error
+
 	ScheduleDecisionTask(
 		ctx context.Context,
 		ScheduleRequest *history.ScheduleDecisionTaskRequest,
@@ -176,6 +226,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		Name: "HistoryService",
 		Methods: []thrift.Method{
 
+			thrift.Method{
+				Name: "CloseShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.CloseShard),
+				},
+				Signature:    "CloseShard(Request *shared.CloseShardRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeHistoryHost",
 				HandlerSpec: thrift.HandlerSpec{
@@ -198,6 +259,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DescribeShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DescribeShard),
+				},
+				Signature:    "DescribeShard(Request *shared.DescribeShardRequest) (*shared.DescribeShardResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "DescribeWorkflowExecution",
 				HandlerSpec: thrift.HandlerSpec{
@@ -209,6 +281,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "DropDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.DropDLQTask),
+				},
+				Signature:    "DropDLQTask(Request *shared.DropDLQTaskRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "GetMutableState",
 				HandlerSpec: thrift.HandlerSpec{
@@ -220,6 +303,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ListDLQTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListDLQTasks),
+				},
+				Signature:    "ListDLQTasks(Request *shared.ListDLQTasksRequest) (*shared.ListDLQTasksResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ListShardQueueTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ListShardQueueTasks),
+				},
+				Signature:    "ListShardQueueTasks(Request *shared.ListShardQueueTasksRequest) (*shared.ListShardQueueTasksResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RebuildMutableState",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RebuildMutableState),
+				},
+				Signature:    "RebuildMutableState(Request *history.RebuildMutableStateRequest) (*shared.RebuildMutableStateResponse)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RecordActivityTaskHeartbeat",
 				HandlerSpec: thrift.HandlerSpec{
@@ -264,6 +380,39 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RefreshWorkflowTasks",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RefreshWorkflowTasks),
+				},
+				Signature:    "RefreshWorkflowTasks(Request *history.RefreshWorkflowTasksRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "ReloadShard",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.ReloadShard),
+				},
+				Signature:    "ReloadShard(Request *shared.ReloadShardRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
+			thrift.Method{
+				Name: "RemoveShardQueueTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RemoveShardQueueTask),
+				},
+				Signature:    "RemoveShardQueueTask(Request *shared.RemoveShardQueueTaskRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RemoveSignalMutableState",
 				HandlerSpec: thrift.HandlerSpec{
@@ -385,6 +534,17 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: history.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RetryDLQTask",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:  transport.Unary,
+					Unary: thrift.UnaryHandler(h.RetryDLQTask),
+				},
+				Signature:    "RetryDLQTask(Request *shared.RetryDLQTaskRequest)",
+				ThriftModule: history.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "ScheduleDecisionTask",
 				HandlerSpec: thrift.HandlerSpec{
@@ -464,13 +624,32 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 26)
+	procedures := make([]transport.Procedure, 0, 36)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
 
 type handler struct{ impl Interface }
 
+func (h handler) CloseShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_CloseShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.CloseShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_CloseShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeHistoryHost(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeHistoryHost_Args
 	if err := args.FromWire(body); err != nil {
@@ -509,6 +688,25 @@ func (h handler) DescribeMutableState(ctx context.Context, body wire.Value) (thr
 	return response, err
 }
 
+func (h handler) DescribeShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DescribeShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.DescribeShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_DescribeShard_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_DescribeWorkflowExecution_Args
 	if err := args.FromWire(body); err != nil {
@@ -528,6 +726,25 @@ func (h handler) DescribeWorkflowExecution(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) DropDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_DropDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.DropDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_DropDLQTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_GetMutableState_Args
 	if err := args.FromWire(body); err != nil {
@@ -547,6 +764,63 @@ func (h handler) GetMutableState(ctx context.Context, body wire.Value) (thrift.R
 	return response, err
 }
 
+func (h handler) ListDLQTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ListDLQTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListDLQTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ListDLQTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ListShardQueueTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ListShardQueueTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.ListShardQueueTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ListShardQueueTasks_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RebuildMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RebuildMutableState_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	success, err := h.impl.RebuildMutableState(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_RebuildMutableState_Helper.WrapResponse(success, err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RecordActivityTaskHeartbeat(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RecordActivityTaskHeartbeat_Args
 	if err := args.FromWire(body); err != nil {
@@ -623,6 +897,63 @@ func (h handler) RecordDecisionTaskStarted(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RefreshWorkflowTasks_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RefreshWorkflowTasks(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_RefreshWorkflowTasks_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) ReloadShard(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_ReloadShard_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.ReloadShard(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_ReloadShard_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
+func (h handler) RemoveShardQueueTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RemoveShardQueueTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RemoveShardQueueTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_RemoveShardQueueTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) RemoveSignalMutableState(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_RemoveSignalMutableState_Args
 	if err := args.FromWire(body); err != nil {
@@ -832,6 +1163,25 @@ func (h handler) RespondDecisionTaskFailed(ctx context.Context, body wire.Value)
 	return response, err
 }
 
+func (h handler) RetryDLQTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args history.HistoryService_RetryDLQTask_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, err
+	}
+
+	err := h.impl.RetryDLQTask(ctx, args.Request)
+
+	hadError := err != nil
+	result, err := history.HistoryService_RetryDLQTask_Helper.WrapResponse(err)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+	}
+	return response, err
+}
+
 func (h handler) ScheduleDecisionTask(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args history.HistoryService_ScheduleDecisionTask_Args
 	if err := args.FromWire(body); err != nil {
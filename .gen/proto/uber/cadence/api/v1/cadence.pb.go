@@ -0,0 +1,2266 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: uber/cadence/api/v1/cadence.proto
+
+package v1
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type RegisterDomainResponse struct {
+}
+
+func (m *RegisterDomainResponse) Reset()      { *m = RegisterDomainResponse{} }
+func (*RegisterDomainResponse) ProtoMessage() {}
+func (*RegisterDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{0}
+}
+func (m *RegisterDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisterDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDomainResponse.Merge(m, src)
+}
+func (m *RegisterDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterDomainResponse proto.InternalMessageInfo
+
+type DeprecateDomainResponse struct {
+}
+
+func (m *DeprecateDomainResponse) Reset()      { *m = DeprecateDomainResponse{} }
+func (*DeprecateDomainResponse) ProtoMessage() {}
+func (*DeprecateDomainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{1}
+}
+func (m *DeprecateDomainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeprecateDomainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeprecateDomainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeprecateDomainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeprecateDomainResponse.Merge(m, src)
+}
+func (m *DeprecateDomainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeprecateDomainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeprecateDomainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeprecateDomainResponse proto.InternalMessageInfo
+
+type RespondDecisionTaskFailedResponse struct {
+}
+
+func (m *RespondDecisionTaskFailedResponse) Reset()      { *m = RespondDecisionTaskFailedResponse{} }
+func (*RespondDecisionTaskFailedResponse) ProtoMessage() {}
+func (*RespondDecisionTaskFailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{2}
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondDecisionTaskFailedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondDecisionTaskFailedResponse.Merge(m, src)
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondDecisionTaskFailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondDecisionTaskFailedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondDecisionTaskFailedResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCompletedResponse struct {
+}
+
+func (m *RespondActivityTaskCompletedResponse) Reset()      { *m = RespondActivityTaskCompletedResponse{} }
+func (*RespondActivityTaskCompletedResponse) ProtoMessage() {}
+func (*RespondActivityTaskCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{3}
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCompletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCompletedResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCompletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCompletedResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCompletedByIDResponse struct {
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) Reset() {
+	*m = RespondActivityTaskCompletedByIDResponse{}
+}
+func (*RespondActivityTaskCompletedByIDResponse) ProtoMessage() {}
+func (*RespondActivityTaskCompletedByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{4}
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCompletedByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCompletedByIDResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCompletedByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCompletedByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCompletedByIDResponse proto.InternalMessageInfo
+
+type RespondActivityTaskFailedResponse struct {
+}
+
+func (m *RespondActivityTaskFailedResponse) Reset()      { *m = RespondActivityTaskFailedResponse{} }
+func (*RespondActivityTaskFailedResponse) ProtoMessage() {}
+func (*RespondActivityTaskFailedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{5}
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskFailedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskFailedResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskFailedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskFailedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskFailedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskFailedResponse proto.InternalMessageInfo
+
+type RespondActivityTaskFailedByIDResponse struct {
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) Reset()      { *m = RespondActivityTaskFailedByIDResponse{} }
+func (*RespondActivityTaskFailedByIDResponse) ProtoMessage() {}
+func (*RespondActivityTaskFailedByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{6}
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskFailedByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskFailedByIDResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskFailedByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskFailedByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskFailedByIDResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCanceledResponse struct {
+}
+
+func (m *RespondActivityTaskCanceledResponse) Reset()      { *m = RespondActivityTaskCanceledResponse{} }
+func (*RespondActivityTaskCanceledResponse) ProtoMessage() {}
+func (*RespondActivityTaskCanceledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{7}
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCanceledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCanceledResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCanceledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCanceledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCanceledResponse proto.InternalMessageInfo
+
+type RespondActivityTaskCanceledByIDResponse struct {
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) Reset() {
+	*m = RespondActivityTaskCanceledByIDResponse{}
+}
+func (*RespondActivityTaskCanceledByIDResponse) ProtoMessage() {}
+func (*RespondActivityTaskCanceledByIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{8}
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondActivityTaskCanceledByIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondActivityTaskCanceledByIDResponse.Merge(m, src)
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondActivityTaskCanceledByIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondActivityTaskCanceledByIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondActivityTaskCanceledByIDResponse proto.InternalMessageInfo
+
+type RequestCancelWorkflowExecutionResponse struct {
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Reset() {
+	*m = RequestCancelWorkflowExecutionResponse{}
+}
+func (*RequestCancelWorkflowExecutionResponse) ProtoMessage() {}
+func (*RequestCancelWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{9}
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RequestCancelWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestCancelWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestCancelWorkflowExecutionResponse proto.InternalMessageInfo
+
+type SignalWorkflowExecutionResponse struct {
+}
+
+func (m *SignalWorkflowExecutionResponse) Reset()      { *m = SignalWorkflowExecutionResponse{} }
+func (*SignalWorkflowExecutionResponse) ProtoMessage() {}
+func (*SignalWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{10}
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SignalWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SignalWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *SignalWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SignalWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SignalWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SignalWorkflowExecutionResponse proto.InternalMessageInfo
+
+type TerminateWorkflowExecutionResponse struct {
+}
+
+func (m *TerminateWorkflowExecutionResponse) Reset()      { *m = TerminateWorkflowExecutionResponse{} }
+func (*TerminateWorkflowExecutionResponse) ProtoMessage() {}
+func (*TerminateWorkflowExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{11}
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TerminateWorkflowExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.Merge(m, src)
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TerminateWorkflowExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TerminateWorkflowExecutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TerminateWorkflowExecutionResponse proto.InternalMessageInfo
+
+type RespondQueryTaskCompletedResponse struct {
+}
+
+func (m *RespondQueryTaskCompletedResponse) Reset()      { *m = RespondQueryTaskCompletedResponse{} }
+func (*RespondQueryTaskCompletedResponse) ProtoMessage() {}
+func (*RespondQueryTaskCompletedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d5c60c45675b178e, []int{12}
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RespondQueryTaskCompletedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespondQueryTaskCompletedResponse.Merge(m, src)
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RespondQueryTaskCompletedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespondQueryTaskCompletedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespondQueryTaskCompletedResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*RegisterDomainResponse)(nil), "uber.cadence.api.v1.RegisterDomainResponse")
+	proto.RegisterType((*DeprecateDomainResponse)(nil), "uber.cadence.api.v1.DeprecateDomainResponse")
+	proto.RegisterType((*RespondDecisionTaskFailedResponse)(nil), "uber.cadence.api.v1.RespondDecisionTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCompletedResponse")
+	proto.RegisterType((*RespondActivityTaskCompletedByIDResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCompletedByIDResponse")
+	proto.RegisterType((*RespondActivityTaskFailedResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskFailedResponse")
+	proto.RegisterType((*RespondActivityTaskFailedByIDResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskFailedByIDResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCanceledResponse")
+	proto.RegisterType((*RespondActivityTaskCanceledByIDResponse)(nil), "uber.cadence.api.v1.RespondActivityTaskCanceledByIDResponse")
+	proto.RegisterType((*RequestCancelWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.RequestCancelWorkflowExecutionResponse")
+	proto.RegisterType((*SignalWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.SignalWorkflowExecutionResponse")
+	proto.RegisterType((*TerminateWorkflowExecutionResponse)(nil), "uber.cadence.api.v1.TerminateWorkflowExecutionResponse")
+	proto.RegisterType((*RespondQueryTaskCompletedResponse)(nil), "uber.cadence.api.v1.RespondQueryTaskCompletedResponse")
+}
+
+func init() { proto.RegisterFile("uber/cadence/api/v1/cadence.proto", fileDescriptor_d5c60c45675b178e) }
+
+var fileDescriptor_d5c60c45675b178e = []byte{
+	// 1005 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x5c, 0x38, 0x3c, 0x7e, 0x14, 0x4d, 0xa5, 0x86, 0x06, 0xd8, 0x26, 0xe9, 0x8f, 0xa4,
+	0xa1, 0xd8, 0xb4, 0xa5, 0x69, 0xda, 0x86, 0x43, 0x5b, 0x53, 0x8a, 0x84, 0x44, 0x71, 0x8a, 0x90,
+	0x38, 0xb1, 0x5e, 0xbf, 0x26, 0xa3, 0xd8, 0x3b, 0xcb, 0xee, 0xd8, 0x34, 0x37, 0x90, 0x10, 0x48,
+	0x48, 0x48, 0x95, 0x10, 0x08, 0x24, 0x24, 0xae, 0xfc, 0x29, 0x1c, 0x73, 0xec, 0x91, 0x38, 0x17,
+	0x8e, 0xfd, 0x13, 0xd0, 0x7a, 0x67, 0xd3, 0x59, 0xef, 0xcc, 0xf3, 0xae, 0x7b, 0x4b, 0xec, 0xef,
+	0x7b, 0xdf, 0xe7, 0xd9, 0xf7, 0x66, 0xbe, 0x59, 0x58, 0x1e, 0x76, 0x31, 0x6e, 0x05, 0x7e, 0x0f,
+	0xc3, 0x00, 0x5b, 0x7e, 0x24, 0x5a, 0xa3, 0xcb, 0xf9, 0xbf, 0xcd, 0x28, 0x96, 0x4a, 0xf2, 0x93,
+	0x29, 0xa4, 0x99, 0x7f, 0xe6, 0x47, 0xa2, 0x39, 0xba, 0xbc, 0xb8, 0x64, 0xe3, 0x25, 0xbb, 0x7e,
+	0x8c, 0xbd, 0x8c, 0xb6, 0xf2, 0x06, 0x9c, 0xea, 0xe0, 0x8e, 0x48, 0x14, 0xc6, 0x6d, 0x39, 0xf0,
+	0x45, 0xd8, 0xc1, 0x24, 0x92, 0x61, 0x82, 0x2b, 0xa7, 0x61, 0xa1, 0x8d, 0x51, 0x8c, 0x81, 0xaf,
+	0x70, 0xea, 0xab, 0xb3, 0xb0, 0x9c, 0xfd, 0xdd, 0x6b, 0x63, 0x20, 0x12, 0x21, 0xc3, 0x87, 0x7e,
+	0xb2, 0x77, 0xcf, 0x17, 0x7d, 0xec, 0x1d, 0x83, 0x2e, 0xc0, 0x39, 0x0d, 0xba, 0x1d, 0x28, 0x31,
+	0x12, 0x6a, 0x3f, 0x05, 0xdd, 0x95, 0x83, 0xa8, 0x8f, 0xca, 0xc0, 0xad, 0xc3, 0x1a, 0x85, 0xbb,
+	0xb3, 0xff, 0x71, 0xdb, 0x22, 0x6c, 0x62, 0xa7, 0x84, 0x57, 0xe1, 0xbc, 0x13, 0x54, 0xa8, 0x76,
+	0x1e, 0xce, 0xda, 0x94, 0xfd, 0x30, 0x40, 0xb3, 0xde, 0x45, 0x58, 0x25, 0x60, 0x85, 0x8a, 0x6b,
+	0x70, 0xa1, 0x83, 0x5f, 0x0f, 0x31, 0x51, 0xd9, 0xd7, 0x5f, 0xc8, 0x78, 0xef, 0x51, 0x5f, 0x7e,
+	0xf3, 0xe1, 0x63, 0x0c, 0x86, 0x4a, 0xc8, 0xe7, 0x4b, 0xb8, 0x0c, 0x67, 0xb6, 0xc5, 0x4e, 0xe8,
+	0x13, 0x90, 0x73, 0xb0, 0xf2, 0x10, 0xe3, 0x81, 0x08, 0x7d, 0x85, 0x6e, 0xd4, 0xf3, 0x25, 0xf9,
+	0x6c, 0x88, 0xb1, 0x7d, 0x8d, 0xaf, 0xfc, 0xb0, 0x02, 0x27, 0xf2, 0x12, 0xdb, 0x18, 0x8f, 0x44,
+	0x80, 0x5c, 0xc0, 0x6b, 0xc5, 0x27, 0xcf, 0xd7, 0x9b, 0x96, 0x1e, 0x6a, 0x4e, 0xb7, 0xc7, 0xe4,
+	0xe7, 0x2d, 0xbe, 0x53, 0x09, 0x9b, 0xc9, 0xa7, 0x52, 0x6d, 0x4c, 0x82, 0x58, 0x74, 0x91, 0x94,
+	0x2a, 0x82, 0x68, 0xa9, 0x69, 0xac, 0x96, 0xfa, 0x0a, 0x5e, 0xfe, 0x44, 0x24, 0x2a, 0xfb, 0x34,
+	0xe1, 0xab, 0x56, 0xae, 0x81, 0xc8, 0x45, 0xd6, 0x66, 0x03, 0xb5, 0x42, 0x00, 0xaf, 0x7c, 0x1e,
+	0xf5, 0x8e, 0x87, 0x82, 0xdb, 0x99, 0x26, 0x24, 0xd7, 0xb8, 0x58, 0x01, 0xa9, 0x45, 0xfa, 0x70,
+	0x62, 0x6a, 0xf8, 0xb8, 0x6b, 0x19, 0xa6, 0x46, 0x34, 0x93, 0xba, 0x54, 0x0d, 0xac, 0xd5, 0xbe,
+	0x63, 0x70, 0x6a, 0x5b, 0xf9, 0xb1, 0x2a, 0xb5, 0x19, 0xbf, 0x62, 0x2d, 0x64, 0x07, 0xe7, 0xe2,
+	0x57, 0x6b, 0x71, 0xb4, 0x87, 0x27, 0x0c, 0xde, 0xfc, 0x08, 0xcb, 0x80, 0xfb, 0x22, 0x51, 0x32,
+	0xde, 0xe7, 0xd7, 0xad, 0x45, 0x09, 0x46, 0xee, 0x66, 0xb3, 0x3e, 0x51, 0x5b, 0x7a, 0x0c, 0x27,
+	0x1f, 0xc8, 0x7e, 0xff, 0x9e, 0x8c, 0xcd, 0x6d, 0x8e, 0xb7, 0xac, 0x05, 0x2d, 0xc8, 0xdc, 0xc1,
+	0x7b, 0xd5, 0x09, 0x5a, 0xf9, 0x17, 0x06, 0x6f, 0x59, 0x76, 0xd8, 0xe3, 0xc1, 0xe6, 0x9b, 0x8e,
+	0xf1, 0x73, 0x53, 0x72, 0x33, 0x37, 0xe6, 0x60, 0x6a, 0x57, 0x3f, 0x31, 0x38, 0xed, 0xdc, 0xf7,
+	0xf9, 0xb5, 0xaa, 0x85, 0xf3, 0xed, 0x3a, 0xf3, 0xb3, 0x51, 0x97, 0x56, 0x7a, 0x38, 0xe6, 0xae,
+	0x4c, 0x3f, 0x1c, 0x13, 0x59, 0xe9, 0xe1, 0x14, 0x09, 0x46, 0xa7, 0x76, 0x30, 0x90, 0x71, 0xe1,
+	0x3c, 0xb8, 0x8f, 0x7e, 0xac, 0xba, 0xe8, 0x2b, 0x47, 0xa7, 0x12, 0x0c, 0xba, 0x53, 0x49, 0xa2,
+	0xb6, 0xf4, 0x3b, 0x83, 0x33, 0x04, 0x2e, 0x3d, 0xa3, 0xf8, 0xad, 0xba, 0xd5, 0xb3, 0x93, 0xed,
+	0x45, 0xad, 0x19, 0xad, 0x6c, 0x3d, 0xdf, 0xe9, 0x56, 0x76, 0x44, 0x87, 0x0a, 0xad, 0x4c, 0x86,
+	0x0e, 0xfe, 0x17, 0x83, 0xa5, 0x59, 0xa9, 0x83, 0x6f, 0xd5, 0xae, 0x6f, 0x2e, 0xd9, 0x07, 0x73,
+	0xb2, 0xcb, 0xc3, 0x56, 0x8e, 0x31, 0xf4, 0xb0, 0xd9, 0xb2, 0x51, 0x85, 0x61, 0x73, 0x47, 0x2a,
+	0xfe, 0x1b, 0x83, 0xb7, 0xc9, 0x4c, 0xc5, 0x6f, 0xd4, 0xab, 0x6c, 0x2e, 0xd4, 0xcd, 0x79, 0xa8,
+	0x85, 0x59, 0x74, 0x86, 0x33, 0xe7, 0x2c, 0x12, 0xa9, 0x8f, 0x6e, 0xf8, 0x99, 0x71, 0x91, 0xff,
+	0x39, 0x99, 0x45, 0x32, 0x2f, 0x3a, 0x67, 0x71, 0x46, 0xca, 0xcc, 0xac, 0x6d, 0xcd, 0x47, 0xd6,
+	0xf6, 0xfe, 0x60, 0xe0, 0xd1, 0x19, 0x95, 0xbb, 0x1e, 0x08, 0x1d, 0x6c, 0x33, 0x73, 0xb7, 0xe6,
+	0xe2, 0x6a, 0x6f, 0xdf, 0x33, 0x58, 0x70, 0xa4, 0x62, 0xee, 0x08, 0x15, 0xae, 0x0c, 0x9d, 0xb9,
+	0x79, 0xbf, 0x1e, 0x49, 0xdb, 0xf8, 0x95, 0xc1, 0x92, 0xc6, 0x08, 0xb5, 0xeb, 0x08, 0x46, 0x5b,
+	0x54, 0x69, 0x27, 0xed, 0x85, 0x22, 0x52, 0x1a, 0xd3, 0x3a, 0x98, 0x60, 0xe5, 0x98, 0x66, 0x07,
+	0xd3, 0x1e, 0x5c, 0x1c, 0xed, 0xe1, 0x67, 0x06, 0x8b, 0xee, 0x5b, 0x09, 0xb7, 0x6f, 0x30, 0xd4,
+	0x35, 0x26, 0xf3, 0x72, 0xbd, 0x36, 0xcf, 0xf0, 0x93, 0xa6, 0xf4, 0x4f, 0x23, 0x0c, 0x4b, 0xa8,
+	0xc4, 0xe1, 0xc7, 0x4d, 0xa0, 0xfd, 0x50, 0x3c, 0xe3, 0xb8, 0x4b, 0x61, 0x77, 0xfb, 0x32, 0xc1,
+	0x9e, 0xc5, 0xd1, 0xa6, 0xb3, 0xb2, 0x8b, 0x42, 0x1f, 0x77, 0x34, 0xd3, 0x18, 0xfa, 0x14, 0x78,
+	0x3b, 0x0e, 0x76, 0xc5, 0xc8, 0xea, 0xeb, 0xa6, 0xb3, 0xba, 0x9b, 0x44, 0x0f, 0xfd, 0x2c, 0x6e,
+	0xf9, 0xa0, 0x2b, 0xdf, 0x60, 0xe9, 0x83, 0xce, 0x76, 0xe3, 0xad, 0x70, 0xd0, 0xb9, 0x2f, 0xca,
+	0x69, 0xaa, 0x9c, 0x0c, 0xc0, 0xb6, 0x12, 0xc1, 0xde, 0x04, 0x93, 0xfe, 0x0a, 0x47, 0xaa, 0xb4,
+	0x20, 0xe9, 0x54, 0x69, 0x25, 0x68, 0xe5, 0x47, 0xf0, 0xea, 0xc4, 0x57, 0xbe, 0x52, 0xdc, 0x7e,
+	0x5b, 0x2c, 0x60, 0x72, 0xb5, 0xf5, 0x2a, 0x50, 0x63, 0xb9, 0xf3, 0xbb, 0x73, 0x79, 0x7e, 0xaf,
+	0x91, 0x77, 0x6d, 0xe7, 0xf8, 0x6e, 0xd4, 0xa5, 0x69, 0x33, 0x12, 0x5e, 0xcf, 0x41, 0xc7, 0x6b,
+	0x7d, 0x89, 0xac, 0x35, 0xbd, 0xd0, 0xef, 0x56, 0x44, 0x67, 0x82, 0x77, 0x7e, 0x64, 0x07, 0x87,
+	0x5e, 0xe3, 0xe9, 0xa1, 0xd7, 0x78, 0x76, 0xe8, 0xb1, 0x6f, 0xc7, 0x1e, 0xfb, 0x7b, 0xec, 0xb1,
+	0x7f, 0xc6, 0x1e, 0x3b, 0x18, 0x7b, 0xec, 0xdf, 0xb1, 0xc7, 0xfe, 0x1b, 0x7b, 0x8d, 0x67, 0x63,
+	0x8f, 0x3d, 0x39, 0xf2, 0x1a, 0x07, 0x47, 0x5e, 0xe3, 0xe9, 0x91, 0xd7, 0x80, 0x85, 0x40, 0x0e,
+	0x6c, 0x42, 0x0f, 0xd8, 0x97, 0x1b, 0x3b, 0x42, 0xed, 0x0e, 0xbb, 0xcd, 0x40, 0x0e, 0x5a, 0x85,
+	0xb7, 0x6d, 0xcd, 0x1d, 0x0c, 0x5b, 0x93, 0x97, 0x6c, 0x2d, 0xcb, 0x5b, 0xb8, 0xee, 0x4b, 0x93,
+	0xaf, 0xae, 0xfe, 0x3f, 0x00, 0xd5, 0x8d, 0x19, 0x93, 0xdb, 0x13, 0x00, 0x00,
+}
+
+func (this *RegisterDomainResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterDomainResponse)
+	if !ok {
+		that2, ok := that.(RegisterDomainResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *DeprecateDomainResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeprecateDomainResponse)
+	if !ok {
+		that2, ok := that.(DeprecateDomainResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondDecisionTaskFailedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		that2, ok := that.(RespondDecisionTaskFailedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondActivityTaskCompletedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		that2, ok := that.(RespondActivityTaskCompletedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondActivityTaskCompletedByIDResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		that2, ok := that.(RespondActivityTaskCompletedByIDResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondActivityTaskFailedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		that2, ok := that.(RespondActivityTaskFailedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondActivityTaskFailedByIDResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		that2, ok := that.(RespondActivityTaskFailedByIDResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondActivityTaskCanceledResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		that2, ok := that.(RespondActivityTaskCanceledResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondActivityTaskCanceledByIDResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		that2, ok := that.(RespondActivityTaskCanceledByIDResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RequestCancelWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RequestCancelWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(RequestCancelWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *SignalWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SignalWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(SignalWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *TerminateWorkflowExecutionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TerminateWorkflowExecutionResponse)
+	if !ok {
+		that2, ok := that.(TerminateWorkflowExecutionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RespondQueryTaskCompletedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		that2, ok := that.(RespondQueryTaskCompletedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RegisterDomainResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RegisterDomainResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeprecateDomainResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.DeprecateDomainResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondDecisionTaskFailedResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondDecisionTaskFailedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondActivityTaskCompletedResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondActivityTaskCompletedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondActivityTaskCompletedByIDResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondActivityTaskCompletedByIDResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondActivityTaskFailedResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondActivityTaskFailedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondActivityTaskFailedByIDResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondActivityTaskFailedByIDResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondActivityTaskCanceledResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondActivityTaskCanceledResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondActivityTaskCanceledByIDResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondActivityTaskCanceledByIDResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RequestCancelWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RequestCancelWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SignalWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.SignalWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TerminateWorkflowExecutionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.TerminateWorkflowExecutionResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RespondQueryTaskCompletedResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&v1.RespondQueryTaskCompletedResponse{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCadence(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *RegisterDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *DeprecateDomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeprecateDomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondDecisionTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondDecisionTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskFailedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskFailedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCanceledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCanceledResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SignalWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignalWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TerminateWorkflowExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RespondQueryTaskCompletedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RespondQueryTaskCompletedResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeVarintCadence(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *RegisterDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DeprecateDomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondDecisionTaskFailedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCompletedByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskFailedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskFailedByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCanceledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondActivityTaskCanceledByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RequestCancelWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SignalWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TerminateWorkflowExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RespondQueryTaskCompletedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovCadence(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCadence(x uint64) (n int) {
+	return sovCadence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *RegisterDomainResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterDomainResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeprecateDomainResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeprecateDomainResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondDecisionTaskFailedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondDecisionTaskFailedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCompletedByIDResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCompletedByIDResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskFailedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskFailedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskFailedByIDResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskFailedByIDResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCanceledResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCanceledResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondActivityTaskCanceledByIDResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondActivityTaskCanceledByIDResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RequestCancelWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RequestCancelWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SignalWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SignalWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TerminateWorkflowExecutionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TerminateWorkflowExecutionResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RespondQueryTaskCompletedResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RespondQueryTaskCompletedResponse{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCadence(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *RegisterDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeprecateDomainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeprecateDomainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeprecateDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondDecisionTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondDecisionTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCompletedByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCompletedByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskFailedByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskFailedByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCanceledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondActivityTaskCanceledByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondActivityTaskCanceledByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RequestCancelWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RequestCancelWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignalWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignalWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TerminateWorkflowExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TerminateWorkflowExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RespondQueryTaskCompletedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RespondQueryTaskCompletedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCadence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCadence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCadence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCadence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCadence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCadence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCadence
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthCadence
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowCadence
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipCadence(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthCadence
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthCadence = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCadence   = fmt.Errorf("proto: integer overflow")
+)
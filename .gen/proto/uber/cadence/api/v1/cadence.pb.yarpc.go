@@ -0,0 +1,2365 @@
+// Code generated by protoc-gen-yarpc-go
+// source: uber/cadence/api/v1/cadence.proto
+// DO NOT EDIT!
+
+package v1
+
+import (
+	"context"
+	"io/ioutil"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/fx"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/encoding/protobuf"
+	"go.uber.org/yarpc/encoding/protobuf/reflection"
+)
+
+var _ = ioutil.NopCloser
+
+// WorkflowServiceYARPCClient is the YARPC client-side interface for the WorkflowService service.
+type WorkflowServiceYARPCClient interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest, ...yarpc.CallOption) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest, ...yarpc.CallOption) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest, ...yarpc.CallOption) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest, ...yarpc.CallOption) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest, ...yarpc.CallOption) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest, ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest, ...yarpc.CallOption) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest, ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest, ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest, ...yarpc.CallOption) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest, ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest, ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest, ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest, ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest, ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest, ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest, ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error)
+	ListArchivedWorkflowExecutions(context.Context, *ListArchivedWorkflowExecutionsRequest, ...yarpc.CallOption) (*ListArchivedWorkflowExecutionsResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest, ...yarpc.CallOption) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest, ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
+}
+
+// NewWorkflowServiceYARPCClient builds a new YARPC client for the WorkflowService service.
+func NewWorkflowServiceYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) WorkflowServiceYARPCClient {
+	return &_WorkflowServiceYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.api.v1.WorkflowService",
+			ClientConfig: clientConfig,
+			Options:      options,
+		},
+	)}
+}
+
+// WorkflowServiceYARPCServer is the YARPC server-side interface for the WorkflowService service.
+type WorkflowServiceYARPCServer interface {
+	RegisterDomain(context.Context, *RegisterDomainRequest) (*RegisterDomainResponse, error)
+	DescribeDomain(context.Context, *DescribeDomainRequest) (*DescribeDomainResponse, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsResponse, error)
+	UpdateDomain(context.Context, *UpdateDomainRequest) (*UpdateDomainResponse, error)
+	DeprecateDomain(context.Context, *DeprecateDomainRequest) (*DeprecateDomainResponse, error)
+	StartWorkflowExecution(context.Context, *StartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	GetWorkflowExecutionHistory(context.Context, *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error)
+	PollForDecisionTask(context.Context, *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	RespondDecisionTaskCompleted(context.Context, *RespondDecisionTaskCompletedRequest) (*RespondDecisionTaskCompletedResponse, error)
+	RespondDecisionTaskFailed(context.Context, *RespondDecisionTaskFailedRequest) (*RespondDecisionTaskFailedResponse, error)
+	PollForActivityTask(context.Context, *PollForActivityTaskRequest) (*PollForActivityTaskResponse, error)
+	RecordActivityTaskHeartbeat(context.Context, *RecordActivityTaskHeartbeatRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(context.Context, *RecordActivityTaskHeartbeatByIDRequest) (*RecordActivityTaskHeartbeatResponse, error)
+	RespondActivityTaskCompleted(context.Context, *RespondActivityTaskCompletedRequest) (*RespondActivityTaskCompletedResponse, error)
+	RespondActivityTaskCompletedByID(context.Context, *RespondActivityTaskCompletedByIDRequest) (*RespondActivityTaskCompletedByIDResponse, error)
+	RespondActivityTaskFailed(context.Context, *RespondActivityTaskFailedRequest) (*RespondActivityTaskFailedResponse, error)
+	RespondActivityTaskFailedByID(context.Context, *RespondActivityTaskFailedByIDRequest) (*RespondActivityTaskFailedByIDResponse, error)
+	RespondActivityTaskCanceled(context.Context, *RespondActivityTaskCanceledRequest) (*RespondActivityTaskCanceledResponse, error)
+	RespondActivityTaskCanceledByID(context.Context, *RespondActivityTaskCanceledByIDRequest) (*RespondActivityTaskCanceledByIDResponse, error)
+	RequestCancelWorkflowExecution(context.Context, *RequestCancelWorkflowExecutionRequest) (*RequestCancelWorkflowExecutionResponse, error)
+	SignalWorkflowExecution(context.Context, *SignalWorkflowExecutionRequest) (*SignalWorkflowExecutionResponse, error)
+	SignalWithStartWorkflowExecution(context.Context, *SignalWithStartWorkflowExecutionRequest) (*StartWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(context.Context, *ResetWorkflowExecutionRequest) (*ResetWorkflowExecutionResponse, error)
+	TerminateWorkflowExecution(context.Context, *TerminateWorkflowExecutionRequest) (*TerminateWorkflowExecutionResponse, error)
+	ListOpenWorkflowExecutions(context.Context, *ListOpenWorkflowExecutionsRequest) (*ListOpenWorkflowExecutionsResponse, error)
+	ListClosedWorkflowExecutions(context.Context, *ListClosedWorkflowExecutionsRequest) (*ListClosedWorkflowExecutionsResponse, error)
+	ListArchivedWorkflowExecutions(context.Context, *ListArchivedWorkflowExecutionsRequest) (*ListArchivedWorkflowExecutionsResponse, error)
+	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
+	ResetStickyTaskList(context.Context, *ResetStickyTaskListRequest) (*ResetStickyTaskListResponse, error)
+	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
+	DescribeWorkflowExecution(context.Context, *DescribeWorkflowExecutionRequest) (*DescribeWorkflowExecutionResponse, error)
+	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
+}
+
+// BuildWorkflowServiceYARPCProcedures prepares an implementation of the WorkflowService service for YARPC registration.
+func BuildWorkflowServiceYARPCProcedures(server WorkflowServiceYARPCServer) []transport.Procedure {
+	handler := &_WorkflowServiceYARPCHandler{server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.api.v1.WorkflowService",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "RegisterDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RegisterDomain,
+							NewRequest: newWorkflowServiceServiceRegisterDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeDomain,
+							NewRequest: newWorkflowServiceServiceDescribeDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListDomains",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListDomains,
+							NewRequest: newWorkflowServiceServiceListDomainsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "UpdateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.UpdateDomain,
+							NewRequest: newWorkflowServiceServiceUpdateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DeprecateDomain",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DeprecateDomain,
+							NewRequest: newWorkflowServiceServiceDeprecateDomainYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "StartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.StartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "GetWorkflowExecutionHistory",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.GetWorkflowExecutionHistory,
+							NewRequest: newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForDecisionTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForDecisionTask,
+							NewRequest: newWorkflowServiceServicePollForDecisionTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondDecisionTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondDecisionTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "PollForActivityTask",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.PollForActivityTask,
+							NewRequest: newWorkflowServiceServicePollForActivityTaskYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeat",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeat,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RecordActivityTaskHeartbeatByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RecordActivityTaskHeartbeatByID,
+							NewRequest: newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCompletedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCompletedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailed",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailed,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskFailedByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskFailedByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceled",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceled,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondActivityTaskCanceledByID",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondActivityTaskCanceledByID,
+							NewRequest: newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RequestCancelWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RequestCancelWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "SignalWithStartWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.SignalWithStartWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ResetWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ResetWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "TerminateWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.TerminateWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListOpenWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListOpenWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListClosedWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListClosedWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ListArchivedWorkflowExecutions",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ListArchivedWorkflowExecutions,
+							NewRequest: newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "RespondQueryTaskCompleted",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.RespondQueryTaskCompleted,
+							NewRequest: newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "ResetStickyTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.ResetStickyTaskList,
+							NewRequest: newWorkflowServiceServiceResetStickyTaskListYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "QueryWorkflow",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.QueryWorkflow,
+							NewRequest: newWorkflowServiceServiceQueryWorkflowYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeWorkflowExecution",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeWorkflowExecution,
+							NewRequest: newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest,
+						},
+					),
+				},
+				{
+					MethodName: "DescribeTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:     handler.DescribeTaskList,
+							NewRequest: newWorkflowServiceServiceDescribeTaskListYARPCRequest,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// FxWorkflowServiceYARPCClientParams defines the input
+// for NewFxWorkflowServiceYARPCClient. It provides the
+// paramaters to get a WorkflowServiceYARPCClient in an
+// Fx application.
+type FxWorkflowServiceYARPCClientParams struct {
+	fx.In
+
+	Provider yarpc.ClientConfig
+}
+
+// FxWorkflowServiceYARPCClientResult defines the output
+// of NewFxWorkflowServiceYARPCClient. It provides a
+// WorkflowServiceYARPCClient to an Fx application.
+type FxWorkflowServiceYARPCClientResult struct {
+	fx.Out
+
+	Client WorkflowServiceYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxWorkflowServiceYARPCClient provides a WorkflowServiceYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  v1.NewFxWorkflowServiceYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxWorkflowServiceYARPCClientParams) FxWorkflowServiceYARPCClientResult {
+		return FxWorkflowServiceYARPCClientResult{
+			Client: NewWorkflowServiceYARPCClient(params.Provider.ClientConfig(name), options...),
+		}
+	}
+}
+
+// FxWorkflowServiceYARPCProceduresParams defines the input
+// for NewFxWorkflowServiceYARPCProcedures. It provides the
+// paramaters to get WorkflowServiceYARPCServer procedures in an
+// Fx application.
+type FxWorkflowServiceYARPCProceduresParams struct {
+	fx.In
+
+	Server WorkflowServiceYARPCServer
+}
+
+// FxWorkflowServiceYARPCProceduresResult defines the output
+// of NewFxWorkflowServiceYARPCProcedures. It provides
+// WorkflowServiceYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxWorkflowServiceYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxWorkflowServiceYARPCProcedures provides WorkflowServiceYARPCServer procedures to an Fx application.
+// It expects a WorkflowServiceYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  v1.NewFxWorkflowServiceYARPCProcedures(),
+//	  ...
+//	)
+func NewFxWorkflowServiceYARPCProcedures() interface{} {
+	return func(params FxWorkflowServiceYARPCProceduresParams) FxWorkflowServiceYARPCProceduresResult {
+		return FxWorkflowServiceYARPCProceduresResult{
+			Procedures: BuildWorkflowServiceYARPCProcedures(params.Server),
+			ReflectionMeta: reflection.ServerMeta{
+				ServiceName:     "uber.cadence.api.v1.WorkflowService",
+				FileDescriptors: yarpcFileDescriptorClosured5c60c45675b178e,
+			},
+		}
+	}
+}
+
+type _WorkflowServiceYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_WorkflowServiceYARPCCaller) RegisterDomain(ctx context.Context, request *RegisterDomainRequest, options ...yarpc.CallOption) (*RegisterDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RegisterDomain", request, newWorkflowServiceServiceRegisterDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RegisterDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeDomain(ctx context.Context, request *DescribeDomainRequest, options ...yarpc.CallOption) (*DescribeDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeDomain", request, newWorkflowServiceServiceDescribeDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListDomains(ctx context.Context, request *ListDomainsRequest, options ...yarpc.CallOption) (*ListDomainsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListDomains", request, newWorkflowServiceServiceListDomainsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListDomainsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) UpdateDomain(ctx context.Context, request *UpdateDomainRequest, options ...yarpc.CallOption) (*UpdateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "UpdateDomain", request, newWorkflowServiceServiceUpdateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*UpdateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DeprecateDomain(ctx context.Context, request *DeprecateDomainRequest, options ...yarpc.CallOption) (*DeprecateDomainResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DeprecateDomain", request, newWorkflowServiceServiceDeprecateDomainYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DeprecateDomainResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) StartWorkflowExecution(ctx context.Context, request *StartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "StartWorkflowExecution", request, newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) GetWorkflowExecutionHistory(ctx context.Context, request *GetWorkflowExecutionHistoryRequest, options ...yarpc.CallOption) (*GetWorkflowExecutionHistoryResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetWorkflowExecutionHistory", request, newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetWorkflowExecutionHistoryResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForDecisionTask(ctx context.Context, request *PollForDecisionTaskRequest, options ...yarpc.CallOption) (*PollForDecisionTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForDecisionTask", request, newWorkflowServiceServicePollForDecisionTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForDecisionTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskCompleted(ctx context.Context, request *RespondDecisionTaskCompletedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskCompleted", request, newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondDecisionTaskFailed(ctx context.Context, request *RespondDecisionTaskFailedRequest, options ...yarpc.CallOption) (*RespondDecisionTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondDecisionTaskFailed", request, newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondDecisionTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) PollForActivityTask(ctx context.Context, request *PollForActivityTaskRequest, options ...yarpc.CallOption) (*PollForActivityTaskResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "PollForActivityTask", request, newWorkflowServiceServicePollForActivityTaskYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*PollForActivityTaskResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeat(ctx context.Context, request *RecordActivityTaskHeartbeatRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeat", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RecordActivityTaskHeartbeatByID(ctx context.Context, request *RecordActivityTaskHeartbeatByIDRequest, options ...yarpc.CallOption) (*RecordActivityTaskHeartbeatResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RecordActivityTaskHeartbeatByID", request, newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RecordActivityTaskHeartbeatResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompleted(ctx context.Context, request *RespondActivityTaskCompletedRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompleted", request, newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCompletedByID(ctx context.Context, request *RespondActivityTaskCompletedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCompletedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCompletedByID", request, newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCompletedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailed(ctx context.Context, request *RespondActivityTaskFailedRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailed", request, newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskFailedByID(ctx context.Context, request *RespondActivityTaskFailedByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskFailedByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskFailedByID", request, newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskFailedByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceled(ctx context.Context, request *RespondActivityTaskCanceledRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceled", request, newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondActivityTaskCanceledByID(ctx context.Context, request *RespondActivityTaskCanceledByIDRequest, options ...yarpc.CallOption) (*RespondActivityTaskCanceledByIDResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondActivityTaskCanceledByID", request, newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondActivityTaskCanceledByIDResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RequestCancelWorkflowExecution(ctx context.Context, request *RequestCancelWorkflowExecutionRequest, options ...yarpc.CallOption) (*RequestCancelWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RequestCancelWorkflowExecution", request, newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RequestCancelWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWorkflowExecution(ctx context.Context, request *SignalWorkflowExecutionRequest, options ...yarpc.CallOption) (*SignalWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWorkflowExecution", request, newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*SignalWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) SignalWithStartWorkflowExecution(ctx context.Context, request *SignalWithStartWorkflowExecutionRequest, options ...yarpc.CallOption) (*StartWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "SignalWithStartWorkflowExecution", request, newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*StartWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetWorkflowExecution(ctx context.Context, request *ResetWorkflowExecutionRequest, options ...yarpc.CallOption) (*ResetWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetWorkflowExecution", request, newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) TerminateWorkflowExecution(ctx context.Context, request *TerminateWorkflowExecutionRequest, options ...yarpc.CallOption) (*TerminateWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "TerminateWorkflowExecution", request, newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*TerminateWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListOpenWorkflowExecutions(ctx context.Context, request *ListOpenWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListOpenWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListOpenWorkflowExecutions", request, newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListOpenWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListClosedWorkflowExecutions(ctx context.Context, request *ListClosedWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListClosedWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListClosedWorkflowExecutions", request, newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListClosedWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ListArchivedWorkflowExecutions(ctx context.Context, request *ListArchivedWorkflowExecutionsRequest, options ...yarpc.CallOption) (*ListArchivedWorkflowExecutionsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ListArchivedWorkflowExecutions", request, newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ListArchivedWorkflowExecutionsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) RespondQueryTaskCompleted(ctx context.Context, request *RespondQueryTaskCompletedRequest, options ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RespondQueryTaskCompleted", request, newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RespondQueryTaskCompletedResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) ResetStickyTaskList(ctx context.Context, request *ResetStickyTaskListRequest, options ...yarpc.CallOption) (*ResetStickyTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetStickyTaskList", request, newWorkflowServiceServiceResetStickyTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetStickyTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) QueryWorkflow(ctx context.Context, request *QueryWorkflowRequest, options ...yarpc.CallOption) (*QueryWorkflowResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "QueryWorkflow", request, newWorkflowServiceServiceQueryWorkflowYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*QueryWorkflowResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeWorkflowExecution(ctx context.Context, request *DescribeWorkflowExecutionRequest, options ...yarpc.CallOption) (*DescribeWorkflowExecutionResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeWorkflowExecution", request, newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeWorkflowExecutionResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_WorkflowServiceYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskList", request, newWorkflowServiceServiceDescribeTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribeTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _WorkflowServiceYARPCHandler struct {
+	server WorkflowServiceYARPCServer
+}
+
+func (h *_WorkflowServiceYARPCHandler) RegisterDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RegisterDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RegisterDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRegisterDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RegisterDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListDomains(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListDomainsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListDomainsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListDomainsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListDomains(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) UpdateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *UpdateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*UpdateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceUpdateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.UpdateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DeprecateDomain(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DeprecateDomainRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DeprecateDomainRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDeprecateDomainYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DeprecateDomain(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) StartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *StartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*StartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.StartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) GetWorkflowExecutionHistory(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetWorkflowExecutionHistoryRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetWorkflowExecutionHistoryRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetWorkflowExecutionHistory(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForDecisionTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForDecisionTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForDecisionTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForDecisionTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondDecisionTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondDecisionTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondDecisionTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondDecisionTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) PollForActivityTask(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *PollForActivityTaskRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*PollForActivityTaskRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServicePollForActivityTaskYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.PollForActivityTask(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeat(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeat(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RecordActivityTaskHeartbeatByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RecordActivityTaskHeartbeatByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RecordActivityTaskHeartbeatByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RecordActivityTaskHeartbeatByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCompletedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCompletedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCompletedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCompletedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailed(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailed(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskFailedByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskFailedByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskFailedByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskFailedByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceled(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceled(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondActivityTaskCanceledByID(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondActivityTaskCanceledByIDRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondActivityTaskCanceledByIDRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondActivityTaskCanceledByID(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RequestCancelWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RequestCancelWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RequestCancelWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RequestCancelWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) SignalWithStartWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *SignalWithStartWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*SignalWithStartWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.SignalWithStartWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) TerminateWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *TerminateWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*TerminateWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.TerminateWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListOpenWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListOpenWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListOpenWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListOpenWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListClosedWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListClosedWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListClosedWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListClosedWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ListArchivedWorkflowExecutions(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ListArchivedWorkflowExecutionsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ListArchivedWorkflowExecutionsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ListArchivedWorkflowExecutions(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) RespondQueryTaskCompleted(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RespondQueryTaskCompletedRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RespondQueryTaskCompletedRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RespondQueryTaskCompleted(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) ResetStickyTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetStickyTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetStickyTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetStickyTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) QueryWorkflow(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *QueryWorkflowRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*QueryWorkflowRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceQueryWorkflowYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.QueryWorkflow(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeWorkflowExecution(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeWorkflowExecutionRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeWorkflowExecutionRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_WorkflowServiceYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribeTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribeTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyWorkflowServiceServiceDescribeTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribeTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCRequest() proto.Message {
+	return &RegisterDomainRequest{}
+}
+
+func newWorkflowServiceServiceRegisterDomainYARPCResponse() proto.Message {
+	return &RegisterDomainResponse{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCRequest() proto.Message {
+	return &DescribeDomainRequest{}
+}
+
+func newWorkflowServiceServiceDescribeDomainYARPCResponse() proto.Message {
+	return &DescribeDomainResponse{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCRequest() proto.Message {
+	return &ListDomainsRequest{}
+}
+
+func newWorkflowServiceServiceListDomainsYARPCResponse() proto.Message {
+	return &ListDomainsResponse{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCRequest() proto.Message {
+	return &UpdateDomainRequest{}
+}
+
+func newWorkflowServiceServiceUpdateDomainYARPCResponse() proto.Message {
+	return &UpdateDomainResponse{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCRequest() proto.Message {
+	return &DeprecateDomainRequest{}
+}
+
+func newWorkflowServiceServiceDeprecateDomainYARPCResponse() proto.Message {
+	return &DeprecateDomainResponse{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &StartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest() proto.Message {
+	return &GetWorkflowExecutionHistoryRequest{}
+}
+
+func newWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse() proto.Message {
+	return &GetWorkflowExecutionHistoryResponse{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCRequest() proto.Message {
+	return &PollForDecisionTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForDecisionTaskYARPCResponse() proto.Message {
+	return &PollForDecisionTaskResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest() proto.Message {
+	return &RespondDecisionTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse() proto.Message {
+	return &RespondDecisionTaskFailedResponse{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCRequest() proto.Message {
+	return &PollForActivityTaskRequest{}
+}
+
+func newWorkflowServiceServicePollForActivityTaskYARPCResponse() proto.Message {
+	return &PollForActivityTaskResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest() proto.Message {
+	return &RecordActivityTaskHeartbeatByIDRequest{}
+}
+
+func newWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse() proto.Message {
+	return &RecordActivityTaskHeartbeatResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCompletedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCompletedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskFailedByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskFailedByIDResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledResponse{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest() proto.Message {
+	return &RespondActivityTaskCanceledByIDRequest{}
+}
+
+func newWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse() proto.Message {
+	return &RespondActivityTaskCanceledByIDResponse{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest() proto.Message {
+	return &RequestCancelWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse() proto.Message {
+	return &RequestCancelWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse() proto.Message {
+	return &SignalWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest() proto.Message {
+	return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse() proto.Message {
+	return &StartWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCRequest() proto.Message {
+	return &ResetWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceResetWorkflowExecutionYARPCResponse() proto.Message {
+	return &ResetWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest() proto.Message {
+	return &TerminateWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse() proto.Message {
+	return &TerminateWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListOpenWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListOpenWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListClosedWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListClosedWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest() proto.Message {
+	return &ListArchivedWorkflowExecutionsRequest{}
+}
+
+func newWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse() proto.Message {
+	return &ListArchivedWorkflowExecutionsResponse{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest() proto.Message {
+	return &RespondQueryTaskCompletedRequest{}
+}
+
+func newWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse() proto.Message {
+	return &RespondQueryTaskCompletedResponse{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCRequest() proto.Message {
+	return &ResetStickyTaskListRequest{}
+}
+
+func newWorkflowServiceServiceResetStickyTaskListYARPCResponse() proto.Message {
+	return &ResetStickyTaskListResponse{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCRequest() proto.Message {
+	return &QueryWorkflowRequest{}
+}
+
+func newWorkflowServiceServiceQueryWorkflowYARPCResponse() proto.Message {
+	return &QueryWorkflowResponse{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest() proto.Message {
+	return &DescribeWorkflowExecutionRequest{}
+}
+
+func newWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse() proto.Message {
+	return &DescribeWorkflowExecutionResponse{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCRequest() proto.Message {
+	return &DescribeTaskListRequest{}
+}
+
+func newWorkflowServiceServiceDescribeTaskListYARPCResponse() proto.Message {
+	return &DescribeTaskListResponse{}
+}
+
+var (
+	emptyWorkflowServiceServiceRegisterDomainYARPCRequest                    = &RegisterDomainRequest{}
+	emptyWorkflowServiceServiceRegisterDomainYARPCResponse                   = &RegisterDomainResponse{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCRequest                    = &DescribeDomainRequest{}
+	emptyWorkflowServiceServiceDescribeDomainYARPCResponse                   = &DescribeDomainResponse{}
+	emptyWorkflowServiceServiceListDomainsYARPCRequest                       = &ListDomainsRequest{}
+	emptyWorkflowServiceServiceListDomainsYARPCResponse                      = &ListDomainsResponse{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCRequest                      = &UpdateDomainRequest{}
+	emptyWorkflowServiceServiceUpdateDomainYARPCResponse                     = &UpdateDomainResponse{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCRequest                   = &DeprecateDomainRequest{}
+	emptyWorkflowServiceServiceDeprecateDomainYARPCResponse                  = &DeprecateDomainResponse{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCRequest            = &StartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceStartWorkflowExecutionYARPCResponse           = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCRequest       = &GetWorkflowExecutionHistoryRequest{}
+	emptyWorkflowServiceServiceGetWorkflowExecutionHistoryYARPCResponse      = &GetWorkflowExecutionHistoryResponse{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCRequest               = &PollForDecisionTaskRequest{}
+	emptyWorkflowServiceServicePollForDecisionTaskYARPCResponse              = &PollForDecisionTaskResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCRequest      = &RespondDecisionTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskCompletedYARPCResponse     = &RespondDecisionTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCRequest         = &RespondDecisionTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondDecisionTaskFailedYARPCResponse        = &RespondDecisionTaskFailedResponse{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCRequest               = &PollForActivityTaskRequest{}
+	emptyWorkflowServiceServicePollForActivityTaskYARPCResponse              = &PollForActivityTaskResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCRequest       = &RecordActivityTaskHeartbeatRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatYARPCResponse      = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCRequest   = &RecordActivityTaskHeartbeatByIDRequest{}
+	emptyWorkflowServiceServiceRecordActivityTaskHeartbeatByIDYARPCResponse  = &RecordActivityTaskHeartbeatResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCRequest      = &RespondActivityTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedYARPCResponse     = &RespondActivityTaskCompletedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCRequest  = &RespondActivityTaskCompletedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCompletedByIDYARPCResponse = &RespondActivityTaskCompletedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCRequest         = &RespondActivityTaskFailedRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedYARPCResponse        = &RespondActivityTaskFailedResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCRequest     = &RespondActivityTaskFailedByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskFailedByIDYARPCResponse    = &RespondActivityTaskFailedByIDResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCRequest       = &RespondActivityTaskCanceledRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledYARPCResponse      = &RespondActivityTaskCanceledResponse{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCRequest   = &RespondActivityTaskCanceledByIDRequest{}
+	emptyWorkflowServiceServiceRespondActivityTaskCanceledByIDYARPCResponse  = &RespondActivityTaskCanceledByIDResponse{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCRequest    = &RequestCancelWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceRequestCancelWorkflowExecutionYARPCResponse   = &RequestCancelWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCRequest           = &SignalWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWorkflowExecutionYARPCResponse          = &SignalWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCRequest  = &SignalWithStartWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceSignalWithStartWorkflowExecutionYARPCResponse = &StartWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCRequest            = &ResetWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceResetWorkflowExecutionYARPCResponse           = &ResetWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCRequest        = &TerminateWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceTerminateWorkflowExecutionYARPCResponse       = &TerminateWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCRequest        = &ListOpenWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListOpenWorkflowExecutionsYARPCResponse       = &ListOpenWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCRequest      = &ListClosedWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListClosedWorkflowExecutionsYARPCResponse     = &ListClosedWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCRequest    = &ListArchivedWorkflowExecutionsRequest{}
+	emptyWorkflowServiceServiceListArchivedWorkflowExecutionsYARPCResponse   = &ListArchivedWorkflowExecutionsResponse{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCRequest         = &RespondQueryTaskCompletedRequest{}
+	emptyWorkflowServiceServiceRespondQueryTaskCompletedYARPCResponse        = &RespondQueryTaskCompletedResponse{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCRequest               = &ResetStickyTaskListRequest{}
+	emptyWorkflowServiceServiceResetStickyTaskListYARPCResponse              = &ResetStickyTaskListResponse{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCRequest                     = &QueryWorkflowRequest{}
+	emptyWorkflowServiceServiceQueryWorkflowYARPCResponse                    = &QueryWorkflowResponse{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCRequest         = &DescribeWorkflowExecutionRequest{}
+	emptyWorkflowServiceServiceDescribeWorkflowExecutionYARPCResponse        = &DescribeWorkflowExecutionResponse{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCRequest                  = &DescribeTaskListRequest{}
+	emptyWorkflowServiceServiceDescribeTaskListYARPCResponse                 = &DescribeTaskListResponse{}
+)
+
+var yarpcFileDescriptorClosured5c60c45675b178e = [][]byte{
+	// uber/cadence/api/v1/cadence.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5b, 0x6f, 0xe3, 0x44,
+		0x14, 0x96, 0x5f, 0x78, 0x38, 0x5c, 0x16, 0xcd, 0x4a, 0x5b, 0xb6, 0x5c, 0xb6, 0xcd, 0x5e, 0xda,
+		0x2d, 0x4b, 0xc2, 0x6e, 0xe9, 0xbd, 0x3c, 0xb4, 0x0d, 0xa5, 0x48, 0x48, 0x94, 0xa4, 0x08, 0x89,
+		0x27, 0x1c, 0xe7, 0x34, 0x19, 0x35, 0xf1, 0x98, 0xf1, 0x24, 0xb4, 0x8f, 0x48, 0x88, 0x07, 0x24,
+		0x24, 0x24, 0x04, 0x02, 0x09, 0x89, 0xbf, 0x8a, 0x1c, 0x8f, 0xd3, 0x71, 0x3c, 0x73, 0x62, 0xa7,
+		0x6f, 0x6d, 0xfc, 0x7d, 0xe7, 0x7c, 0x3e, 0x73, 0xce, 0xcc, 0x37, 0x86, 0xd5, 0x51, 0x07, 0x65,
+		0x23, 0xf0, 0xbb, 0x18, 0x06, 0xd8, 0xf0, 0x23, 0xde, 0x18, 0xbf, 0xcc, 0xfe, 0xad, 0x47, 0x52,
+		0x28, 0xc1, 0xee, 0x27, 0x90, 0x7a, 0xf6, 0x9b, 0x1f, 0xf1, 0xfa, 0xf8, 0xe5, 0xf2, 0x8a, 0x8d,
+		0x17, 0xf7, 0x7d, 0x89, 0xdd, 0x94, 0x56, 0x7b, 0x07, 0x1e, 0xb4, 0xb0, 0xc7, 0x63, 0x85, 0xb2,
+		0x29, 0x86, 0x3e, 0x0f, 0x5b, 0x18, 0x47, 0x22, 0x8c, 0xb1, 0xf6, 0x10, 0x96, 0x9a, 0x18, 0x49,
+		0x0c, 0x7c, 0x85, 0x33, 0x8f, 0x1e, 0xc3, 0x6a, 0xfa, 0x77, 0xb7, 0x89, 0x01, 0x8f, 0xb9, 0x08,
+		0x2f, 0xfc, 0xf8, 0xea, 0xd4, 0xe7, 0x03, 0xec, 0x4e, 0x41, 0xcf, 0xe0, 0x89, 0x06, 0x1d, 0x05,
+		0x8a, 0x8f, 0xb9, 0xba, 0x49, 0x40, 0x27, 0x62, 0x18, 0x0d, 0x50, 0x19, 0xb8, 0x0d, 0x58, 0xa7,
+		0x70, 0xc7, 0x37, 0x5f, 0x34, 0x2d, 0x89, 0x4d, 0xec, 0x4c, 0xe2, 0x35, 0x78, 0xea, 0x04, 0xe5,
+		0xa2, 0x3d, 0x85, 0xc7, 0xb6, 0xcc, 0x7e, 0x18, 0xa0, 0x19, 0xef, 0x39, 0xac, 0x11, 0xb0, 0x5c,
+		0xc4, 0x75, 0x78, 0xd6, 0xc2, 0x1f, 0x46, 0x18, 0xab, 0xf4, 0xf1, 0xb7, 0x42, 0x5e, 0x5d, 0x0e,
+		0xc4, 0x8f, 0x9f, 0x5d, 0x63, 0x30, 0x52, 0x5c, 0xdc, 0x96, 0x70, 0x15, 0x1e, 0xb5, 0x79, 0x2f,
+		0xf4, 0x09, 0xc8, 0x13, 0xa8, 0x5d, 0xa0, 0x1c, 0xf2, 0xd0, 0x57, 0xe8, 0x46, 0xdd, 0x96, 0xe4,
+		0xeb, 0x11, 0x4a, 0x7b, 0x8d, 0x5f, 0xfd, 0x52, 0x83, 0x7b, 0x59, 0x88, 0x36, 0xca, 0x31, 0x0f,
+		0x90, 0x71, 0x78, 0x2b, 0xbf, 0xf2, 0x6c, 0xa3, 0x6e, 0xe9, 0xa1, 0xfa, 0x6c, 0x7b, 0x4c, 0x5e,
+		0x6f, 0xf9, 0xc3, 0x52, 0xd8, 0x34, 0x7d, 0x92, 0xaa, 0x89, 0x71, 0x20, 0x79, 0x07, 0xc9, 0x54,
+		0x79, 0x10, 0x9d, 0x6a, 0x16, 0xab, 0x53, 0x7d, 0x0f, 0xaf, 0x7f, 0xc9, 0x63, 0x95, 0xfe, 0x1a,
+		0xb3, 0x35, 0x2b, 0xd7, 0x40, 0x64, 0x49, 0xd6, 0xe7, 0x03, 0x75, 0x86, 0x00, 0xde, 0xf8, 0x26,
+		0xea, 0x4e, 0x87, 0x82, 0xd9, 0x99, 0x26, 0x24, 0xcb, 0xf1, 0xbc, 0x04, 0x52, 0x27, 0x19, 0xc0,
+		0xbd, 0x99, 0xe1, 0x63, 0xae, 0x32, 0xcc, 0x8c, 0x68, 0x9a, 0xea, 0x45, 0x39, 0xb0, 0xce, 0xf6,
+		0x93, 0x07, 0x0f, 0xda, 0xca, 0x97, 0xaa, 0xd0, 0x66, 0xec, 0x95, 0x35, 0x90, 0x1d, 0x9c, 0x25,
+		0xdf, 0xac, 0xc4, 0xd1, 0x1a, 0x7e, 0xf7, 0xe0, 0xdd, 0xcf, 0xb1, 0x08, 0x38, 0xe3, 0xb1, 0x12,
+		0xf2, 0x86, 0xed, 0x58, 0x83, 0x12, 0x8c, 0x4c, 0xcd, 0x6e, 0x75, 0xa2, 0x96, 0x74, 0x0d, 0xf7,
+		0xcf, 0xc5, 0x60, 0x70, 0x2a, 0xa4, 0xb9, 0xcd, 0xb1, 0x86, 0x35, 0xa0, 0x05, 0x99, 0x29, 0xf8,
+		0xb8, 0x3c, 0x41, 0x67, 0xfe, 0xc3, 0x83, 0xf7, 0x2c, 0x3b, 0xec, 0x74, 0xb0, 0xd9, 0xae, 0x63,
+		0xfc, 0xdc, 0x94, 0x4c, 0xcc, 0xde, 0x02, 0x4c, 0xad, 0xea, 0x57, 0x0f, 0x1e, 0x3a, 0xf7, 0x7d,
+		0xb6, 0x55, 0x36, 0x70, 0xb6, 0x5d, 0xa7, 0x7a, 0xb6, 0xab, 0xd2, 0x0a, 0x8b, 0x63, 0xee, 0xca,
+		0xf4, 0xe2, 0x98, 0xc8, 0x52, 0x8b, 0x93, 0x27, 0x18, 0x9d, 0xda, 0xc2, 0x40, 0xc8, 0xdc, 0x79,
+		0x70, 0x86, 0xbe, 0x54, 0x1d, 0xf4, 0x95, 0xa3, 0x53, 0x09, 0x06, 0xdd, 0xa9, 0x24, 0x51, 0x4b,
+		0xfa, 0xdb, 0x83, 0x47, 0x04, 0x2e, 0x39, 0xa3, 0xd8, 0x41, 0xd5, 0xe8, 0xe9, 0xc9, 0x76, 0x57,
+		0x69, 0x46, 0x2b, 0x5b, 0xcf, 0x77, 0xba, 0x95, 0x1d, 0xd6, 0xa1, 0x44, 0x2b, 0x93, 0xa6, 0x83,
+		0xfd, 0xe7, 0xc1, 0xca, 0x3c, 0xd7, 0xc1, 0x0e, 0x2b, 0xc7, 0x37, 0x4b, 0xf6, 0xe9, 0x82, 0xec,
+		0xe2, 0xb0, 0x15, 0x6d, 0x0c, 0x3d, 0x6c, 0x36, 0x6f, 0x54, 0x62, 0xd8, 0xdc, 0x96, 0x8a, 0xfd,
+		0xe5, 0xc1, 0xfb, 0xa4, 0xa7, 0x62, 0x7b, 0xd5, 0x22, 0x9b, 0x85, 0xda, 0x5f, 0x84, 0x9a, 0x9b,
+		0x45, 0xa7, 0x39, 0x73, 0xce, 0x22, 0xe1, 0xfa, 0xe8, 0x86, 0x9f, 0x6b, 0x17, 0xd9, 0xbf, 0x93,
+		0x59, 0x24, 0xfd, 0xa2, 0x73, 0x16, 0xe7, 0xb8, 0xcc, 0x54, 0xda, 0xe1, 0x62, 0x64, 0x2d, 0xef,
+		0x1f, 0x0f, 0x3e, 0xa0, 0x3d, 0x2a, 0x73, 0x2d, 0x08, 0x6d, 0x6c, 0x53, 0x71, 0x07, 0x0b, 0x71,
+		0xb5, 0xb6, 0x9f, 0x3d, 0x58, 0x72, 0xb8, 0x62, 0xe6, 0x30, 0x15, 0x2e, 0x0f, 0x9d, 0xaa, 0xf9,
+		0xa4, 0x1a, 0x49, 0xcb, 0xf8, 0xd3, 0x83, 0x15, 0x8d, 0xe1, 0xaa, 0xef, 0x30, 0x46, 0x87, 0x54,
+		0x68, 0x27, 0xed, 0x4e, 0x16, 0x29, 0xb1, 0x69, 0x2d, 0x8c, 0xb1, 0xb4, 0x4d, 0xb3, 0x83, 0x69,
+		0x0d, 0x2e, 0x8e, 0xd6, 0xf0, 0x9b, 0x07, 0xcb, 0xee, 0x5b, 0x09, 0xb3, 0x6f, 0x30, 0xd4, 0x35,
+		0x26, 0xd5, 0xb2, 0x53, 0x99, 0x67, 0xe8, 0x49, 0x5c, 0xfa, 0x57, 0x11, 0x86, 0x05, 0x54, 0xec,
+		0xd0, 0xe3, 0x26, 0xd0, 0x7a, 0x28, 0x9e, 0x71, 0xdc, 0x25, 0xb0, 0x93, 0x81, 0x88, 0xb1, 0x6b,
+		0x51, 0xb4, 0xeb, 0x8c, 0xec, 0xa2, 0xd0, 0xc7, 0x1d, 0xcd, 0x34, 0x86, 0x3e, 0x01, 0x1e, 0xc9,
+		0xa0, 0xcf, 0xc7, 0x56, 0x5d, 0xfb, 0xce, 0xe8, 0x6e, 0x12, 0x3d, 0xf4, 0xf3, 0xb8, 0xc5, 0x83,
+		0xae, 0x78, 0x83, 0xa5, 0x0f, 0x3a, 0xdb, 0x8d, 0xb7, 0xc4, 0x41, 0xe7, 0xbe, 0x28, 0x27, 0xae,
+		0x72, 0x32, 0x00, 0x6d, 0xc5, 0x83, 0xab, 0x09, 0x26, 0x79, 0x0b, 0x87, 0xab, 0xb4, 0x20, 0x69,
+		0x57, 0x69, 0x25, 0xe8, 0xcc, 0x97, 0xf0, 0xe6, 0x44, 0x57, 0x56, 0x29, 0x66, 0xbf, 0x2d, 0xe6,
+		0x30, 0x59, 0xb6, 0x8d, 0x32, 0x50, 0xa3, 0xdc, 0xd9, 0xdd, 0xb9, 0x38, 0xbf, 0x5b, 0xe4, 0x5d,
+		0xdb, 0x39, 0xbe, 0xdb, 0x55, 0x69, 0x5a, 0x8c, 0x80, 0xb7, 0x33, 0xd0, 0xb4, 0xd6, 0x2f, 0xc8,
+		0x58, 0xb3, 0x85, 0xfe, 0xa8, 0x24, 0x3a, 0x4d, 0x78, 0xdc, 0x86, 0xa5, 0x40, 0x0c, 0x6d, 0x9c,
+		0x73, 0xef, 0xbb, 0xed, 0x1e, 0x57, 0xfd, 0x51, 0xa7, 0x1e, 0x88, 0x61, 0x23, 0xf7, 0xe1, 0xac,
+		0xde, 0xc3, 0xb0, 0x31, 0xf9, 0x5e, 0xd6, 0xb0, 0x7c, 0x50, 0xeb, 0xbc, 0x36, 0x79, 0xb4, 0xf9,
+		0xff, 0x00, 0x5f, 0x97, 0x7a, 0x24, 0xa6, 0x13, 0x00, 0x00,
+	},
+	// uber/cadence/api/v1/shared.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x6c, 0x1c, 0x49,
+		0x7a, 0x18, 0xfe, 0xeb, 0xa1, 0x28, 0x89, 0x1f, 0x29, 0x72, 0x54, 0x1a, 0x49, 0xa4, 0x24, 0x8e,
+		0xc4, 0x26, 0x25, 0x8d, 0x28, 0x89, 0x94, 0x28, 0x9d, 0x5e, 0x4b, 0xed, 0xde, 0x70, 0xa6, 0x29,
+		0x8d, 0x45, 0x0d, 0x79, 0x3d, 0xc3, 0xd5, 0xad, 0x0e, 0xf8, 0xb5, 0x5b, 0xd3, 0x4d, 0xaa, 0xc1,
+		0xe1, 0x0c, 0xdd, 0xdd, 0x23, 0x92, 0xe7, 0xd8, 0x70, 0x36, 0x17, 0xe7, 0x71, 0x1b, 0xdf, 0xd9,
+		0x87, 0x5c, 0x2e, 0x9b, 0x20, 0x38, 0xdb, 0x97, 0x38, 0x70, 0x9c, 0x33, 0x6c, 0x38, 0xb1, 0x83,
+		0x00, 0xc9, 0xf9, 0xe0, 0x3c, 0x01, 0xdb, 0x48, 0xb0, 0xb0, 0x83, 0xe0, 0x62, 0x24, 0x08, 0x62,
+		0xc4, 0x08, 0x60, 0x3b, 0x09, 0xf2, 0x87, 0x93, 0x20, 0x08, 0xea, 0xd1, 0xef, 0xea, 0x9e, 0x1e,
+		0x8a, 0x92, 0x36, 0xb6, 0xfe, 0x9b, 0xa9, 0xfa, 0xaa, 0xea, 0xab, 0xef, 0x5d, 0x55, 0x5f, 0x57,
+		0xc1, 0xb9, 0xce, 0x33, 0xdd, 0x9c, 0x6d, 0xa8, 0x9a, 0xde, 0x6a, 0xe8, 0xb3, 0xea, 0x96, 0x31,
+		0xfb, 0xe2, 0xfa, 0xac, 0xf5, 0x5c, 0x35, 0x75, 0x6d, 0x66, 0xcb, 0x6c, 0xdb, 0x6d, 0x74, 0x0c,
+		0x43, 0xcc, 0x30, 0x88, 0x19, 0x75, 0xcb, 0x98, 0x79, 0x71, 0xfd, 0x54, 0x7e, 0xbd, 0xdd, 0x5e,
+		0x6f, 0xea, 0xb3, 0x04, 0xe4, 0x59, 0x67, 0x6d, 0x76, 0xdb, 0x54, 0xb7, 0xb6, 0x74, 0xd3, 0xa2,
+		0x8d, 0xc4, 0xcb, 0x30, 0xb2, 0xa0, 0x6a, 0xb2, 0xfe, 0x03, 0x1d, 0xdd, 0xb2, 0x25, 0xd3, 0x6c,
+		0x9b, 0x68, 0x14, 0x0e, 0x6d, 0xea, 0x96, 0xa5, 0xae, 0xeb, 0xa3, 0xc2, 0x39, 0xa1, 0x30, 0x20,
+		0x3b, 0x7f, 0xc5, 0x6b, 0x90, 0xab, 0xb4, 0x6c, 0xdd, 0x6c, 0xa9, 0xcd, 0x9a, 0x6e, 0xbe, 0x30,
+		0x1a, 0x7a, 0xb7, 0x16, 0x37, 0x61, 0xb4, 0xdc, 0xde, 0x54, 0x8d, 0x56, 0xb1, 0x69, 0xea, 0xaa,
+		0xb6, 0x2b, 0xed, 0x18, 0x96, 0x6d, 0x75, 0x6b, 0xf5, 0xa7, 0x05, 0x98, 0x7a, 0xd2, 0x36, 0x37,
+		0xd6, 0x9a, 0xed, 0x6d, 0x69, 0x47, 0x6f, 0x74, 0x6c, 0xa3, 0xed, 0xf4, 0x50, 0xb3, 0x55, 0xd3,
+		0xd6, 0xb5, 0x48, 0x17, 0x10, 0xe8, 0x02, 0x15, 0x20, 0x6b, 0x61, 0x48, 0xc5, 0xa4, 0x53, 0x53,
+		0x0c, 0x6d, 0x34, 0x47, 0x40, 0x86, 0x49, 0x39, 0x9b, 0x71, 0x45, 0x43, 0xc7, 0xe1, 0xa0, 0xd9,
+		0x69, 0xe1, 0xfa, 0x3c, 0xa9, 0xef, 0x37, 0x3b, 0xad, 0x8a, 0x86, 0xe7, 0x2a, 0xb5, 0x6c, 0xc3,
+		0xde, 0xad, 0xb6, 0xed, 0x74, 0x58, 0x5f, 0x81, 0x2c, 0xa3, 0xca, 0x42, 0xc7, 0xda, 0xed, 0x06,
+		0x7d, 0x1f, 0x26, 0x4a, 0x6a, 0xab, 0xa1, 0x37, 0x9b, 0xaa, 0x6f, 0x76, 0x0c, 0x2d, 0x5d, 0xeb,
+		0xd6, 0xfc, 0x0a, 0x64, 0x3f, 0xd7, 0xd1, 0xcd, 0xdd, 0x45, 0xd5, 0x68, 0x76, 0x87, 0xfe, 0x49,
+		0x01, 0x72, 0x94, 0x0f, 0xd5, 0xb6, 0x5d, 0x6c, 0xd8, 0xc6, 0x8b, 0x6e, 0x9c, 0x43, 0x67, 0x61,
+		0x50, 0x23, 0x2d, 0x94, 0x96, 0xba, 0xa9, 0x8f, 0x66, 0x48, 0x2d, 0xd0, 0xa2, 0xaa, 0xba, 0xa9,
+		0xa3, 0x8b, 0x30, 0xd2, 0xe8, 0x98, 0xa6, 0xde, 0xb2, 0x95, 0x46, 0xb3, 0x63, 0xd9, 0xba, 0x39,
+		0xda, 0x47, 0x09, 0xcc, 0x8a, 0x4b, 0xb4, 0x14, 0x9d, 0x87, 0x61, 0x95, 0x0c, 0xe9, 0xc2, 0x1d,
+		0x20, 0x70, 0x47, 0x68, 0x29, 0x03, 0x13, 0x67, 0x00, 0x2d, 0x19, 0x9b, 0x86, 0x2d, 0xed, 0x34,
+		0x74, 0x5d, 0xeb, 0x3e, 0xa7, 0x2f, 0x09, 0x70, 0xf0, 0xa1, 0xae, 0x6a, 0xba, 0x89, 0xde, 0x83,
+		0x83, 0x6b, 0x86, 0xde, 0xd4, 0xac, 0x51, 0x38, 0xd7, 0x57, 0x18, 0x9c, 0xbb, 0x38, 0xc3, 0x51,
+		0x85, 0x19, 0x0a, 0x3c, 0xb3, 0x48, 0x20, 0xa5, 0x96, 0x6d, 0xee, 0xca, 0xac, 0xd9, 0xa9, 0xbb,
+		0x30, 0xe8, 0x2b, 0x46, 0x59, 0xe8, 0xdb, 0xd0, 0x77, 0xd9, 0x80, 0xf8, 0x27, 0xca, 0x41, 0xff,
+		0x0b, 0xb5, 0xd9, 0xa1, 0x74, 0x18, 0x92, 0xe9, 0x9f, 0x7b, 0x99, 0x3b, 0x82, 0x28, 0xc2, 0x90,
+		0x23, 0xaa, 0xf5, 0xdd, 0x2d, 0x1d, 0x21, 0x38, 0x40, 0x08, 0x46, 0xe5, 0x91, 0xfc, 0xc6, 0x30,
+		0x84, 0xe8, 0x86, 0xbd, 0x1b, 0x0b, 0xb3, 0x0a, 0x87, 0xeb, 0xaa, 0xb5, 0xb1, 0x64, 0x58, 0x36,
+		0xaf, 0x1e, 0x7d, 0x06, 0x0e, 0x6c, 0x18, 0x2d, 0x2a, 0xc4, 0xc3, 0x73, 0x13, 0xdc, 0x19, 0x3a,
+		0x1d, 0x3c, 0x32, 0x5a, 0x9a, 0x4c, 0xc0, 0xc5, 0x12, 0x64, 0x9d, 0xd2, 0xc7, 0xba, 0xad, 0x6a,
+		0xaa, 0xad, 0xa2, 0x59, 0xc8, 0x6d, 0xaa, 0x3b, 0x8a, 0xad, 0x5a, 0x1b, 0x96, 0xb2, 0xa5, 0x9b,
+		0x8a, 0xa5, 0x37, 0xda, 0x2d, 0x8d, 0x0c, 0x27, 0xc8, 0x47, 0x37, 0xd5, 0x1d, 0xdc, 0xc4, 0x5a,
+		0xd1, 0xcd, 0x1a, 0xa9, 0x10, 0x1f, 0xc1, 0xd1, 0x88, 0x3a, 0x62, 0x01, 0xd9, 0x66, 0x85, 0x8a,
+		0x41, 0x1b, 0x0f, 0xc8, 0xe0, 0x14, 0x05, 0x14, 0x2b, 0xe7, 0x57, 0xac, 0xff, 0xde, 0x07, 0xc7,
+		0x23, 0xbd, 0x55, 0x5a, 0x6b, 0x6d, 0x54, 0x86, 0x01, 0xdd, 0x29, 0x20, 0xfd, 0x0d, 0xce, 0x5d,
+		0xe0, 0xce, 0x33, 0xd2, 0x5c, 0xf6, 0x1a, 0x62, 0x42, 0xd9, 0xbb, 0x5b, 0x3a, 0x19, 0x74, 0x70,
+		0x6e, 0x22, 0xb1, 0x03, 0xcc, 0x0d, 0x99, 0x80, 0xa3, 0x71, 0x00, 0x6a, 0x30, 0x6c, 0x63, 0x53,
+		0x27, 0xa6, 0xa0, 0x4f, 0x1e, 0x20, 0x25, 0x75, 0x63, 0x93, 0x54, 0x37, 0x9a, 0x6d, 0x4b, 0xa7,
+		0xd5, 0x05, 0x5a, 0x4d, 0x4a, 0x48, 0x75, 0x1d, 0x86, 0x68, 0xb5, 0x65, 0xab, 0x76, 0xc7, 0x1a,
+		0x9d, 0x23, 0x5c, 0xba, 0x9e, 0x0e, 0xfb, 0x12, 0x6e, 0x59, 0x23, 0x0d, 0xe5, 0xc1, 0x86, 0xf7,
+		0x07, 0x6b, 0xce, 0x73, 0xc3, 0xb2, 0xdb, 0xe6, 0xae, 0xd2, 0xd4, 0x5b, 0xeb, 0xf6, 0xf3, 0xd1,
+		0x79, 0x32, 0xf0, 0x11, 0x56, 0xba, 0x44, 0x0a, 0xb1, 0xad, 0xdb, 0x52, 0x89, 0x22, 0x32, 0x8d,
+		0x35, 0xb4, 0xd1, 0x45, 0xaa, 0x8a, 0xb4, 0x9c, 0xaa, 0x7e, 0x45, 0x43, 0x9f, 0x73, 0x21, 0x3d,
+		0x42, 0xaf, 0xf4, 0x44, 0xe8, 0x11, 0xda, 0xde, 0x13, 0x83, 0xf3, 0x30, 0xec, 0xf6, 0x45, 0x89,
+		0xf3, 0x94, 0xe2, 0xe8, 0x96, 0x62, 0x02, 0x89, 0xff, 0x24, 0x03, 0xf9, 0xe8, 0xc4, 0xdb, 0xad,
+		0x35, 0x63, 0xbd, 0x63, 0x12, 0x0b, 0x88, 0xee, 0xc1, 0x00, 0x16, 0x49, 0xa5, 0x69, 0x58, 0x36,
+		0x63, 0xff, 0x78, 0xa2, 0x98, 0xcb, 0x87, 0x6d, 0xf6, 0x0b, 0xad, 0x42, 0xc1, 0xc3, 0x82, 0xf1,
+		0xb1, 0xad, 0x78, 0x1c, 0x6b, 0x77, 0x6c, 0x26, 0xe5, 0x16, 0x11, 0x8c, 0x7e, 0x79, 0xd2, 0x85,
+		0x27, 0x1e, 0xa5, 0xde, 0x2e, 0x39, 0xcc, 0x6c, 0x77, 0x6c, 0x2a, 0xf7, 0x16, 0x7a, 0x04, 0x93,
+		0x04, 0xa5, 0x2e, 0x3d, 0xe6, 0x49, 0x8f, 0x79, 0x0c, 0x9a, 0xd0, 0x59, 0x09, 0x86, 0x1a, 0xcf,
+		0x8d, 0xa6, 0xa6, 0x6c, 0xb5, 0x9b, 0x46, 0x63, 0x97, 0x08, 0xd1, 0xf0, 0xdc, 0x39, 0xee, 0x14,
+		0x4b, 0x18, 0x70, 0x85, 0xc0, 0xc9, 0x83, 0x0d, 0xef, 0x8f, 0xf8, 0xc9, 0x01, 0xb8, 0x50, 0x6b,
+		0x3c, 0xd7, 0xb5, 0x4e, 0x53, 0x77, 0x6d, 0x8a, 0x6a, 0x6d, 0x94, 0xf5, 0x86, 0x61, 0x61, 0x3f,
+		0x62, 0xdb, 0xa6, 0xf1, 0xac, 0x63, 0xeb, 0x16, 0x56, 0x50, 0x95, 0x41, 0xf8, 0x14, 0xd4, 0x29,
+		0xaa, 0x68, 0x68, 0x11, 0x8e, 0xb8, 0x00, 0x5d, 0x55, 0xc6, 0x6f, 0xc0, 0xe4, 0x21, 0xd5, 0xf7,
+		0x0f, 0x9d, 0x80, 0x83, 0x54, 0xf0, 0x46, 0xc7, 0xc8, 0x18, 0xec, 0x5f, 0x90, 0xa1, 0xf9, 0xde,
+		0x18, 0x9a, 0x83, 0x7e, 0xa3, 0xb5, 0xd5, 0xb1, 0x09, 0x95, 0x86, 0x64, 0xfa, 0x07, 0x3d, 0x84,
+		0x09, 0x8b, 0x4d, 0x3e, 0x9e, 0x1b, 0x57, 0x09, 0x37, 0xc6, 0x1d, 0x40, 0x3e, 0x33, 0x42, 0x3d,
+		0x79, 0xaa, 0xef, 0xef, 0x69, 0x2e, 0xdc, 0x53, 0xcd, 0xb1, 0x07, 0xbe, 0x9e, 0x16, 0x20, 0xdf,
+		0x45, 0x3c, 0x6e, 0x93, 0x6e, 0x4e, 0x59, 0xf1, 0xa2, 0x71, 0x0f, 0xc6, 0x9e, 0xeb, 0xaa, 0x69,
+		0x3f, 0xd3, 0xd5, 0x28, 0x16, 0xf3, 0xa4, 0xf9, 0x49, 0x17, 0x20, 0x2a, 0x56, 0xa6, 0x6e, 0x9b,
+		0xbb, 0x8e, 0x58, 0x2d, 0x12, 0x42, 0xf3, 0xc5, 0x4a, 0xc6, 0x80, 0x8e, 0x58, 0x99, 0xde, 0x1f,
+		0xb1, 0x0a, 0x97, 0x59, 0xe8, 0x41, 0x83, 0x92, 0x97, 0x14, 0x2d, 0xf1, 0x07, 0xe1, 0x8c, 0x4b,
+		0x2b, 0x93, 0xd3, 0xc1, 0x18, 0x1c, 0xc6, 0xd3, 0x34, 0xbd, 0xd6, 0x87, 0xc8, 0xff, 0x8a, 0x86,
+		0x3e, 0x0b, 0xe3, 0x2e, 0x3d, 0xd7, 0x0c, 0x93, 0xaf, 0xbf, 0x7d, 0xf2, 0x18, 0x23, 0xe7, 0xa2,
+		0x61, 0x86, 0xa8, 0x29, 0x4a, 0x70, 0xb9, 0xd4, 0xde, 0xdc, 0x6a, 0xea, 0xb6, 0x1e, 0x31, 0x39,
+		0x1c, 0x5c, 0x4e, 0xc0, 0x41, 0x53, 0xb7, 0x3a, 0x4d, 0x6a, 0x74, 0x86, 0x64, 0xf6, 0x4f, 0xfc,
+		0x02, 0x5c, 0xc4, 0xd1, 0x55, 0xea, 0x2e, 0x54, 0x8b, 0xb9, 0xad, 0x01, 0x99, 0xfd, 0xc3, 0xd1,
+		0x8b, 0xa6, 0xdb, 0xaa, 0xd1, 0xa4, 0x58, 0x0f, 0xc9, 0xce, 0x5f, 0xf1, 0x1e, 0x8c, 0x53, 0x4a,
+		0xf7, 0x4e, 0x21, 0x51, 0x82, 0x4b, 0xb4, 0x6d, 0x1a, 0xd4, 0x7c, 0x28, 0x40, 0x10, 0x85, 0x4f,
+		0x04, 0xb8, 0x13, 0x60, 0xba, 0xb4, 0x43, 0x63, 0xfb, 0x94, 0x33, 0x66, 0x3a, 0x0f, 0x01, 0x9d,
+		0x0f, 0x45, 0x05, 0xb9, 0x84, 0xa8, 0xc0, 0x1f, 0x6e, 0x63, 0x34, 0x1b, 0xed, 0x96, 0x6d, 0xb6,
+		0x9b, 0x4c, 0xe3, 0x9d, 0xbf, 0x68, 0x06, 0x8e, 0x51, 0xb3, 0xe9, 0xf6, 0xdb, 0x6e, 0x35, 0x77,
+		0x89, 0x6e, 0x1e, 0x96, 0x8f, 0x92, 0x2a, 0x07, 0xe1, 0xe5, 0x56, 0x73, 0x57, 0xfc, 0x89, 0x0c,
+		0x5c, 0xaf, 0x19, 0xeb, 0x2d, 0x75, 0x5f, 0xe6, 0x13, 0x88, 0x49, 0x72, 0x7b, 0x8d, 0x49, 0xce,
+		0xc2, 0xa0, 0x45, 0x50, 0xa2, 0xc1, 0x34, 0x9d, 0x39, 0xd0, 0x22, 0x12, 0x4c, 0xf3, 0xcd, 0x9d,
+		0x8f, 0x28, 0x73, 0xa9, 0x88, 0x32, 0x1f, 0x47, 0x94, 0xaf, 0x0a, 0x90, 0x97, 0xf5, 0x46, 0xdb,
+		0xd4, 0x1e, 0xab, 0xe6, 0x06, 0x57, 0xe0, 0xce, 0xc2, 0xe0, 0x26, 0xa9, 0x53, 0x7c, 0xb1, 0x27,
+		0xd0, 0x22, 0x82, 0x63, 0xac, 0x30, 0xa3, 0x1b, 0x70, 0xf0, 0x39, 0x09, 0xae, 0x99, 0x95, 0x3f,
+		0x9d, 0x10, 0x7f, 0xcb, 0x0c, 0x54, 0xfc, 0x1f, 0xfd, 0x70, 0xad, 0xd4, 0x6e, 0xd9, 0x46, 0xab,
+		0xa3, 0x17, 0xad, 0xaa, 0xbe, 0x9d, 0x86, 0x4d, 0x8b, 0x70, 0xc4, 0x9d, 0x31, 0x71, 0x59, 0x90,
+		0x36, 0xca, 0x1b, 0xda, 0xf6, 0xfd, 0x0b, 0xba, 0xa6, 0xdc, 0x1e, 0x5d, 0x53, 0xde, 0xcf, 0xab,
+		0x5e, 0x22, 0x90, 0xc2, 0xbe, 0x47, 0x20, 0x73, 0xa9, 0x22, 0x90, 0x87, 0x30, 0xf1, 0x4c, 0x6d,
+		0x6c, 0xb4, 0xd7, 0xd6, 0x58, 0x7f, 0x46, 0xcb, 0xd6, 0xcd, 0x17, 0x6a, 0x53, 0x31, 0x5a, 0x21,
+		0x77, 0x33, 0xce, 0x00, 0x49, 0x6f, 0x15, 0x06, 0x56, 0x69, 0xed, 0xa7, 0xd3, 0x41, 0x15, 0x18,
+		0x30, 0x5a, 0x86, 0x6d, 0xa8, 0x76, 0xdb, 0x24, 0x61, 0xe8, 0xf0, 0xdc, 0x65, 0x7e, 0x34, 0xe4,
+		0x17, 0x93, 0x8a, 0xd3, 0x44, 0xf6, 0x5a, 0xe3, 0x28, 0x74, 0x4d, 0x35, 0x9a, 0x1d, 0x53, 0x57,
+		0x98, 0x21, 0x7e, 0x4a, 0xd7, 0x98, 0xac, 0x54, 0x26, 0x85, 0x78, 0xcd, 0xea, 0x80, 0x39, 0xa2,
+		0xac, 0x11, 0x26, 0x3a, 0xad, 0xcb, 0x4c, 0xa2, 0x6f, 0xc2, 0x89, 0xa6, 0x6a, 0xd9, 0x4a, 0x83,
+		0xfa, 0x11, 0xcc, 0x53, 0xe6, 0x23, 0x5a, 0x04, 0x3e, 0x87, 0x6b, 0x4b, 0x6e, 0xa5, 0x4c, 0xea,
+		0xd0, 0x24, 0x1c, 0x69, 0x98, 0x98, 0xfd, 0x2c, 0x60, 0x18, 0xdd, 0x21, 0x48, 0x0c, 0xe1, 0x42,
+		0x27, 0x68, 0x13, 0x3f, 0xe9, 0x87, 0xab, 0x84, 0xaa, 0x25, 0xbf, 0x96, 0xbe, 0x12, 0x5b, 0x1b,
+		0xd1, 0x96, 0xfc, 0x3e, 0x68, 0x4b, 0x61, 0x8f, 0xda, 0x32, 0xb7, 0x57, 0x6d, 0x99, 0xdf, 0x77,
+		0x6d, 0x59, 0xdc, 0x53, 0xbc, 0xbe, 0xb2, 0x87, 0x78, 0xdd, 0x6f, 0xc2, 0x9f, 0x06, 0x4d, 0x78,
+		0x03, 0x46, 0x7d, 0xdc, 0x53, 0x4c, 0xbd, 0x63, 0xe9, 0xce, 0x50, 0x1a, 0x19, 0x6a, 0x3a, 0x91,
+		0x4f, 0x15, 0x4d, 0xc6, 0x4d, 0xd8, 0xa0, 0xc7, 0xb7, 0x79, 0xc5, 0x11, 0x3d, 0x6d, 0xed, 0x45,
+		0x4f, 0x53, 0x89, 0xf5, 0xef, 0x8f, 0xc0, 0x61, 0x47, 0x76, 0xb1, 0xe0, 0x69, 0xec, 0xb7, 0x67,
+		0xa6, 0xe3, 0x76, 0x2d, 0x9c, 0x56, 0x54, 0xf0, 0x34, 0xdf, 0x3f, 0xf4, 0x57, 0x04, 0x98, 0x76,
+		0xc3, 0x74, 0x6f, 0xad, 0x82, 0x59, 0xec, 0xf6, 0xaf, 0xba, 0x8a, 0xc2, 0x0c, 0xf9, 0x3b, 0xdc,
+		0x51, 0xd2, 0x2d, 0x9a, 0xe4, 0x0b, 0x56, 0x2a, 0x38, 0xb4, 0x03, 0x67, 0xbd, 0x35, 0x83, 0xc9,
+		0xc5, 0x66, 0x8c, 0x60, 0xc3, 0xdf, 0x03, 0x48, 0x0a, 0x8e, 0xe5, 0x33, 0x56, 0x42, 0x2d, 0xfa,
+		0x5b, 0x02, 0xcc, 0x32, 0xb3, 0xa4, 0x7b, 0xee, 0xdf, 0xd3, 0x26, 0x1e, 0x2a, 0x54, 0xef, 0x3f,
+		0x1b, 0x63, 0x5c, 0x53, 0x87, 0xca, 0xf2, 0xe5, 0x46, 0x7a, 0x60, 0xf4, 0xb1, 0x00, 0x97, 0xb1,
+		0x59, 0x4d, 0x8b, 0xe4, 0x24, 0x41, 0x72, 0x9e, 0x8b, 0x64, 0xca, 0x40, 0x5c, 0xbe, 0xb8, 0x96,
+		0x0e, 0x10, 0xfd, 0x8c, 0x00, 0xd7, 0x9c, 0xad, 0xe1, 0x06, 0x89, 0x7e, 0x53, 0xc8, 0x57, 0x21,
+		0x81, 0x8c, 0x3d, 0x2c, 0x9f, 0xe4, 0xcb, 0x66, 0x7a, 0x60, 0xf4, 0x83, 0x70, 0x8e, 0x21, 0x18,
+		0x2f, 0x6a, 0x73, 0x04, 0xb1, 0x39, 0x3e, 0x7f, 0x93, 0x96, 0x19, 0xf2, 0x78, 0x23, 0xa9, 0x1a,
+		0x7d, 0x53, 0x80, 0xab, 0x6c, 0xf4, 0x94, 0x5c, 0x9c, 0x27, 0xa8, 0xbc, 0x9b, 0x80, 0x4a, 0x1a,
+		0x3e, 0x5e, 0x6a, 0xa4, 0x05, 0x45, 0xbf, 0x29, 0xc0, 0xbb, 0x21, 0x4e, 0xea, 0x2c, 0xf0, 0x4f,
+		0x8b, 0x33, 0x8d, 0x5e, 0x1e, 0x77, 0xe7, 0x6b, 0x0f, 0x2b, 0x0a, 0xf9, 0x8e, 0xb9, 0xc7, 0x96,
+		0xe8, 0x87, 0x61, 0xc2, 0x24, 0xb1, 0xba, 0xc2, 0x02, 0x72, 0x1e, 0xce, 0x74, 0xdb, 0xee, 0x46,
+		0x0c, 0xce, 0x49, 0x91, 0xbe, 0x9c, 0x37, 0x13, 0xeb, 0xd1, 0x2f, 0x0b, 0x70, 0xab, 0xc1, 0x42,
+		0x2e, 0x45, 0xb5, 0x94, 0x96, 0xbe, 0x9d, 0x96, 0x92, 0x4f, 0x09, 0x56, 0x52, 0xf7, 0x28, 0x2e,
+		0x0d, 0x05, 0xaf, 0x35, 0x7a, 0x6c, 0x81, 0xfe, 0xae, 0x00, 0x73, 0xd4, 0x2c, 0x87, 0x56, 0x47,
+		0xc9, 0x58, 0x6b, 0x04, 0xeb, 0x85, 0x78, 0x4b, 0x9d, 0x36, 0x54, 0x93, 0xaf, 0x5a, 0xbd, 0x80,
+		0xa3, 0x7f, 0x20, 0xc0, 0x2d, 0xb6, 0x30, 0xec, 0x55, 0x66, 0xa9, 0x27, 0x5f, 0xe4, 0xe3, 0xdc,
+		0xeb, 0xf2, 0x57, 0xbe, 0x6e, 0xf5, 0xda, 0x44, 0xfc, 0xad, 0x01, 0xb8, 0x18, 0x81, 0x73, 0x4e,
+		0xe7, 0x5e, 0xe8, 0x2d, 0xfb, 0x15, 0x2c, 0xdb, 0x6e, 0xc2, 0x09, 0xb6, 0x7f, 0xed, 0x76, 0xc7,
+		0x22, 0xe3, 0x21, 0x12, 0x92, 0xe4, 0x68, 0xad, 0xd3, 0x03, 0xdd, 0xf7, 0x46, 0xcf, 0x60, 0x2c,
+		0xdc, 0xca, 0x5b, 0xd3, 0x0f, 0xf7, 0xb4, 0xa6, 0x3f, 0x19, 0x1c, 0xc0, 0xad, 0x40, 0x77, 0xdd,
+		0x31, 0xd8, 0xa2, 0x44, 0xd7, 0x14, 0xfd, 0x05, 0xf9, 0xaf, 0x8d, 0x66, 0xc9, 0x8e, 0x15, 0x43,
+		0xbd, 0xe2, 0xd4, 0x13, 0x22, 0x55, 0xb4, 0x3f, 0xa1, 0x6b, 0xd1, 0x70, 0x74, 0x7d, 0x73, 0x2f,
+		0xd1, 0xf5, 0x3b, 0x70, 0xca, 0xb1, 0x11, 0x9a, 0x4f, 0x5f, 0xd8, 0x06, 0xd3, 0x2d, 0x22, 0x13,
+		0x27, 0x5d, 0x08, 0x8f, 0x8d, 0x64, 0xcb, 0x29, 0xb0, 0xfc, 0xbc, 0xfd, 0x52, 0xcb, 0xcf, 0x3b,
+		0x30, 0xea, 0xe1, 0x11, 0x5a, 0x88, 0xde, 0x21, 0x58, 0x9c, 0x70, 0xeb, 0x17, 0x03, 0x2b, 0xd2,
+		0x7b, 0x30, 0x16, 0x6d, 0xe9, 0xac, 0x4d, 0xef, 0x12, 0xa6, 0x9e, 0x0c, 0x37, 0xed, 0xbe, 0x48,
+		0xbd, 0x97, 0xb0, 0x48, 0x3d, 0x05, 0x87, 0x0d, 0x4d, 0x27, 0x47, 0xdb, 0xc4, 0x59, 0x0f, 0xc8,
+		0xee, 0xff, 0xfd, 0x59, 0xd6, 0x8f, 0xc2, 0x21, 0xd5, 0xb6, 0xf5, 0xcd, 0x2d, 0x9b, 0x38, 0xa9,
+		0x7e, 0xd9, 0xf9, 0x8b, 0xae, 0x43, 0x4e, 0xdf, 0xd9, 0x32, 0xe8, 0x79, 0x0f, 0x91, 0x1b, 0xcb,
+		0x56, 0x37, 0xb7, 0xd8, 0x89, 0xd1, 0x31, 0xaf, 0xae, 0xee, 0x54, 0x45, 0xd7, 0x1e, 0x5a, 0x74,
+		0xed, 0x81, 0x96, 0x60, 0x72, 0xcd, 0x30, 0x2d, 0xdb, 0x33, 0x94, 0x44, 0x4e, 0xdd, 0xbd, 0x0e,
+		0x26, 0x98, 0x2d, 0x82, 0xcd, 0x59, 0x02, 0xea, 0x2e, 0x3a, 0x54, 0x6b, 0x63, 0x81, 0x6d, 0x75,
+		0xb0, 0xed, 0xe3, 0x2f, 0x0b, 0x70, 0x89, 0x73, 0x54, 0x45, 0xc8, 0x18, 0x35, 0x6d, 0x31, 0xbb,
+		0xc7, 0x68, 0x11, 0xce, 0x05, 0xb1, 0x69, 0x38, 0x3d, 0x78, 0x76, 0x81, 0xee, 0x64, 0x9f, 0xd1,
+		0x7c, 0xb8, 0x04, 0xc7, 0xa9, 0x68, 0xe2, 0x4f, 0x09, 0x70, 0x21, 0x82, 0x0d, 0x3b, 0xf5, 0xe7,
+		0xa1, 0xd2, 0xcb, 0x2e, 0x74, 0x2a, 0x24, 0xf3, 0x29, 0x90, 0x6c, 0x43, 0x21, 0x82, 0x23, 0xe6,
+		0xa1, 0xb6, 0xdc, 0xb1, 0xc3, 0x58, 0x96, 0x60, 0xc8, 0xb1, 0x18, 0xbe, 0xa5, 0x21, 0x5f, 0xc6,
+		0x98, 0xcd, 0x20, 0x9e, 0x60, 0xd0, 0xf6, 0xfe, 0x88, 0x1f, 0x1e, 0x04, 0xce, 0x39, 0xaa, 0xa3,
+		0x27, 0x44, 0x51, 0xc3, 0xe3, 0xce, 0x42, 0x0e, 0x07, 0x32, 0x11, 0x2b, 0x41, 0x69, 0x75, 0xb4,
+		0xa5, 0x6f, 0x87, 0xec, 0x43, 0xc4, 0x69, 0xe5, 0xf6, 0x61, 0xf7, 0x64, 0x5f, 0x8e, 0xc1, 0x7a,
+		0xb1, 0xef, 0x73, 0xfb, 0x6e, 0xdf, 0xe7, 0x53, 0xd9, 0xf7, 0x34, 0xa2, 0xb5, 0xd8, 0x5d, 0xb4,
+		0xd2, 0xed, 0x59, 0xae, 0xa4, 0xd9, 0xb3, 0x0c, 0xd8, 0xfb, 0xa7, 0xfb, 0xbc, 0xdd, 0xa8, 0xa5,
+		0xdc, 0x6e, 0x6c, 0xf5, 0xb8, 0xdd, 0xb8, 0x13, 0x6f, 0xc9, 0xc5, 0xef, 0x08, 0x30, 0xe5, 0xb7,
+		0x63, 0x8e, 0x3d, 0x8c, 0x18, 0x86, 0x97, 0x39, 0x59, 0xef, 0x7e, 0xbc, 0x99, 0xeb, 0x7a, 0xbc,
+		0xe9, 0xf3, 0x08, 0xd4, 0x9a, 0x38, 0x7f, 0xc5, 0xbf, 0x24, 0x80, 0x18, 0x98, 0x02, 0x3f, 0x7e,
+		0xbc, 0x02, 0xc8, 0x71, 0x00, 0x3e, 0xf1, 0x01, 0xd2, 0x57, 0xd6, 0x0a, 0x4c, 0xbb, 0xa2, 0x05,
+		0x3c, 0x5c, 0x2e, 0xe4, 0xe1, 0xc6, 0x01, 0x7c, 0x19, 0x61, 0xf4, 0x20, 0x66, 0xc0, 0x74, 0x92,
+		0xc1, 0xc4, 0x3f, 0x0c, 0x91, 0x34, 0xd6, 0xec, 0x5f, 0x86, 0xa3, 0x9e, 0x0a, 0x62, 0x07, 0xad,
+		0xef, 0x38, 0x1e, 0x20, 0xab, 0xfb, 0x0d, 0x92, 0xbe, 0x63, 0xc7, 0xa0, 0x9f, 0x8b, 0x41, 0xdf,
+		0x49, 0x5d, 0x8b, 0x1a, 0xe1, 0x61, 0xcb, 0x47, 0x9e, 0xd0, 0x44, 0x0b, 0xa1, 0x89, 0x5e, 0x84,
+		0x91, 0x67, 0x46, 0x4b, 0x35, 0x77, 0x95, 0xc6, 0x73, 0xbd, 0xb1, 0x61, 0x75, 0x36, 0x89, 0x29,
+		0x18, 0x90, 0x87, 0x69, 0x71, 0x89, 0x95, 0x8a, 0xdf, 0x15, 0x60, 0xd2, 0x3f, 0xe5, 0x38, 0xbb,
+		0xdd, 0x1b, 0x0f, 0x78, 0x93, 0xc8, 0x71, 0x27, 0x11, 0xf6, 0x07, 0xf9, 0xbd, 0xf8, 0x83, 0x3f,
+		0xca, 0xc0, 0x84, 0x7f, 0x12, 0x7c, 0x07, 0xf9, 0xaa, 0xa6, 0xb0, 0x00, 0xfd, 0x0d, 0xb5, 0x63,
+		0x39, 0xb8, 0x5f, 0x49, 0xde, 0xe6, 0x74, 0xd1, 0x2b, 0xe1, 0x36, 0x32, 0x6d, 0xea, 0x77, 0xd2,
+		0x93, 0x41, 0x27, 0x9d, 0xc4, 0x65, 0xcf, 0xe5, 0xcf, 0x05, 0x5c, 0x7e, 0x1e, 0x06, 0x9f, 0xa9,
+		0x96, 0xee, 0xf8, 0x38, 0x1a, 0xe7, 0x0d, 0xe0, 0x22, 0xea, 0xdb, 0xce, 0x00, 0x60, 0x67, 0xc8,
+		0xaa, 0x69, 0xb2, 0xd0, 0xe1, 0x96, 0xbe, 0x4d, 0x6b, 0xaf, 0x00, 0x5a, 0x6b, 0x9b, 0x1b, 0x6c,
+		0xda, 0x2f, 0x74, 0xd3, 0x72, 0x12, 0x85, 0xfa, 0xe4, 0x2c, 0xae, 0x21, 0x13, 0x7f, 0x9f, 0x96,
+		0x8b, 0x1f, 0xf7, 0xc3, 0x94, 0x7f, 0x0f, 0x2b, 0xd6, 0x0c, 0xbd, 0x4d, 0x48, 0xf9, 0x13, 0x91,
+		0x90, 0x92, 0xc6, 0xf3, 0x3f, 0x4d, 0xe1, 0xf9, 0xf7, 0xe3, 0xec, 0x42, 0xfc, 0x19, 0x01, 0xc4,
+		0x80, 0x70, 0xbe, 0x59, 0x07, 0xe3, 0x77, 0x85, 0x85, 0xc0, 0xe2, 0x48, 0xfc, 0x45, 0x21, 0xa8,
+		0x46, 0x3d, 0xaf, 0x38, 0xde, 0x80, 0x97, 0x11, 0xff, 0x85, 0x00, 0x13, 0x7e, 0xa4, 0xf7, 0x6b,
+		0x61, 0xc2, 0x9f, 0x4b, 0xbe, 0x87, 0xb9, 0x14, 0xba, 0xce, 0x65, 0x2e, 0x34, 0x97, 0xef, 0x09,
+		0x30, 0xe9, 0x9f, 0x4b, 0x9c, 0x23, 0xf4, 0x61, 0xdd, 0x9f, 0x06, 0xeb, 0x4f, 0x89, 0x8b, 0xfc,
+		0xcb, 0x02, 0x4c, 0x07, 0xe4, 0x8b, 0xec, 0x4b, 0x7b, 0x09, 0xe7, 0xbd, 0x1b, 0xeb, 0xfd, 0x59,
+		0xe0, 0xfe, 0xbc, 0x00, 0xf1, 0x87, 0x27, 0x7c, 0x79, 0xea, 0x8a, 0x5b, 0xce, 0x71, 0xc8, 0x2c,
+		0xf3, 0x98, 0xfc, 0xd9, 0xb7, 0xd5, 0xee, 0xff, 0x0a, 0x09, 0x0a, 0x45, 0x5b, 0xd7, 0x12, 0x04,
+		0x05, 0x22, 0xeb, 0xee, 0xa6, 0x6a, 0xfb, 0x4e, 0x2c, 0x4c, 0x87, 0x0d, 0x11, 0xda, 0x51, 0x38,
+		0x1e, 0xb3, 0xa8, 0xa3, 0x7e, 0xed, 0x6a, 0xf2, 0x5d, 0x01, 0x4e, 0x93, 0xe3, 0xa2, 0x18, 0x53,
+		0xfa, 0x2a, 0x53, 0xfb, 0xf6, 0x8d, 0x85, 0xdf, 0x0f, 0x63, 0x64, 0x0e, 0x78, 0x88, 0x5e, 0x66,
+		0x90, 0x5a, 0x67, 0xc5, 0x7f, 0x26, 0xc0, 0x19, 0x32, 0x44, 0x9c, 0x74, 0xec, 0xc7, 0x28, 0xfb,
+		0x45, 0x8f, 0x44, 0x1b, 0xff, 0xcb, 0x02, 0x9c, 0xf3, 0x1d, 0x22, 0xf2, 0x55, 0x32, 0x61, 0x36,
+		0xaf, 0x54, 0x19, 0x13, 0x31, 0xff, 0x8b, 0x19, 0x98, 0x8d, 0xee, 0x12, 0x25, 0xdb, 0x3d, 0x17,
+		0x5b, 0xf0, 0x63, 0x7b, 0x1f, 0x4e, 0xbb, 0x07, 0x34, 0x9c, 0x0d, 0x7e, 0xca, 0x9c, 0x51, 0x07,
+		0x24, 0xb2, 0xc5, 0xbf, 0xe6, 0x6b, 0xce, 0x39, 0x83, 0xc8, 0xf7, 0x74, 0x06, 0x31, 0xa6, 0xc7,
+		0x9d, 0xd2, 0x24, 0x12, 0xe3, 0x23, 0x01, 0x0a, 0x31, 0xc4, 0xe0, 0x1d, 0xd8, 0x74, 0xe7, 0x0e,
+		0xa4, 0xe0, 0x4e, 0x7c, 0x02, 0xec, 0x6f, 0x0b, 0x30, 0x4e, 0x4f, 0x20, 0xe9, 0x69, 0x25, 0xd7,
+		0xca, 0xef, 0x35, 0x21, 0x71, 0xbf, 0x84, 0xcb, 0x4b, 0x6c, 0x2c, 0xa4, 0x4f, 0x6c, 0xfc, 0x21,
+		0x0e, 0x9d, 0xe9, 0x89, 0x1c, 0x77, 0x8e, 0xfe, 0xc4, 0x50, 0x88, 0x4f, 0x0c, 0xcd, 0xf9, 0x97,
+		0x1d, 0x7e, 0x3e, 0xe7, 0x43, 0x7c, 0xfe, 0x22, 0x4c, 0x47, 0xf7, 0x62, 0x75, 0x73, 0xd3, 0x68,
+		0xa9, 0xf6, 0x7e, 0x84, 0x66, 0x49, 0x63, 0xff, 0x6a, 0x06, 0xde, 0x4d, 0x77, 0x60, 0x1e, 0x54,
+		0x91, 0x57, 0x20, 0x79, 0xde, 0x1a, 0x30, 0x17, 0x58, 0x03, 0xae, 0x02, 0x7a, 0x69, 0x0d, 0x3c,
+		0xba, 0x1d, 0xd1, 0xbc, 0xfd, 0xcb, 0x5f, 0xfe, 0x5a, 0x1f, 0xbc, 0x93, 0x8e, 0x86, 0x7c, 0x4b,
+		0xbc, 0xea, 0x37, 0x60, 0xc3, 0x73, 0xef, 0x25, 0x64, 0x62, 0x74, 0xe9, 0x39, 0xb0, 0x3f, 0xb1,
+		0x4f, 0xe1, 0x9e, 0x8f, 0x2f, 0xf9, 0x14, 0x7c, 0x29, 0xbc, 0x2c, 0x5f, 0xae, 0x00, 0xe2, 0xd8,
+		0xeb, 0x39, 0x1a, 0x21, 0x19, 0x61, 0x3b, 0xed, 0xe3, 0xe2, 0x7c, 0x80, 0x8b, 0xe2, 0x6f, 0x09,
+		0x70, 0x3b, 0x96, 0x5c, 0x5d, 0x5c, 0x0a, 0x1f, 0x07, 0x88, 0xc1, 0xe1, 0xf5, 0x0a, 0xae, 0xf8,
+		0x5f, 0x33, 0x70, 0xbb, 0x4b, 0xbe, 0xc0, 0x1f, 0x37, 0x5d, 0x0d, 0x19, 0xdd, 0x42, 0xbc, 0xd1,
+		0x9d, 0x8b, 0xc9, 0xc6, 0x9f, 0x4f, 0xa5, 0xe2, 0x8b, 0x71, 0x2a, 0xfe, 0x51, 0x1f, 0xdc, 0xec,
+		0x42, 0xf3, 0x97, 0xd0, 0xed, 0x54, 0x3d, 0xbf, 0xd5, 0x6d, 0x4f, 0xb7, 0x7f, 0x4f, 0x80, 0x6b,
+		0xb1, 0xe4, 0x8a, 0xf3, 0xdc, 0x9f, 0x66, 0xa5, 0x8e, 0xf7, 0x46, 0xe2, 0x4f, 0x1f, 0x84, 0x1b,
+		0x09, 0x29, 0x4d, 0xb1, 0xaa, 0xfe, 0x36, 0x07, 0xfd, 0x8f, 0x63, 0x0e, 0x7a, 0x1a, 0xc5, 0xd7,
+		0x52, 0x28, 0x7e, 0x52, 0x2e, 0x7b, 0xeb, 0x55, 0xe5, 0xb2, 0xef, 0xec, 0x25, 0x39, 0x65, 0x2a,
+		0x9c, 0x4f, 0xf2, 0xa1, 0xc0, 0x49, 0x66, 0xff, 0xf1, 0x3e, 0xb8, 0x96, 0xa0, 0x25, 0xb1, 0xbb,
+		0x9c, 0x6f, 0x56, 0x45, 0x96, 0x1c, 0xef, 0x40, 0xbf, 0x2c, 0xbe, 0x15, 0x2f, 0x25, 0x69, 0x9c,
+		0x42, 0xfc, 0xc7, 0x67, 0x7c, 0xd3, 0x37, 0x1f, 0x63, 0xfa, 0xf6, 0x29, 0x11, 0x40, 0xfc, 0x5a,
+		0x06, 0xae, 0xf0, 0x91, 0x8f, 0xd9, 0x88, 0x8a, 0xe3, 0x07, 0x1f, 0xfd, 0x5c, 0x0c, 0xfa, 0xaf,
+		0xc8, 0x42, 0x47, 0x78, 0x5e, 0xd8, 0x13, 0xcf, 0xc5, 0xdf, 0xce, 0x40, 0x0c, 0x4b, 0x7b, 0x3e,
+		0x3f, 0x78, 0xcd, 0x3e, 0x6a, 0x9f, 0x28, 0xd0, 0x63, 0x14, 0xc0, 0xdb, 0x5a, 0x9b, 0xe7, 0x6e,
+		0xe0, 0xfd, 0x7e, 0x06, 0x2e, 0xf7, 0xa8, 0xfe, 0x3d, 0xae, 0xa4, 0x5f, 0x73, 0xb8, 0x14, 0x21,
+		0xf8, 0xdc, 0x7e, 0x12, 0x7c, 0xbe, 0x07, 0x82, 0x2f, 0x72, 0x09, 0xfe, 0x6f, 0x32, 0x70, 0x35,
+		0x46, 0x94, 0x7b, 0xde, 0x60, 0x7f, 0x2b, 0xcb, 0x01, 0xd2, 0xfe, 0x99, 0xbe, 0x38, 0xd2, 0xbe,
+		0xca, 0x2c, 0xbd, 0xb7, 0x5c, 0x08, 0x72, 0xe1, 0x3b, 0x19, 0x98, 0x8d, 0xe1, 0x42, 0xd2, 0xfe,
+		0x1c, 0xd7, 0x89, 0xf1, 0x49, 0x98, 0xdb, 0x77, 0x12, 0xe6, 0xf7, 0x93, 0x84, 0x85, 0x1e, 0x48,
+		0x38, 0xc7, 0x25, 0xe1, 0x5f, 0xbd, 0x05, 0x43, 0x0f, 0xe9, 0xe5, 0x37, 0xa4, 0x08, 0x9f, 0x3b,
+		0x84, 0x16, 0x5f, 0x87, 0x74, 0xd6, 0xeb, 0x19, 0x18, 0xf0, 0x52, 0x8c, 0xa9, 0x7b, 0xf7, 0x0a,
+		0xd0, 0x7d, 0x00, 0xda, 0xd0, 0x77, 0xa2, 0x9a, 0xe7, 0x4e, 0x93, 0x0c, 0x44, 0xe6, 0x38, 0xa0,
+		0x3b, 0x3f, 0xb1, 0xe9, 0x71, 0xf2, 0x62, 0x26, 0xe9, 0xb0, 0xec, 0x2f, 0x3a, 0x09, 0x87, 0x48,
+		0x98, 0x63, 0x68, 0xa3, 0x53, 0xa4, 0xe6, 0x20, 0xfe, 0x5b, 0xd1, 0xc8, 0xf7, 0x70, 0x51, 0x9e,
+		0x29, 0xc1, 0x99, 0x47, 0xbe, 0x36, 0x9b, 0x4f, 0xc7, 0x4c, 0x7e, 0x0c, 0x24, 0x5f, 0xdc, 0x4e,
+		0x07, 0x48, 0x3e, 0xf4, 0xe2, 0x20, 0x17, 0x8e, 0xd5, 0x22, 0xdf, 0x9c, 0xbd, 0x9b, 0xf2, 0x8a,
+		0xa3, 0x98, 0x60, 0x44, 0xbe, 0xb4, 0x9d, 0x16, 0x94, 0x7c, 0x0c, 0xca, 0x41, 0x71, 0x8d, 0x78,
+		0xe3, 0x28, 0x7e, 0xf3, 0x09, 0x1f, 0x83, 0xa6, 0x73, 0xe9, 0xf2, 0x85, 0xed, 0x74, 0xae, 0xff,
+		0x6f, 0xf0, 0x89, 0x87, 0x85, 0x4d, 0x53, 0xb0, 0x09, 0x8d, 0x20, 0x47, 0x13, 0xeb, 0xef, 0xa7,
+		0x43, 0x2e, 0xc6, 0x46, 0xcb, 0x85, 0xed, 0xb4, 0xd6, 0xfc, 0xc7, 0x05, 0x28, 0x04, 0x83, 0xf0,
+		0xf0, 0xb1, 0x71, 0xe4, 0xcb, 0xb2, 0xbb, 0x5d, 0x93, 0xd8, 0xe2, 0xf2, 0xbc, 0xe4, 0x29, 0x2d,
+		0x05, 0x14, 0xfa, 0xb2, 0x00, 0x17, 0x42, 0x38, 0xc5, 0x69, 0x02, 0xfd, 0xaa, 0xec, 0x76, 0x77,
+		0x8c, 0xf8, 0x4a, 0x20, 0x6a, 0x5d, 0x61, 0x38, 0x14, 0x4a, 0x10, 0x7d, 0x2d, 0x25, 0x85, 0x62,
+		0xa5, 0x7e, 0x4a, 0x4b, 0x01, 0x85, 0xbe, 0x12, 0xc1, 0x29, 0x41, 0xa2, 0x68, 0x76, 0xd4, 0x9d,
+		0xae, 0x38, 0xc5, 0x09, 0xd3, 0xa4, 0xd6, 0x1d, 0x08, 0xfd, 0x79, 0x01, 0xce, 0x07, 0x31, 0x8a,
+		0xd3, 0x3e, 0xba, 0x38, 0xbf, 0x95, 0x32, 0x13, 0x32, 0x8c, 0xcc, 0x84, 0xd6, 0x0d, 0x04, 0xfd,
+		0x84, 0x00, 0x85, 0xe0, 0x17, 0xbb, 0x09, 0x22, 0xfd, 0xa1, 0x90, 0xc0, 0xb1, 0x34, 0xb9, 0x8b,
+		0xf2, 0x94, 0x9a, 0x02, 0x0a, 0x7d, 0x24, 0xc0, 0x85, 0x10, 0x52, 0x71, 0x32, 0xfd, 0x91, 0x90,
+		0x20, 0xd4, 0xdd, 0x33, 0xd6, 0x64, 0x51, 0xed, 0x0a, 0xc3, 0xa1, 0x51, 0x82, 0x50, 0x7f, 0x3d,
+		0x2d, 0x8d, 0xe2, 0xa5, 0x5a, 0x4d, 0x01, 0x85, 0xfe, 0x82, 0x00, 0xe7, 0x83, 0x48, 0xc5, 0xc9,
+		0xd0, 0x37, 0x85, 0x04, 0x21, 0xea, 0x9a, 0x25, 0x24, 0x4f, 0xa8, 0xdd, 0x40, 0xd0, 0x57, 0x23,
+		0x04, 0x4a, 0xd0, 0xb0, 0x9f, 0x15, 0x12, 0x54, 0x2c, 0x45, 0xe2, 0x98, 0x3c, 0xa9, 0x76, 0x07,
+		0x42, 0x2f, 0x20, 0x4f, 0x13, 0x29, 0x62, 0x25, 0xe7, 0x97, 0x28, 0x1e, 0xd7, 0x62, 0x63, 0xf1,
+		0x98, 0xcc, 0x1c, 0xf9, 0xb4, 0x1d, 0x5f, 0x89, 0xb6, 0xe0, 0x0c, 0x1d, 0x77, 0xcd, 0x30, 0x79,
+		0xa3, 0xfe, 0x23, 0x3a, 0xea, 0x4c, 0xfc, 0xa8, 0xbc, 0x5c, 0x1a, 0x79, 0xcc, 0x8e, 0xab, 0x42,
+		0x7f, 0x53, 0x80, 0xd9, 0x90, 0x74, 0xf2, 0x93, 0xa1, 0x7c, 0x58, 0xfc, 0x73, 0x8a, 0xc5, 0x7b,
+		0xdd, 0x85, 0x34, 0xf1, 0x48, 0x4e, 0x9e, 0x56, 0x53, 0xc3, 0xa2, 0x5f, 0x14, 0xe0, 0x66, 0xe2,
+		0x55, 0x01, 0x71, 0xf2, 0xfb, 0xaf, 0x29, 0xb2, 0xa5, 0xde, 0xee, 0x0b, 0xe0, 0x0b, 0xf3, 0x8c,
+		0xd9, 0x13, 0x3c, 0xf6, 0x1d, 0x17, 0x79, 0xc4, 0xe5, 0xe1, 0xf9, 0xbd, 0xb4, 0x82, 0x1d, 0xb3,
+		0x0e, 0x0f, 0x0a, 0x76, 0x0c, 0x10, 0xda, 0x85, 0xb3, 0x54, 0xc0, 0xe2, 0x11, 0xf9, 0x5d, 0x21,
+		0xe1, 0xca, 0x8c, 0xa4, 0x64, 0x2a, 0xf9, 0x8c, 0x9d, 0x50, 0x8b, 0x7e, 0x54, 0x80, 0xa9, 0xc0,
+		0x1d, 0x0a, 0x71, 0x1c, 0xfb, 0x43, 0x8a, 0xc0, 0x67, 0xba, 0x5d, 0xa4, 0xc0, 0xe7, 0xd1, 0xb9,
+		0x46, 0x17, 0x08, 0xf4, 0xa7, 0xe0, 0x1c, 0x4b, 0x69, 0x31, 0x59, 0xd2, 0x4b, 0x14, 0x87, 0xff,
+		0x29, 0x24, 0x5c, 0xe6, 0x90, 0x98, 0x31, 0x23, 0x8f, 0x6f, 0x26, 0x55, 0xe3, 0x30, 0xf5, 0x0a,
+		0x6f, 0x01, 0xc2, 0x4e, 0xb8, 0xa2, 0xa8, 0x7c, 0x29, 0xd3, 0x4b, 0x98, 0x1a, 0x73, 0x52, 0xc6,
+		0x09, 0x53, 0x63, 0x20, 0xd1, 0xb7, 0x04, 0x98, 0xe1, 0x20, 0x68, 0xbb, 0xab, 0xe3, 0x28, 0x8a,
+		0x3f, 0x96, 0x49, 0x30, 0x08, 0xe9, 0xd7, 0xd9, 0xf2, 0xf4, 0x76, 0x6a, 0x58, 0xf4, 0x4b, 0x02,
+		0xdc, 0xe4, 0xad, 0x95, 0xba, 0x5a, 0xaf, 0x6f, 0x50, 0x64, 0xcb, 0x29, 0xd7, 0x4c, 0xc9, 0x26,
+		0x6c, 0x76, 0xbb, 0xb7, 0x06, 0x71, 0xec, 0x8f, 0x57, 0xc7, 0x9f, 0xea, 0x89, 0xfd, 0x71, 0xaa,
+		0x59, 0xd8, 0x4e, 0x09, 0x89, 0xfe, 0xa3, 0x00, 0x52, 0x0f, 0x37, 0x79, 0x84, 0x37, 0x18, 0x7c,
+		0x98, 0xff, 0x1c, 0xc5, 0xbc, 0xf6, 0x12, 0x37, 0x7a, 0xc4, 0x9d, 0x84, 0xca, 0xef, 0x9a, 0x2f,
+		0xd5, 0x1e, 0xfd, 0x5b, 0x01, 0x16, 0x7a, 0x98, 0x65, 0x9c, 0xa9, 0xfa, 0xfb, 0x74, 0x8a, 0x2b,
+		0x2f, 0x31, 0x45, 0xbe, 0x15, 0x7b, 0xc7, 0xdc, 0x7b, 0x63, 0xf4, 0xeb, 0x02, 0xdc, 0x4f, 0x9a,
+		0x4d, 0x77, 0x1d, 0xf9, 0x0e, 0x9d, 0xd7, 0x12, 0x7f, 0x2b, 0x66, 0x6f, 0x19, 0x38, 0xf2, 0x6d,
+		0x7d, 0x6f, 0x0d, 0x89, 0xef, 0xe7, 0x4d, 0xc3, 0xfd, 0x64, 0x9f, 0x5d, 0x8f, 0x12, 0x99, 0xc6,
+		0xbf, 0xcc, 0x24, 0xf8, 0xfe, 0xde, 0xbe, 0x5c, 0x96, 0x67, 0xb6, 0x7b, 0x82, 0x47, 0xbf, 0x2a,
+		0xc0, 0xdd, 0x2e, 0xb7, 0xa0, 0x24, 0xe8, 0xce, 0x27, 0x14, 0xf3, 0x87, 0xbd, 0xde, 0x86, 0x12,
+		0xab, 0x30, 0x37, 0xac, 0xde, 0x1b, 0xa1, 0x5f, 0xc1, 0x37, 0xa3, 0x24, 0xcf, 0x21, 0x4e, 0x33,
+		0x7e, 0x27, 0x93, 0x70, 0x09, 0x4d, 0xaf, 0xa7, 0xba, 0xf2, 0x35, 0xab, 0xc7, 0x16, 0xe8, 0xef,
+		0x08, 0x70, 0x3d, 0x16, 0xe9, 0xd8, 0xa8, 0xfe, 0x3f, 0x53, 0xac, 0x8b, 0x3d, 0x1c, 0xda, 0xc6,
+		0x84, 0xf9, 0x57, 0x1a, 0x3d, 0x40, 0xa3, 0x5f, 0x10, 0xe0, 0x46, 0x2c, 0xb6, 0x09, 0xcb, 0xc5,
+		0xff, 0x96, 0x24, 0xe0, 0xbd, 0x9d, 0x48, 0xca, 0x33, 0x8d, 0x9e, 0xe0, 0xd1, 0xdf, 0x16, 0xe0,
+		0x5a, 0xcf, 0x62, 0xf1, 0xbf, 0x33, 0x49, 0x97, 0xa0, 0xf5, 0x20, 0x11, 0x97, 0x1b, 0x3d, 0x08,
+		0xc3, 0xb7, 0x05, 0x98, 0x8b, 0x27, 0x6f, 0xac, 0xeb, 0xfd, 0xd1, 0xbe, 0x84, 0x2b, 0x89, 0x7a,
+		0x3a, 0x24, 0x93, 0xaf, 0x36, 0x7a, 0x01, 0x47, 0x3f, 0x9f, 0x24, 0x0f, 0x09, 0xab, 0xe3, 0xaf,
+		0xf6, 0x8e, 0x71, 0xdc, 0x3a, 0xf9, 0x6a, 0xa3, 0x17, 0x70, 0x12, 0x8e, 0xc5, 0x63, 0x9c, 0x10,
+		0x3b, 0x7e, 0xdc, 0x97, 0x10, 0x8e, 0xf5, 0x78, 0x50, 0x23, 0xcf, 0x36, 0x7a, 0x6b, 0x40, 0x5c,
+		0x65, 0x8a, 0xbb, 0x9f, 0x12, 0x2c, 0xf5, 0xb7, 0xfa, 0x12, 0x5c, 0xe5, 0x1e, 0x73, 0x3a, 0xe5,
+		0xdb, 0xd6, 0xde, 0x1a, 0xa2, 0x5f, 0x13, 0xe0, 0x5e, 0x8a, 0xf9, 0xc4, 0xa9, 0xe7, 0xb7, 0xe9,
+		0x64, 0x2a, 0x7b, 0x4f, 0x69, 0x0c, 0xcf, 0xe4, 0xa6, 0xb5, 0x87, 0x56, 0xf8, 0xf2, 0xb3, 0xcf,
+		0x24, 0xe1, 0x1f, 0xbf, 0x5a, 0xfa, 0x95, 0xbe, 0x04, 0xbf, 0xd3, 0x6b, 0x7e, 0xa1, 0x7c, 0x4d,
+		0xef, 0xb1, 0x85, 0x58, 0x86, 0x43, 0xec, 0x68, 0x0c, 0xdd, 0x85, 0x83, 0x04, 0x3d, 0xe7, 0x41,
+		0x14, 0xfe, 0xf9, 0x9d, 0xff, 0x20, 0x4d, 0x66, 0x0d, 0xc4, 0x7b, 0x70, 0x32, 0x4a, 0x29, 0xa3,
+		0x69, 0xeb, 0x66, 0xd7, 0x17, 0x3f, 0xc4, 0x02, 0x20, 0xff, 0x99, 0x20, 0x6b, 0xc6, 0x7b, 0xed,
+		0xe4, 0x09, 0x8c, 0xb8, 0x9f, 0x2e, 0x33, 0xb0, 0x49, 0x38, 0xa2, 0xab, 0x66, 0xd3, 0xc0, 0x71,
+		0xb1, 0x6d, 0x30, 0xf8, 0x3e, 0x79, 0xc8, 0x29, 0xc4, 0xa0, 0x18, 0x05, 0xf6, 0xe1, 0x1c, 0x01,
+		0xa1, 0xa7, 0x7a, 0x40, 0x8b, 0x30, 0x80, 0xf8, 0xb3, 0x19, 0x00, 0xf6, 0xdc, 0x05, 0x7e, 0x52,
+		0x84, 0x33, 0x36, 0x26, 0x0e, 0x7b, 0xa5, 0x23, 0xe9, 0x2d, 0x15, 0xda, 0x09, 0x7b, 0x95, 0x83,
+		0x35, 0x40, 0xe7, 0x60, 0x50, 0xd3, 0xad, 0x86, 0x69, 0x6c, 0xb9, 0x27, 0xd6, 0x03, 0xb2, 0xbf,
+		0x08, 0x23, 0xd8, 0xde, 0x6e, 0xe9, 0xa6, 0xa2, 0x6f, 0xaa, 0x46, 0xd3, 0xc9, 0x2d, 0x26, 0x45,
+		0x12, 0x2e, 0x41, 0xf7, 0xe1, 0x80, 0xa6, 0xda, 0xea, 0xe8, 0x1c, 0x61, 0xcc, 0xa5, 0x84, 0xb1,
+		0xf1, 0x04, 0x66, 0xca, 0xaa, 0xad, 0xd2, 0xb7, 0x6a, 0x48, 0x33, 0x3c, 0xa1, 0x4e, 0xc7, 0xfd,
+		0xa2, 0x9f, 0xfc, 0x3e, 0x75, 0x1b, 0x06, 0x5c, 0xb0, 0x6e, 0x6f, 0xd7, 0x0c, 0xf8, 0xdf, 0xae,
+		0xf9, 0xe9, 0x03, 0x70, 0x8c, 0x8e, 0x15, 0x7c, 0x89, 0x63, 0x87, 0x7b, 0x50, 0x69, 0xea, 0xb6,
+		0xde, 0x22, 0xbf, 0xb6, 0x74, 0xd3, 0x68, 0x6b, 0x8a, 0xd1, 0x52, 0x34, 0x75, 0xd7, 0x62, 0x37,
+		0x8a, 0x9c, 0x9e, 0xa1, 0x4f, 0x4d, 0xcd, 0x38, 0x4f, 0x4d, 0xcd, 0x54, 0x5a, 0xf6, 0x8d, 0xb9,
+		0xf7, 0xf1, 0x68, 0x9c, 0x93, 0x34, 0xd9, 0xe9, 0x6d, 0x85, 0x74, 0x56, 0x69, 0x95, 0xd5, 0x5d,
+		0x0b, 0xbd, 0x03, 0x83, 0xfa, 0xa6, 0x61, 0x2b, 0x9b, 0xba, 0x6d, 0x1a, 0x0d, 0x76, 0x9e, 0x7d,
+		0x2a, 0xd2, 0xf3, 0x42, 0xbb, 0xdd, 0xa4, 0x1d, 0x03, 0x06, 0x7f, 0x4c, 0xa0, 0x51, 0x15, 0x72,
+		0xaa, 0xd9, 0x78, 0x6e, 0xe0, 0xcb, 0x61, 0x9e, 0x75, 0x1a, 0x1b, 0xba, 0xed, 0x5d, 0xb7, 0x3e,
+		0x38, 0x77, 0x26, 0xd2, 0x4b, 0xcd, 0x36, 0x8d, 0xd6, 0x3a, 0xed, 0x07, 0x39, 0x2d, 0x17, 0x48,
+		0x43, 0x92, 0x06, 0xae, 0xc3, 0x84, 0xdb, 0x5f, 0xec, 0xe4, 0x0b, 0xdd, 0x27, 0x3f, 0xee, 0xf4,
+		0xc2, 0x9f, 0xf3, 0x12, 0x8c, 0xb8, 0xc3, 0x04, 0x9e, 0x8f, 0x99, 0xe4, 0x6f, 0xc8, 0x31, 0x58,
+		0x26, 0x9a, 0xc3, 0x6a, 0xe0, 0x3f, 0x5a, 0x81, 0xe3, 0x61, 0x22, 0x10, 0xe9, 0x1b, 0x9d, 0x4f,
+		0x41, 0x85, 0x63, 0x41, 0x2a, 0x2c, 0xe3, 0x86, 0xe2, 0x57, 0x32, 0x90, 0x5d, 0xdd, 0xd2, 0x54,
+		0x5b, 0xf7, 0x29, 0xd6, 0xbb, 0x41, 0x4d, 0x80, 0x14, 0x9d, 0x07, 0xf4, 0xe4, 0x7e, 0x50, 0x4f,
+		0x72, 0x29, 0xda, 0xfb, 0xb5, 0xa8, 0xc4, 0xb4, 0x28, 0x4f, 0xb4, 0x68, 0x96, 0x4b, 0xa8, 0x30,
+		0xce, 0x61, 0x5d, 0xda, 0xbb, 0xde, 0x94, 0xe1, 0x2c, 0x7b, 0xb5, 0x4a, 0xd6, 0xb7, 0x9a, 0x46,
+		0x43, 0x8d, 0x3e, 0x66, 0x33, 0x81, 0x1f, 0x04, 0x22, 0x20, 0xfe, 0x2f, 0xbb, 0x06, 0x59, 0x19,
+		0x16, 0x2f, 0x7c, 0xb3, 0x57, 0x9e, 0x62, 0x17, 0xdb, 0xcb, 0x0c, 0x1c, 0x0b, 0x3e, 0x9d, 0xe5,
+		0xef, 0xec, 0x68, 0xe0, 0xfd, 0x2c, 0x22, 0xb1, 0x2b, 0x70, 0x98, 0x01, 0x62, 0xe3, 0x86, 0x49,
+		0x73, 0x93, 0x1f, 0xdc, 0x24, 0x63, 0x2f, 0xbb, 0xbd, 0x88, 0xdf, 0xe8, 0x87, 0xe3, 0xb2, 0xbe,
+		0x6e, 0xe0, 0x7f, 0x0e, 0xb2, 0x64, 0xad, 0xcc, 0x35, 0xad, 0x21, 0xfb, 0x98, 0xeb, 0x6a, 0x1f,
+		0xf3, 0x11, 0xfb, 0xf8, 0x85, 0xde, 0x6c, 0x0f, 0xbd, 0x44, 0x21, 0xad, 0x79, 0x39, 0x1b, 0x34,
+		0x2f, 0xf4, 0x1b, 0x2c, 0xbf, 0x09, 0xf1, 0x13, 0x70, 0x7e, 0x3f, 0x08, 0x18, 0xc7, 0xc2, 0xc5,
+		0x38, 0x16, 0x3e, 0x64, 0x92, 0xbd, 0x92, 0x30, 0x3a, 0x97, 0x21, 0x11, 0x57, 0x71, 0x1e, 0x86,
+		0x2d, 0xbd, 0xd1, 0x31, 0xc9, 0x09, 0x40, 0x7b, 0x43, 0x77, 0xef, 0xc4, 0x77, 0x4a, 0xeb, 0xb8,
+		0x90, 0x67, 0x7e, 0xb4, 0xbd, 0x9b, 0x9f, 0x6b, 0x31, 0x36, 0xb8, 0x45, 0x86, 0xe6, 0x58, 0xd9,
+		0xbd, 0x6b, 0xe1, 0x07, 0xf8, 0xc1, 0x38, 0x8b, 0x3d, 0x6e, 0x65, 0x39, 0x62, 0x79, 0x1a, 0x06,
+		0xb6, 0xd4, 0x75, 0x5d, 0xb1, 0x8c, 0x2f, 0x52, 0xd9, 0xec, 0x97, 0x0f, 0xe3, 0x82, 0x9a, 0xf1,
+		0x45, 0x1d, 0x5d, 0x80, 0x91, 0x96, 0xbe, 0x63, 0x2b, 0x04, 0x82, 0xd2, 0x84, 0xe6, 0x64, 0x1e,
+		0xc1, 0xc5, 0x2b, 0xea, 0xba, 0x4e, 0x68, 0x82, 0xdf, 0x96, 0x3b, 0x16, 0xe8, 0xdb, 0xda, 0x6a,
+		0xb7, 0x2c, 0x1d, 0x49, 0x70, 0x88, 0xe6, 0x5f, 0x39, 0x81, 0xd5, 0xe5, 0x98, 0x03, 0x6e, 0x2c,
+		0xf0, 0xcf, 0x74, 0x87, 0x3f, 0xb4, 0xb5, 0xec, 0xb4, 0x4d, 0x8d, 0xc6, 0x7b, 0x70, 0x3c, 0xdc,
+		0x55, 0xbc, 0xee, 0x39, 0x91, 0x41, 0xce, 0x8b, 0x0c, 0xf0, 0xc7, 0x5d, 0x27, 0xf8, 0xc8, 0xa0,
+		0xcf, 0xba, 0xef, 0xfb, 0x19, 0xad, 0xb5, 0x36, 0x33, 0xe0, 0x67, 0xbb, 0x84, 0x23, 0xce, 0x03,
+		0x80, 0xf8, 0x37, 0xaa, 0xc2, 0x91, 0x86, 0x5f, 0xe8, 0x99, 0x11, 0x2f, 0x24, 0xf4, 0x11, 0x54,
+		0x92, 0x60, 0x73, 0xb4, 0x05, 0x63, 0xa6, 0xa7, 0x4f, 0x4a, 0xb0, 0xef, 0x7c, 0xc2, 0x75, 0xc7,
+		0xc9, 0x46, 0x54, 0x1e, 0x35, 0x63, 0x6a, 0xd0, 0x25, 0xc8, 0xe2, 0xe5, 0x48, 0xfb, 0x85, 0x6e,
+		0xba, 0xb7, 0x1c, 0xd1, 0x1c, 0xb5, 0x11, 0xa7, 0x9c, 0x5d, 0x72, 0x84, 0x53, 0xd4, 0x0c, 0x4b,
+		0x59, 0x6f, 0xb6, 0x9f, 0xa9, 0x4d, 0xe7, 0xce, 0x59, 0x6a, 0x3e, 0x86, 0x0d, 0xeb, 0x01, 0x29,
+		0xa6, 0x68, 0x88, 0xdf, 0xcb, 0xc0, 0x31, 0xbf, 0xeb, 0x49, 0xe2, 0xd9, 0x43, 0x18, 0xea, 0x10,
+		0x50, 0x8d, 0x72, 0x81, 0x52, 0xf0, 0x7c, 0x2a, 0x77, 0x26, 0x0f, 0xb2, 0xa6, 0x7c, 0x66, 0xe4,
+		0x5f, 0x21, 0x33, 0x0a, 0xaf, 0x82, 0x19, 0x51, 0x73, 0x35, 0xc7, 0x31, 0x57, 0xe2, 0x1f, 0x64,
+		0x20, 0x17, 0x24, 0xef, 0x5b, 0x81, 0x7e, 0xb5, 0x02, 0x5d, 0xc3, 0x36, 0x64, 0xcb, 0xd4, 0x1b,
+		0xa9, 0x44, 0x3a, 0xca, 0xc6, 0x1c, 0x8f, 0x8d, 0xbf, 0xdb, 0x0f, 0xe3, 0x64, 0x05, 0x18, 0x4d,
+		0x4b, 0x65, 0x9d, 0xbf, 0xfd, 0xe2, 0xec, 0x8d, 0x7c, 0x71, 0xe6, 0xff, 0xbc, 0x7f, 0x25, 0xf1,
+		0x6e, 0xab, 0xa7, 0xe1, 0xbb, 0xad, 0x5e, 0xd7, 0x8b, 0x26, 0x81, 0x2f, 0xe2, 0x5a, 0x7b, 0xf9,
+		0x22, 0xee, 0x35, 0x7e, 0x4a, 0x76, 0x1b, 0xf2, 0x71, 0x72, 0xce, 0x0c, 0x97, 0xf7, 0x22, 0x1a,
+		0xf8, 0xdf, 0x49, 0xfd, 0x48, 0x80, 0x53, 0x2b, 0xed, 0x66, 0x73, 0xb1, 0x6d, 0xfa, 0x13, 0xe6,
+		0xba, 0xa9, 0xc7, 0xcb, 0xdc, 0x26, 0x9e, 0x74, 0xb5, 0xc3, 0x87, 0xfd, 0x70, 0x9a, 0x8b, 0x0e,
+		0x9b, 0xc5, 0x38, 0x00, 0x19, 0x97, 0xea, 0x3c, 0xfd, 0x1c, 0x83, 0x60, 0x42, 0xa3, 0xcc, 0x4f,
+		0x79, 0xbe, 0x3a, 0xbe, 0xbe, 0xdd, 0xd4, 0x5f, 0x18, 0xed, 0x8e, 0xa5, 0xc4, 0xdc, 0x91, 0x74,
+		0xc2, 0x01, 0xa8, 0x05, 0xaf, 0xe0, 0x49, 0x9d, 0xbc, 0xee, 0xbf, 0xfb, 0xed, 0x46, 0xe0, 0x1a,
+		0x54, 0x9c, 0x2e, 0x8f, 0xef, 0xae, 0x6d, 0xb6, 0xd7, 0x95, 0x46, 0xbb, 0xd3, 0xb2, 0x95, 0xe7,
+		0x46, 0xcb, 0x26, 0xf7, 0x97, 0xf7, 0xc9, 0x59, 0x56, 0x53, 0xc2, 0x15, 0x0f, 0x8d, 0x96, 0x8d,
+		0x6e, 0xc1, 0x21, 0xf6, 0x00, 0xac, 0xbb, 0xa8, 0x4f, 0xd8, 0xde, 0x93, 0x1d, 0x60, 0x5e, 0xd8,
+		0xb9, 0xc8, 0x09, 0x3b, 0xd1, 0x1d, 0xe8, 0xff, 0x81, 0x8e, 0x6e, 0xee, 0xb2, 0x4c, 0x60, 0x31,
+		0x91, 0x98, 0xe4, 0x15, 0x6a, 0x99, 0x36, 0x40, 0xff, 0x3f, 0x9c, 0xe1, 0xed, 0xc1, 0xbb, 0xf2,
+		0xf8, 0x34, 0x8d, 0x3c, 0x8e, 0x45, 0x53, 0x31, 0x58, 0x95, 0xf8, 0x6d, 0x01, 0xc6, 0x6a, 0xb6,
+		0xd1, 0xd8, 0xd8, 0x75, 0xeb, 0x7c, 0x5b, 0xb7, 0x0f, 0x20, 0x8b, 0x9b, 0xea, 0xa6, 0xd2, 0xe3,
+		0x6d, 0xb7, 0xc3, 0xb4, 0x99, 0xf3, 0x3f, 0xdd, 0x5d, 0x8c, 0xb9, 0x14, 0x77, 0x31, 0x8a, 0xbf,
+		0xde, 0x07, 0x93, 0x54, 0x45, 0x34, 0x6e, 0x62, 0xb0, 0xa3, 0xcd, 0x5d, 0xb4, 0xe7, 0x1d, 0x18,
+		0x70, 0x32, 0x62, 0x9d, 0x85, 0xfd, 0x78, 0x62, 0x6a, 0xad, 0xec, 0xc1, 0xf3, 0xaf, 0xaa, 0xcd,
+		0xc7, 0x5c, 0x55, 0x9b, 0x74, 0xd9, 0xe8, 0x17, 0xe0, 0xa8, 0x45, 0x88, 0x1f, 0xfd, 0x0c, 0x60,
+		0x26, 0xe6, 0xb0, 0x35, 0x86, 0x55, 0x72, 0x96, 0x76, 0xe4, 0x63, 0xde, 0x6d, 0x18, 0x35, 0x75,
+		0xbb, 0x63, 0xb6, 0xc8, 0x79, 0x7a, 0x20, 0xc9, 0x98, 0x3d, 0x6b, 0x78, 0x9c, 0xd6, 0x57, 0xf5,
+		0x6d, 0x3f, 0x29, 0xf1, 0x75, 0x97, 0x6b, 0x6d, 0xb3, 0xa1, 0x2b, 0x0d, 0x53, 0x57, 0x6d, 0x9d,
+		0xd3, 0x9c, 0xde, 0xc3, 0x70, 0x8a, 0x40, 0x95, 0x08, 0x50, 0xb8, 0x0f, 0xce, 0x65, 0xb9, 0x2b,
+		0xdc, 0xcb, 0x72, 0x7f, 0x08, 0xa6, 0x92, 0xd9, 0xc9, 0xac, 0xe1, 0x2a, 0x1c, 0x09, 0xe2, 0x00,
+		0x09, 0x39, 0x9b, 0x09, 0x66, 0xd5, 0xf7, 0x1e, 0x96, 0x6a, 0x6d, 0x88, 0xff, 0x50, 0x80, 0x73,
+		0x9c, 0xf1, 0xe9, 0x49, 0x46, 0x4a, 0x59, 0x5a, 0xf0, 0x5f, 0xc7, 0xf5, 0xf2, 0x97, 0xd5, 0xe6,
+		0x53, 0x5f, 0x56, 0x2b, 0xfe, 0x07, 0xcf, 0xa3, 0xf9, 0xb3, 0x0a, 0xdf, 0x90, 0x47, 0x43, 0x35,
+		0x40, 0x6e, 0xbf, 0xca, 0x26, 0x7b, 0x1c, 0x7d, 0xb4, 0x90, 0xb0, 0x04, 0x0b, 0xbf, 0xa4, 0x2e,
+		0x67, 0xed, 0x50, 0x89, 0xf8, 0x6b, 0x07, 0xe1, 0x34, 0x77, 0x8e, 0x6f, 0xd4, 0x4d, 0x86, 0x2e,
+		0x4c, 0xcc, 0x77, 0xbf, 0x79, 0xb7, 0xb0, 0xb7, 0x9b, 0x77, 0xf9, 0x71, 0xef, 0x2c, 0x1c, 0xf3,
+		0x92, 0xf3, 0xbd, 0x6f, 0xb2, 0xe8, 0x67, 0xa0, 0xde, 0x0d, 0x86, 0xde, 0xab, 0x0f, 0xa9, 0xae,
+		0xd5, 0x5d, 0x49, 0x73, 0xad, 0xee, 0x65, 0x38, 0xca, 0xbc, 0x70, 0xe4, 0xbd, 0x09, 0xc7, 0x6d,
+		0x7b, 0xc3, 0x76, 0xbf, 0x39, 0x57, 0x7b, 0xb9, 0x9b, 0x73, 0x5b, 0xc9, 0x37, 0xe7, 0xfa, 0x02,
+		0x84, 0x9d, 0xe0, 0xcb, 0x19, 0x8f, 0x61, 0x92, 0x43, 0x41, 0xa5, 0xbd, 0xa6, 0xd8, 0xcf, 0x0d,
+		0x4b, 0x71, 0x5a, 0x91, 0x08, 0xb4, 0x4f, 0x3e, 0x1b, 0x25, 0xe9, 0xf2, 0x5a, 0xfd, 0xb9, 0x61,
+		0x15, 0xdd, 0x78, 0xe3, 0xa8, 0x87, 0xa4, 0xa3, 0xc9, 0xe4, 0x8b, 0x84, 0x21, 0x39, 0xeb, 0xd6,
+		0x38, 0xb7, 0xd3, 0x3f, 0x08, 0x07, 0x59, 0x5f, 0x17, 0x12, 0xa4, 0x23, 0x21, 0xca, 0x2a, 0xc0,
+		0x48, 0xf8, 0xdd, 0x9e, 0x6f, 0xd2, 0x98, 0x79, 0x78, 0x3b, 0xf0, 0x64, 0x8f, 0xb8, 0x0b, 0x22,
+		0xcd, 0x62, 0xf5, 0x2b, 0xd1, 0x43, 0x07, 0xb1, 0x94, 0x96, 0x6e, 0x6f, 0x77, 0x98, 0xfd, 0xa6,
+		0x00, 0x17, 0x12, 0xc6, 0x5e, 0xd8, 0xad, 0x94, 0x5f, 0x7a, 0x89, 0x1a, 0xf3, 0x08, 0x72, 0x48,
+		0x4d, 0x0b, 0x11, 0x35, 0xf5, 0xcd, 0x68, 0x2e, 0x7e, 0x46, 0xa1, 0x57, 0x5d, 0xc4, 0x15, 0x98,
+		0x4c, 0x98, 0x90, 0x6b, 0x9a, 0x2e, 0x41, 0x36, 0x9c, 0x9e, 0x47, 0xe6, 0x75, 0x58, 0x1e, 0x69,
+		0x04, 0xb3, 0xe4, 0xc4, 0x1d, 0x37, 0xaa, 0xe1, 0x7e, 0x18, 0x92, 0x92, 0x3f, 0xde, 0x45, 0x04,
+		0xb9, 0xc0, 0x45, 0x04, 0x49, 0xdc, 0xf9, 0x8a, 0xe7, 0x01, 0xa3, 0x49, 0xf0, 0xbd, 0x8c, 0x4b,
+		0xbe, 0xd4, 0xcf, 0xc5, 0x7d, 0xa9, 0xdf, 0x83, 0x57, 0x23, 0xa2, 0x1a, 0xa5, 0x05, 0x4b, 0xaf,
+		0x79, 0xa5, 0xa2, 0xfa, 0x1b, 0x02, 0x5c, 0x4c, 0xe2, 0xc3, 0x1b, 0x95, 0x55, 0x8f, 0xbb, 0x73,
+		0xb1, 0xdc, 0x0d, 0x4b, 0xea, 0x7f, 0x12, 0x60, 0x8a, 0x33, 0x21, 0xca, 0xdd, 0x37, 0x3f, 0x1b,
+		0xce, 0x45, 0xfb, 0x3e, 0xc6, 0xcd, 0xc7, 0x33, 0x6e, 0x91, 0x6b, 0x63, 0x62, 0x85, 0xe6, 0xff,
+		0x41, 0x1b, 0xf3, 0xaf, 0x04, 0x38, 0x1f, 0xc8, 0x3a, 0xee, 0x79, 0x5f, 0xef, 0x15, 0x85, 0x3e,
+		0x49, 0x11, 0x60, 0x70, 0x3f, 0xab, 0x10, 0x7e, 0x0c, 0xe4, 0xbf, 0x64, 0x40, 0x7c, 0xa0, 0x47,
+		0x77, 0x6e, 0x9c, 0x85, 0x75, 0x97, 0x09, 0xed, 0xcf, 0xd3, 0xf1, 0xd3, 0x70, 0x74, 0x53, 0xdd,
+		0x31, 0x36, 0x3b, 0x9b, 0x8a, 0x77, 0xae, 0x95, 0x27, 0xd1, 0xc1, 0x08, 0xab, 0x58, 0x49, 0x38,
+		0xde, 0x2a, 0xf0, 0x16, 0xf8, 0x97, 0x01, 0x6d, 0xab, 0x86, 0xad, 0xac, 0xb5, 0x4d, 0x2f, 0x7b,
+		0x99, 0xed, 0xfe, 0x8e, 0xe0, 0x9a, 0xc5, 0xb6, 0xe9, 0xe4, 0x15, 0xa3, 0x35, 0x18, 0x63, 0x1b,
+		0x08, 0x14, 0x4e, 0x59, 0x23, 0xf9, 0x3a, 0x34, 0x12, 0x98, 0x4f, 0x78, 0x46, 0xc7, 0x9f, 0x5e,
+		0x44, 0x73, 0x7c, 0x48, 0x48, 0x70, 0xe2, 0x39, 0xb7, 0x5c, 0xfc, 0x49, 0x01, 0x26, 0x13, 0xa9,
+		0xcd, 0xdc, 0x94, 0x6f, 0xf7, 0x03, 0x5e, 0x72, 0xf7, 0x83, 0x77, 0xe8, 0x86, 0x05, 0x86, 0x9e,
+		0x52, 0xea, 0x54, 0x73, 0x0e, 0xcb, 0xee, 0x7f, 0xf1, 0xaf, 0x65, 0x20, 0x4f, 0xd3, 0xaf, 0x3e,
+		0x2d, 0xe2, 0x1d, 0xba, 0xcb, 0x30, 0x1f, 0x7f, 0x97, 0x61, 0x21, 0xee, 0x02, 0xd9, 0xb9, 0x44,
+		0xad, 0x98, 0xe7, 0xbc, 0x60, 0xe0, 0xdc, 0x0b, 0xb5, 0x18, 0xbc, 0x5b, 0xee, 0xcb, 0x07, 0xe1,
+		0x22, 0xa3, 0x8e, 0x61, 0x3f, 0x7f, 0xbb, 0xbb, 0xff, 0x76, 0x77, 0xbf, 0xe7, 0xdd, 0xfd, 0x90,
+		0x38, 0xb7, 0x22, 0xe2, 0x3c, 0x01, 0x43, 0x0c, 0x80, 0x72, 0x80, 0xbe, 0x8f, 0xc5, 0x1a, 0x55,
+		0x08, 0x1f, 0xc6, 0x3c, 0x01, 0xfd, 0x50, 0x08, 0xde, 0x5c, 0x56, 0x0e, 0xed, 0xfb, 0x7f, 0x24,
+		0xec, 0xcb, 0xc6, 0xff, 0xd7, 0x79, 0x1b, 0xff, 0xff, 0x5e, 0x80, 0x09, 0x37, 0xfb, 0xf7, 0xd3,
+		0x62, 0x2e, 0xbc, 0x30, 0x26, 0x1f, 0x17, 0xc6, 0x14, 0xe2, 0x9d, 0x7e, 0xf8, 0x29, 0x80, 0xff,
+		0x23, 0xc0, 0xb8, 0xac, 0x5b, 0xba, 0xfd, 0x69, 0x9f, 0xde, 0x6d, 0x18, 0x75, 0xb7, 0xe3, 0xd6,
+		0x8c, 0x96, 0x61, 0x3d, 0x0f, 0xef, 0xee, 0x1f, 0x77, 0xea, 0x17, 0x49, 0xb5, 0xb3, 0x65, 0x1f,
+		0xd4, 0x89, 0xb9, 0x70, 0x84, 0x70, 0x1b, 0xf2, 0x71, 0xf3, 0x4f, 0x3e, 0xdc, 0xf9, 0x52, 0x1f,
+		0x4c, 0x60, 0x53, 0xb2, 0xbc, 0xa5, 0xb7, 0x22, 0x8d, 0xad, 0x6e, 0xd4, 0xe3, 0xc6, 0x04, 0xb9,
+		0xd4, 0x31, 0x41, 0x9e, 0xe7, 0xf6, 0x56, 0xd8, 0x46, 0x09, 0x31, 0x2c, 0xcc, 0xc7, 0x33, 0xbb,
+		0x38, 0x95, 0xfc, 0x84, 0x3d, 0xf5, 0xe1, 0xf2, 0x88, 0x15, 0x2c, 0x40, 0x4f, 0x20, 0xeb, 0x4b,
+		0xfb, 0xa6, 0x1d, 0xd2, 0xdd, 0xe2, 0x2b, 0x29, 0x2f, 0x65, 0x61, 0x1d, 0xeb, 0xc1, 0x02, 0xf4,
+		0x10, 0x06, 0xb1, 0xe5, 0x77, 0xfa, 0xa4, 0x67, 0x20, 0x17, 0xbb, 0x3a, 0x00, 0xd6, 0x1d, 0xd8,
+		0xee, 0x6f, 0xf1, 0x1b, 0x02, 0x88, 0x49, 0x6c, 0x60, 0x4c, 0xfc, 0x3e, 0x00, 0x17, 0x07, 0x27,
+		0xf3, 0x67, 0x3a, 0xdd, 0x1c, 0x68, 0x92, 0x81, 0xd7, 0x3a, 0x75, 0xee, 0xcf, 0xef, 0xf4, 0xc1,
+		0x24, 0x46, 0x8d, 0x58, 0x71, 0xed, 0xad, 0x8c, 0xbc, 0x29, 0x19, 0x41, 0xef, 0xc3, 0x11, 0x9a,
+		0x16, 0xe7, 0xf4, 0xb5, 0x48, 0x9c, 0xdd, 0xf5, 0x94, 0x5f, 0xf6, 0x61, 0xf6, 0xb1, 0x5c, 0xb9,
+		0x21, 0xda, 0x0f, 0x93, 0xbd, 0x8f, 0x05, 0x98, 0x4a, 0x66, 0xf0, 0x1b, 0x94, 0xbe, 0x27, 0x30,
+		0xe2, 0x86, 0x0f, 0xfb, 0x9a, 0x9f, 0xff, 0xe7, 0xfa, 0xe0, 0x3c, 0x9e, 0x75, 0x91, 0x85, 0xd4,
+		0x9f, 0x1a, 0xc1, 0xf6, 0xa2, 0xaa, 0x34, 0x82, 0x1d, 0x22, 0x8e, 0x3c, 0xd2, 0x08, 0x51, 0x2b,
+		0x24, 0x7f, 0x73, 0xfb, 0x28, 0x7f, 0xf3, 0xfb, 0x23, 0x7f, 0x7f, 0x5d, 0x80, 0x0b, 0xdd, 0x38,
+		0xf1, 0x06, 0x25, 0xf0, 0xdb, 0x02, 0xe4, 0xc8, 0xd9, 0xb2, 0xd3, 0xe5, 0xeb, 0x59, 0x6e, 0xbb,
+		0x67, 0xdf, 0xf9, 0x1e, 0xcf, 0xbe, 0xc5, 0x7b, 0x70, 0x3c, 0x84, 0x2f, 0xa3, 0xde, 0x04, 0x0c,
+		0x11, 0x08, 0x25, 0x70, 0xeb, 0xea, 0x20, 0x29, 0x63, 0x2f, 0xb9, 0x3e, 0x86, 0x23, 0x81, 0x3e,
+		0x71, 0xdc, 0x41, 0xdb, 0xb8, 0x97, 0x2f, 0x0e, 0xc8, 0x03, 0xa4, 0x84, 0x2c, 0x5e, 0xdc, 0x6a,
+		0xd5, 0x5c, 0x77, 0x76, 0x0c, 0x69, 0x75, 0xd1, 0x5c, 0xb7, 0xc4, 0x2f, 0xc2, 0x29, 0x12, 0x96,
+		0xd0, 0xf3, 0x57, 0x77, 0x01, 0xf3, 0x3a, 0x08, 0x28, 0x8e, 0xc3, 0x69, 0xee, 0xd8, 0x94, 0x18,
+		0xe2, 0xbf, 0xf3, 0xf6, 0x6f, 0xc9, 0x4c, 0xf7, 0xb2, 0x6f, 0x2c, 0xc3, 0xb0, 0xf7, 0x61, 0xaa,
+		0xfb, 0x4e, 0x64, 0xdc, 0x36, 0x44, 0x74, 0x18, 0xb2, 0x1a, 0x3c, 0xd2, 0xf0, 0xff, 0x8d, 0x30,
+		0x29, 0x1f, 0x61, 0x12, 0x31, 0x80, 0xa6, 0xd9, 0x36, 0x95, 0x4d, 0xdd, 0xb2, 0xd4, 0x75, 0xe7,
+		0xe1, 0x80, 0x21, 0x52, 0xf8, 0x98, 0x96, 0x89, 0x3f, 0x22, 0xc0, 0x39, 0x27, 0xe3, 0xb6, 0xe7,
+		0xa8, 0x78, 0x7f, 0x38, 0xf0, 0x67, 0x0f, 0xc0, 0xb1, 0x15, 0xbd, 0xa5, 0x19, 0xad, 0x75, 0x67,
+		0x73, 0x91, 0xa4, 0x37, 0xbe, 0xb6, 0xe7, 0x37, 0xdf, 0x83, 0x7e, 0x6c, 0x69, 0x9c, 0x5b, 0x15,
+		0xf9, 0xdf, 0x38, 0x85, 0x30, 0xc4, 0x26, 0x4a, 0x97, 0x69, 0x3b, 0x7c, 0x68, 0x17, 0x3d, 0x9e,
+		0x2a, 0xc4, 0x9c, 0x4e, 0xdd, 0x81, 0x51, 0xf2, 0x76, 0x72, 0xf0, 0xd4, 0x8d, 0x1e, 0xf4, 0xd1,
+		0x3c, 0x1c, 0xf2, 0xb6, 0xf2, 0x43, 0xff, 0x99, 0x1b, 0xa9, 0x75, 0x5f, 0x5d, 0x8e, 0x1e, 0x10,
+		0xd2, 0xfb, 0x3b, 0xc9, 0xab, 0xcb, 0xb5, 0xf0, 0x21, 0xa1, 0xef, 0x90, 0x6e, 0x31, 0x78, 0x48,
+		0x77, 0x09, 0xb2, 0x8e, 0x67, 0x62, 0x45, 0xce, 0x21, 0xa5, 0xe3, 0x98, 0xd8, 0xf9, 0x9b, 0x15,
+		0x77, 0x22, 0xfa, 0x34, 0xf6, 0x44, 0x34, 0xee, 0xe9, 0x7c, 0x2d, 0xf6, 0xe9, 0x7c, 0xf1, 0x37,
+		0xc8, 0x9b, 0xb8, 0xb1, 0xa2, 0xc8, 0xac, 0x53, 0x13, 0x4e, 0x06, 0xb2, 0x43, 0x7c, 0x29, 0xaa,
+		0x90, 0x90, 0xa2, 0xca, 0xbb, 0xc2, 0xc0, 0x6b, 0x2a, 0x9f, 0xd0, 0xb9, 0xe5, 0xe8, 0x19, 0x9c,
+		0xe4, 0x7e, 0xe3, 0xea, 0xe6, 0x3e, 0xf7, 0xe2, 0x56, 0x8e, 0x6f, 0xf3, 0x8a, 0xd1, 0x13, 0x40,
+		0x5b, 0x54, 0xb8, 0x9c, 0x7b, 0x9a, 0x0c, 0xdd, 0x62, 0x5f, 0x0a, 0x15, 0xd2, 0xc8, 0x22, 0xe9,
+		0xfc, 0xe8, 0x56, 0xa0, 0xd0, 0xd0, 0x2d, 0xf1, 0x0f, 0x04, 0x38, 0xe9, 0x10, 0x34, 0xad, 0x51,
+		0x7d, 0x99, 0xe4, 0x85, 0x07, 0x30, 0xec, 0xb6, 0xf5, 0x5f, 0x53, 0x3a, 0x91, 0xd8, 0x01, 0x55,
+		0x48, 0xdb, 0xf7, 0x0f, 0x2f, 0x7f, 0x8d, 0x56, 0xa3, 0xd9, 0xd1, 0x74, 0x2f, 0x33, 0xca, 0xf9,
+		0xd6, 0xa3, 0x40, 0x73, 0x6b, 0x58, 0xbd, 0xd3, 0x09, 0x8d, 0x18, 0xc4, 0x6f, 0x09, 0x30, 0x1a,
+		0x9d, 0x31, 0x93, 0x9c, 0xbb, 0x70, 0x68, 0xab, 0xdd, 0x6c, 0xea, 0xa6, 0x13, 0x12, 0x9c, 0x8d,
+		0xcd, 0x6e, 0xd1, 0x4d, 0x42, 0x53, 0x07, 0x1e, 0x3d, 0x86, 0x6c, 0x04, 0x11, 0x4a, 0x9c, 0xc9,
+		0xc4, 0xb9, 0x39, 0x1f, 0x9d, 0xd8, 0x41, 0x34, 0xbf, 0x23, 0xc0, 0x70, 0x10, 0x24, 0x26, 0xa3,
+		0x0e, 0x62, 0x32, 0xea, 0xc8, 0x32, 0x5f, 0xd5, 0x94, 0xa6, 0xfe, 0x42, 0x6f, 0x3a, 0x77, 0xc5,
+		0xe2, 0x92, 0x25, 0x5c, 0x80, 0xbf, 0x29, 0x51, 0x1b, 0x1b, 0xac, 0x96, 0xbe, 0x1b, 0x76, 0x58,
+		0x6d, 0x6c, 0xd0, 0xca, 0x32, 0x1c, 0x61, 0xf7, 0xbd, 0x2a, 0xcf, 0x9a, 0xed, 0xc6, 0xc6, 0x68,
+		0x21, 0x61, 0x4f, 0x09, 0x63, 0x59, 0x29, 0x2f, 0x60, 0x38, 0x79, 0x90, 0xde, 0x0b, 0x4b, 0xfe,
+		0x88, 0xef, 0xc1, 0xa0, 0xaf, 0x0e, 0x5f, 0x6b, 0xcb, 0x5e, 0xbb, 0x77, 0xaf, 0xb5, 0x25, 0xff,
+		0xe9, 0xb9, 0x8e, 0xde, 0xd2, 0xbc, 0x2b, 0xeb, 0xfb, 0xf5, 0x96, 0x56, 0xd1, 0x44, 0x19, 0xc0,
+		0xa3, 0x34, 0x4e, 0x4a, 0x24, 0xa6, 0x4d, 0x6d, 0x34, 0x74, 0xcb, 0xf2, 0xc7, 0xeb, 0xc3, 0xb8,
+		0xbc, 0x48, 0x8a, 0x49, 0xc4, 0x9e, 0xf0, 0x96, 0x2d, 0x3e, 0x00, 0x19, 0xf4, 0xed, 0x82, 0xe1,
+		0x57, 0xf0, 0xe8, 0xe7, 0xe8, 0x4d, 0xee, 0x2b, 0xfc, 0xf4, 0x6b, 0x9b, 0x51, 0x06, 0x12, 0x7d,
+		0x80, 0x7f, 0x16, 0x8e, 0x39, 0x4f, 0xf9, 0x37, 0xda, 0xfa, 0xda, 0x9a, 0xd1, 0x30, 0xf4, 0x16,
+		0xd5, 0x0a, 0x41, 0x46, 0xac, 0xaa, 0xe4, 0xd5, 0xe0, 0xf1, 0x1c, 0x83, 0xca, 0x1b, 0x8f, 0x9e,
+		0x82, 0x8c, 0x32, 0x90, 0xe8, 0x78, 0x3c, 0x7b, 0x5c, 0xe0, 0xdb, 0xe3, 0xfb, 0x70, 0xba, 0x45,
+		0x3f, 0x33, 0x33, 0x0d, 0xf5, 0x59, 0x53, 0x57, 0xa8, 0xa7, 0xa7, 0xdb, 0x47, 0x16, 0xf9, 0x58,
+		0x77, 0x40, 0x1e, 0x6d, 0x91, 0xef, 0xca, 0x28, 0x84, 0x84, 0x01, 0xe8, 0x3b, 0xff, 0xf8, 0xb6,
+		0xec, 0xbc, 0xcf, 0x3a, 0xf3, 0x70, 0xa5, 0x1b, 0xbe, 0xa7, 0x3d, 0xa8, 0x08, 0xba, 0xd3, 0x9f,
+		0x08, 0x70, 0x9c, 0xbb, 0x59, 0x8a, 0xa6, 0xe0, 0xdc, 0x93, 0x65, 0xf9, 0xd1, 0xe2, 0xd2, 0xf2,
+		0x13, 0xa5, 0x52, 0x56, 0x64, 0x69, 0xb5, 0x26, 0x29, 0x2b, 0xcb, 0x4b, 0x95, 0xd2, 0x07, 0x4a,
+		0xa5, 0xfa, 0x7e, 0x71, 0xa9, 0x52, 0xce, 0xfe, 0x7f, 0xe8, 0x0e, 0xdc, 0x8c, 0x85, 0x2a, 0x2e,
+		0xe1, 0xd2, 0xf2, 0xea, 0xca, 0x52, 0xa5, 0x54, 0xac, 0x4b, 0xca, 0x62, 0xb1, 0xb2, 0x24, 0x95,
+		0x95, 0xe5, 0xea, 0xd2, 0x07, 0x59, 0x01, 0x5d, 0x81, 0x42, 0xda, 0x96, 0xd9, 0x0c, 0xba, 0x0a,
+		0x97, 0x62, 0xa1, 0x65, 0xe9, 0xfb, 0xa4, 0x52, 0xdd, 0x07, 0xde, 0x37, 0xfd, 0x23, 0x02, 0x0c,
+		0xf9, 0x3f, 0xa6, 0x46, 0x63, 0x70, 0xbc, 0xbc, 0xfc, 0xb8, 0x58, 0xa9, 0x2a, 0xb5, 0x7a, 0xb1,
+		0xbe, 0x5a, 0xf3, 0x4d, 0xe1, 0x0c, 0x8c, 0x06, 0xab, 0x64, 0xe9, 0x41, 0xa5, 0x56, 0x97, 0x64,
+		0xa9, 0x9c, 0x15, 0xa2, 0xb5, 0x65, 0x69, 0x45, 0x96, 0xf0, 0x30, 0xe5, 0x6c, 0x26, 0xda, 0x6d,
+		0x59, 0x5a, 0x92, 0x70, 0x55, 0xdf, 0xf4, 0xcf, 0x09, 0x30, 0xe8, 0xbb, 0xa4, 0x1c, 0x8d, 0x42,
+		0xae, 0x5e, 0x79, 0x2c, 0x2d, 0xaf, 0xd6, 0x95, 0xfa, 0x07, 0x2b, 0x92, 0x0f, 0x81, 0xb3, 0x70,
+		0x3a, 0x50, 0x53, 0xab, 0x17, 0xe5, 0xba, 0x52, 0x5f, 0x56, 0x4a, 0x4b, 0xcb, 0x35, 0x29, 0x2b,
+		0x20, 0x11, 0xf2, 0x41, 0x80, 0xd2, 0x43, 0xa9, 0xbc, 0xba, 0x24, 0x61, 0x18, 0x02, 0x9c, 0xcd,
+		0x24, 0xc2, 0xd0, 0x7e, 0xfa, 0xd0, 0x29, 0x38, 0x11, 0x80, 0x79, 0x28, 0x15, 0xe5, 0xfa, 0x82,
+		0x54, 0xac, 0x67, 0x0f, 0x4c, 0xff, 0xd3, 0x03, 0x30, 0xe4, 0x26, 0xe0, 0x61, 0x7c, 0xf1, 0xd4,
+		0xa4, 0x52, 0xa5, 0x56, 0x59, 0xae, 0x86, 0x11, 0x2e, 0xc0, 0x54, 0xb0, 0xca, 0x1d, 0xac, 0x58,
+		0xaa, 0x57, 0xde, 0xaf, 0xd4, 0x3f, 0x50, 0xea, 0xc5, 0xda, 0xa3, 0xac, 0x80, 0x66, 0x60, 0x3a,
+		0x08, 0x29, 0x4b, 0x9f, 0x5b, 0x95, 0x6a, 0x75, 0xa5, 0x54, 0xac, 0x96, 0xa4, 0xa5, 0x10, 0x7c,
+		0x06, 0x8d, 0xc3, 0x58, 0xa8, 0x67, 0x4a, 0x8b, 0xca, 0x63, 0x49, 0xce, 0xf6, 0x61, 0x29, 0x08,
+		0x56, 0x97, 0x96, 0x1f, 0xaf, 0x60, 0x82, 0x2b, 0xae, 0x70, 0x48, 0x9f, 0x97, 0x4a, 0xab, 0xf5,
+		0xca, 0x72, 0x35, 0x7b, 0x00, 0x5d, 0x82, 0xf3, 0x41, 0x70, 0x2c, 0x81, 0x3c, 0xd0, 0x7e, 0x94,
+		0x87, 0x53, 0xa1, 0x9e, 0x29, 0x82, 0x74, 0xe4, 0x83, 0xe8, 0x32, 0x5c, 0xe4, 0xd6, 0x73, 0x3a,
+		0x3b, 0x84, 0xe6, 0xe1, 0x4e, 0xe2, 0xac, 0xa5, 0xcf, 0xd7, 0x25, 0xb9, 0x5a, 0xe4, 0xb6, 0x3e,
+		0x8c, 0xc5, 0x21, 0xdc, 0xba, 0xb4, 0x2c, 0x97, 0x95, 0xc7, 0x45, 0xf9, 0x91, 0x24, 0x67, 0x07,
+		0xd0, 0x4d, 0xb8, 0x16, 0xa6, 0x42, 0xb5, 0x5e, 0xa9, 0xae, 0x4a, 0x4a, 0xb1, 0xa6, 0x54, 0xa5,
+		0x27, 0xbc, 0x6e, 0x01, 0x5d, 0x83, 0x2b, 0x3c, 0xd2, 0x96, 0x1e, 0x56, 0x96, 0xca, 0xbc, 0x16,
+		0x83, 0xd1, 0x71, 0x6a, 0x95, 0x07, 0xd5, 0x62, 0x32, 0xfa, 0x43, 0xd3, 0x7f, 0x34, 0x0c, 0x03,
+		0xee, 0xed, 0xe5, 0xe8, 0x04, 0x20, 0xe9, 0x7d, 0xa9, 0x1a, 0x91, 0xf9, 0x4b, 0x70, 0xde, 0x57,
+		0x1e, 0xed, 0x88, 0x62, 0x47, 0x34, 0xf0, 0x32, 0x5c, 0x4c, 0x06, 0x75, 0x84, 0x00, 0x2b, 0x64,
+		0x01, 0xa6, 0x92, 0x81, 0xa9, 0x11, 0xca, 0xf6, 0x75, 0xef, 0x16, 0xb3, 0xbe, 0xac, 0x2c, 0xaf,
+		0xd6, 0xb3, 0x07, 0xd0, 0x05, 0x10, 0x7d, 0xc0, 0x1e, 0x55, 0x8a, 0xb5, 0x47, 0xae, 0xf0, 0x97,
+		0xb3, 0xfd, 0xd8, 0x68, 0xc6, 0xc3, 0xb1, 0x19, 0x1d, 0x4c, 0xec, 0xcd, 0x9b, 0xcc, 0xa1, 0x44,
+		0x38, 0x0f, 0xbb, 0xc3, 0x68, 0x12, 0xce, 0xc6, 0xc2, 0xb1, 0xf9, 0x0e, 0x84, 0x3a, 0x0b, 0x28,
+		0x9e, 0x6f, 0x0a, 0x10, 0x9a, 0x42, 0x08, 0x8e, 0x4d, 0x61, 0x30, 0xb1, 0x37, 0x6f, 0x0a, 0x43,
+		0x21, 0xd4, 0x82, 0x70, 0x0c, 0xb5, 0x23, 0x89, 0x9d, 0x79, 0xf3, 0x1c, 0xc6, 0xea, 0x1f, 0x3f,
+		0x28, 0x55, 0x2d, 0xa6, 0x69, 0x52, 0x39, 0x3b, 0x82, 0xe6, 0x60, 0xc6, 0x07, 0x9e, 0x64, 0x79,
+		0x1c, 0x54, 0xb2, 0xe8, 0x3c, 0x4c, 0x74, 0x19, 0x42, 0x2a, 0x67, 0x8f, 0x62, 0xaf, 0xe0, 0x03,
+		0x23, 0x46, 0xc2, 0x25, 0x0e, 0xc2, 0x76, 0x36, 0x52, 0xbb, 0x58, 0xc1, 0xfe, 0xe4, 0x18, 0xb6,
+		0xd3, 0xbe, 0x3a, 0xbf, 0x95, 0x71, 0x90, 0xc8, 0x61, 0x2b, 0x18, 0x69, 0xef, 0x0e, 0x7e, 0x3c,
+		0x34, 0x2f, 0x9e, 0x42, 0x84, 0x69, 0x71, 0x02, 0x4d, 0xc3, 0x85, 0x34, 0x6d, 0xa4, 0x72, 0xf6,
+		0x24, 0x2a, 0xc1, 0x7b, 0xf1, 0x74, 0x4b, 0x50, 0x7e, 0xa5, 0x52, 0xad, 0xd4, 0x2b, 0xc4, 0x33,
+		0x8e, 0xa2, 0xcf, 0xc2, 0xfc, 0xde, 0x3a, 0x61, 0x54, 0x18, 0x43, 0xef, 0xc0, 0x6d, 0x5f, 0x0f,
+		0x49, 0x4d, 0x22, 0xf3, 0x3d, 0x85, 0xed, 0xb9, 0xaf, 0x31, 0x35, 0x9d, 0xcc, 0x90, 0x4a, 0xe5,
+		0xec, 0xe9, 0xee, 0xf4, 0xa0, 0xb6, 0x4e, 0x2a, 0x67, 0xcf, 0xe0, 0x48, 0xa5, 0x8b, 0xa5, 0x90,
+		0xe4, 0xc7, 0x95, 0x2a, 0x99, 0xf8, 0x78, 0x0a, 0xee, 0x30, 0x6b, 0x5d, 0x66, 0xe6, 0x3a, 0x9b,
+		0x47, 0xb7, 0xe1, 0x86, 0xaf, 0x4d, 0xb2, 0x61, 0xf6, 0x51, 0xf9, 0x2c, 0x36, 0xd1, 0xe9, 0x1b,
+		0x32, 0xca, 0x9e, 0x43, 0xb3, 0x70, 0xd9, 0x2f, 0x83, 0x71, 0xf0, 0x8e, 0x40, 0x4f, 0xa0, 0xeb,
+		0x70, 0x35, 0x4d, 0x03, 0x4f, 0xf1, 0x45, 0xec, 0xf9, 0xd3, 0x34, 0x61, 0x38, 0x4d, 0x62, 0xf7,
+		0x94, 0x6a, 0x08, 0x47, 0x4c, 0xa7, 0xd2, 0x22, 0xe5, 0x19, 0x90, 0xf3, 0x21, 0xde, 0xc4, 0x37,
+		0xf1, 0xf8, 0x79, 0x21, 0x24, 0x86, 0xdd, 0x5d, 0xa0, 0x8f, 0x3f, 0x17, 0x71, 0x78, 0xdc, 0x5b,
+		0x63, 0x46, 0x8f, 0x02, 0xba, 0x01, 0xb3, 0x29, 0xa5, 0xdf, 0x95, 0xd4, 0x4b, 0xd3, 0x7f, 0x0f,
+		0xe0, 0xa4, 0x1b, 0xc4, 0x05, 0xbf, 0xa2, 0xc0, 0x46, 0x96, 0xe7, 0x19, 0x94, 0x52, 0x71, 0xb5,
+		0xe6, 0xf7, 0xcc, 0xd7, 0xe1, 0x6a, 0x02, 0xdc, 0x6a, 0xf5, 0x61, 0xb1, 0x5a, 0xc6, 0xff, 0x1d,
+		0xa0, 0xac, 0x80, 0xde, 0x83, 0x77, 0x12, 0x9a, 0x2c, 0x14, 0xcb, 0x9c, 0x00, 0xb1, 0x58, 0xaf,
+		0xcb, 0x95, 0x85, 0xd5, 0xba, 0x54, 0xcb, 0x66, 0x90, 0x04, 0xc5, 0x2e, 0x1d, 0xc4, 0x59, 0x6f,
+		0x5f, 0x37, 0x7d, 0xe8, 0x2e, 0x7c, 0xa6, 0x1b, 0x1e, 0x5e, 0x38, 0xe9, 0x6f, 0x7a, 0x00, 0xdd,
+		0x83, 0x5b, 0x5d, 0x9a, 0x06, 0x4c, 0xb5, 0xaf, 0x6d, 0x3f, 0x96, 0x90, 0xae, 0xd8, 0xfb, 0x22,
+		0x38, 0x7f, 0xe3, 0x83, 0xa8, 0x02, 0x52, 0xb7, 0x81, 0xe3, 0x63, 0x5c, 0x7f, 0x57, 0x87, 0x52,
+		0x50, 0x31, 0x26, 0xfe, 0xf5, 0x77, 0x73, 0x18, 0x3d, 0x80, 0x52, 0x3a, 0x52, 0x24, 0x77, 0x34,
+		0x80, 0x3e, 0x0f, 0xf5, 0xde, 0xb8, 0x9a, 0x24, 0xe5, 0xbe, 0x9e, 0x01, 0xdd, 0x87, 0xbb, 0x5d,
+		0x89, 0x16, 0x0c, 0x89, 0x7d, 0xcd, 0x07, 0xb1, 0xb9, 0x4d, 0x68, 0xee, 0x97, 0x11, 0x6f, 0xf1,
+		0x5a, 0xc1, 0xd1, 0x4c, 0x20, 0x22, 0x8e, 0x34, 0x94, 0xa5, 0x9a, 0x54, 0x57, 0x6a, 0xf5, 0x4a,
+		0xe9, 0x11, 0x8d, 0x18, 0x96, 0x2a, 0xb5, 0x7a, 0xf6, 0x08, 0x76, 0x85, 0x09, 0xad, 0xdc, 0xb9,
+		0xe2, 0x1f, 0x92, 0xec, 0xd3, 0x30, 0x0c, 0xb6, 0x2a, 0x4b, 0xd9, 0xe1, 0x14, 0x2c, 0x61, 0xa6,
+		0x25, 0x99, 0x70, 0x23, 0xd8, 0xb5, 0xa7, 0xd2, 0x10, 0x6a, 0x1a, 0xb9, 0x9d, 0x64, 0xb1, 0x69,
+		0x4a, 0xe8, 0x64, 0x71, 0x59, 0x2e, 0x49, 0x74, 0xc9, 0xe9, 0xd9, 0x88, 0xa3, 0xe8, 0x16, 0xcc,
+		0x25, 0x35, 0x2a, 0x56, 0x96, 0x96, 0xdf, 0x97, 0xe4, 0x70, 0x3b, 0xd4, 0x85, 0xe4, 0xbe, 0xa9,
+		0x57, 0xaa, 0x2b, 0xab, 0x75, 0xa5, 0x56, 0x79, 0x2a, 0x65, 0x8f, 0x05, 0x17, 0x8a, 0x31, 0x8c,
+		0x72, 0x68, 0x95, 0xcd, 0x4d, 0x7f, 0x57, 0x80, 0xe9, 0xf4, 0xaf, 0x93, 0x63, 0x85, 0x4f, 0x1d,
+		0xc8, 0x44, 0xec, 0xeb, 0x13, 0xa8, 0xf5, 0xda, 0x78, 0xb5, 0xfa, 0xa8, 0xba, 0xfc, 0xa4, 0x9a,
+		0xb8, 0xf0, 0x12, 0xc8, 0x24, 0xd2, 0x3f, 0xc3, 0x8c, 0x27, 0x91, 0xda, 0x1f, 0xf1, 0x26, 0xd1,
+		0x6b, 0xe3, 0x74, 0x93, 0xf8, 0x58, 0x80, 0x89, 0xae, 0xaf, 0x85, 0x62, 0x1f, 0xd5, 0x25, 0xa2,
+		0x88, 0x60, 0xbc, 0x00, 0xef, 0xa6, 0x6b, 0xe2, 0xd6, 0x17, 0x97, 0x64, 0xa9, 0x58, 0xfe, 0x40,
+		0x91, 0x57, 0xab, 0xd5, 0x4a, 0xf5, 0x41, 0x56, 0x98, 0xfe, 0xbd, 0x0c, 0x9c, 0x49, 0x3a, 0xec,
+		0xc6, 0x6b, 0x4a, 0x5e, 0xcc, 0x42, 0x64, 0x3a, 0xb2, 0xef, 0xe4, 0xdf, 0xd2, 0x8a, 0x01, 0xf6,
+		0x02, 0x2a, 0x01, 0x47, 0xac, 0xdd, 0xc0, 0x59, 0xf0, 0x90, 0x09, 0xec, 0xad, 0xc5, 0x75, 0xed,
+		0x04, 0x52, 0x7d, 0x38, 0x54, 0xeb, 0x06, 0xed, 0x8b, 0x88, 0x0e, 0x60, 0x95, 0xec, 0x8e, 0x78,
+		0x28, 0xc6, 0xed, 0x4f, 0x33, 0x5d, 0x2f, 0x54, 0x3b, 0x38, 0xfd, 0xc3, 0x30, 0x58, 0x0a, 0x3c,
+		0x27, 0x9c, 0xa3, 0xec, 0x8b, 0xec, 0x40, 0x9e, 0x82, 0x13, 0x81, 0x1a, 0x17, 0xd5, 0xac, 0x80,
+		0xb7, 0x52, 0x02, 0x75, 0x41, 0x7f, 0x93, 0xcd, 0x44, 0xba, 0x2d, 0x2e, 0x14, 0xab, 0xe5, 0xe5,
+		0x6a, 0xb6, 0x6f, 0xfa, 0xc7, 0x04, 0x38, 0xc1, 0x3f, 0xc5, 0xc5, 0x6b, 0xc4, 0xcf, 0xad, 0x4a,
+		0x72, 0x78, 0xb5, 0x1b, 0xde, 0xe2, 0xb8, 0x08, 0x93, 0xf1, 0x60, 0x7e, 0xce, 0x4e, 0xc1, 0xb9,
+		0x78, 0x40, 0x87, 0xa7, 0xd3, 0xbf, 0x20, 0x40, 0x8e, 0x77, 0x7e, 0x89, 0x57, 0x94, 0x2b, 0x52,
+		0xb5, 0x5c, 0xa9, 0x3e, 0xf0, 0xc2, 0x22, 0x4c, 0x50, 0x3f, 0x2e, 0x53, 0x70, 0x2e, 0x06, 0xc6,
+		0x5b, 0xfa, 0x0b, 0x09, 0x3d, 0x39, 0x4b, 0x81, 0x0c, 0x16, 0xf1, 0x18, 0x98, 0xc8, 0x2a, 0xac,
+		0x6f, 0xfa, 0x6b, 0x02, 0x9c, 0xe0, 0x67, 0xe4, 0x63, 0x22, 0x3e, 0xac, 0xd4, 0xea, 0xcb, 0xf2,
+		0x07, 0x0a, 0x8d, 0x73, 0x17, 0x2b, 0x4b, 0x75, 0x49, 0xe6, 0x10, 0x31, 0x1e, 0xac, 0xb8, 0xb4,
+		0x44, 0x4b, 0xb3, 0x02, 0xde, 0x50, 0x8a, 0x07, 0xa4, 0x52, 0x46, 0x41, 0x33, 0xd3, 0xdf, 0x0f,
+		0x43, 0xce, 0xc1, 0xcd, 0x23, 0xa3, 0xa5, 0x91, 0x6d, 0x51, 0x4c, 0x79, 0xec, 0xae, 0x95, 0x47,
+		0x95, 0x6a, 0xd9, 0x37, 0xfe, 0x18, 0x1c, 0x0f, 0xd5, 0x55, 0x97, 0xe5, 0xc7, 0xc5, 0xa5, 0xac,
+		0xc0, 0xa9, 0xa2, 0xae, 0x3f, 0x9b, 0x99, 0x7e, 0x0e, 0xc3, 0xc1, 0x2b, 0xcb, 0xd0, 0x69, 0x38,
+		0x59, 0x94, 0x4b, 0x0f, 0x2b, 0xef, 0x17, 0x97, 0xb8, 0x3b, 0xd0, 0xe1, 0xca, 0x72, 0xa5, 0x56,
+		0x5c, 0xa0, 0x5c, 0xe1, 0x34, 0x95, 0xaa, 0xb4, 0x32, 0x33, 0xfd, 0x8f, 0x05, 0x38, 0xe1, 0x5c,
+		0xcc, 0x4e, 0xee, 0x65, 0x67, 0x57, 0xe5, 0xb6, 0x4d, 0x4c, 0xe1, 0x70, 0x14, 0xc4, 0x96, 0x26,
+		0xcb, 0xb2, 0x6f, 0xf0, 0x44, 0x30, 0xec, 0x44, 0xcb, 0x92, 0x4c, 0xcd, 0x4f, 0x3c, 0x98, 0x2c,
+		0xd5, 0xe5, 0x0f, 0x98, 0x0e, 0x51, 0x19, 0x89, 0x87, 0x2d, 0xc9, 0xcb, 0x55, 0x57, 0xea, 0xb2,
+		0x7d, 0xd3, 0x9a, 0xc7, 0x0d, 0x22, 0x18, 0x01, 0x6e, 0x84, 0xa4, 0xe1, 0x34, 0x9c, 0x0c, 0xd5,
+		0xf9, 0x56, 0x21, 0xd1, 0x4a, 0x47, 0x40, 0xb3, 0x99, 0x85, 0x1a, 0x9c, 0x6c, 0xb4, 0x37, 0x79,
+		0xc7, 0x63, 0x2b, 0xc2, 0xd3, 0x5b, 0xeb, 0x86, 0xfd, 0xbc, 0xf3, 0x6c, 0xa6, 0xd1, 0xde, 0x9c,
+		0xc5, 0x10, 0xb3, 0x0c, 0x62, 0x76, 0x66, 0x5d, 0x6f, 0xcd, 0x92, 0x7b, 0x21, 0x83, 0xe5, 0xea,
+		0x96, 0x31, 0xfb, 0xe2, 0xfa, 0xb3, 0x83, 0xa4, 0xea, 0xc6, 0xff, 0x1d, 0x00, 0xdc, 0xa1, 0x48,
+		0x75, 0x27, 0xbf, 0x00, 0x00,
+	},
+	// google/protobuf/wrappers.proto
+	[]byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0xcf, 0xcf, 0x4f,
+		0xcf, 0x49, 0xd5, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0x2a, 0x4d, 0xd3, 0x2f, 0x2f, 0x4a, 0x2c,
+		0x28, 0x48, 0x2d, 0x2a, 0xd6, 0x03, 0x8b, 0x08, 0xf1, 0x43, 0xe4, 0xf5, 0x60, 0xf2, 0x4a, 0xca,
+		0x5c, 0xdc, 0x2e, 0xf9, 0xa5, 0x49, 0x39, 0xa9, 0x61, 0x89, 0x39, 0xa5, 0xa9, 0x42, 0x22, 0x5c,
+		0xac, 0x65, 0x20, 0x86, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x63, 0x10, 0x84, 0xa3, 0xa4, 0xc4, 0xc5,
+		0xe5, 0x96, 0x93, 0x9f, 0x58, 0x82, 0x45, 0x0d, 0x13, 0x92, 0x1a, 0xcf, 0xbc, 0x12, 0x33, 0x13,
+		0x2c, 0x6a, 0x98, 0x61, 0x6a, 0x94, 0xb9, 0xb8, 0x43, 0x71, 0x29, 0x62, 0x41, 0x35, 0xc8, 0xd8,
+		0x08, 0x8b, 0x1a, 0x56, 0x34, 0x83, 0xb0, 0x2a, 0xe2, 0x85, 0x29, 0x52, 0xe4, 0xe2, 0x74, 0xca,
+		0xcf, 0xcf, 0xc1, 0xa2, 0x84, 0x03, 0xc9, 0x9c, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0x74, 0x2c, 0x8a,
+		0x38, 0x91, 0x1c, 0xe4, 0x54, 0x59, 0x92, 0x5a, 0x8c, 0x45, 0x0d, 0x0f, 0x54, 0x8d, 0x53, 0x33,
+		0x23, 0x97, 0x70, 0x72, 0x7e, 0xae, 0x1e, 0x5a, 0xf0, 0x3a, 0xf1, 0x86, 0x43, 0xc3, 0x3f, 0x00,
+		0x24, 0x12, 0xc0, 0x18, 0x65, 0x08, 0x55, 0x91, 0x9e, 0x9f, 0x93, 0x98, 0x97, 0xae, 0x97, 0x5f,
+		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
+		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
+		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
+	},
+}
+
+func init() {
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) WorkflowServiceYARPCClient {
+			return NewWorkflowServiceYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
+}
@@ -120,3 +120,163 @@ func (c *clientImpl) getRandomClient() (adminserviceclient.Interface, error) {
 
 	return client.(adminserviceclient.Interface), nil
 }
+
+func (c *clientImpl) ListDLQTasks(
+	ctx context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDLQTasksResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListDLQTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) RetryDLQTask(
+	ctx context.Context,
+	request *shared.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RetryDLQTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) DropDLQTask(
+	ctx context.Context,
+	request *shared.DropDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DropDLQTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeShard(
+	ctx context.Context,
+	request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeShardResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListShardQueueTasks(
+	ctx context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListShardQueueTasksResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListShardQueueTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) RemoveShardQueueTask(
+	ctx context.Context,
+	request *shared.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RemoveShardQueueTask(ctx, request, opts...)
+}
+
+func (c *clientImpl) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.CloseShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) ReloadShard(
+	ctx context.Context,
+	request *shared.ReloadShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ReloadShard(ctx, request, opts...)
+}
+
+func (c *clientImpl) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RefreshWorkflowTasks(ctx, request, opts...)
+}
+
+func (c *clientImpl) RebuildMutableState(
+	ctx context.Context,
+	request *shared.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RebuildMutableStateResponse, error) {
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RebuildMutableState(ctx, request, opts...)
+}
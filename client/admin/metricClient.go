@@ -97,3 +97,183 @@ func (c *metricClient) GetWorkflowExecutionRawHistory(
 	}
 	return resp, err
 }
+
+func (c *metricClient) ListDLQTasks(
+	ctx context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDLQTasksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListDLQTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListDLQTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDLQTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListDLQTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RetryDLQTask(
+	ctx context.Context,
+	request *shared.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRetryDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRetryDLQTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RetryDLQTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRetryDLQTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DropDLQTask(
+	ctx context.Context,
+	request *shared.DropDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDropDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDropDLQTaskScope, metrics.CadenceClientLatency)
+	err := c.client.DropDLQTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDropDLQTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DescribeShard(
+	ctx context.Context,
+	request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeShardResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeShardScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeShardScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListShardQueueTasks(
+	ctx context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListShardQueueTasksResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListShardQueueTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListShardQueueTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListShardQueueTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListShardQueueTasksScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RemoveShardQueueTask(
+	ctx context.Context,
+	request *shared.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRemoveShardQueueTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRemoveShardQueueTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveShardQueueTask(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRemoveShardQueueTaskScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientCloseShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientCloseShardScope, metrics.CadenceClientLatency)
+	err := c.client.CloseShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientCloseShardScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) ReloadShard(
+	ctx context.Context,
+	request *shared.ReloadShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientReloadShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientReloadShardScope, metrics.CadenceClientLatency)
+	err := c.client.ReloadShard(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientReloadShardScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRefreshWorkflowTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRefreshWorkflowTasksScope, metrics.CadenceClientLatency)
+	err := c.client.RefreshWorkflowTasks(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRefreshWorkflowTasksScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) RebuildMutableState(
+	ctx context.Context,
+	request *shared.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RebuildMutableStateResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRebuildMutableStateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRebuildMutableStateScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RebuildMutableState(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRebuildMutableStateScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
@@ -93,3 +93,139 @@ func (c *retryableClient) GetWorkflowExecutionRawHistory(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) ListDLQTasks(
+	ctx context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDLQTasksResponse, error) {
+
+	var resp *shared.ListDLQTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RetryDLQTask(
+	ctx context.Context,
+	request *shared.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RetryDLQTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DropDLQTask(
+	ctx context.Context,
+	request *shared.DropDLQTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.DropDLQTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) DescribeShard(
+	ctx context.Context,
+	request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeShardResponse, error) {
+
+	var resp *shared.DescribeShardResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeShard(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListShardQueueTasks(
+	ctx context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListShardQueueTasksResponse, error) {
+
+	var resp *shared.ListShardQueueTasksResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListShardQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RemoveShardQueueTask(
+	ctx context.Context,
+	request *shared.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RemoveShardQueueTask(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) CloseShard(
+	ctx context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.CloseShard(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) ReloadShard(
+	ctx context.Context,
+	request *shared.ReloadShardRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ReloadShard(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *shared.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.RefreshWorkflowTasks(ctx, request, opts...)
+	}
+	return backoff.Retry(op, c.policy, c.isRetryable)
+}
+
+func (c *retryableClient) RebuildMutableState(
+	ctx context.Context,
+	request *shared.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RebuildMutableStateResponse, error) {
+
+	var resp *shared.RebuildMutableStateResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RebuildMutableState(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -21,10 +21,13 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
 
+	tcg "github.com/uber/tchannel-go"
 	"go.uber.org/yarpc"
 	"go.uber.org/yarpc/transport/tchannel"
 
@@ -65,7 +68,11 @@ type (
 		remoteFrontendClients map[string]frontend.Client
 	}
 
+	// Dialer establishes the outbound connections of a dispatcher
+	Dialer func(ctx context.Context, network, hostPort string) (net.Conn, error)
+
 	ipDispatcherProvider struct {
+		dialer Dialer
 	}
 )
 
@@ -178,6 +185,12 @@ func NewIPYarpcDispatcherProvider() DispatcherProvider {
 	return &ipDispatcherProvider{}
 }
 
+// NewIPYarpcDispatcherProviderWithDialer create a dispatcher provider which handles with IP address
+// and establishes connections through the given dialer, e.g. to secure them with TLS
+func NewIPYarpcDispatcherProviderWithDialer(dialer Dialer) DispatcherProvider {
+	return &ipDispatcherProvider{dialer: dialer}
+}
+
 func (p *ipDispatcherProvider) Get(name string, address string) (*yarpc.Dispatcher, error) {
 	match, err := regexp.MatchString(ipPortRegex, address)
 	if err != nil {
@@ -187,11 +200,19 @@ func (p *ipDispatcherProvider) Get(name string, address string) (*yarpc.Dispatch
 		return nil, errors.New("invalid ip:port address")
 	}
 
-	channel, err := tchannel.NewChannelTransport(
+	opts := []tchannel.TransportOption{
 		tchannel.ServiceName(crossDCCaller),
 		// this aim to get rid of the annoying popup about accepting incoming network connections
 		tchannel.ListenAddr("127.0.0.1:0"),
-	)
+	}
+	if p.dialer != nil {
+		ch, err := tcg.NewChannel(crossDCCaller, &tcg.ChannelOptions{Dialer: p.dialer})
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, tchannel.WithChannel(ch))
+	}
+	channel, err := tchannel.NewChannelTransport(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,489 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
+	"github.com/uber/cadence/common/mapper"
+)
+
+var _ Client = (*grpcClient)(nil)
+
+type grpcClient struct {
+	client apiv1.WorkflowServiceYARPCClient
+}
+
+// NewGRPCClient creates a new frontend service client which calls
+// the proto WorkflowService over gRPC, the thrift exceptions returned
+// by the service are restored from the error details
+func NewGRPCClient(
+	client apiv1.WorkflowServiceYARPCClient,
+) Client {
+	return &grpcClient{
+		client: client,
+	}
+}
+
+func (c *grpcClient) DeprecateDomain(
+	ctx context.Context,
+	request *shared.DeprecateDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.DeprecateDomain(ctx, mapper.ToProtoDeprecateDomainRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) DescribeDomain(
+	ctx context.Context,
+	request *shared.DescribeDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeDomainResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.DescribeDomain(ctx, mapper.ToProtoDescribeDomainRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoDescribeDomainResponse(response), nil
+}
+
+func (c *grpcClient) DescribeTaskList(
+	ctx context.Context,
+	request *shared.DescribeTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeTaskListResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.DescribeTaskList(ctx, mapper.ToProtoDescribeTaskListRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoDescribeTaskListResponse(response), nil
+}
+
+func (c *grpcClient) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *shared.DescribeWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeWorkflowExecutionResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.DescribeWorkflowExecution(ctx, mapper.ToProtoDescribeWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoDescribeWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+	opts ...yarpc.CallOption,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.GetWorkflowExecutionHistory(ctx, mapper.ToProtoGetWorkflowExecutionHistoryRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+func (c *grpcClient) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListArchivedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.ListArchivedWorkflowExecutions(ctx, mapper.ToProtoListArchivedWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoListArchivedWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListClosedWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListClosedWorkflowExecutionsResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.ListClosedWorkflowExecutions(ctx, mapper.ToProtoListClosedWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoListClosedWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) ListDomains(
+	ctx context.Context,
+	request *shared.ListDomainsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListDomainsResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.ListDomains(ctx, mapper.ToProtoListDomainsRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoListDomainsResponse(response), nil
+}
+
+func (c *grpcClient) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.ListOpenWorkflowExecutions(ctx, mapper.ToProtoListOpenWorkflowExecutionsRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoListOpenWorkflowExecutionsResponse(response), nil
+}
+
+func (c *grpcClient) PollForActivityTask(
+	ctx context.Context,
+	request *shared.PollForActivityTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForActivityTaskResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.PollForActivityTask(ctx, mapper.ToProtoPollForActivityTaskRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoPollForActivityTaskResponse(response), nil
+}
+
+func (c *grpcClient) PollForDecisionTask(
+	ctx context.Context,
+	request *shared.PollForDecisionTaskRequest,
+	opts ...yarpc.CallOption,
+) (*shared.PollForDecisionTaskResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.PollForDecisionTask(ctx, mapper.ToProtoPollForDecisionTaskRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoPollForDecisionTaskResponse(response), nil
+}
+
+func (c *grpcClient) QueryWorkflow(
+	ctx context.Context,
+	request *shared.QueryWorkflowRequest,
+	opts ...yarpc.CallOption,
+) (*shared.QueryWorkflowResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.QueryWorkflow(ctx, mapper.ToProtoQueryWorkflowRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoQueryWorkflowResponse(response), nil
+}
+
+func (c *grpcClient) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.RecordActivityTaskHeartbeat(ctx, mapper.ToProtoRecordActivityTaskHeartbeatRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (c *grpcClient) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *shared.RecordActivityTaskHeartbeatByIDRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.RecordActivityTaskHeartbeatByID(ctx, mapper.ToProtoRecordActivityTaskHeartbeatByIDRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+func (c *grpcClient) RegisterDomain(
+	ctx context.Context,
+	request *shared.RegisterDomainRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RegisterDomain(ctx, mapper.ToProtoRegisterDomainRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *shared.RequestCancelWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RequestCancelWorkflowExecution(ctx, mapper.ToProtoRequestCancelWorkflowExecutionRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) ResetStickyTaskList(
+	ctx context.Context,
+	request *shared.ResetStickyTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetStickyTaskListResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.ResetStickyTaskList(ctx, mapper.ToProtoResetStickyTaskListRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoResetStickyTaskListResponse(response), nil
+}
+
+func (c *grpcClient) ResetWorkflowExecution(
+	ctx context.Context,
+	request *shared.ResetWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.ResetWorkflowExecutionResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.ResetWorkflowExecution(ctx, mapper.ToProtoResetWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoResetWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondActivityTaskCanceled(ctx, mapper.ToProtoRespondActivityTaskCanceledRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCanceledByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondActivityTaskCanceledByID(ctx, mapper.ToProtoRespondActivityTaskCanceledByIDRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondActivityTaskCompleted(ctx, mapper.ToProtoRespondActivityTaskCompletedRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskCompletedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondActivityTaskCompletedByID(ctx, mapper.ToProtoRespondActivityTaskCompletedByIDRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondActivityTaskFailed(ctx, mapper.ToProtoRespondActivityTaskFailedRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *shared.RespondActivityTaskFailedByIDRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondActivityTaskFailedByID(ctx, mapper.ToProtoRespondActivityTaskFailedByIDRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) (*shared.RespondDecisionTaskCompletedResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.RespondDecisionTaskCompleted(ctx, mapper.ToProtoRespondDecisionTaskCompletedRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoRespondDecisionTaskCompletedResponse(response), nil
+}
+
+func (c *grpcClient) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *shared.RespondDecisionTaskFailedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondDecisionTaskFailed(ctx, mapper.ToProtoRespondDecisionTaskFailedRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *shared.RespondQueryTaskCompletedRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.RespondQueryTaskCompleted(ctx, mapper.ToProtoRespondQueryTaskCompletedRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWithStartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.SignalWithStartWorkflowExecution(ctx, mapper.ToProtoSignalWithStartWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoStartWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.SignalWorkflowExecution(ctx, mapper.ToProtoSignalWorkflowExecutionRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.StartWorkflowExecution(ctx, mapper.ToProtoStartWorkflowExecutionRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoStartWorkflowExecutionResponse(response), nil
+}
+
+func (c *grpcClient) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *shared.TerminateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	_, err := c.client.TerminateWorkflowExecution(ctx, mapper.ToProtoTerminateWorkflowExecutionRequest(request), opts...)
+	return mapper.FromProtoError(err, headers)
+}
+
+func (c *grpcClient) UpdateDomain(
+	ctx context.Context,
+	request *shared.UpdateDomainRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateDomainResponse, error) {
+
+	var headers map[string]string
+	opts = append(opts, yarpc.ResponseHeaders(&headers))
+	response, err := c.client.UpdateDomain(ctx, mapper.ToProtoUpdateDomainRequest(request), opts...)
+	if err != nil {
+		return nil, mapper.FromProtoError(err, headers)
+	}
+	return mapper.FromProtoUpdateDomainResponse(response), nil
+}
@@ -686,3 +686,227 @@ redirectLoop:
 	}
 	return err
 }
+
+func (c *clientImpl) ListDLQTasks(
+	ctx context.Context,
+	request *workflow.ListDLQTasksRequest,
+	opts ...yarpc.CallOption) (*workflow.ListDLQTasksResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.ListDLQTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListDLQTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RetryDLQTask(
+	ctx context.Context,
+	request *workflow.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RetryDLQTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) DropDLQTask(
+	ctx context.Context,
+	request *workflow.DropDLQTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.DropDLQTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) DescribeShard(
+	ctx context.Context,
+	request *workflow.DescribeShardRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeShardResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.DescribeShardResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeShard(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ListShardQueueTasks(
+	ctx context.Context,
+	request *workflow.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption) (*workflow.ListShardQueueTasksResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.ListShardQueueTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListShardQueueTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RemoveShardQueueTask(
+	ctx context.Context,
+	request *workflow.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RemoveShardQueueTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) CloseShard(
+	ctx context.Context,
+	request *workflow.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.CloseShard(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) ReloadShard(
+	ctx context.Context,
+	request *workflow.ReloadShardRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardId()))
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.ReloadShard(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *h.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForWorkflowID(request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RefreshWorkflowTasks(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) RebuildMutableState(
+	ctx context.Context,
+	request *h.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption) (*workflow.RebuildMutableStateResponse, error) {
+
+	client, err := c.getClientForWorkflowID(request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.RebuildMutableStateResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.RebuildMutableState(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
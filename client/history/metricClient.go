@@ -469,3 +469,173 @@ func (c *metricClient) SyncActivity(
 
 	return err
 }
+
+func (c *metricClient) ListDLQTasks(
+	context context.Context,
+	request *shared.ListDLQTasksRequest,
+	opts ...yarpc.CallOption) (*shared.ListDLQTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListDLQTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListDLQTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListDLQTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListDLQTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RetryDLQTask(
+	context context.Context,
+	request *shared.RetryDLQTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRetryDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRetryDLQTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RetryDLQTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRetryDLQTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DropDLQTask(
+	context context.Context,
+	request *shared.DropDLQTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientDropDLQTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDropDLQTaskScope, metrics.CadenceClientLatency)
+	err := c.client.DropDLQTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDropDLQTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) DescribeShard(
+	context context.Context,
+	request *shared.DescribeShardRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeShardResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeShardScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeShard(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeShardScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) ListShardQueueTasks(
+	context context.Context,
+	request *shared.ListShardQueueTasksRequest,
+	opts ...yarpc.CallOption) (*shared.ListShardQueueTasksResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientListShardQueueTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientListShardQueueTasksScope, metrics.CadenceClientLatency)
+	resp, err := c.client.ListShardQueueTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientListShardQueueTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RemoveShardQueueTask(
+	context context.Context,
+	request *shared.RemoveShardQueueTaskRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRemoveShardQueueTaskScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRemoveShardQueueTaskScope, metrics.CadenceClientLatency)
+	err := c.client.RemoveShardQueueTask(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRemoveShardQueueTaskScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) CloseShard(
+	context context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientCloseShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientCloseShardScope, metrics.CadenceClientLatency)
+	err := c.client.CloseShard(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientCloseShardScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) ReloadShard(
+	context context.Context,
+	request *shared.ReloadShardRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientReloadShardScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientReloadShardScope, metrics.CadenceClientLatency)
+	err := c.client.ReloadShard(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientReloadShardScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) RefreshWorkflowTasks(
+	context context.Context,
+	request *h.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRefreshWorkflowTasksScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRefreshWorkflowTasksScope, metrics.CadenceClientLatency)
+	err := c.client.RefreshWorkflowTasks(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRefreshWorkflowTasksScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) RebuildMutableState(
+	context context.Context,
+	request *h.RebuildMutableStateRequest,
+	opts ...yarpc.CallOption) (*shared.RebuildMutableStateResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientRebuildMutableStateScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRebuildMutableStateScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RebuildMutableState(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRebuildMutableStateScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
@@ -26,6 +26,7 @@ import (
 	"strings"
 
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/tools/cassandra"
 
 	"github.com/urfave/cli"
@@ -72,7 +73,7 @@ func startHandler(c *cli.Context) {
 		if _, ok := cfg.Services[svc]; !ok {
 			log.Fatalf("`%v` service missing config", svc)
 		}
-		server := newServer(svc, &cfg)
+		server := newServer(svc, &cfg, dynamicconfig.NewNopClient())
 		server.Start()
 	}
 
@@ -186,6 +187,29 @@ func buildCLI() *cli.App {
 				startHandler(c)
 			},
 		},
+		{
+			Name:  "dev",
+			Usage: "start all cadence services in one process on top of a sqlite database, for development",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "db",
+					Usage: "sqlite database file, a temporary one is used when empty",
+				},
+				cli.StringFlag{
+					Name:  "domain",
+					Value: "default",
+					Usage: "domain registered on startup",
+				},
+				cli.StringFlag{
+					Name:  "log-level",
+					Value: "warn",
+					Usage: "log level of the services",
+				},
+			},
+			Action: func(c *cli.Context) {
+				devHandler(c)
+			},
+		},
 	}
 
 	return app
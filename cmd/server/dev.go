@@ -0,0 +1,221 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/urfave/cli"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/tchannel"
+)
+
+const (
+	devHost            = "127.0.0.1"
+	devClusterName     = "active"
+	devClientName      = "cadence-dev"
+	devRetentionDays   = 1
+	devRegisterTimeout = 5 * time.Second
+	devStartupTimeout  = time.Minute
+)
+
+type (
+	// devDynamicConfigClient overrides the defaults of
+	// the given boolean properties for the dev server
+	devDynamicConfigClient struct {
+		dynamicconfig.Client
+		boolValues map[dynamicconfig.Key]bool
+	}
+)
+
+// devServicePorts are the ports of the services started
+// by the dev command, the same as in the development config
+var devServicePorts = map[string]int{
+	frontendService: 7933,
+	historyService:  7934,
+	matchingService: 7935,
+	workerService:   7939,
+}
+
+// devHandler is the handler for the cli dev command, it runs all the
+// services in this process on top of a sqlite database file
+func devHandler(c *cli.Context) {
+	dbFile := c.String("db")
+	if len(dbFile) == 0 {
+		dir, err := ioutil.TempDir("", "cadence-dev-")
+		if err != nil {
+			log.Fatalf("error creating database directory: %v", err)
+		}
+		dbFile = filepath.Join(dir, "cadence.db")
+	}
+
+	cfg := newDevConfig(dbFile, c.String("log-level"))
+	dc := &devDynamicConfigClient{
+		Client: dynamicconfig.NewNopClient(),
+		boolValues: map[dynamicconfig.Key]bool{
+			// the sql execution store doesn't persist the history branch of a workflow
+			dynamicconfig.EnableEventsV2: false,
+		},
+	}
+	for _, svc := range validServices {
+		server := newServer(svc, cfg, dc)
+		server.Start()
+	}
+
+	domain := c.String("domain")
+	frontendAddress := devAddress(frontendService)
+	if err := registerDevDomain(frontendAddress, domain); err != nil {
+		log.Fatalf("error registering domain %v: %v", domain, err)
+	}
+
+	fmt.Printf("cadence dev server started\n")
+	fmt.Printf("  frontend: %v\n", frontendAddress)
+	fmt.Printf("  domain:   %v\n", domain)
+	fmt.Printf("  database: %v\n", dbFile)
+
+	select {}
+}
+
+// newDevConfig returns the config of a single cluster with all
+// the services on localhost, persisting everything to the given
+// sqlite file, with no kafka, elastic search or archival
+func newDevConfig(dbFile string, logLevel string) *config.Config {
+	const storeName = "sqlite"
+
+	cfg := &config.Config{
+		Log: config.Logger{
+			Stdout: true,
+			Level:  logLevel,
+		},
+		Persistence: config.Persistence{
+			DefaultStore:     storeName,
+			VisibilityStore:  storeName,
+			NumHistoryShards: 4,
+			DataStores: map[string]config.DataStore{
+				storeName: {
+					SQL: &config.SQL{
+						DriverName:   sqlite.DriverName,
+						DatabaseName: dbFile,
+						NumShards:    1,
+					},
+				},
+			},
+		},
+		Membership: config.Membership{
+			Mode:  config.MembershipModeStatic,
+			Hosts: make(map[string][]string),
+		},
+		ClustersInfo: config.ClustersInfo{
+			FailoverVersionIncrement:       10,
+			MasterClusterName:              devClusterName,
+			CurrentClusterName:             devClusterName,
+			ClusterInitialFailoverVersions: map[string]int64{devClusterName: 0},
+			ClusterAddress: map[string]config.Address{
+				devClusterName: {
+					RPCName:    common.FrontendServiceName,
+					RPCAddress: devAddress(frontendService),
+				},
+			},
+		},
+		DCRedirectionPolicy: config.DCRedirectionPolicy{Policy: "noop"},
+		Archival:            config.Archival{Status: "disabled"},
+		Services:            make(map[string]config.Service),
+	}
+
+	for _, svc := range validServices {
+		cfg.Services[svc] = config.Service{
+			RPC: config.RPC{
+				Port:            devServicePorts[svc],
+				BindOnLocalHost: true,
+			},
+		}
+		cfg.Membership.Hosts["cadence-"+svc] = []string{devAddress(svc)}
+	}
+	return cfg
+}
+
+func devAddress(service string) string {
+	return fmt.Sprintf("%v:%v", devHost, devServicePorts[service])
+}
+
+// registerDevDomain registers the domain through the frontend,
+// retrying until the frontend is up and serving requests
+func registerDevDomain(frontendAddress string, domain string) error {
+	ch, err := tchannel.NewChannelTransport(tchannel.ServiceName(devClientName), tchannel.ListenAddr(devHost+":0"))
+	if err != nil {
+		return err
+	}
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: devClientName,
+		Outbounds: yarpc.Outbounds{
+			common.FrontendServiceName: {Unary: ch.NewSingleOutbound(frontendAddress)},
+		},
+	})
+	if err := dispatcher.Start(); err != nil {
+		return err
+	}
+	defer dispatcher.Stop()
+
+	client := workflowserviceclient.New(dispatcher.ClientConfig(common.FrontendServiceName))
+	request := &shared.RegisterDomainRequest{
+		Name:                                   common.StringPtr(domain),
+		Description:                            common.StringPtr("default domain of the cadence dev server"),
+		WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(devRetentionDays),
+		EmitMetric:                             common.BoolPtr(false),
+	}
+
+	policy := backoff.NewExponentialRetryPolicy(100 * time.Millisecond)
+	policy.SetMaximumInterval(time.Second)
+	policy.SetExpirationInterval(devStartupTimeout)
+	return backoff.Retry(
+		func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), devRegisterTimeout)
+			defer cancel()
+			err := client.RegisterDomain(ctx, request)
+			if _, ok := err.(*shared.DomainAlreadyExistsError); ok {
+				return nil
+			}
+			return err
+		},
+		policy,
+		func(err error) bool { return true },
+	)
+}
+
+// GetBoolValue returns the overridden value of the property, or the default when there is none
+func (c *devDynamicConfigClient) GetBoolValue(name dynamicconfig.Key, filters map[dynamicconfig.Filter]interface{}, defaultValue bool) (bool, error) {
+	if value, ok := c.boolValues[name]; ok {
+		return value, nil
+	}
+	return c.Client.GetBoolValue(name, filters, defaultValue)
+}
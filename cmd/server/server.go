@@ -21,6 +21,7 @@
 package main
 
 import (
+	"io"
 	"log"
 	"time"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/uber/cadence/service/worker"
 
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/s3store"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/messaging"
 	"go.uber.org/zap"
@@ -48,6 +50,8 @@ type (
 		cfg    *config.Config
 		doneC  chan struct{}
 		daemon common.Daemon
+		tracer io.Closer
+		dc     dynamicconfig.Client
 	}
 )
 
@@ -60,11 +64,12 @@ const (
 
 // newServer returns a new instance of a daemon
 // that represents a cadence service
-func newServer(service string, cfg *config.Config) common.Daemon {
+func newServer(service string, cfg *config.Config, dc dynamicconfig.Client) common.Daemon {
 	return &server{
 		cfg:   cfg,
 		name:  service,
 		doneC: make(chan struct{}),
+		dc:    dc,
 	}
 }
 
@@ -93,6 +98,9 @@ func (s *server) Stop() {
 			log.Printf("timed out waiting for server %v to exit\n", s.name)
 		}
 	}
+	if err := s.tracer.Close(); err != nil {
+		log.Printf("error flushing the spans of server %v: %v\n", s.name, err)
+	}
 }
 
 // startService starts a service with the given name and config
@@ -105,17 +113,28 @@ func (s *server) startService() common.Daemon {
 	params.Logger = s.cfg.Log.NewBarkLogger()
 	params.PersistenceConfig = s.cfg.Persistence
 
-	params.MembershipFactory, err = s.cfg.Ringpop.NewFactory(params.Logger, params.Name)
-	if err != nil {
-		log.Fatalf("error creating ringpop factory: %v", err)
+	if s.cfg.Membership.Mode == config.MembershipModeRingpop {
+		params.MembershipFactory, err = s.cfg.Ringpop.NewFactory(params.Logger, params.Name)
+		if err != nil {
+			log.Fatalf("error creating ringpop factory: %v", err)
+		}
+	} else {
+		params.MembershipFactory, err = s.cfg.Membership.NewFactory(params.Logger, params.Name)
+		if err != nil {
+			log.Fatalf("error creating membership factory: %v", err)
+		}
 	}
 
-	params.DynamicConfig = dynamicconfig.NewNopClient()
+	params.DynamicConfig = s.dc
 	dc := dynamicconfig.NewCollection(params.DynamicConfig, params.Logger)
 
 	svcCfg := s.cfg.Services[s.name]
 	params.MetricScope = svcCfg.Metrics.NewScope()
-	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	params.Tracer, s.tracer, err = svcCfg.Tracing.NewTracer(params.Name, params.Logger)
+	if err != nil {
+		log.Fatalf("error creating tracer: %v", err)
+	}
+	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Tracer, params.Logger)
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
 	enableGlobalDomain := dc.GetBoolProperty(dynamicconfig.EnableGlobalDomain, s.cfg.ClustersInfo.EnableGlobalDomain)
 	archivalStatus := dc.GetStringProperty(dynamicconfig.ArchivalStatus, s.cfg.Archival.Status)
@@ -138,6 +157,13 @@ func (s *server) startService() common.Daemon {
 		enableReadFromArchival,
 	)
 	params.DispatcherProvider = client.NewIPYarpcDispatcherProvider()
+	if svcCfg.RPC.TLS != nil {
+		dialer, err := svcCfg.RPC.TLS.NewDialer(params.Logger)
+		if err != nil {
+			log.Fatalf("error loading tls certificates: %v", err)
+		}
+		params.DispatcherProvider = client.NewIPYarpcDispatcherProviderWithDialer(client.Dialer(dialer))
+	}
 	params.ESConfig = &s.cfg.ElasticSearch
 	params.ESConfig.Enable = dc.GetBoolProperty(dynamicconfig.EnableVisibilityToKafka, params.ESConfig.Enable)() // force override with dynamic config
 	if params.ClusterMetadata.IsGlobalDomainEnabled() {
@@ -163,7 +189,11 @@ func (s *server) startService() common.Daemon {
 	}
 
 	if params.ClusterMetadata.ArchivalConfig().ConfiguredForArchival() {
-		params.BlobstoreClient, err = filestore.NewClient(&s.cfg.Archival.Filestore)
+		if s.cfg.Archival.S3store != nil {
+			params.BlobstoreClient, err = s3store.NewClient(s.cfg.Archival.S3store)
+		} else {
+			params.BlobstoreClient, err = filestore.NewClient(&s.cfg.Archival.Filestore)
+		}
 		if err != nil {
 			log.Fatalf("error creating blobstore: %v", err)
 		}
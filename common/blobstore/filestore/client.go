@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -200,6 +201,47 @@ func (c *client) ListByPrefix(_ context.Context, bucket string, prefix string) (
 	return matchingKeys, nil
 }
 
+func (c *client) ListByPrefixPage(
+	_ context.Context,
+	bucket string,
+	prefix string,
+	pageSize int,
+	pageToken []byte,
+) ([]blob.Key, []byte, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	bd := bucketDirectory(c.storeDirectory, bucket)
+	if exists, err := directoryExists(bd); err != nil {
+		return nil, nil, ErrCheckBucketExists
+	} else if !exists {
+		return nil, nil, blobstore.ErrBucketNotExists
+	}
+
+	files, err := listFiles(bd)
+	if err != nil {
+		return nil, nil, ErrListFiles
+	}
+	sort.Strings(files)
+	// the page token is the last key of the previous page
+	lastKey := string(pageToken)
+	var matchingKeys []blob.Key
+	for _, f := range files {
+		if !strings.HasPrefix(f, prefix) || f <= lastKey {
+			continue
+		}
+		if len(matchingKeys) == pageSize {
+			return matchingKeys, []byte(matchingKeys[pageSize-1].String()), nil
+		}
+		key, err := blob.NewKeyFromString(f)
+		if err != nil {
+			return nil, nil, ErrConstructKey
+		}
+		matchingKeys = append(matchingKeys, key)
+	}
+	return matchingKeys, nil, nil
+}
+
 func (c *client) BucketMetadata(_ context.Context, bucket string) (*blobstore.BucketMetadataResponse, error) {
 	c.Lock()
 	defer c.Unlock()
@@ -290,6 +290,47 @@ func (s *ClientSuite) TestListByPrefix_Success() {
 	s.Equal([]string{"matching_1.ext", "matching_2.ext", "matching_3.ext"}, matchingFilenames)
 }
 
+func (s *ClientSuite) TestListByPrefixPage_Fail_BucketNotExists() {
+	dir, err := ioutil.TempDir("", "TestListByPrefixPage_Fail_BucketNotExists")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	client := s.constructClient(dir)
+
+	files, token, err := client.ListByPrefixPage(context.Background(), "bucket-not-exists", "foo", 10, nil)
+	s.Equal(blobstore.ErrBucketNotExists, err)
+	s.Nil(files)
+	s.Nil(token)
+}
+
+func (s *ClientSuite) TestListByPrefixPage_Success() {
+	dir, err := ioutil.TempDir("", "TestListByPrefixPage_Success")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	client := s.constructClient(dir)
+
+	allFiles := []string{"matching_1.ext", "matching_2.ext", "not_matching_1.ext", "not_matching_2.ext", "matching_3.ext"}
+	for _, f := range allFiles {
+		key, err := blob.NewKeyFromString(f)
+		s.NoError(err)
+		b := blob.NewBlob([]byte("blob body"), map[string]string{})
+		s.NoError(client.Upload(context.Background(), defaultBucketName, key, b))
+	}
+	matchingKeys, token, err := client.ListByPrefixPage(context.Background(), defaultBucketName, "matching", 2, nil)
+	s.NoError(err)
+	s.NotEmpty(token)
+	var matchingFilenames []string
+	for _, k := range matchingKeys {
+		matchingFilenames = append(matchingFilenames, k.String())
+	}
+	s.Equal([]string{"matching_1.ext", "matching_2.ext"}, matchingFilenames)
+
+	matchingKeys, token, err = client.ListByPrefixPage(context.Background(), defaultBucketName, "matching", 2, token)
+	s.NoError(err)
+	s.Empty(token)
+	s.Len(matchingKeys, 1)
+	s.Equal("matching_3.ext", matchingKeys[0].String())
+}
+
 func (s *ClientSuite) TestBucketMetadata_Fail_BucketNotExists() {
 	dir, err := ioutil.TempDir("", "TestBucketMetadata_Fail_BucketNotExists")
 	s.NoError(err)
@@ -53,6 +53,9 @@ type Client interface {
 	Exists(ctx context.Context, bucket string, key blob.Key) (bool, error)
 	Delete(ctx context.Context, bucket string, key blob.Key) (bool, error)
 	ListByPrefix(ctx context.Context, bucket string, prefix string) ([]blob.Key, error)
+	// ListByPrefixPage returns up to pageSize keys with prefix in lexical order starting after the position
+	// identified by pageToken, the returned token is empty once all matching keys have been listed
+	ListByPrefixPage(ctx context.Context, bucket string, prefix string, pageSize int, pageToken []byte) ([]blob.Key, []byte, error)
 	BucketMetadata(ctx context.Context, bucket string) (*BucketMetadataResponse, error)
 
 	IsRetryableError(err error) bool
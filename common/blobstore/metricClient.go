@@ -120,6 +120,25 @@ func (c *metricClient) ListByPrefix(ctx context.Context, bucket string, prefix s
 	return resp, err
 }
 
+func (c *metricClient) ListByPrefixPage(
+	ctx context.Context,
+	bucket string,
+	prefix string,
+	pageSize int,
+	pageToken []byte,
+) ([]blob.Key, []byte, error) {
+	c.metricsClient.IncCounter(metrics.BlobstoreClientListByPrefixPageScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.BlobstoreClientListByPrefixPageScope, metrics.CadenceClientLatency)
+	resp, nextPageToken, err := c.client.ListByPrefixPage(ctx, bucket, prefix, pageSize, pageToken)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.BlobstoreClientListByPrefixPageScope, metrics.CadenceClientFailures)
+	}
+	return resp, nextPageToken, err
+}
+
 func (c *metricClient) BucketMetadata(ctx context.Context, bucket string) (*BucketMetadataResponse, error) {
 	c.metricsClient.IncCounter(metrics.BlobstoreClientBucketMetadataScope, metrics.CadenceClientRequests)
 
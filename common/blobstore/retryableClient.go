@@ -105,6 +105,24 @@ func (c *retryableClient) ListByPrefix(ctx context.Context, bucket string, prefi
 	return resp, err
 }
 
+func (c *retryableClient) ListByPrefixPage(
+	ctx context.Context,
+	bucket string,
+	prefix string,
+	pageSize int,
+	pageToken []byte,
+) ([]blob.Key, []byte, error) {
+	var resp []blob.Key
+	var nextPageToken []byte
+	op := func() error {
+		var err error
+		resp, nextPageToken, err = c.client.ListByPrefixPage(ctx, bucket, prefix, pageSize, pageToken)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, nextPageToken, err
+}
+
 func (c *retryableClient) BucketMetadata(ctx context.Context, bucket string) (*BucketMetadataResponse, error) {
 	var resp *BucketMetadataResponse
 	op := func() error {
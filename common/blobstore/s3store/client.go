@@ -0,0 +1,356 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+)
+
+const (
+	// tagsMetadataKey is the object metadata entry under which blob tags are stored.
+	// S3 limits objects to ten tags and canonicalizes metadata keys,
+	// so tags are stored as a single base64 encoded json map instead.
+	tagsMetadataKey = "cadence-tags"
+
+	// errCodeNotFound is returned by S3 for HEAD requests on missing objects
+	errCodeNotFound = "NotFound"
+	// errCodeNoSuchLifecycleConfiguration is returned by S3 for buckets without lifecycle rules
+	errCodeNoSuchLifecycleConfiguration = "NoSuchLifecycleConfiguration"
+
+	retryPolicyInitialInterval    = 100 * time.Millisecond
+	retryPolicyMaximumInterval    = 10 * time.Second
+	retryPolicyExpirationInterval = time.Minute
+)
+
+var (
+	// ErrConstructKey could not construct key
+	ErrConstructKey = &shared.BadRequestError{Message: "could not construct key"}
+	// ErrReadObject could not read object body
+	ErrReadObject = &shared.BadRequestError{Message: "could not read object body"}
+)
+
+type client struct {
+	s3cli s3iface.S3API
+}
+
+// NewClient returns a new Client backed by S3 or an S3 compatible store
+func NewClient(cfg *Config) (blobstore.Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	awsConfig := &aws.Config{
+		Region:           aws.String(cfg.Region),
+		S3ForcePathStyle: aws.Bool(cfg.S3ForcePathStyle),
+		DisableSSL:       aws.Bool(cfg.DisableSSL),
+		// retries are done by blobstore.NewRetryableClient using GetRetryPolicy and IsRetryableError
+		MaxRetries: aws.Int(0),
+	}
+	if len(cfg.Endpoint) != 0 {
+		awsConfig.Endpoint = aws.String(cfg.Endpoint)
+	}
+	if len(cfg.AccessKeyID) != 0 {
+		awsConfig.Credentials = credentials.NewStaticCredentials(cfg.AccessKeyID, cfg.SecretAccessKey, "")
+	}
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		s3cli: s3.New(sess),
+	}, nil
+}
+
+func (c *client) Upload(ctx context.Context, bucket string, key blob.Key, blob *blob.Blob) error {
+	metadata, err := serializeTags(blob.Tags)
+	if err != nil {
+		return blobstore.ErrBlobSerialization
+	}
+	_, err = c.s3cli.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key.String()),
+		Body:     bytes.NewReader(blob.Body),
+		Metadata: metadata,
+	})
+	return convertError(err)
+}
+
+func (c *client) Download(ctx context.Context, bucket string, key blob.Key) (*blob.Blob, error) {
+	result, err := c.s3cli.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key.String()),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer result.Body.Close()
+
+	body, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, ErrReadObject
+	}
+	tags, err := deserializeTags(result.Metadata)
+	if err != nil {
+		return nil, blobstore.ErrBlobDeserialization
+	}
+	return blob.NewBlob(body, tags), nil
+}
+
+func (c *client) GetTags(ctx context.Context, bucket string, key blob.Key) (map[string]string, error) {
+	result, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key.String()),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	tags, err := deserializeTags(result.Metadata)
+	if err != nil {
+		return nil, blobstore.ErrBlobDeserialization
+	}
+	return tags, nil
+}
+
+func (c *client) Exists(ctx context.Context, bucket string, key blob.Key) (bool, error) {
+	_, err := c.s3cli.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key.String()),
+	})
+	if err != nil {
+		if err := convertError(err); err != blobstore.ErrBlobNotExists {
+			return false, err
+		}
+		return false, nil
+	}
+	return true, nil
+}
+
+func (c *client) Delete(ctx context.Context, bucket string, key blob.Key) (bool, error) {
+	exists, err := c.Exists(ctx, bucket, key)
+	if err != nil || !exists {
+		return false, err
+	}
+	_, err = c.s3cli.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key.String()),
+	})
+	if err != nil {
+		return false, convertError(err)
+	}
+	return true, nil
+}
+
+func (c *client) ListByPrefix(ctx context.Context, bucket string, prefix string) ([]blob.Key, error) {
+	var matchingKeys []blob.Key
+	var keyErr error
+	err := c.s3cli.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			key, err := blob.NewKeyFromString(aws.StringValue(object.Key))
+			if err != nil {
+				keyErr = ErrConstructKey
+				return false
+			}
+			matchingKeys = append(matchingKeys, key)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if keyErr != nil {
+		return nil, keyErr
+	}
+	return matchingKeys, nil
+}
+
+func (c *client) ListByPrefixPage(
+	ctx context.Context,
+	bucket string,
+	prefix string,
+	pageSize int,
+	pageToken []byte,
+) ([]blob.Key, []byte, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket:  aws.String(bucket),
+		Prefix:  aws.String(prefix),
+		MaxKeys: aws.Int64(int64(pageSize)),
+	}
+	if len(pageToken) != 0 {
+		input.ContinuationToken = aws.String(string(pageToken))
+	}
+	page, err := c.s3cli.ListObjectsV2WithContext(ctx, input)
+	if err != nil {
+		return nil, nil, convertError(err)
+	}
+	var matchingKeys []blob.Key
+	for _, object := range page.Contents {
+		key, err := blob.NewKeyFromString(aws.StringValue(object.Key))
+		if err != nil {
+			return nil, nil, ErrConstructKey
+		}
+		matchingKeys = append(matchingKeys, key)
+	}
+	var nextPageToken []byte
+	if aws.BoolValue(page.IsTruncated) {
+		nextPageToken = []byte(aws.StringValue(page.NextContinuationToken))
+	}
+	return matchingKeys, nextPageToken, nil
+}
+
+func (c *client) BucketMetadata(ctx context.Context, bucket string) (*blobstore.BucketMetadataResponse, error) {
+	acl, err := c.s3cli.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	var owner string
+	if acl.Owner != nil {
+		owner = aws.StringValue(acl.Owner.DisplayName)
+		if len(owner) == 0 {
+			owner = aws.StringValue(acl.Owner.ID)
+		}
+	}
+
+	retentionDays := 0
+	lifecycle, err := c.s3cli.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); !ok || aerr.Code() != errCodeNoSuchLifecycleConfiguration {
+			return nil, convertError(err)
+		}
+	} else {
+		retentionDays = bucketRetentionDays(lifecycle.Rules)
+	}
+
+	return &blobstore.BucketMetadataResponse{
+		Owner:         owner,
+		RetentionDays: retentionDays,
+	}, nil
+}
+
+func (c *client) IsRetryableError(err error) bool {
+	// the sdk treats any error it doesn't recognize as retryable,
+	// so only errors returned by the sdk are classified here
+	if _, ok := err.(awserr.Error); !ok {
+		return false
+	}
+	if request.IsErrorRetryable(err) || request.IsErrorThrottle(err) {
+		return true
+	}
+	if reqErr, ok := err.(awserr.RequestFailure); ok {
+		return reqErr.StatusCode() >= http.StatusInternalServerError
+	}
+	return false
+}
+
+func (c *client) GetRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(retryPolicyInitialInterval)
+	policy.SetMaximumInterval(retryPolicyMaximumInterval)
+	policy.SetExpirationInterval(retryPolicyExpirationInterval)
+	return policy
+}
+
+// bucketRetentionDays returns the expiration days of the enabled lifecycle rule which applies to the whole bucket
+func bucketRetentionDays(rules []*s3.LifecycleRule) int {
+	for _, rule := range rules {
+		if aws.StringValue(rule.Status) != s3.ExpirationStatusEnabled || rule.Expiration == nil || rule.Expiration.Days == nil {
+			continue
+		}
+		if len(aws.StringValue(rule.Prefix)) != 0 {
+			continue
+		}
+		if rule.Filter != nil && (rule.Filter.And != nil || rule.Filter.Tag != nil || len(aws.StringValue(rule.Filter.Prefix)) != 0) {
+			continue
+		}
+		return int(aws.Int64Value(rule.Expiration.Days))
+	}
+	return 0
+}
+
+// convertError converts S3 not found errors into blobstore errors,
+// all other errors are returned as is so that IsRetryableError can inspect them
+func convertError(err error) error {
+	aerr, ok := err.(awserr.Error)
+	if !ok {
+		return err
+	}
+	switch aerr.Code() {
+	case s3.ErrCodeNoSuchBucket:
+		return blobstore.ErrBucketNotExists
+	case s3.ErrCodeNoSuchKey, errCodeNotFound:
+		return blobstore.ErrBlobNotExists
+	}
+	return err
+}
+
+func serializeTags(tags map[string]string) (map[string]*string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(tags)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]*string{
+		tagsMetadataKey: aws.String(base64.StdEncoding.EncodeToString(data)),
+	}, nil
+}
+
+func deserializeTags(metadata map[string]*string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for k, v := range metadata {
+		// metadata keys come back from S3 in canonical header form
+		if !strings.EqualFold(k, tagsMetadataKey) {
+			continue
+		}
+		data, err := base64.StdEncoding.DecodeString(aws.StringValue(v))
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &tags); err != nil {
+			return nil, err
+		}
+	}
+	return tags, nil
+}
@@ -0,0 +1,296 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+)
+
+const (
+	defaultBucketName          = "default-bucket-name"
+	defaultBucketOwner         = "default-bucket-owner"
+	defaultBucketRetentionDays = 10
+	noRetentionBucketName      = "no-retention-bucket-name"
+)
+
+type ClientSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	fakeS3 *fakeS3
+	client blobstore.Client
+}
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.fakeS3 = newFakeS3()
+	s.fakeS3.createBucket(defaultBucketName, defaultBucketOwner, defaultBucketRetentionDays)
+	s.fakeS3.createBucket(noRetentionBucketName, defaultBucketOwner, 0)
+	s.client = s.constructClient(s.fakeS3.server.URL)
+}
+
+func (s *ClientSuite) TearDownTest() {
+	s.fakeS3.close()
+}
+
+func (s *ClientSuite) TestNewClient_Fail_InvalidConfig() {
+	client, err := NewClient(&Config{})
+	s.Error(err)
+	s.Nil(client)
+}
+
+func (s *ClientSuite) TestUpload_Fail_BucketNotExists() {
+	b := blob.NewBlob([]byte("blob body"), map[string]string{"tagKey": "tagValue"})
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	s.Equal(blobstore.ErrBucketNotExists, s.client.Upload(context.Background(), "bucket-not-exists", key, b))
+}
+
+func (s *ClientSuite) TestDownload_Fail_BucketNotExists() {
+	key, err := blob.NewKeyFromString("blobname.ext")
+	s.NoError(err)
+	b, err := s.client.Download(context.Background(), "bucket-not-exists", key)
+	s.Equal(blobstore.ErrBucketNotExists, err)
+	s.Nil(b)
+}
+
+func (s *ClientSuite) TestDownload_Fail_BlobNotExists() {
+	key, err := blob.NewKeyFromString("blobname.ext")
+	s.NoError(err)
+	b, err := s.client.Download(context.Background(), defaultBucketName, key)
+	s.Equal(blobstore.ErrBlobNotExists, err)
+	s.Nil(b)
+}
+
+func (s *ClientSuite) TestUploadDownload_Success() {
+	b := blob.NewBlob([]byte("body version 1"), map[string]string{})
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, b))
+	downloadBlob, err := s.client.Download(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.NotNil(downloadBlob)
+	s.assertBlobEquals(map[string]string{}, "body version 1", downloadBlob)
+
+	tags := map[string]string{
+		"key":            "value",
+		"workflow_id":    "workflow id with spaces and ünicode",
+		"is_last":        "true",
+		"upload_cluster": "active",
+	}
+	b = blob.NewBlob([]byte("body version 2"), tags)
+	s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, b))
+	downloadBlob, err = s.client.Download(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.NotNil(downloadBlob)
+	s.assertBlobEquals(tags, "body version 2", downloadBlob)
+}
+
+func (s *ClientSuite) TestGetTags_Fail_BlobNotExists() {
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	tags, err := s.client.GetTags(context.Background(), defaultBucketName, key)
+	s.Equal(blobstore.ErrBlobNotExists, err)
+	s.Nil(tags)
+}
+
+func (s *ClientSuite) TestGetTags_Success() {
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	expectedTags := make(map[string]string)
+	for i := 0; i < 15; i++ {
+		expectedTags[fmt.Sprintf("tag_key_%v", i)] = fmt.Sprintf("tag value %v", i)
+	}
+	s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), expectedTags)))
+	tags, err := s.client.GetTags(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.Equal(expectedTags, tags)
+}
+
+func (s *ClientSuite) TestExists_Success() {
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	exists, err := s.client.Exists(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.False(exists)
+
+	s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{})))
+	exists, err = s.client.Exists(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.True(exists)
+}
+
+func (s *ClientSuite) TestDelete_Success() {
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	deleted, err := s.client.Delete(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.False(deleted)
+
+	s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{})))
+	deleted, err = s.client.Delete(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.True(deleted)
+	exists, err := s.client.Exists(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.False(exists)
+}
+
+func (s *ClientSuite) TestListByPrefix_Fail_BucketNotExists() {
+	keys, err := s.client.ListByPrefix(context.Background(), "bucket-not-exists", "foo")
+	s.Equal(blobstore.ErrBucketNotExists, err)
+	s.Nil(keys)
+}
+
+func (s *ClientSuite) TestListByPrefix_Success() {
+	var expectedKeys []string
+	for i := 0; i < 5; i++ {
+		key, err := blob.NewKeyFromString(fmt.Sprintf("matching_%v.ext", i))
+		s.NoError(err)
+		s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{})))
+		expectedKeys = append(expectedKeys, key.String())
+
+		key, err = blob.NewKeyFromString(fmt.Sprintf("other_%v.ext", i))
+		s.NoError(err)
+		s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{})))
+	}
+
+	// fake server returns two keys per page so this also covers pagination
+	keys, err := s.client.ListByPrefix(context.Background(), defaultBucketName, "matching")
+	s.NoError(err)
+	var actualKeys []string
+	for _, k := range keys {
+		actualKeys = append(actualKeys, k.String())
+	}
+	s.Equal(expectedKeys, actualKeys)
+}
+
+func (s *ClientSuite) TestListByPrefixPage_Fail_BucketNotExists() {
+	keys, token, err := s.client.ListByPrefixPage(context.Background(), "bucket-not-exists", "foo", 10, nil)
+	s.Equal(blobstore.ErrBucketNotExists, err)
+	s.Nil(keys)
+	s.Nil(token)
+}
+
+func (s *ClientSuite) TestListByPrefixPage_Success() {
+	var expectedKeys []string
+	for i := 0; i < 3; i++ {
+		key, err := blob.NewKeyFromString(fmt.Sprintf("matching_%v.ext", i))
+		s.NoError(err)
+		s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{})))
+		expectedKeys = append(expectedKeys, key.String())
+
+		key, err = blob.NewKeyFromString(fmt.Sprintf("other_%v.ext", i))
+		s.NoError(err)
+		s.NoError(s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{})))
+	}
+
+	var actualKeys []string
+	var token []byte
+	for pages := 1; ; pages++ {
+		keys, nextToken, err := s.client.ListByPrefixPage(context.Background(), defaultBucketName, "matching", 1, token)
+		s.NoError(err)
+		s.Len(keys, 1)
+		actualKeys = append(actualKeys, keys[0].String())
+		if len(nextToken) == 0 {
+			s.Equal(3, pages)
+			break
+		}
+		token = nextToken
+	}
+	s.Equal(expectedKeys, actualKeys)
+}
+
+func (s *ClientSuite) TestBucketMetadata_Fail_BucketNotExists() {
+	metadata, err := s.client.BucketMetadata(context.Background(), "bucket-not-exists")
+	s.Equal(blobstore.ErrBucketNotExists, err)
+	s.Nil(metadata)
+}
+
+func (s *ClientSuite) TestBucketMetadata_Success() {
+	metadata, err := s.client.BucketMetadata(context.Background(), defaultBucketName)
+	s.NoError(err)
+	s.NotNil(metadata)
+	s.Equal(defaultBucketRetentionDays, metadata.RetentionDays)
+	s.Equal(defaultBucketOwner, metadata.Owner)
+}
+
+func (s *ClientSuite) TestBucketMetadata_Success_NoLifecycleConfiguration() {
+	metadata, err := s.client.BucketMetadata(context.Background(), noRetentionBucketName)
+	s.NoError(err)
+	s.NotNil(metadata)
+	s.Equal(0, metadata.RetentionDays)
+	s.Equal(defaultBucketOwner, metadata.Owner)
+}
+
+func (s *ClientSuite) TestIsRetryableError() {
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	s.fakeS3.failNextRequests(1)
+	err = s.client.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{}))
+	s.Error(err)
+	s.True(s.client.IsRetryableError(err))
+
+	_, err = s.client.Download(context.Background(), defaultBucketName, key)
+	s.Equal(blobstore.ErrBlobNotExists, err)
+	s.False(s.client.IsRetryableError(err))
+}
+
+func (s *ClientSuite) TestRetryableClient_Success() {
+	key, err := blob.NewKeyFromString("blob.blob")
+	s.NoError(err)
+	retryableClient := blobstore.NewRetryableClient(s.client, s.client.GetRetryPolicy(), s.client.IsRetryableError)
+	s.fakeS3.failNextRequests(2)
+	s.NoError(retryableClient.Upload(context.Background(), defaultBucketName, key, blob.NewBlob([]byte("blob body"), map[string]string{})))
+	exists, err := s.client.Exists(context.Background(), defaultBucketName, key)
+	s.NoError(err)
+	s.True(exists)
+}
+
+func (s *ClientSuite) constructClient(endpoint string) blobstore.Client {
+	client, err := NewClient(&Config{
+		Region:           "us-east-1",
+		Endpoint:         endpoint,
+		S3ForcePathStyle: true,
+		DisableSSL:       true,
+		AccessKeyID:      "test-access-key-id",
+		SecretAccessKey:  "test-secret-access-key",
+	})
+	s.NoError(err)
+	s.NotNil(client)
+	return client
+}
+
+func (s *ClientSuite) assertBlobEquals(expectedTags map[string]string, expectedBody string, actual *blob.Blob) {
+	s.Equal(expectedTags, actual.Tags)
+	s.Equal(expectedBody, string(actual.Body))
+}
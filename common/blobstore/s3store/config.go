@@ -0,0 +1,54 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"errors"
+)
+
+type (
+	// Config describes the configuration needed to construct a blobstore client backed by S3 or an S3 compatible store
+	Config struct {
+		// Region is the region in which buckets live
+		Region string `yaml:"region"`
+		// Endpoint overrides the default S3 endpoint, used to target S3 compatible stores such as MinIO
+		Endpoint string `yaml:"endpoint"`
+		// S3ForcePathStyle uses path style addressing (endpoint/bucket/key) instead of virtual hosted buckets
+		S3ForcePathStyle bool `yaml:"s3ForcePathStyle"`
+		// DisableSSL uses plain http to talk to endpoint
+		DisableSSL bool `yaml:"disableSSL"`
+		// AccessKeyID and SecretAccessKey are static credentials,
+		// if both are empty credentials are resolved by the default AWS credential chain
+		AccessKeyID     string `yaml:"accessKeyID"`
+		SecretAccessKey string `yaml:"secretAccessKey"`
+	}
+)
+
+// Validate validates config
+func (c *Config) Validate() error {
+	if len(c.Region) == 0 {
+		return errors.New("empty region")
+	}
+	if (len(c.AccessKeyID) == 0) != (len(c.SecretAccessKey) == 0) {
+		return errors.New("accessKeyID and secretAccessKey must be set together")
+	}
+	return nil
+}
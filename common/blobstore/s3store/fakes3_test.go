@@ -0,0 +1,270 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package s3store
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	fakeS3ListPageSize = 2
+	fakeS3MetaPrefix   = "X-Amz-Meta-"
+)
+
+type (
+	// fakeS3 is an in-process server which implements the subset of the S3 API used by client,
+	// requests must use path style addressing and are not authenticated
+	fakeS3 struct {
+		sync.Mutex
+		server  *httptest.Server
+		buckets map[string]*fakeBucket
+		// failures is the number of upcoming requests to fail with a 503 SlowDown error
+		failures int
+	}
+
+	fakeBucket struct {
+		owner         string
+		retentionDays int
+		objects       map[string]*fakeObject
+	}
+
+	fakeObject struct {
+		body     []byte
+		metadata http.Header
+	}
+
+	fakeErrorResponse struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}
+
+	fakeListBucketResult struct {
+		XMLName               xml.Name         `xml:"ListBucketResult"`
+		Name                  string           `xml:"Name"`
+		Prefix                string           `xml:"Prefix"`
+		KeyCount              int              `xml:"KeyCount"`
+		IsTruncated           bool             `xml:"IsTruncated"`
+		NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+		Contents              []fakeListObject `xml:"Contents"`
+	}
+
+	fakeListObject struct {
+		Key  string `xml:"Key"`
+		Size int    `xml:"Size"`
+	}
+
+	fakeAccessControlPolicy struct {
+		XMLName xml.Name `xml:"AccessControlPolicy"`
+		Owner   struct {
+			ID          string `xml:"ID"`
+			DisplayName string `xml:"DisplayName"`
+		} `xml:"Owner"`
+	}
+
+	fakeLifecycleConfiguration struct {
+		XMLName xml.Name `xml:"LifecycleConfiguration"`
+		Rule    struct {
+			ID     string `xml:"ID"`
+			Filter struct {
+				Prefix string `xml:"Prefix"`
+			} `xml:"Filter"`
+			Status     string `xml:"Status"`
+			Expiration struct {
+				Days int `xml:"Days"`
+			} `xml:"Expiration"`
+		} `xml:"Rule"`
+	}
+)
+
+func newFakeS3() *fakeS3 {
+	f := &fakeS3{
+		buckets: make(map[string]*fakeBucket),
+	}
+	f.server = httptest.NewServer(f)
+	return f
+}
+
+func (f *fakeS3) close() {
+	f.server.Close()
+}
+
+func (f *fakeS3) createBucket(name string, owner string, retentionDays int) {
+	f.Lock()
+	defer f.Unlock()
+	f.buckets[name] = &fakeBucket{
+		owner:         owner,
+		retentionDays: retentionDays,
+		objects:       make(map[string]*fakeObject),
+	}
+}
+
+func (f *fakeS3) failNextRequests(count int) {
+	f.Lock()
+	defer f.Unlock()
+	f.failures = count
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+
+	if f.failures > 0 {
+		f.failures--
+		writeFakeError(w, http.StatusServiceUnavailable, "SlowDown", "reduce your request rate")
+		return
+	}
+
+	path := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	bucket, ok := f.buckets[path[0]]
+	if !ok {
+		writeFakeError(w, http.StatusNotFound, "NoSuchBucket", "the specified bucket does not exist")
+		return
+	}
+	if len(path) == 1 || len(path[1]) == 0 {
+		f.serveBucket(w, r, path[0], bucket)
+		return
+	}
+	f.serveObject(w, r, bucket, path[1])
+}
+
+func (f *fakeS3) serveBucket(w http.ResponseWriter, r *http.Request, name string, bucket *fakeBucket) {
+	query := r.URL.Query()
+	if _, ok := query["acl"]; ok {
+		policy := fakeAccessControlPolicy{}
+		policy.Owner.ID = "fake-owner-id"
+		policy.Owner.DisplayName = bucket.owner
+		writeFakeXML(w, policy)
+		return
+	}
+	if _, ok := query["lifecycle"]; ok {
+		if bucket.retentionDays == 0 {
+			writeFakeError(w, http.StatusNotFound, errCodeNoSuchLifecycleConfiguration, "the lifecycle configuration does not exist")
+			return
+		}
+		lifecycle := fakeLifecycleConfiguration{}
+		lifecycle.Rule.ID = "retention"
+		lifecycle.Rule.Status = "Enabled"
+		lifecycle.Rule.Expiration.Days = bucket.retentionDays
+		writeFakeXML(w, lifecycle)
+		return
+	}
+
+	prefix := query.Get("prefix")
+	var keys []string
+	for k := range bucket.objects {
+		if strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	start := 0
+	if token := query.Get("continuation-token"); len(token) != 0 {
+		start, _ = strconv.Atoi(token)
+	}
+	pageSize := fakeS3ListPageSize
+	if maxKeys, err := strconv.Atoi(query.Get("max-keys")); err == nil && maxKeys < pageSize {
+		pageSize = maxKeys
+	}
+	end := start + pageSize
+	result := fakeListBucketResult{
+		Name:   name,
+		Prefix: prefix,
+	}
+	if end < len(keys) {
+		result.IsTruncated = true
+		result.NextContinuationToken = strconv.Itoa(end)
+	} else {
+		end = len(keys)
+	}
+	for _, k := range keys[start:end] {
+		result.Contents = append(result.Contents, fakeListObject{Key: k, Size: len(bucket.objects[k].body)})
+	}
+	result.KeyCount = len(result.Contents)
+	writeFakeXML(w, result)
+}
+
+func (f *fakeS3) serveObject(w http.ResponseWriter, r *http.Request, bucket *fakeBucket, key string) {
+	switch r.Method {
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeFakeError(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		metadata := make(http.Header)
+		for k, v := range r.Header {
+			if strings.HasPrefix(k, fakeS3MetaPrefix) {
+				metadata[k] = v
+			}
+		}
+		bucket.objects[key] = &fakeObject{body: body, metadata: metadata}
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet, http.MethodHead:
+		object, ok := bucket.objects[key]
+		if !ok {
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeFakeError(w, http.StatusNotFound, "NoSuchKey", "the specified key does not exist")
+			return
+		}
+		for k, v := range object.metadata {
+			w.Header()[k] = v
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.body)))
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodGet {
+			w.Write(object.body)
+		}
+	case http.MethodDelete:
+		delete(bucket.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeFakeError(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+func writeFakeXML(w http.ResponseWriter, v interface{}) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func writeFakeError(w http.ResponseWriter, status int, code string, message string) {
+	data, _ := xml.Marshal(fakeErrorResponse{Code: code, Message: message})
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	w.Write(data)
+}
@@ -79,6 +79,9 @@ type (
 		GetDomainID(name string) (string, error)
 		GetAllDomain() map[string]*DomainCacheEntry
 		GetCacheSize() (sizeOfCacheByName int64, sizeOfCacheByID int64)
+		// GetLastRefreshTime returns when the domains were last loaded
+		// from persistence, the zero time if they never were
+		GetLastRefreshTime() time.Time
 	}
 
 	domainCache struct {
@@ -86,6 +89,7 @@ type (
 		shutdownChan    chan struct{}
 		cacheNameToID   *atomic.Value
 		cacheByID       *atomic.Value
+		lastRefreshTime int64
 		metadataMgr     persistence.MetadataManager
 		clusterMetadata cluster.Metadata
 		timeSource      clock.TimeSource
@@ -190,6 +194,15 @@ func (c *domainCache) Stop() {
 	close(c.shutdownChan)
 }
 
+// GetLastRefreshTime returns when the domains were last loaded from persistence
+func (c *domainCache) GetLastRefreshTime() time.Time {
+	lastRefreshTime := atomic.LoadInt64(&c.lastRefreshTime)
+	if lastRefreshTime == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, lastRefreshTime)
+}
+
 func (c *domainCache) GetAllDomain() map[string]*DomainCacheEntry {
 	result := make(map[string]*DomainCacheEntry)
 	ite := c.cacheByID.Load().(Cache).Iterator()
@@ -370,6 +383,7 @@ UpdateLoop:
 	c.cacheByID.Store(newCacheByID)
 	c.cacheNameToID.Store(newCacheNameToID)
 	c.triggerDomainChangeCallbackLocked(prevEntries, nextEntries)
+	atomic.StoreInt64(&c.lastRefreshTime, c.timeSource.Now().UnixNano())
 	return nil
 }
 
@@ -20,7 +20,11 @@
 
 package cache
 
-import "github.com/stretchr/testify/mock"
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+)
 
 // DomainCacheMock is an autogenerated mock type for the DomainCache type
 type DomainCacheMock struct {
@@ -110,6 +114,20 @@ func (_m *DomainCacheMock) GetDomainByID(id string) (*DomainCacheEntry, error) {
 	return r0, r1
 }
 
+// GetLastRefreshTime provides a mock function with given fields:
+func (_m *DomainCacheMock) GetLastRefreshTime() time.Time {
+	ret := _m.Called()
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func() time.Time); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	return r0
+}
+
 // GetDomainID provides a mock function with given fields: name
 func (_m *DomainCacheMock) GetDomainID(name string) (string, error) {
 	ret := _m.Called(name)
@@ -60,10 +60,12 @@ const (
 
 // Data encoding types
 const (
-	EncodingTypeJSON     EncodingType = "json"
-	EncodingTypeThriftRW              = "thriftrw"
-	EncodingTypeGob                   = "gob"
-	EncodingTypeUnknown               = "unknow"
+	EncodingTypeJSON           EncodingType = "json"
+	EncodingTypeThriftRW                    = "thriftrw"
+	EncodingTypeThriftRWSnappy              = "thriftrw+snappy"
+	EncodingTypeThriftRWZstd                = "thriftrw+zstd"
+	EncodingTypeGob                         = "gob"
+	EncodingTypeUnknown                     = "unknow"
 )
 
 // NoRetryBackoff is used to represent backoff when no retry is needed
@@ -33,6 +33,7 @@ type (
 	Client interface {
 		Search(ctx context.Context, p *SearchParameters) (*elastic.SearchResult, error)
 		RunBulkProcessor(ctx context.Context, p *BulkProcessorParameters) (*elastic.BulkProcessor, error)
+		ClusterHealth(ctx context.Context) (*elastic.ClusterHealthResponse, error)
 	}
 
 	// SearchParameters holds all required and optional parameters for executing a search
@@ -110,3 +111,7 @@ func (c *elasticWrapper) RunBulkProcessor(ctx context.Context, p *BulkProcessorP
 		After(p.AfterFunc).
 		Do(ctx)
 }
+
+func (c *elasticWrapper) ClusterHealth(ctx context.Context) (*elastic.ClusterHealthResponse, error) {
+	return c.client.ClusterHealth().Do(ctx)
+}
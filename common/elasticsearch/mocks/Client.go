@@ -31,6 +31,29 @@ type Client struct {
 	mock.Mock
 }
 
+// ClusterHealth provides a mock function with given fields: ctx
+func (_m *Client) ClusterHealth(ctx context.Context) (*elastic.ClusterHealthResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *elastic.ClusterHealthResponse
+	if rf, ok := ret.Get(0).(func(context.Context) *elastic.ClusterHealthResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*elastic.ClusterHealthResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RunBulkProcessor provides a mock function with given fields: ctx, p
 func (_m *Client) RunBulkProcessor(ctx context.Context, p *elasticsearch.BulkProcessorParameters) (*elastic.BulkProcessor, error) {
 	ret := _m.Called(ctx, p)
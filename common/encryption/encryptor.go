@@ -0,0 +1,216 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+type (
+	// Encryptor encrypts data with per-domain data keys. The data key, wrapped by the
+	// key provider, is carried along the encrypted data so decryption needs no domain.
+	Encryptor interface {
+		// Encrypt encrypts the data with the current data key of the domain
+		Encrypt(domainID string, data []byte) ([]byte, error)
+		// Decrypt decrypts data returned by Encrypt
+		Decrypt(data []byte) ([]byte, error)
+	}
+
+	envelopeEncryptor struct {
+		provider   KeyProvider
+		dataKeyTTL time.Duration
+
+		sync.Mutex
+		dataKeys  map[string]*dataKey
+		unwrapped map[string]cipher.AEAD
+	}
+
+	dataKey struct {
+		keyID      string
+		wrappedKey []byte
+		aead       cipher.AEAD
+		expiry     time.Time
+	}
+)
+
+const (
+	// envelopeVersion is the first byte of the encrypted data
+	envelopeVersion = byte(1)
+	// dataKeySize is the size of a data key in bytes, i.e. AES-256
+	dataKeySize = 32
+	// defaultDataKeyTTL is how long a data key is used before a new one is generated
+	defaultDataKeyTTL = 24 * time.Hour
+	// unwrappedKeyCacheSize is the max number of unwrapped data keys kept in memory
+	unwrappedKeyCacheSize = 4096
+)
+
+var (
+	errInvalidEnvelope = errors.New("invalid encryption envelope")
+)
+
+// NewEncryptor returns an encryptor which generates a data key per domain, wrapped by the
+// given key provider. Data keys are regenerated when they expire after dataKeyTTL or when
+// the current master key of the provider changes.
+func NewEncryptor(provider KeyProvider, dataKeyTTL time.Duration) Encryptor {
+	if dataKeyTTL <= 0 {
+		dataKeyTTL = defaultDataKeyTTL
+	}
+	return &envelopeEncryptor{
+		provider:   provider,
+		dataKeyTTL: dataKeyTTL,
+		dataKeys:   make(map[string]*dataKey),
+		unwrapped:  make(map[string]cipher.AEAD),
+	}
+}
+
+func (e *envelopeEncryptor) Encrypt(domainID string, data []byte) ([]byte, error) {
+	key, err := e.getDataKey(domainID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	out := []byte{envelopeVersion}
+	out = appendField(out, []byte(key.keyID))
+	out = appendField(out, []byte(domainID))
+	out = appendField(out, key.wrappedKey)
+	out = append(out, nonce...)
+	return key.aead.Seal(out, nonce, data, []byte(domainID)), nil
+}
+
+func (e *envelopeEncryptor) Decrypt(data []byte) ([]byte, error) {
+	if len(data) == 0 || data[0] != envelopeVersion {
+		return nil, errInvalidEnvelope
+	}
+	rest := data[1:]
+	keyID, rest, err := readField(rest)
+	if err != nil {
+		return nil, err
+	}
+	domainID, rest, err := readField(rest)
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, rest, err := readField(rest)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := e.unwrap(string(keyID), wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) < aead.NonceSize() {
+		return nil, errInvalidEnvelope
+	}
+	nonce := rest[:aead.NonceSize()]
+	return aead.Open(nil, nonce, rest[aead.NonceSize():], domainID)
+}
+
+func (e *envelopeEncryptor) getDataKey(domainID string) (*dataKey, error) {
+	keyID, err := e.provider.CurrentKeyID()
+	if err != nil {
+		return nil, err
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	key, ok := e.dataKeys[domainID]
+	if ok && key.keyID == keyID && time.Now().Before(key.expiry) {
+		return key, nil
+	}
+
+	plainKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, plainKey); err != nil {
+		return nil, err
+	}
+	wrappedKey, err := e.provider.WrapKey(keyID, plainKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(plainKey)
+	if err != nil {
+		return nil, err
+	}
+	key = &dataKey{
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+		aead:       aead,
+		expiry:     time.Now().Add(e.dataKeyTTL),
+	}
+	e.dataKeys[domainID] = key
+	return key, nil
+}
+
+func (e *envelopeEncryptor) unwrap(keyID string, wrappedKey []byte) (cipher.AEAD, error) {
+	cacheKey := keyID + "/" + string(wrappedKey)
+	e.Lock()
+	aead, ok := e.unwrapped[cacheKey]
+	e.Unlock()
+	if ok {
+		return aead, nil
+	}
+
+	plainKey, err := e.provider.UnwrapKey(keyID, wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err = newAEAD(plainKey)
+	if err != nil {
+		return nil, err
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	if len(e.unwrapped) >= unwrappedKeyCacheSize {
+		// data keys are rotated rarely, so simply start over once the cache is full
+		e.unwrapped = make(map[string]cipher.AEAD)
+	}
+	e.unwrapped[cacheKey] = aead
+	return aead, nil
+}
+
+func appendField(out []byte, field []byte) []byte {
+	var size [2]byte
+	binary.BigEndian.PutUint16(size[:], uint16(len(field)))
+	out = append(out, size[:]...)
+	return append(out, field...)
+}
+
+func readField(data []byte) ([]byte, []byte, error) {
+	if len(data) < 2 {
+		return nil, nil, errInvalidEnvelope
+	}
+	size := int(binary.BigEndian.Uint16(data))
+	if len(data) < 2+size {
+		return nil, nil, errInvalidEnvelope
+	}
+	return data[2 : 2+size], data[2+size:], nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	EncryptorSuite struct {
+		*require.Assertions
+		suite.Suite
+
+		dir     string
+		keyFile string
+	}
+)
+
+func TestEncryptorSuite(t *testing.T) {
+	suite.Run(t, new(EncryptorSuite))
+}
+
+func (s *EncryptorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "EncryptorSuite")
+	s.NoError(err)
+	s.dir = dir
+	s.keyFile = filepath.Join(dir, "keys.yaml")
+}
+
+func (s *EncryptorSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *EncryptorSuite) TestEncryptDecrypt() {
+	_, err := RotateKeyFile(s.keyFile)
+	s.NoError(err)
+	provider, err := NewFileKeyProvider(s.keyFile)
+	s.NoError(err)
+	encryptor := NewEncryptor(provider, 0)
+
+	plaintext := []byte("some workflow input")
+	ciphertext, err := encryptor.Encrypt("some-domain", plaintext)
+	s.NoError(err)
+	s.NotContains(string(ciphertext), string(plaintext))
+
+	decrypted, err := encryptor.Decrypt(ciphertext)
+	s.NoError(err)
+	s.Equal(plaintext, decrypted)
+
+	// a new encryptor, e.g. on another host, only needs the key file
+	decrypted, err = NewEncryptor(provider, 0).Decrypt(ciphertext)
+	s.NoError(err)
+	s.Equal(plaintext, decrypted)
+}
+
+func (s *EncryptorSuite) TestDataKeyPerDomain() {
+	_, err := RotateKeyFile(s.keyFile)
+	s.NoError(err)
+	provider, err := NewFileKeyProvider(s.keyFile)
+	s.NoError(err)
+	encryptor := NewEncryptor(provider, 0).(*envelopeEncryptor)
+
+	_, err = encryptor.Encrypt("domain-1", []byte("payload"))
+	s.NoError(err)
+	_, err = encryptor.Encrypt("domain-2", []byte("payload"))
+	s.NoError(err)
+	s.Len(encryptor.dataKeys, 2)
+	s.NotEqual(encryptor.dataKeys["domain-1"].wrappedKey, encryptor.dataKeys["domain-2"].wrappedKey)
+}
+
+func (s *EncryptorSuite) TestDecrypt_Tampered() {
+	_, err := RotateKeyFile(s.keyFile)
+	s.NoError(err)
+	provider, err := NewFileKeyProvider(s.keyFile)
+	s.NoError(err)
+	encryptor := NewEncryptor(provider, 0)
+
+	ciphertext, err := encryptor.Encrypt("some-domain", []byte("some workflow input"))
+	s.NoError(err)
+	ciphertext[len(ciphertext)-1] ^= 0xff
+	_, err = encryptor.Decrypt(ciphertext)
+	s.Error(err)
+
+	_, err = encryptor.Decrypt([]byte("not encrypted"))
+	s.Equal(errInvalidEnvelope, err)
+}
+
+func (s *EncryptorSuite) TestRotateKeyFile() {
+	oldKeyID, err := RotateKeyFile(s.keyFile)
+	s.NoError(err)
+	provider, err := NewFileKeyProvider(s.keyFile)
+	s.NoError(err)
+	encryptor := NewEncryptor(provider, 0).(*envelopeEncryptor)
+
+	oldCiphertext, err := encryptor.Encrypt("some-domain", []byte("old payload"))
+	s.NoError(err)
+	s.Equal(oldKeyID, encryptor.dataKeys["some-domain"].keyID)
+
+	newKeyID, err := RotateKeyFile(s.keyFile)
+	s.NoError(err)
+	s.NotEqual(oldKeyID, newKeyID)
+	keyFile, err := ReadKeyFile(s.keyFile)
+	s.NoError(err)
+	s.Equal(newKeyID, keyFile.CurrentKey)
+	s.Len(keyFile.Keys, 2)
+
+	// force the provider to check the file for changes
+	provider.(*fileKeyProvider).lastChecked = time.Time{}
+	newCiphertext, err := encryptor.Encrypt("some-domain", []byte("new payload"))
+	s.NoError(err)
+	s.Equal(newKeyID, encryptor.dataKeys["some-domain"].keyID)
+
+	decrypted, err := encryptor.Decrypt(oldCiphertext)
+	s.NoError(err)
+	s.Equal("old payload", string(decrypted))
+	decrypted, err = encryptor.Decrypt(newCiphertext)
+	s.NoError(err)
+	s.Equal("new payload", string(decrypted))
+}
+
+func (s *EncryptorSuite) TestNewFileKeyProvider_InvalidFile() {
+	_, err := NewFileKeyProvider(s.keyFile)
+	s.Error(err)
+
+	s.NoError(ioutil.WriteFile(s.keyFile, []byte("currentKey: missing\n"), 0600))
+	_, err = NewFileKeyProvider(s.keyFile)
+	s.Equal(errKeyFileNoCurrentKey, err)
+}
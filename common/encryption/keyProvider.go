@@ -0,0 +1,252 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// KeyProvider is the key management service holding the master keys which
+	// the per-domain data keys are wrapped with. Master keys never leave the provider.
+	KeyProvider interface {
+		// CurrentKeyID returns the ID of the master key new data keys should be wrapped with
+		CurrentKeyID() (string, error)
+		// WrapKey encrypts a data key with the given master key
+		WrapKey(keyID string, dataKey []byte) ([]byte, error)
+		// UnwrapKey decrypts a data key wrapped with the given master key
+		UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+	}
+
+	// KeyFile is the content of the file read by the file based key provider
+	KeyFile struct {
+		// CurrentKey is the ID of the master key used to wrap new data keys
+		CurrentKey string `yaml:"currentKey"`
+		// Keys are the base64 encoded 256 bits master keys by ID, retired keys have
+		// to be kept as long as data encrypted with them is retained
+		Keys map[string]string `yaml:"keys"`
+	}
+
+	fileKeyProvider struct {
+		path string
+
+		sync.RWMutex
+		lastChecked time.Time
+		currentKey  string
+		keys        map[string]cipher.AEAD
+	}
+)
+
+const (
+	// masterKeySize is the size of a master key in bytes, i.e. AES-256
+	masterKeySize = 32
+	// keyFileRefreshInterval is how often the key file is reloaded to pick up rotated keys
+	keyFileRefreshInterval = time.Minute
+	// keyFileMinRefreshInterval is the min interval between reloads when an unknown key is requested
+	keyFileMinRefreshInterval = time.Second
+)
+
+var (
+	errKeyFileNoCurrentKey = errors.New("key file has no current key")
+	errWrappedKeyTooShort  = errors.New("wrapped data key is too short")
+)
+
+// NewFileKeyProvider returns a key provider reading the master keys from a local yaml file.
+// The file is reloaded when it changes so that keys can be rotated without a restart.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	p := &fileKeyProvider{path: path}
+	if err := p.reload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *fileKeyProvider) CurrentKeyID() (string, error) {
+	p.refresh(keyFileRefreshInterval)
+	p.RLock()
+	defer p.RUnlock()
+	return p.currentKey, nil
+}
+
+func (p *fileKeyProvider) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	aead, err := p.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(keyID)), nil
+}
+
+func (p *fileKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	aead, err := p.getKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errWrappedKeyTooShort
+	}
+	nonce := wrappedKey[:aead.NonceSize()]
+	return aead.Open(nil, nonce, wrappedKey[aead.NonceSize():], []byte(keyID))
+}
+
+func (p *fileKeyProvider) getKey(keyID string) (cipher.AEAD, error) {
+	p.RLock()
+	aead, ok := p.keys[keyID]
+	p.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	// the key may have been added to the file since it was last read
+	p.refresh(keyFileMinRefreshInterval)
+	p.RLock()
+	defer p.RUnlock()
+	if aead, ok := p.keys[keyID]; ok {
+		return aead, nil
+	}
+	return nil, fmt.Errorf("master key %v not found in key file %v", keyID, p.path)
+}
+
+func (p *fileKeyProvider) refresh(interval time.Duration) {
+	p.RLock()
+	lastChecked := p.lastChecked
+	p.RUnlock()
+	if time.Since(lastChecked) < interval {
+		return
+	}
+	// keep serving the keys already loaded if the file is broken mid rotation
+	p.reload()
+}
+
+func (p *fileKeyProvider) reload() error {
+	p.Lock()
+	defer p.Unlock()
+
+	p.lastChecked = time.Now()
+	keyFile, err := ReadKeyFile(p.path)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]cipher.AEAD, len(keyFile.Keys))
+	for id, encoded := range keyFile.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return fmt.Errorf("invalid master key %v: %v", id, err)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return fmt.Errorf("invalid master key %v: %v", id, err)
+		}
+		keys[id] = aead
+	}
+	if _, ok := keys[keyFile.CurrentKey]; !ok {
+		return errKeyFileNoCurrentKey
+	}
+
+	p.currentKey = keyFile.CurrentKey
+	p.keys = keys
+	return nil
+}
+
+// ReadKeyFile reads a key file
+func ReadKeyFile(path string) (*KeyFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	keyFile := &KeyFile{}
+	if err := yaml.Unmarshal(data, keyFile); err != nil {
+		return nil, err
+	}
+	return keyFile, nil
+}
+
+// RotateKeyFile generates a new master key and makes it the current key of the key file,
+// creating the file if it does not exist. Existing keys are kept so that data encrypted
+// with them stays readable. It returns the ID of the new key.
+// Only the file at path is rewritten, distributing it to the other hosts is up to the caller.
+func RotateKeyFile(path string) (string, error) {
+	keyFile, err := ReadKeyFile(path)
+	if os.IsNotExist(err) {
+		keyFile, err = &KeyFile{}, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if keyFile.Keys == nil {
+		keyFile.Keys = make(map[string]string)
+	}
+
+	key := make([]byte, masterKeySize)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return "", err
+	}
+	keyID := uuid.New()
+	keyFile.Keys[keyID] = base64.StdEncoding.EncodeToString(key)
+	keyFile.CurrentKey = keyID
+
+	data, err := yaml.Marshal(keyFile)
+	if err != nil {
+		return "", err
+	}
+	// write then rename so that hosts never read a partially written file
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path))
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", err
+	}
+	return keyID, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/uber/cadence/common/cache"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// PersistenceCheckName is the name of the check of the database
+	PersistenceCheckName = "persistence"
+	// MembershipCheckName is the name of the check of the membership ring
+	MembershipCheckName = "membership"
+	// DomainCacheCheckName is the name of the check of the domain cache
+	DomainCacheCheckName = "domainCache"
+	// KafkaCheckName is the name of the check of the Kafka clusters
+	KafkaCheckName = "kafka"
+	// ElasticSearchCheckName is the name of the check of the ElasticSearch cluster
+	ElasticSearchCheckName = "elasticsearch"
+	// ShardsCheckName is the name of the check of the history shards owned by the host
+	ShardsCheckName = "shards"
+
+	// DefaultDomainCacheMaxAge is the age after which a domain cache which
+	// missed several refreshes is reported as unhealthy
+	DefaultDomainCacheMaxAge = 3 * cache.DomainCacheRefreshInterval
+)
+
+// PersistenceCheck reads the domain metadata record to check the database is reachable
+func PersistenceCheck(metadataMgr persistence.MetadataManager) Check {
+	return func(ctx context.Context) (map[string]string, error) {
+		response, err := metadataMgr.GetMetadata()
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{
+			"notificationVersion": strconv.FormatInt(response.NotificationVersion, 10),
+		}, nil
+	}
+}
+
+// MembershipCheck reports the number of hosts of every service in the ring,
+// it fails when the host is not part of the ring of its own service
+func MembershipCheck(monitor membership.Monitor, service string, services []string) Check {
+	return func(ctx context.Context) (map[string]string, error) {
+		details := make(map[string]string)
+		for _, name := range services {
+			resolver, err := monitor.GetResolver(name)
+			if err != nil {
+				return details, err
+			}
+			details[name] = strconv.Itoa(resolver.MemberCount())
+		}
+		if _, err := monitor.WhoAmI(); err != nil {
+			return details, err
+		}
+		if details[service] == "0" {
+			return details, fmt.Errorf("no reachable member for %v", service)
+		}
+		return details, nil
+	}
+}
+
+// DomainCacheCheck fails when the domains were not refreshed within maxAge
+func DomainCacheCheck(domainCache cache.DomainCache, maxAge time.Duration) Check {
+	return func(ctx context.Context) (map[string]string, error) {
+		sizeByName, _ := domainCache.GetCacheSize()
+		details := map[string]string{"domains": strconv.FormatInt(sizeByName, 10)}
+		lastRefreshTime := domainCache.GetLastRefreshTime()
+		if lastRefreshTime.IsZero() {
+			return details, fmt.Errorf("domains were never refreshed")
+		}
+		age := time.Since(lastRefreshTime)
+		details["refreshAge"] = age.String()
+		if age > maxAge {
+			return details, fmt.Errorf("domains were not refreshed for %v", age)
+		}
+		return details, nil
+	}
+}
+
+// ShardsCheck reports the number of shards owned by the host, it never fails as a host
+// legitimately owns no shard while it is starting, shutting down or when there are more
+// hosts than shards
+func ShardsCheck(ownedShards func() int, totalShards int) Check {
+	return func(ctx context.Context) (map[string]string, error) {
+		return map[string]string{
+			"owned": strconv.Itoa(ownedShards()),
+			"total": strconv.Itoa(totalShards),
+		}, nil
+	}
+}
+
+// KafkaCheck checks a broker of every Kafka cluster is reachable
+func KafkaCheck(client messaging.Client) Check {
+	return func(ctx context.Context) (map[string]string, error) {
+		return nil, client.Ping(ctx)
+	}
+}
+
+// ElasticSearchCheck fails when the ElasticSearch cluster is unreachable or red
+func ElasticSearchCheck(client es.Client) Check {
+	return func(ctx context.Context) (map[string]string, error) {
+		response, err := client.ClusterHealth(ctx)
+		if err != nil {
+			return nil, err
+		}
+		details := map[string]string{
+			"status": response.Status,
+			"nodes":  strconv.Itoa(response.NumberOfNodes),
+		}
+		if response.Status == "red" {
+			return details, fmt.Errorf("cluster %v is red", response.ClusterName)
+		}
+		return details, nil
+	}
+}
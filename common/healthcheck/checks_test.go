@@ -0,0 +1,164 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/olivere/elastic"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/cache"
+	esMocks "github.com/uber/cadence/common/elasticsearch/mocks"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	checksSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	fakeMonitor struct {
+		membership.Monitor
+		resolvers map[string]membership.ServiceResolver
+	}
+)
+
+func TestChecksSuite(t *testing.T) {
+	suite.Run(t, new(checksSuite))
+}
+
+func (s *checksSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *checksSuite) TestPersistenceCheck() {
+	metadataMgr := &mocks.MetadataManager{}
+	defer metadataMgr.AssertExpectations(s.T())
+	metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 12}, nil).Once()
+	metadataMgr.On("GetMetadata").Return(nil, errors.New("no hosts available")).Once()
+
+	check := PersistenceCheck(metadataMgr)
+	details, err := check(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"notificationVersion": "12"}, details)
+	_, err = check(context.Background())
+	s.EqualError(err, "no hosts available")
+}
+
+func (s *checksSuite) TestMembershipCheck() {
+	frontendResolver := &mocks.ServiceResolver{}
+	historyResolver := &mocks.ServiceResolver{}
+	frontendResolver.On("MemberCount").Return(2)
+	historyResolver.On("MemberCount").Return(0)
+	monitor := &fakeMonitor{resolvers: map[string]membership.ServiceResolver{
+		"cadence-frontend": frontendResolver,
+		"cadence-history":  historyResolver,
+	}}
+	services := []string{"cadence-frontend", "cadence-history"}
+
+	details, err := MembershipCheck(monitor, "cadence-frontend", services)(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"cadence-frontend": "2", "cadence-history": "0"}, details)
+
+	details, err = MembershipCheck(monitor, "cadence-history", services)(context.Background())
+	s.EqualError(err, "no reachable member for cadence-history")
+	s.Equal("0", details["cadence-history"])
+
+	_, err = MembershipCheck(monitor, "cadence-frontend", []string{"cadence-matching"})(context.Background())
+	s.Equal(membership.ErrUnknownService, err)
+}
+
+func (s *checksSuite) TestDomainCacheCheck() {
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetCacheSize").Return(int64(3), int64(3))
+	domainCache.On("GetLastRefreshTime").Return(time.Time{}).Once()
+	domainCache.On("GetLastRefreshTime").Return(time.Now().Add(-time.Second)).Once()
+	domainCache.On("GetLastRefreshTime").Return(time.Now().Add(-time.Minute)).Once()
+	check := DomainCacheCheck(domainCache, 30*time.Second)
+
+	details, err := check(context.Background())
+	s.EqualError(err, "domains were never refreshed")
+	s.Equal("3", details["domains"])
+
+	details, err = check(context.Background())
+	s.NoError(err)
+	s.Contains(details, "refreshAge")
+
+	_, err = check(context.Background())
+	s.Error(err)
+}
+
+func (s *checksSuite) TestShardsCheck() {
+	owned := 0
+	check := ShardsCheck(func() int { return owned }, 16)
+	details, err := check(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"owned": "0", "total": "16"}, details)
+
+	owned = 3
+	details, err = check(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"owned": "3", "total": "16"}, details)
+}
+
+func (s *checksSuite) TestElasticSearchCheck() {
+	client := &esMocks.Client{}
+	defer client.AssertExpectations(s.T())
+	client.On("ClusterHealth", mock.Anything).Return(&elastic.ClusterHealthResponse{
+		ClusterName: "cadence", Status: "yellow", NumberOfNodes: 3,
+	}, nil).Once()
+	client.On("ClusterHealth", mock.Anything).Return(&elastic.ClusterHealthResponse{
+		ClusterName: "cadence", Status: "red", NumberOfNodes: 1,
+	}, nil).Once()
+	client.On("ClusterHealth", mock.Anything).Return(nil, errors.New("connection refused")).Once()
+	check := ElasticSearchCheck(client)
+
+	details, err := check(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{"status": "yellow", "nodes": "3"}, details)
+
+	details, err = check(context.Background())
+	s.EqualError(err, "cluster cadence is red")
+	s.Equal("red", details["status"])
+
+	_, err = check(context.Background())
+	s.EqualError(err, "connection refused")
+}
+
+func (m *fakeMonitor) WhoAmI() (*membership.HostInfo, error) {
+	return membership.NewHostInfo("127.0.0.1:7933", nil), nil
+}
+
+func (m *fakeMonitor) GetResolver(service string) (membership.ServiceResolver, error) {
+	resolver, ok := m.resolvers[service]
+	if !ok {
+		return nil, membership.ErrUnknownService
+	}
+	return resolver, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/health"
+	"github.com/uber/cadence/common"
+)
+
+// DefaultTimeout is the time every check of a registry has to complete
+const DefaultTimeout = 2 * time.Second
+
+type (
+	// Check reports the state of a dependency of the service. The details
+	// describe the dependency and are reported even when the check fails
+	Check func(ctx context.Context) (details map[string]string, err error)
+
+	// Registry holds the checks of the dependencies of a service, the
+	// service is healthy when all of them succeed
+	Registry struct {
+		name    string
+		timeout time.Duration
+
+		sync.RWMutex
+		checks map[string]Check
+	}
+
+	checkResult struct {
+		details map[string]string
+		err     error
+	}
+)
+
+// NewRegistry creates an empty registry for the named service
+func NewRegistry(name string, timeout time.Duration) *Registry {
+	return &Registry{
+		name:    name,
+		timeout: timeout,
+		checks:  make(map[string]Check),
+	}
+}
+
+// Register adds the check of a dependency, replacing the check
+// previously registered under the same name
+func (r *Registry) Register(name string, check Check) {
+	r.Lock()
+	defer r.Unlock()
+	r.checks[name] = check
+}
+
+// Check runs all the checks concurrently and reports their status,
+// checks which do not complete within the timeout are failed
+func (r *Registry) Check(ctx context.Context) *health.HealthStatus {
+	r.RLock()
+	names := make([]string, 0, len(r.checks))
+	checks := make([]Check, 0, len(r.checks))
+	for name := range r.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		checks = append(checks, r.checks[name])
+	}
+	r.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	dependencies := make([]*health.DependencyStatus, len(checks))
+	var wg sync.WaitGroup
+	for i := range checks {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			dependencies[i] = runCheck(ctx, names[i], checks[i])
+		}(i)
+	}
+	wg.Wait()
+
+	var failed []string
+	for _, dependency := range dependencies {
+		if !dependency.Ok {
+			failed = append(failed, dependency.Name)
+		}
+	}
+	status := &health.HealthStatus{
+		Ok:           len(failed) == 0,
+		Msg:          common.StringPtr(fmt.Sprintf("%v good", r.name)),
+		Dependencies: dependencies,
+	}
+	if !status.Ok {
+		status.Msg = common.StringPtr(fmt.Sprintf("%v unhealthy: %v", r.name, strings.Join(failed, ", ")))
+	}
+	return status
+}
+
+func runCheck(ctx context.Context, name string, check Check) *health.DependencyStatus {
+	start := time.Now()
+	resultC := make(chan checkResult, 1)
+	go func() {
+		details, err := check(ctx)
+		resultC <- checkResult{details: details, err: err}
+	}()
+
+	var result checkResult
+	select {
+	case result = <-resultC:
+	case <-ctx.Done():
+		result.err = fmt.Errorf("check did not complete: %v", ctx.Err())
+	}
+
+	status := &health.DependencyStatus{
+		Name:         name,
+		Ok:           result.err == nil,
+		LatencyNanos: common.Int64Ptr(int64(time.Since(start))),
+		Details:      result.details,
+	}
+	if result.err != nil {
+		status.Msg = common.StringPtr(result.err.Error())
+	}
+	return status
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/health"
+)
+
+type (
+	registrySuite struct {
+		*require.Assertions
+		suite.Suite
+		registry *Registry
+	}
+)
+
+func TestRegistrySuite(t *testing.T) {
+	suite.Run(t, new(registrySuite))
+}
+
+func (s *registrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.registry = NewRegistry("cadence-frontend", 100*time.Millisecond)
+}
+
+func (s *registrySuite) TestCheck_NoChecks() {
+	status := s.registry.Check(context.Background())
+	s.True(status.Ok)
+	s.Equal("cadence-frontend good", status.GetMsg())
+	s.Empty(status.Dependencies)
+}
+
+func (s *registrySuite) TestCheck_Healthy() {
+	s.registry.Register("persistence", func(ctx context.Context) (map[string]string, error) {
+		return map[string]string{"notificationVersion": "1"}, nil
+	})
+	s.registry.Register("kafka", func(ctx context.Context) (map[string]string, error) {
+		return nil, nil
+	})
+
+	status := s.registry.Check(context.Background())
+	s.True(status.Ok)
+	s.Equal("cadence-frontend good", status.GetMsg())
+	s.Len(status.Dependencies, 2)
+	s.Equal("kafka", status.Dependencies[0].Name)
+	s.True(status.Dependencies[0].Ok)
+	s.Equal("persistence", status.Dependencies[1].Name)
+	s.Equal(map[string]string{"notificationVersion": "1"}, status.Dependencies[1].Details)
+	s.NotNil(status.Dependencies[1].LatencyNanos)
+	s.Nil(status.Dependencies[1].Msg)
+}
+
+func (s *registrySuite) TestCheck_Unhealthy() {
+	s.registry.Register("persistence", func(ctx context.Context) (map[string]string, error) {
+		return nil, errors.New("no hosts available")
+	})
+	s.registry.Register("kafka", func(ctx context.Context) (map[string]string, error) {
+		<-ctx.Done()
+		time.Sleep(time.Second)
+		return nil, nil
+	})
+	s.registry.Register("membership", func(ctx context.Context) (map[string]string, error) {
+		return nil, nil
+	})
+
+	start := time.Now()
+	status := s.registry.Check(context.Background())
+	s.True(time.Since(start) < time.Second)
+	s.False(status.Ok)
+	s.Equal("cadence-frontend unhealthy: kafka, persistence", status.GetMsg())
+	s.False(status.Dependencies[0].Ok)
+	s.Contains(status.Dependencies[0].GetMsg(), "deadline exceeded")
+	s.True(status.Dependencies[1].Ok)
+	s.False(status.Dependencies[2].Ok)
+	s.Equal("no hosts available", status.Dependencies[2].GetMsg())
+}
+
+func (s *registrySuite) TestRegister_Replaces() {
+	s.registry.Register("persistence", func(ctx context.Context) (map[string]string, error) {
+		return nil, errors.New("no hosts available")
+	})
+	s.registry.Register("persistence", func(ctx context.Context) (map[string]string, error) {
+		return nil, nil
+	})
+
+	status := s.registry.Check(context.Background())
+	s.True(status.Ok)
+	s.Len(status.Dependencies, 1)
+}
+
+func (s *registrySuite) TestHTTPHandler() {
+	healthy := true
+	s.registry.Register("persistence", func(ctx context.Context) (map[string]string, error) {
+		if !healthy {
+			return nil, errors.New("no hosts available")
+		}
+		return nil, nil
+	})
+	server := httptest.NewServer(NewHTTPHandler(s.registry))
+	defer server.Close()
+
+	response, err := http.Get(server.URL + HealthPath)
+	s.NoError(err)
+	s.Equal(http.StatusOK, response.StatusCode)
+	s.Equal("application/json", response.Header.Get("Content-Type"))
+	var status health.HealthStatus
+	s.NoError(json.NewDecoder(response.Body).Decode(&status))
+	response.Body.Close()
+	s.True(status.Ok)
+	s.Equal("persistence", status.Dependencies[0].Name)
+
+	healthy = false
+	response, err = http.Get(server.URL + HealthPath)
+	s.NoError(err)
+	s.Equal(http.StatusServiceUnavailable, response.StatusCode)
+	s.NoError(json.NewDecoder(response.Body).Decode(&status))
+	response.Body.Close()
+	s.False(status.Ok)
+	s.Equal("no hosts available", status.Dependencies[0].GetMsg())
+
+	response, err = http.Post(server.URL+HealthPath, "application/json", nil)
+	s.NoError(err)
+	s.Equal(http.StatusMethodNotAllowed, response.StatusCode)
+	response.Body.Close()
+
+	response, err = http.Get(server.URL + "/metrics")
+	s.NoError(err)
+	s.Equal(http.StatusNotFound, response.StatusCode)
+	response.Body.Close()
+}
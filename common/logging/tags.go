@@ -96,6 +96,8 @@ const (
 	TagESField                    = "es-field"
 	TagContextTimeout             = "context-timeout"
 	TagHandlerName                = "handler-name"
+	TagCorruptionType             = "corruption-type"
+	TagDryRun                     = "dry-run"
 
 	// workflow logging tag values
 	// TagWorkflowComponent Values
@@ -195,4 +197,6 @@ const (
 	TagClusterArchivalStatus    = "cluster-archival-status"
 	TagArchivalUploadSkipReason = "archival-upload-skip-reason"
 	TagArchivalUploadFailReason = "archival-upload-fail-reason"
+	TagArchivalVerifyStatus     = "archival-verify-status"
+	TagArchivalVerifyReason     = "archival-verify-reason"
 )
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+const (
+	// ErrorTypeHeader is the response header naming the proto message
+	// of the exception a gRPC call failed with
+	ErrorTypeHeader = "cadence-error-type"
+	// ErrorDetailsHeader is the response header holding the base64
+	// encoded exception a gRPC call failed with
+	ErrorDetailsHeader = "cadence-error-details"
+)
+
+// ToProtoError converts an exception returned by the thrift handler
+// to the status of the gRPC call. gRPC statuses only carry a code and
+// a message, so the exception is also written to the response headers
+// for FromProtoError to restore it on the client side
+func ToProtoError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	code, message, details := toProtoError(err)
+	if details == nil {
+		return err
+	}
+	if call := yarpc.CallFromContext(ctx); call != nil {
+		if data, err := proto.Marshal(details); err == nil {
+			call.WriteResponseHeader(ErrorTypeHeader, proto.MessageName(details))
+			call.WriteResponseHeader(ErrorDetailsHeader, base64.StdEncoding.EncodeToString(data))
+		}
+	}
+	return yarpcerrors.Newf(code, "%s", message)
+}
+
+// FromProtoError converts the error of a gRPC call back to the thrift
+// exception described by the response headers, errors without details
+// are returned as is
+func FromProtoError(err error, headers map[string]string) error {
+	if err == nil {
+		return nil
+	}
+	messageType := proto.MessageType(headers[ErrorTypeHeader])
+	if messageType == nil {
+		return err
+	}
+	data, decodeErr := base64.StdEncoding.DecodeString(headers[ErrorDetailsHeader])
+	if decodeErr != nil {
+		return err
+	}
+	details := reflect.New(messageType.Elem()).Interface().(proto.Message)
+	if proto.Unmarshal(data, details) != nil {
+		return err
+	}
+	if thriftErr := fromProtoError(details); thriftErr != nil {
+		return thriftErr
+	}
+	return err
+}
+
+func toProtoError(err error) (yarpcerrors.Code, string, proto.Message) {
+	switch err := err.(type) {
+	case *shared.BadRequestError:
+		return yarpcerrors.CodeInvalidArgument, err.Message, ToProtoBadRequestError(err)
+	case *shared.EntityNotExistsError:
+		return yarpcerrors.CodeNotFound, err.Message, ToProtoEntityNotExistsError(err)
+	case *shared.WorkflowExecutionAlreadyStartedError:
+		return yarpcerrors.CodeAlreadyExists, err.GetMessage(), ToProtoWorkflowExecutionAlreadyStartedError(err)
+	case *shared.DomainAlreadyExistsError:
+		return yarpcerrors.CodeAlreadyExists, err.Message, ToProtoDomainAlreadyExistsError(err)
+	case *shared.CancellationAlreadyRequestedError:
+		return yarpcerrors.CodeAlreadyExists, err.Message, ToProtoCancellationAlreadyRequestedError(err)
+	case *shared.ServiceBusyError:
+		return yarpcerrors.CodeResourceExhausted, err.Message, ToProtoServiceBusyError(err)
+	case *shared.LimitExceededError:
+		return yarpcerrors.CodeResourceExhausted, err.Message, ToProtoLimitExceededError(err)
+	case *shared.DomainNotActiveError:
+		return yarpcerrors.CodeFailedPrecondition, err.Message, ToProtoDomainNotActiveError(err)
+	case *shared.QueryFailedError:
+		return yarpcerrors.CodeFailedPrecondition, err.Message, ToProtoQueryFailedError(err)
+	case *shared.InternalServiceError:
+		return yarpcerrors.CodeInternal, err.Message, ToProtoInternalServiceError(err)
+	}
+	return yarpcerrors.CodeUnknown, "", nil
+}
+
+func fromProtoError(details proto.Message) error {
+	switch details := details.(type) {
+	case *apiv1.BadRequestError:
+		return FromProtoBadRequestError(details)
+	case *apiv1.EntityNotExistsError:
+		return FromProtoEntityNotExistsError(details)
+	case *apiv1.WorkflowExecutionAlreadyStartedError:
+		return FromProtoWorkflowExecutionAlreadyStartedError(details)
+	case *apiv1.DomainAlreadyExistsError:
+		return FromProtoDomainAlreadyExistsError(details)
+	case *apiv1.CancellationAlreadyRequestedError:
+		return FromProtoCancellationAlreadyRequestedError(details)
+	case *apiv1.ServiceBusyError:
+		return FromProtoServiceBusyError(details)
+	case *apiv1.LimitExceededError:
+		return FromProtoLimitExceededError(details)
+	case *apiv1.DomainNotActiveError:
+		return FromProtoDomainNotActiveError(details)
+	case *apiv1.QueryFailedError:
+		return FromProtoQueryFailedError(details)
+	case *apiv1.InternalServiceError:
+		return FromProtoInternalServiceError(details)
+	}
+	return nil
+}
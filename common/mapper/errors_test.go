@@ -0,0 +1,107 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mapper
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+type (
+	errorsSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestErrorsSuite(t *testing.T) {
+	suite.Run(t, new(errorsSuite))
+}
+
+func (s *errorsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *errorsSuite) TestToProtoError_Code() {
+	testCases := []struct {
+		err  error
+		code yarpcerrors.Code
+	}{
+		{&shared.BadRequestError{Message: "bad"}, yarpcerrors.CodeInvalidArgument},
+		{&shared.EntityNotExistsError{Message: "missing"}, yarpcerrors.CodeNotFound},
+		{&shared.WorkflowExecutionAlreadyStartedError{Message: common.StringPtr("started")}, yarpcerrors.CodeAlreadyExists},
+		{&shared.DomainAlreadyExistsError{Message: "exists"}, yarpcerrors.CodeAlreadyExists},
+		{&shared.CancellationAlreadyRequestedError{Message: "requested"}, yarpcerrors.CodeAlreadyExists},
+		{&shared.ServiceBusyError{Message: "busy"}, yarpcerrors.CodeResourceExhausted},
+		{&shared.LimitExceededError{Message: "limit"}, yarpcerrors.CodeResourceExhausted},
+		{&shared.DomainNotActiveError{Message: "passive"}, yarpcerrors.CodeFailedPrecondition},
+		{&shared.QueryFailedError{Message: "query"}, yarpcerrors.CodeFailedPrecondition},
+		{&shared.InternalServiceError{Message: "internal"}, yarpcerrors.CodeInternal},
+	}
+	for _, tc := range testCases {
+		err := ToProtoError(context.Background(), tc.err)
+		s.Equal(tc.code, yarpcerrors.FromError(err).Code(), tc.err.Error())
+	}
+}
+
+func (s *errorsSuite) TestToProtoError_PassThrough() {
+	s.Nil(ToProtoError(context.Background(), nil))
+	err := errors.New("unknown")
+	s.Equal(err, ToProtoError(context.Background(), err))
+	status := yarpcerrors.DeadlineExceededErrorf("timeout")
+	s.Equal(status, ToProtoError(context.Background(), status))
+}
+
+func (s *errorsSuite) TestFromProtoError() {
+	thriftErr := &shared.DomainNotActiveError{
+		Message:        "domain is not active",
+		DomainName:     "domain",
+		CurrentCluster: "standby",
+		ActiveCluster:  "active",
+	}
+	data, err := proto.Marshal(ToProtoDomainNotActiveError(thriftErr))
+	s.NoError(err)
+	headers := map[string]string{
+		ErrorTypeHeader:    "uber.cadence.api.v1.DomainNotActiveError",
+		ErrorDetailsHeader: base64.StdEncoding.EncodeToString(data),
+	}
+	status := yarpcerrors.FailedPreconditionErrorf("domain is not active")
+	s.Equal(thriftErr, FromProtoError(status, headers))
+}
+
+func (s *errorsSuite) TestFromProtoError_NoDetails() {
+	s.Nil(FromProtoError(nil, nil))
+	status := yarpcerrors.UnavailableErrorf("unavailable")
+	s.Equal(status, FromProtoError(status, nil))
+	s.Equal(status, FromProtoError(status, map[string]string{
+		ErrorTypeHeader:    "uber.cadence.api.v1.BadRequestError",
+		ErrorDetailsHeader: "not base64",
+	}))
+}
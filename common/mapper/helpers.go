@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mapper
+
+import (
+	"github.com/gogo/protobuf/types"
+)
+
+// the thrift types use nil to mark an unset optional field while
+// proto3 scalars can't tell unset from the zero value, so the zero
+// value maps to nil and back
+
+func stringPtr(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func int32Ptr(v int32) *int32 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func int64Ptr(v int64) *int64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func float64Ptr(v float64) *float64 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	if !v {
+		return nil
+	}
+	return &v
+}
+
+// fields whose zero value is a meaningful update, such as
+// disabling metrics for a domain, use the wrapper types instead
+
+func toStringValue(v *string) *types.StringValue {
+	if v == nil {
+		return nil
+	}
+	return &types.StringValue{Value: *v}
+}
+
+func fromStringValue(v *types.StringValue) *string {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
+
+func toInt32Value(v *int32) *types.Int32Value {
+	if v == nil {
+		return nil
+	}
+	return &types.Int32Value{Value: *v}
+}
+
+func fromInt32Value(v *types.Int32Value) *int32 {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
+
+func toBoolValue(v *bool) *types.BoolValue {
+	if v == nil {
+		return nil
+	}
+	return &types.BoolValue{Value: *v}
+}
+
+func fromBoolValue(v *types.BoolValue) *bool {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
@@ -0,0 +1,4288 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mapper
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
+)
+
+// ToProtoBadRequestError converts a thrift BadRequestError to proto
+func ToProtoBadRequestError(t *shared.BadRequestError) *apiv1.BadRequestError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.BadRequestError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoBadRequestError converts a proto BadRequestError to thrift
+func FromProtoBadRequestError(p *apiv1.BadRequestError) *shared.BadRequestError {
+	if p == nil {
+		return nil
+	}
+	return &shared.BadRequestError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoInternalServiceError converts a thrift InternalServiceError to proto
+func ToProtoInternalServiceError(t *shared.InternalServiceError) *apiv1.InternalServiceError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.InternalServiceError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoInternalServiceError converts a proto InternalServiceError to thrift
+func FromProtoInternalServiceError(p *apiv1.InternalServiceError) *shared.InternalServiceError {
+	if p == nil {
+		return nil
+	}
+	return &shared.InternalServiceError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoDomainAlreadyExistsError converts a thrift DomainAlreadyExistsError to proto
+func ToProtoDomainAlreadyExistsError(t *shared.DomainAlreadyExistsError) *apiv1.DomainAlreadyExistsError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainAlreadyExistsError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoDomainAlreadyExistsError converts a proto DomainAlreadyExistsError to thrift
+func FromProtoDomainAlreadyExistsError(p *apiv1.DomainAlreadyExistsError) *shared.DomainAlreadyExistsError {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainAlreadyExistsError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoWorkflowExecutionAlreadyStartedError converts a thrift WorkflowExecutionAlreadyStartedError to proto
+func ToProtoWorkflowExecutionAlreadyStartedError(t *shared.WorkflowExecutionAlreadyStartedError) *apiv1.WorkflowExecutionAlreadyStartedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionAlreadyStartedError{
+		Message:        t.GetMessage(),
+		StartRequestId: t.GetStartRequestId(),
+		RunId:          t.GetRunId(),
+	}
+}
+
+// FromProtoWorkflowExecutionAlreadyStartedError converts a proto WorkflowExecutionAlreadyStartedError to thrift
+func FromProtoWorkflowExecutionAlreadyStartedError(p *apiv1.WorkflowExecutionAlreadyStartedError) *shared.WorkflowExecutionAlreadyStartedError {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionAlreadyStartedError{
+		Message:        stringPtr(p.Message),
+		StartRequestId: stringPtr(p.StartRequestId),
+		RunId:          stringPtr(p.RunId),
+	}
+}
+
+// ToProtoEntityNotExistsError converts a thrift EntityNotExistsError to proto
+func ToProtoEntityNotExistsError(t *shared.EntityNotExistsError) *apiv1.EntityNotExistsError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.EntityNotExistsError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoEntityNotExistsError converts a proto EntityNotExistsError to thrift
+func FromProtoEntityNotExistsError(p *apiv1.EntityNotExistsError) *shared.EntityNotExistsError {
+	if p == nil {
+		return nil
+	}
+	return &shared.EntityNotExistsError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoServiceBusyError converts a thrift ServiceBusyError to proto
+func ToProtoServiceBusyError(t *shared.ServiceBusyError) *apiv1.ServiceBusyError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ServiceBusyError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoServiceBusyError converts a proto ServiceBusyError to thrift
+func FromProtoServiceBusyError(p *apiv1.ServiceBusyError) *shared.ServiceBusyError {
+	if p == nil {
+		return nil
+	}
+	return &shared.ServiceBusyError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoCancellationAlreadyRequestedError converts a thrift CancellationAlreadyRequestedError to proto
+func ToProtoCancellationAlreadyRequestedError(t *shared.CancellationAlreadyRequestedError) *apiv1.CancellationAlreadyRequestedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancellationAlreadyRequestedError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoCancellationAlreadyRequestedError converts a proto CancellationAlreadyRequestedError to thrift
+func FromProtoCancellationAlreadyRequestedError(p *apiv1.CancellationAlreadyRequestedError) *shared.CancellationAlreadyRequestedError {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancellationAlreadyRequestedError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoQueryFailedError converts a thrift QueryFailedError to proto
+func ToProtoQueryFailedError(t *shared.QueryFailedError) *apiv1.QueryFailedError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryFailedError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoQueryFailedError converts a proto QueryFailedError to thrift
+func FromProtoQueryFailedError(p *apiv1.QueryFailedError) *shared.QueryFailedError {
+	if p == nil {
+		return nil
+	}
+	return &shared.QueryFailedError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoDomainNotActiveError converts a thrift DomainNotActiveError to proto
+func ToProtoDomainNotActiveError(t *shared.DomainNotActiveError) *apiv1.DomainNotActiveError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainNotActiveError{
+		Message:        t.Message,
+		DomainName:     t.DomainName,
+		CurrentCluster: t.CurrentCluster,
+		ActiveCluster:  t.ActiveCluster,
+	}
+}
+
+// FromProtoDomainNotActiveError converts a proto DomainNotActiveError to thrift
+func FromProtoDomainNotActiveError(p *apiv1.DomainNotActiveError) *shared.DomainNotActiveError {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainNotActiveError{
+		Message:        p.Message,
+		DomainName:     p.DomainName,
+		CurrentCluster: p.CurrentCluster,
+		ActiveCluster:  p.ActiveCluster,
+	}
+}
+
+// ToProtoLimitExceededError converts a thrift LimitExceededError to proto
+func ToProtoLimitExceededError(t *shared.LimitExceededError) *apiv1.LimitExceededError {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.LimitExceededError{
+		Message: t.Message,
+	}
+}
+
+// FromProtoLimitExceededError converts a proto LimitExceededError to thrift
+func FromProtoLimitExceededError(p *apiv1.LimitExceededError) *shared.LimitExceededError {
+	if p == nil {
+		return nil
+	}
+	return &shared.LimitExceededError{
+		Message: p.Message,
+	}
+}
+
+// ToProtoWorkflowIdReusePolicy converts a thrift WorkflowIdReusePolicy to proto, the proto
+// values are shifted by one to leave room for WORKFLOW_ID_REUSE_POLICY_INVALID
+func ToProtoWorkflowIdReusePolicy(t *shared.WorkflowIdReusePolicy) apiv1.WorkflowIdReusePolicy {
+	if t == nil {
+		return apiv1.WORKFLOW_ID_REUSE_POLICY_INVALID
+	}
+	return apiv1.WorkflowIdReusePolicy(*t + 1)
+}
+
+// FromProtoWorkflowIdReusePolicy converts a proto WorkflowIdReusePolicy to thrift
+func FromProtoWorkflowIdReusePolicy(p apiv1.WorkflowIdReusePolicy) *shared.WorkflowIdReusePolicy {
+	if p == apiv1.WORKFLOW_ID_REUSE_POLICY_INVALID {
+		return nil
+	}
+	t := shared.WorkflowIdReusePolicy(p - 1)
+	return &t
+}
+
+// ToProtoDomainStatus converts a thrift DomainStatus to proto, the proto
+// values are shifted by one to leave room for DOMAIN_STATUS_INVALID
+func ToProtoDomainStatus(t *shared.DomainStatus) apiv1.DomainStatus {
+	if t == nil {
+		return apiv1.DOMAIN_STATUS_INVALID
+	}
+	return apiv1.DomainStatus(*t + 1)
+}
+
+// FromProtoDomainStatus converts a proto DomainStatus to thrift
+func FromProtoDomainStatus(p apiv1.DomainStatus) *shared.DomainStatus {
+	if p == apiv1.DOMAIN_STATUS_INVALID {
+		return nil
+	}
+	t := shared.DomainStatus(p - 1)
+	return &t
+}
+
+// ToProtoTimeoutType converts a thrift TimeoutType to proto, the proto
+// values are shifted by one to leave room for TIMEOUT_TYPE_INVALID
+func ToProtoTimeoutType(t *shared.TimeoutType) apiv1.TimeoutType {
+	if t == nil {
+		return apiv1.TIMEOUT_TYPE_INVALID
+	}
+	return apiv1.TimeoutType(*t + 1)
+}
+
+// FromProtoTimeoutType converts a proto TimeoutType to thrift
+func FromProtoTimeoutType(p apiv1.TimeoutType) *shared.TimeoutType {
+	if p == apiv1.TIMEOUT_TYPE_INVALID {
+		return nil
+	}
+	t := shared.TimeoutType(p - 1)
+	return &t
+}
+
+// ToProtoDecisionType converts a thrift DecisionType to proto, the proto
+// values are shifted by one to leave room for DECISION_TYPE_INVALID
+func ToProtoDecisionType(t *shared.DecisionType) apiv1.DecisionType {
+	if t == nil {
+		return apiv1.DECISION_TYPE_INVALID
+	}
+	return apiv1.DecisionType(*t + 1)
+}
+
+// FromProtoDecisionType converts a proto DecisionType to thrift
+func FromProtoDecisionType(p apiv1.DecisionType) *shared.DecisionType {
+	if p == apiv1.DECISION_TYPE_INVALID {
+		return nil
+	}
+	t := shared.DecisionType(p - 1)
+	return &t
+}
+
+// ToProtoEventType converts a thrift EventType to proto, the proto
+// values are shifted by one to leave room for EVENT_TYPE_INVALID
+func ToProtoEventType(t *shared.EventType) apiv1.EventType {
+	if t == nil {
+		return apiv1.EVENT_TYPE_INVALID
+	}
+	return apiv1.EventType(*t + 1)
+}
+
+// FromProtoEventType converts a proto EventType to thrift
+func FromProtoEventType(p apiv1.EventType) *shared.EventType {
+	if p == apiv1.EVENT_TYPE_INVALID {
+		return nil
+	}
+	t := shared.EventType(p - 1)
+	return &t
+}
+
+// ToProtoDecisionTaskFailedCause converts a thrift DecisionTaskFailedCause to proto, the proto
+// values are shifted by one to leave room for DECISION_TASK_FAILED_CAUSE_INVALID
+func ToProtoDecisionTaskFailedCause(t *shared.DecisionTaskFailedCause) apiv1.DecisionTaskFailedCause {
+	if t == nil {
+		return apiv1.DECISION_TASK_FAILED_CAUSE_INVALID
+	}
+	return apiv1.DecisionTaskFailedCause(*t + 1)
+}
+
+// FromProtoDecisionTaskFailedCause converts a proto DecisionTaskFailedCause to thrift
+func FromProtoDecisionTaskFailedCause(p apiv1.DecisionTaskFailedCause) *shared.DecisionTaskFailedCause {
+	if p == apiv1.DECISION_TASK_FAILED_CAUSE_INVALID {
+		return nil
+	}
+	t := shared.DecisionTaskFailedCause(p - 1)
+	return &t
+}
+
+// ToProtoCancelExternalWorkflowExecutionFailedCause converts a thrift CancelExternalWorkflowExecutionFailedCause to proto, the proto
+// values are shifted by one to leave room for CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+func ToProtoCancelExternalWorkflowExecutionFailedCause(t *shared.CancelExternalWorkflowExecutionFailedCause) apiv1.CancelExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	return apiv1.CancelExternalWorkflowExecutionFailedCause(*t + 1)
+}
+
+// FromProtoCancelExternalWorkflowExecutionFailedCause converts a proto CancelExternalWorkflowExecutionFailedCause to thrift
+func FromProtoCancelExternalWorkflowExecutionFailedCause(p apiv1.CancelExternalWorkflowExecutionFailedCause) *shared.CancelExternalWorkflowExecutionFailedCause {
+	if p == apiv1.CANCEL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID {
+		return nil
+	}
+	t := shared.CancelExternalWorkflowExecutionFailedCause(p - 1)
+	return &t
+}
+
+// ToProtoSignalExternalWorkflowExecutionFailedCause converts a thrift SignalExternalWorkflowExecutionFailedCause to proto, the proto
+// values are shifted by one to leave room for SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+func ToProtoSignalExternalWorkflowExecutionFailedCause(t *shared.SignalExternalWorkflowExecutionFailedCause) apiv1.SignalExternalWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	return apiv1.SignalExternalWorkflowExecutionFailedCause(*t + 1)
+}
+
+// FromProtoSignalExternalWorkflowExecutionFailedCause converts a proto SignalExternalWorkflowExecutionFailedCause to thrift
+func FromProtoSignalExternalWorkflowExecutionFailedCause(p apiv1.SignalExternalWorkflowExecutionFailedCause) *shared.SignalExternalWorkflowExecutionFailedCause {
+	if p == apiv1.SIGNAL_EXTERNAL_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID {
+		return nil
+	}
+	t := shared.SignalExternalWorkflowExecutionFailedCause(p - 1)
+	return &t
+}
+
+// ToProtoChildWorkflowExecutionFailedCause converts a thrift ChildWorkflowExecutionFailedCause to proto, the proto
+// values are shifted by one to leave room for CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+func ToProtoChildWorkflowExecutionFailedCause(t *shared.ChildWorkflowExecutionFailedCause) apiv1.ChildWorkflowExecutionFailedCause {
+	if t == nil {
+		return apiv1.CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID
+	}
+	return apiv1.ChildWorkflowExecutionFailedCause(*t + 1)
+}
+
+// FromProtoChildWorkflowExecutionFailedCause converts a proto ChildWorkflowExecutionFailedCause to thrift
+func FromProtoChildWorkflowExecutionFailedCause(p apiv1.ChildWorkflowExecutionFailedCause) *shared.ChildWorkflowExecutionFailedCause {
+	if p == apiv1.CHILD_WORKFLOW_EXECUTION_FAILED_CAUSE_INVALID {
+		return nil
+	}
+	t := shared.ChildWorkflowExecutionFailedCause(p - 1)
+	return &t
+}
+
+// ToProtoWorkflowExecutionCloseStatus converts a thrift WorkflowExecutionCloseStatus to proto, the proto
+// values are shifted by one to leave room for WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+func ToProtoWorkflowExecutionCloseStatus(t *shared.WorkflowExecutionCloseStatus) apiv1.WorkflowExecutionCloseStatus {
+	if t == nil {
+		return apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID
+	}
+	return apiv1.WorkflowExecutionCloseStatus(*t + 1)
+}
+
+// FromProtoWorkflowExecutionCloseStatus converts a proto WorkflowExecutionCloseStatus to thrift
+func FromProtoWorkflowExecutionCloseStatus(p apiv1.WorkflowExecutionCloseStatus) *shared.WorkflowExecutionCloseStatus {
+	if p == apiv1.WORKFLOW_EXECUTION_CLOSE_STATUS_INVALID {
+		return nil
+	}
+	t := shared.WorkflowExecutionCloseStatus(p - 1)
+	return &t
+}
+
+// ToProtoChildPolicy converts a thrift ChildPolicy to proto, the proto
+// values are shifted by one to leave room for CHILD_POLICY_INVALID
+func ToProtoChildPolicy(t *shared.ChildPolicy) apiv1.ChildPolicy {
+	if t == nil {
+		return apiv1.CHILD_POLICY_INVALID
+	}
+	return apiv1.ChildPolicy(*t + 1)
+}
+
+// FromProtoChildPolicy converts a proto ChildPolicy to thrift
+func FromProtoChildPolicy(p apiv1.ChildPolicy) *shared.ChildPolicy {
+	if p == apiv1.CHILD_POLICY_INVALID {
+		return nil
+	}
+	t := shared.ChildPolicy(p - 1)
+	return &t
+}
+
+// ToProtoQueryTaskCompletedType converts a thrift QueryTaskCompletedType to proto, the proto
+// values are shifted by one to leave room for QUERY_TASK_COMPLETED_TYPE_INVALID
+func ToProtoQueryTaskCompletedType(t *shared.QueryTaskCompletedType) apiv1.QueryTaskCompletedType {
+	if t == nil {
+		return apiv1.QUERY_TASK_COMPLETED_TYPE_INVALID
+	}
+	return apiv1.QueryTaskCompletedType(*t + 1)
+}
+
+// FromProtoQueryTaskCompletedType converts a proto QueryTaskCompletedType to thrift
+func FromProtoQueryTaskCompletedType(p apiv1.QueryTaskCompletedType) *shared.QueryTaskCompletedType {
+	if p == apiv1.QUERY_TASK_COMPLETED_TYPE_INVALID {
+		return nil
+	}
+	t := shared.QueryTaskCompletedType(p - 1)
+	return &t
+}
+
+// ToProtoPendingActivityState converts a thrift PendingActivityState to proto, the proto
+// values are shifted by one to leave room for PENDING_ACTIVITY_STATE_INVALID
+func ToProtoPendingActivityState(t *shared.PendingActivityState) apiv1.PendingActivityState {
+	if t == nil {
+		return apiv1.PENDING_ACTIVITY_STATE_INVALID
+	}
+	return apiv1.PendingActivityState(*t + 1)
+}
+
+// FromProtoPendingActivityState converts a proto PendingActivityState to thrift
+func FromProtoPendingActivityState(p apiv1.PendingActivityState) *shared.PendingActivityState {
+	if p == apiv1.PENDING_ACTIVITY_STATE_INVALID {
+		return nil
+	}
+	t := shared.PendingActivityState(p - 1)
+	return &t
+}
+
+// ToProtoHistoryEventFilterType converts a thrift HistoryEventFilterType to proto, the proto
+// values are shifted by one to leave room for HISTORY_EVENT_FILTER_TYPE_INVALID
+func ToProtoHistoryEventFilterType(t *shared.HistoryEventFilterType) apiv1.HistoryEventFilterType {
+	if t == nil {
+		return apiv1.HISTORY_EVENT_FILTER_TYPE_INVALID
+	}
+	return apiv1.HistoryEventFilterType(*t + 1)
+}
+
+// FromProtoHistoryEventFilterType converts a proto HistoryEventFilterType to thrift
+func FromProtoHistoryEventFilterType(p apiv1.HistoryEventFilterType) *shared.HistoryEventFilterType {
+	if p == apiv1.HISTORY_EVENT_FILTER_TYPE_INVALID {
+		return nil
+	}
+	t := shared.HistoryEventFilterType(p - 1)
+	return &t
+}
+
+// ToProtoTaskListKind converts a thrift TaskListKind to proto, the proto
+// values are shifted by one to leave room for TASK_LIST_KIND_INVALID
+func ToProtoTaskListKind(t *shared.TaskListKind) apiv1.TaskListKind {
+	if t == nil {
+		return apiv1.TASK_LIST_KIND_INVALID
+	}
+	return apiv1.TaskListKind(*t + 1)
+}
+
+// FromProtoTaskListKind converts a proto TaskListKind to thrift
+func FromProtoTaskListKind(p apiv1.TaskListKind) *shared.TaskListKind {
+	if p == apiv1.TASK_LIST_KIND_INVALID {
+		return nil
+	}
+	t := shared.TaskListKind(p - 1)
+	return &t
+}
+
+// ToProtoArchivalStatus converts a thrift ArchivalStatus to proto, the proto
+// values are shifted by one to leave room for ARCHIVAL_STATUS_INVALID
+func ToProtoArchivalStatus(t *shared.ArchivalStatus) apiv1.ArchivalStatus {
+	if t == nil {
+		return apiv1.ARCHIVAL_STATUS_INVALID
+	}
+	return apiv1.ArchivalStatus(*t + 1)
+}
+
+// FromProtoArchivalStatus converts a proto ArchivalStatus to thrift
+func FromProtoArchivalStatus(p apiv1.ArchivalStatus) *shared.ArchivalStatus {
+	if p == apiv1.ARCHIVAL_STATUS_INVALID {
+		return nil
+	}
+	t := shared.ArchivalStatus(p - 1)
+	return &t
+}
+
+// ToProtoHeader converts a thrift Header to proto
+func ToProtoHeader(t *shared.Header) *apiv1.Header {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Header{
+		Fields: t.Fields,
+	}
+}
+
+// FromProtoHeader converts a proto Header to thrift
+func FromProtoHeader(p *apiv1.Header) *shared.Header {
+	if p == nil {
+		return nil
+	}
+	return &shared.Header{
+		Fields: p.Fields,
+	}
+}
+
+// ToProtoWorkflowType converts a thrift WorkflowType to proto
+func ToProtoWorkflowType(t *shared.WorkflowType) *apiv1.WorkflowType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowType{
+		Name: t.GetName(),
+	}
+}
+
+// FromProtoWorkflowType converts a proto WorkflowType to thrift
+func FromProtoWorkflowType(p *apiv1.WorkflowType) *shared.WorkflowType {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowType{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// ToProtoActivityType converts a thrift ActivityType to proto
+func ToProtoActivityType(t *shared.ActivityType) *apiv1.ActivityType {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityType{
+		Name: t.GetName(),
+	}
+}
+
+// FromProtoActivityType converts a proto ActivityType to thrift
+func FromProtoActivityType(p *apiv1.ActivityType) *shared.ActivityType {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityType{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// ToProtoTaskList converts a thrift TaskList to proto
+func ToProtoTaskList(t *shared.TaskList) *apiv1.TaskList {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskList{
+		Name: t.GetName(),
+		Kind: ToProtoTaskListKind(t.Kind),
+	}
+}
+
+// FromProtoTaskList converts a proto TaskList to thrift
+func FromProtoTaskList(p *apiv1.TaskList) *shared.TaskList {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskList{
+		Name: stringPtr(p.Name),
+		Kind: FromProtoTaskListKind(p.Kind),
+	}
+}
+
+// ToProtoTaskListMetadata converts a thrift TaskListMetadata to proto
+func ToProtoTaskListMetadata(t *shared.TaskListMetadata) *apiv1.TaskListMetadata {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListMetadata{
+		MaxTasksPerSecond: t.GetMaxTasksPerSecond(),
+	}
+}
+
+// FromProtoTaskListMetadata converts a proto TaskListMetadata to thrift
+func FromProtoTaskListMetadata(p *apiv1.TaskListMetadata) *shared.TaskListMetadata {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskListMetadata{
+		MaxTasksPerSecond: float64Ptr(p.MaxTasksPerSecond),
+	}
+}
+
+// ToProtoWorkflowExecution converts a thrift WorkflowExecution to proto
+func ToProtoWorkflowExecution(t *shared.WorkflowExecution) *apiv1.WorkflowExecution {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecution{
+		WorkflowId: t.GetWorkflowId(),
+		RunId:      t.GetRunId(),
+	}
+}
+
+// FromProtoWorkflowExecution converts a proto WorkflowExecution to thrift
+func FromProtoWorkflowExecution(p *apiv1.WorkflowExecution) *shared.WorkflowExecution {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecution{
+		WorkflowId: stringPtr(p.WorkflowId),
+		RunId:      stringPtr(p.RunId),
+	}
+}
+
+// ToProtoWorkflowExecutionInfo converts a thrift WorkflowExecutionInfo to proto
+func ToProtoWorkflowExecutionInfo(t *shared.WorkflowExecutionInfo) *apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionInfo{
+		Execution:       ToProtoWorkflowExecution(t.Execution),
+		Type:            ToProtoWorkflowType(t.Type),
+		StartTime:       t.GetStartTime(),
+		CloseTime:       t.GetCloseTime(),
+		CloseStatus:     ToProtoWorkflowExecutionCloseStatus(t.CloseStatus),
+		HistoryLength:   t.GetHistoryLength(),
+		ParentDomainId:  t.GetParentDomainId(),
+		ParentExecution: ToProtoWorkflowExecution(t.ParentExecution),
+		ExecutionTime:   t.GetExecutionTime(),
+	}
+}
+
+// FromProtoWorkflowExecutionInfo converts a proto WorkflowExecutionInfo to thrift
+func FromProtoWorkflowExecutionInfo(p *apiv1.WorkflowExecutionInfo) *shared.WorkflowExecutionInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionInfo{
+		Execution:       FromProtoWorkflowExecution(p.Execution),
+		Type:            FromProtoWorkflowType(p.Type),
+		StartTime:       int64Ptr(p.StartTime),
+		CloseTime:       int64Ptr(p.CloseTime),
+		CloseStatus:     FromProtoWorkflowExecutionCloseStatus(p.CloseStatus),
+		HistoryLength:   int64Ptr(p.HistoryLength),
+		ParentDomainId:  stringPtr(p.ParentDomainId),
+		ParentExecution: FromProtoWorkflowExecution(p.ParentExecution),
+		ExecutionTime:   int64Ptr(p.ExecutionTime),
+	}
+}
+
+// ToProtoWorkflowExecutionConfiguration converts a thrift WorkflowExecutionConfiguration to proto
+func ToProtoWorkflowExecutionConfiguration(t *shared.WorkflowExecutionConfiguration) *apiv1.WorkflowExecutionConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionConfiguration{
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         ToProtoChildPolicy(t.ChildPolicy),
+	}
+}
+
+// FromProtoWorkflowExecutionConfiguration converts a proto WorkflowExecutionConfiguration to thrift
+func FromProtoWorkflowExecutionConfiguration(p *apiv1.WorkflowExecutionConfiguration) *shared.WorkflowExecutionConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionConfiguration{
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         FromProtoChildPolicy(p.ChildPolicy),
+	}
+}
+
+// ToProtoScheduleActivityTaskDecisionAttributes converts a thrift ScheduleActivityTaskDecisionAttributes to proto
+func ToProtoScheduleActivityTaskDecisionAttributes(t *shared.ScheduleActivityTaskDecisionAttributes) *apiv1.ScheduleActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  ToProtoActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      ToProtoTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		RetryPolicy:                   ToProtoRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// FromProtoScheduleActivityTaskDecisionAttributes converts a proto ScheduleActivityTaskDecisionAttributes to thrift
+func FromProtoScheduleActivityTaskDecisionAttributes(p *apiv1.ScheduleActivityTaskDecisionAttributes) *shared.ScheduleActivityTaskDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ScheduleActivityTaskDecisionAttributes{
+		ActivityId:                    stringPtr(p.ActivityId),
+		ActivityType:                  FromProtoActivityType(p.ActivityType),
+		Domain:                        stringPtr(p.Domain),
+		TaskList:                      FromProtoTaskList(p.TaskList),
+		Input:                         p.Input,
+		ScheduleToCloseTimeoutSeconds: int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: int32Ptr(p.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       int32Ptr(p.HeartbeatTimeoutSeconds),
+		RetryPolicy:                   FromProtoRetryPolicy(p.RetryPolicy),
+	}
+}
+
+// ToProtoRequestCancelActivityTaskDecisionAttributes converts a thrift RequestCancelActivityTaskDecisionAttributes to proto
+func ToProtoRequestCancelActivityTaskDecisionAttributes(t *shared.RequestCancelActivityTaskDecisionAttributes) *apiv1.RequestCancelActivityTaskDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: t.GetActivityId(),
+	}
+}
+
+// FromProtoRequestCancelActivityTaskDecisionAttributes converts a proto RequestCancelActivityTaskDecisionAttributes to thrift
+func FromProtoRequestCancelActivityTaskDecisionAttributes(p *apiv1.RequestCancelActivityTaskDecisionAttributes) *shared.RequestCancelActivityTaskDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskDecisionAttributes{
+		ActivityId: stringPtr(p.ActivityId),
+	}
+}
+
+// ToProtoStartTimerDecisionAttributes converts a thrift StartTimerDecisionAttributes to proto
+func ToProtoStartTimerDecisionAttributes(t *shared.StartTimerDecisionAttributes) *apiv1.StartTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimerDecisionAttributes{
+		TimerId:                   t.GetTimerId(),
+		StartToFireTimeoutSeconds: t.GetStartToFireTimeoutSeconds(),
+	}
+}
+
+// FromProtoStartTimerDecisionAttributes converts a proto StartTimerDecisionAttributes to thrift
+func FromProtoStartTimerDecisionAttributes(p *apiv1.StartTimerDecisionAttributes) *shared.StartTimerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartTimerDecisionAttributes{
+		TimerId:                   stringPtr(p.TimerId),
+		StartToFireTimeoutSeconds: int64Ptr(p.StartToFireTimeoutSeconds),
+	}
+}
+
+// ToProtoCompleteWorkflowExecutionDecisionAttributes converts a thrift CompleteWorkflowExecutionDecisionAttributes to proto
+func ToProtoCompleteWorkflowExecutionDecisionAttributes(t *shared.CompleteWorkflowExecutionDecisionAttributes) *apiv1.CompleteWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CompleteWorkflowExecutionDecisionAttributes{
+		Result: t.Result,
+	}
+}
+
+// FromProtoCompleteWorkflowExecutionDecisionAttributes converts a proto CompleteWorkflowExecutionDecisionAttributes to thrift
+func FromProtoCompleteWorkflowExecutionDecisionAttributes(p *apiv1.CompleteWorkflowExecutionDecisionAttributes) *shared.CompleteWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CompleteWorkflowExecutionDecisionAttributes{
+		Result: p.Result,
+	}
+}
+
+// ToProtoFailWorkflowExecutionDecisionAttributes converts a thrift FailWorkflowExecutionDecisionAttributes to proto
+func ToProtoFailWorkflowExecutionDecisionAttributes(t *shared.FailWorkflowExecutionDecisionAttributes) *apiv1.FailWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.FailWorkflowExecutionDecisionAttributes{
+		Reason:  t.GetReason(),
+		Details: t.Details,
+	}
+}
+
+// FromProtoFailWorkflowExecutionDecisionAttributes converts a proto FailWorkflowExecutionDecisionAttributes to thrift
+func FromProtoFailWorkflowExecutionDecisionAttributes(p *apiv1.FailWorkflowExecutionDecisionAttributes) *shared.FailWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.FailWorkflowExecutionDecisionAttributes{
+		Reason:  stringPtr(p.Reason),
+		Details: p.Details,
+	}
+}
+
+// ToProtoCancelTimerDecisionAttributes converts a thrift CancelTimerDecisionAttributes to proto
+func ToProtoCancelTimerDecisionAttributes(t *shared.CancelTimerDecisionAttributes) *apiv1.CancelTimerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerDecisionAttributes{
+		TimerId: t.GetTimerId(),
+	}
+}
+
+// FromProtoCancelTimerDecisionAttributes converts a proto CancelTimerDecisionAttributes to thrift
+func FromProtoCancelTimerDecisionAttributes(p *apiv1.CancelTimerDecisionAttributes) *shared.CancelTimerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelTimerDecisionAttributes{
+		TimerId: stringPtr(p.TimerId),
+	}
+}
+
+// ToProtoCancelWorkflowExecutionDecisionAttributes converts a thrift CancelWorkflowExecutionDecisionAttributes to proto
+func ToProtoCancelWorkflowExecutionDecisionAttributes(t *shared.CancelWorkflowExecutionDecisionAttributes) *apiv1.CancelWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelWorkflowExecutionDecisionAttributes{
+		Details: t.Details,
+	}
+}
+
+// FromProtoCancelWorkflowExecutionDecisionAttributes converts a proto CancelWorkflowExecutionDecisionAttributes to thrift
+func FromProtoCancelWorkflowExecutionDecisionAttributes(p *apiv1.CancelWorkflowExecutionDecisionAttributes) *shared.CancelWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelWorkflowExecutionDecisionAttributes{
+		Details: p.Details,
+	}
+}
+
+// ToProtoRequestCancelExternalWorkflowExecutionDecisionAttributes converts a thrift RequestCancelExternalWorkflowExecutionDecisionAttributes to proto
+func ToProtoRequestCancelExternalWorkflowExecutionDecisionAttributes(t *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes) *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowId:        t.GetWorkflowId(),
+		RunId:             t.GetRunId(),
+		Control:           t.Control,
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// FromProtoRequestCancelExternalWorkflowExecutionDecisionAttributes converts a proto RequestCancelExternalWorkflowExecutionDecisionAttributes to thrift
+func FromProtoRequestCancelExternalWorkflowExecutionDecisionAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionDecisionAttributes) *shared.RequestCancelExternalWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(p.Domain),
+		WorkflowId:        stringPtr(p.WorkflowId),
+		RunId:             stringPtr(p.RunId),
+		Control:           p.Control,
+		ChildWorkflowOnly: boolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// ToProtoSignalExternalWorkflowExecutionDecisionAttributes converts a thrift SignalExternalWorkflowExecutionDecisionAttributes to proto
+func ToProtoSignalExternalWorkflowExecutionDecisionAttributes(t *shared.SignalExternalWorkflowExecutionDecisionAttributes) *apiv1.SignalExternalWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            t.GetDomain(),
+		Execution:         ToProtoWorkflowExecution(t.Execution),
+		SignalName:        t.GetSignalName(),
+		Input:             t.Input,
+		Control:           t.Control,
+		ChildWorkflowOnly: t.GetChildWorkflowOnly(),
+	}
+}
+
+// FromProtoSignalExternalWorkflowExecutionDecisionAttributes converts a proto SignalExternalWorkflowExecutionDecisionAttributes to thrift
+func FromProtoSignalExternalWorkflowExecutionDecisionAttributes(p *apiv1.SignalExternalWorkflowExecutionDecisionAttributes) *shared.SignalExternalWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionDecisionAttributes{
+		Domain:            stringPtr(p.Domain),
+		Execution:         FromProtoWorkflowExecution(p.Execution),
+		SignalName:        stringPtr(p.SignalName),
+		Input:             p.Input,
+		Control:           p.Control,
+		ChildWorkflowOnly: boolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// ToProtoRecordMarkerDecisionAttributes converts a thrift RecordMarkerDecisionAttributes to proto
+func ToProtoRecordMarkerDecisionAttributes(t *shared.RecordMarkerDecisionAttributes) *apiv1.RecordMarkerDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordMarkerDecisionAttributes{
+		MarkerName: t.GetMarkerName(),
+		Details:    t.Details,
+		Header:     ToProtoHeader(t.Header),
+	}
+}
+
+// FromProtoRecordMarkerDecisionAttributes converts a proto RecordMarkerDecisionAttributes to thrift
+func FromProtoRecordMarkerDecisionAttributes(p *apiv1.RecordMarkerDecisionAttributes) *shared.RecordMarkerDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordMarkerDecisionAttributes{
+		MarkerName: stringPtr(p.MarkerName),
+		Details:    p.Details,
+		Header:     FromProtoHeader(p.Header),
+	}
+}
+
+// ToProtoContinueAsNewWorkflowExecutionDecisionAttributes converts a thrift ContinueAsNewWorkflowExecutionDecisionAttributes to proto
+func ToProtoContinueAsNewWorkflowExecutionDecisionAttributes(t *shared.ContinueAsNewWorkflowExecutionDecisionAttributes) *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        ToProtoWorkflowType(t.WorkflowType),
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+		RetryPolicy:                         ToProtoRetryPolicy(t.RetryPolicy),
+		Initiator:                           ToProtoContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       t.GetFailureReason(),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		CronSchedule:                        t.GetCronSchedule(),
+	}
+}
+
+// FromProtoContinueAsNewWorkflowExecutionDecisionAttributes converts a proto ContinueAsNewWorkflowExecutionDecisionAttributes to thrift
+func FromProtoContinueAsNewWorkflowExecutionDecisionAttributes(p *apiv1.ContinueAsNewWorkflowExecutionDecisionAttributes) *shared.ContinueAsNewWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        FromProtoWorkflowType(p.WorkflowType),
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		BackoffStartIntervalInSeconds:       int32Ptr(p.BackoffStartIntervalInSeconds),
+		RetryPolicy:                         FromProtoRetryPolicy(p.RetryPolicy),
+		Initiator:                           FromProtoContinueAsNewInitiator(p.Initiator),
+		FailureReason:                       stringPtr(p.FailureReason),
+		FailureDetails:                      p.FailureDetails,
+		LastCompletionResult:                p.LastCompletionResult,
+		CronSchedule:                        stringPtr(p.CronSchedule),
+	}
+}
+
+// ToProtoStartChildWorkflowExecutionDecisionAttributes converts a thrift StartChildWorkflowExecutionDecisionAttributes to proto
+func ToProtoStartChildWorkflowExecutionDecisionAttributes(t *shared.StartChildWorkflowExecutionDecisionAttributes) *apiv1.StartChildWorkflowExecutionDecisionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        ToProtoWorkflowType(t.WorkflowType),
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         ToProtoChildPolicy(t.ChildPolicy),
+		Control:                             t.Control,
+		WorkflowIdReusePolicy:               ToProtoWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToProtoRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+	}
+}
+
+// FromProtoStartChildWorkflowExecutionDecisionAttributes converts a proto StartChildWorkflowExecutionDecisionAttributes to thrift
+func FromProtoStartChildWorkflowExecutionDecisionAttributes(p *apiv1.StartChildWorkflowExecutionDecisionAttributes) *shared.StartChildWorkflowExecutionDecisionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionDecisionAttributes{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        FromProtoWorkflowType(p.WorkflowType),
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         FromProtoChildPolicy(p.ChildPolicy),
+		Control:                             p.Control,
+		WorkflowIdReusePolicy:               FromProtoWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromProtoRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+	}
+}
+
+// ToProtoDecision converts a thrift Decision to proto
+func ToProtoDecision(t *shared.Decision) *apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.Decision{
+		DecisionType:                                             ToProtoDecisionType(t.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   ToProtoScheduleActivityTaskDecisionAttributes(t.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             ToProtoStartTimerDecisionAttributes(t.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              ToProtoCompleteWorkflowExecutionDecisionAttributes(t.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  ToProtoFailWorkflowExecutionDecisionAttributes(t.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              ToProtoRequestCancelActivityTaskDecisionAttributes(t.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            ToProtoCancelTimerDecisionAttributes(t.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                ToProtoCancelWorkflowExecutionDecisionAttributes(t.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: ToProtoRequestCancelExternalWorkflowExecutionDecisionAttributes(t.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           ToProtoRecordMarkerDecisionAttributes(t.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         ToProtoContinueAsNewWorkflowExecutionDecisionAttributes(t.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            ToProtoStartChildWorkflowExecutionDecisionAttributes(t.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        ToProtoSignalExternalWorkflowExecutionDecisionAttributes(t.SignalExternalWorkflowExecutionDecisionAttributes),
+	}
+}
+
+// FromProtoDecision converts a proto Decision to thrift
+func FromProtoDecision(p *apiv1.Decision) *shared.Decision {
+	if p == nil {
+		return nil
+	}
+	return &shared.Decision{
+		DecisionType:                                             FromProtoDecisionType(p.DecisionType),
+		ScheduleActivityTaskDecisionAttributes:                   FromProtoScheduleActivityTaskDecisionAttributes(p.ScheduleActivityTaskDecisionAttributes),
+		StartTimerDecisionAttributes:                             FromProtoStartTimerDecisionAttributes(p.StartTimerDecisionAttributes),
+		CompleteWorkflowExecutionDecisionAttributes:              FromProtoCompleteWorkflowExecutionDecisionAttributes(p.CompleteWorkflowExecutionDecisionAttributes),
+		FailWorkflowExecutionDecisionAttributes:                  FromProtoFailWorkflowExecutionDecisionAttributes(p.FailWorkflowExecutionDecisionAttributes),
+		RequestCancelActivityTaskDecisionAttributes:              FromProtoRequestCancelActivityTaskDecisionAttributes(p.RequestCancelActivityTaskDecisionAttributes),
+		CancelTimerDecisionAttributes:                            FromProtoCancelTimerDecisionAttributes(p.CancelTimerDecisionAttributes),
+		CancelWorkflowExecutionDecisionAttributes:                FromProtoCancelWorkflowExecutionDecisionAttributes(p.CancelWorkflowExecutionDecisionAttributes),
+		RequestCancelExternalWorkflowExecutionDecisionAttributes: FromProtoRequestCancelExternalWorkflowExecutionDecisionAttributes(p.RequestCancelExternalWorkflowExecutionDecisionAttributes),
+		RecordMarkerDecisionAttributes:                           FromProtoRecordMarkerDecisionAttributes(p.RecordMarkerDecisionAttributes),
+		ContinueAsNewWorkflowExecutionDecisionAttributes:         FromProtoContinueAsNewWorkflowExecutionDecisionAttributes(p.ContinueAsNewWorkflowExecutionDecisionAttributes),
+		StartChildWorkflowExecutionDecisionAttributes:            FromProtoStartChildWorkflowExecutionDecisionAttributes(p.StartChildWorkflowExecutionDecisionAttributes),
+		SignalExternalWorkflowExecutionDecisionAttributes:        FromProtoSignalExternalWorkflowExecutionDecisionAttributes(p.SignalExternalWorkflowExecutionDecisionAttributes),
+	}
+}
+
+// ToProtoWorkflowExecutionStartedEventAttributes converts a thrift WorkflowExecutionStartedEventAttributes to proto
+func ToProtoWorkflowExecutionStartedEventAttributes(t *shared.WorkflowExecutionStartedEventAttributes) *apiv1.WorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        ToProtoWorkflowType(t.WorkflowType),
+		ParentWorkflowDomain:                t.GetParentWorkflowDomain(),
+		ParentWorkflowExecution:             ToProtoWorkflowExecution(t.ParentWorkflowExecution),
+		ParentInitiatedEventId:              t.GetParentInitiatedEventId(),
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         ToProtoChildPolicy(t.ChildPolicy),
+		ContinuedExecutionRunId:             t.GetContinuedExecutionRunId(),
+		Initiator:                           ToProtoContinueAsNewInitiator(t.Initiator),
+		ContinuedFailureReason:              t.GetContinuedFailureReason(),
+		ContinuedFailureDetails:             t.ContinuedFailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+		Identity:                            t.GetIdentity(),
+		RetryPolicy:                         ToProtoRetryPolicy(t.RetryPolicy),
+		Attempt:                             t.GetAttempt(),
+		ExpirationTimestamp:                 t.GetExpirationTimestamp(),
+		CronSchedule:                        t.GetCronSchedule(),
+		FirstDecisionTaskBackoffSeconds:     t.GetFirstDecisionTaskBackoffSeconds(),
+	}
+}
+
+// FromProtoWorkflowExecutionStartedEventAttributes converts a proto WorkflowExecutionStartedEventAttributes to thrift
+func FromProtoWorkflowExecutionStartedEventAttributes(p *apiv1.WorkflowExecutionStartedEventAttributes) *shared.WorkflowExecutionStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionStartedEventAttributes{
+		WorkflowType:                        FromProtoWorkflowType(p.WorkflowType),
+		ParentWorkflowDomain:                stringPtr(p.ParentWorkflowDomain),
+		ParentWorkflowExecution:             FromProtoWorkflowExecution(p.ParentWorkflowExecution),
+		ParentInitiatedEventId:              int64Ptr(p.ParentInitiatedEventId),
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         FromProtoChildPolicy(p.ChildPolicy),
+		ContinuedExecutionRunId:             stringPtr(p.ContinuedExecutionRunId),
+		Initiator:                           FromProtoContinueAsNewInitiator(p.Initiator),
+		ContinuedFailureReason:              stringPtr(p.ContinuedFailureReason),
+		ContinuedFailureDetails:             p.ContinuedFailureDetails,
+		LastCompletionResult:                p.LastCompletionResult,
+		Identity:                            stringPtr(p.Identity),
+		RetryPolicy:                         FromProtoRetryPolicy(p.RetryPolicy),
+		Attempt:                             int32Ptr(p.Attempt),
+		ExpirationTimestamp:                 int64Ptr(p.ExpirationTimestamp),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+		FirstDecisionTaskBackoffSeconds:     int32Ptr(p.FirstDecisionTaskBackoffSeconds),
+	}
+}
+
+// ToProtoWorkflowExecutionCompletedEventAttributes converts a thrift WorkflowExecutionCompletedEventAttributes to proto
+func ToProtoWorkflowExecutionCompletedEventAttributes(t *shared.WorkflowExecutionCompletedEventAttributes) *apiv1.WorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCompletedEventAttributes{
+		Result:                       t.Result,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromProtoWorkflowExecutionCompletedEventAttributes converts a proto WorkflowExecutionCompletedEventAttributes to thrift
+func FromProtoWorkflowExecutionCompletedEventAttributes(p *apiv1.WorkflowExecutionCompletedEventAttributes) *shared.WorkflowExecutionCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCompletedEventAttributes{
+		Result:                       p.Result,
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// ToProtoWorkflowExecutionFailedEventAttributes converts a thrift WorkflowExecutionFailedEventAttributes to proto
+func ToProtoWorkflowExecutionFailedEventAttributes(t *shared.WorkflowExecutionFailedEventAttributes) *apiv1.WorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFailedEventAttributes{
+		Reason:                       t.GetReason(),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromProtoWorkflowExecutionFailedEventAttributes converts a proto WorkflowExecutionFailedEventAttributes to thrift
+func FromProtoWorkflowExecutionFailedEventAttributes(p *apiv1.WorkflowExecutionFailedEventAttributes) *shared.WorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFailedEventAttributes{
+		Reason:                       stringPtr(p.Reason),
+		Details:                      p.Details,
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// ToProtoWorkflowExecutionTimedOutEventAttributes converts a thrift WorkflowExecutionTimedOutEventAttributes to proto
+func ToProtoWorkflowExecutionTimedOutEventAttributes(t *shared.WorkflowExecutionTimedOutEventAttributes) *apiv1.WorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: ToProtoTimeoutType(t.TimeoutType),
+	}
+}
+
+// FromProtoWorkflowExecutionTimedOutEventAttributes converts a proto WorkflowExecutionTimedOutEventAttributes to thrift
+func FromProtoWorkflowExecutionTimedOutEventAttributes(p *apiv1.WorkflowExecutionTimedOutEventAttributes) *shared.WorkflowExecutionTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTimedOutEventAttributes{
+		TimeoutType: FromProtoTimeoutType(p.TimeoutType),
+	}
+}
+
+// ToProtoContinueAsNewInitiator converts a thrift ContinueAsNewInitiator to proto, the proto
+// values are shifted by one to leave room for CONTINUE_AS_NEW_INITIATOR_INVALID
+func ToProtoContinueAsNewInitiator(t *shared.ContinueAsNewInitiator) apiv1.ContinueAsNewInitiator {
+	if t == nil {
+		return apiv1.CONTINUE_AS_NEW_INITIATOR_INVALID
+	}
+	return apiv1.ContinueAsNewInitiator(*t + 1)
+}
+
+// FromProtoContinueAsNewInitiator converts a proto ContinueAsNewInitiator to thrift
+func FromProtoContinueAsNewInitiator(p apiv1.ContinueAsNewInitiator) *shared.ContinueAsNewInitiator {
+	if p == apiv1.CONTINUE_AS_NEW_INITIATOR_INVALID {
+		return nil
+	}
+	t := shared.ContinueAsNewInitiator(p - 1)
+	return &t
+}
+
+// ToProtoWorkflowExecutionContinuedAsNewEventAttributes converts a thrift WorkflowExecutionContinuedAsNewEventAttributes to proto
+func ToProtoWorkflowExecutionContinuedAsNewEventAttributes(t *shared.WorkflowExecutionContinuedAsNewEventAttributes) *apiv1.WorkflowExecutionContinuedAsNewEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   t.GetNewExecutionRunId(),
+		WorkflowType:                        ToProtoWorkflowType(t.WorkflowType),
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		BackoffStartIntervalInSeconds:       t.GetBackoffStartIntervalInSeconds(),
+		Initiator:                           ToProtoContinueAsNewInitiator(t.Initiator),
+		FailureReason:                       t.GetFailureReason(),
+		FailureDetails:                      t.FailureDetails,
+		LastCompletionResult:                t.LastCompletionResult,
+	}
+}
+
+// FromProtoWorkflowExecutionContinuedAsNewEventAttributes converts a proto WorkflowExecutionContinuedAsNewEventAttributes to thrift
+func FromProtoWorkflowExecutionContinuedAsNewEventAttributes(p *apiv1.WorkflowExecutionContinuedAsNewEventAttributes) *shared.WorkflowExecutionContinuedAsNewEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionContinuedAsNewEventAttributes{
+		NewExecutionRunId:                   stringPtr(p.NewExecutionRunId),
+		WorkflowType:                        FromProtoWorkflowType(p.WorkflowType),
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		DecisionTaskCompletedEventId:        int64Ptr(p.DecisionTaskCompletedEventId),
+		BackoffStartIntervalInSeconds:       int32Ptr(p.BackoffStartIntervalInSeconds),
+		Initiator:                           FromProtoContinueAsNewInitiator(p.Initiator),
+		FailureReason:                       stringPtr(p.FailureReason),
+		FailureDetails:                      p.FailureDetails,
+		LastCompletionResult:                p.LastCompletionResult,
+	}
+}
+
+// ToProtoDecisionTaskScheduledEventAttributes converts a thrift DecisionTaskScheduledEventAttributes to proto
+func ToProtoDecisionTaskScheduledEventAttributes(t *shared.DecisionTaskScheduledEventAttributes) *apiv1.DecisionTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskScheduledEventAttributes{
+		TaskList:                   ToProtoTaskList(t.TaskList),
+		StartToCloseTimeoutSeconds: t.GetStartToCloseTimeoutSeconds(),
+		Attempt:                    t.GetAttempt(),
+	}
+}
+
+// FromProtoDecisionTaskScheduledEventAttributes converts a proto DecisionTaskScheduledEventAttributes to thrift
+func FromProtoDecisionTaskScheduledEventAttributes(p *apiv1.DecisionTaskScheduledEventAttributes) *shared.DecisionTaskScheduledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskScheduledEventAttributes{
+		TaskList:                   FromProtoTaskList(p.TaskList),
+		StartToCloseTimeoutSeconds: int32Ptr(p.StartToCloseTimeoutSeconds),
+		Attempt:                    int64Ptr(p.Attempt),
+	}
+}
+
+// ToProtoDecisionTaskStartedEventAttributes converts a thrift DecisionTaskStartedEventAttributes to proto
+func ToProtoDecisionTaskStartedEventAttributes(t *shared.DecisionTaskStartedEventAttributes) *apiv1.DecisionTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		Identity:         t.GetIdentity(),
+		RequestId:        t.GetRequestId(),
+	}
+}
+
+// FromProtoDecisionTaskStartedEventAttributes converts a proto DecisionTaskStartedEventAttributes to thrift
+func FromProtoDecisionTaskStartedEventAttributes(p *apiv1.DecisionTaskStartedEventAttributes) *shared.DecisionTaskStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskStartedEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		Identity:         stringPtr(p.Identity),
+		RequestId:        stringPtr(p.RequestId),
+	}
+}
+
+// ToProtoDecisionTaskCompletedEventAttributes converts a thrift DecisionTaskCompletedEventAttributes to proto
+func ToProtoDecisionTaskCompletedEventAttributes(t *shared.DecisionTaskCompletedEventAttributes) *apiv1.DecisionTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: t.ExecutionContext,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+		BinaryChecksum:   t.GetBinaryChecksum(),
+	}
+}
+
+// FromProtoDecisionTaskCompletedEventAttributes converts a proto DecisionTaskCompletedEventAttributes to thrift
+func FromProtoDecisionTaskCompletedEventAttributes(p *apiv1.DecisionTaskCompletedEventAttributes) *shared.DecisionTaskCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskCompletedEventAttributes{
+		ExecutionContext: p.ExecutionContext,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+		BinaryChecksum:   stringPtr(p.BinaryChecksum),
+	}
+}
+
+// ToProtoDecisionTaskTimedOutEventAttributes converts a thrift DecisionTaskTimedOutEventAttributes to proto
+func ToProtoDecisionTaskTimedOutEventAttributes(t *shared.DecisionTaskTimedOutEventAttributes) *apiv1.DecisionTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      ToProtoTimeoutType(t.TimeoutType),
+	}
+}
+
+// FromProtoDecisionTaskTimedOutEventAttributes converts a proto DecisionTaskTimedOutEventAttributes to thrift
+func FromProtoDecisionTaskTimedOutEventAttributes(p *apiv1.DecisionTaskTimedOutEventAttributes) *shared.DecisionTaskTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskTimedOutEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		TimeoutType:      FromProtoTimeoutType(p.TimeoutType),
+	}
+}
+
+// ToProtoDecisionTaskFailedEventAttributes converts a thrift DecisionTaskFailedEventAttributes to proto
+func ToProtoDecisionTaskFailedEventAttributes(t *shared.DecisionTaskFailedEventAttributes) *apiv1.DecisionTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Cause:            ToProtoDecisionTaskFailedCause(t.Cause),
+		Details:          t.Details,
+		Identity:         t.GetIdentity(),
+		Reason:           t.GetReason(),
+		BaseRunId:        t.GetBaseRunId(),
+		NewRunId:         t.GetNewRunId(),
+		ForkEventVersion: t.GetForkEventVersion(),
+	}
+}
+
+// FromProtoDecisionTaskFailedEventAttributes converts a proto DecisionTaskFailedEventAttributes to thrift
+func FromProtoDecisionTaskFailedEventAttributes(p *apiv1.DecisionTaskFailedEventAttributes) *shared.DecisionTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.DecisionTaskFailedEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Cause:            FromProtoDecisionTaskFailedCause(p.Cause),
+		Details:          p.Details,
+		Identity:         stringPtr(p.Identity),
+		Reason:           stringPtr(p.Reason),
+		BaseRunId:        stringPtr(p.BaseRunId),
+		NewRunId:         stringPtr(p.NewRunId),
+		ForkEventVersion: int64Ptr(p.ForkEventVersion),
+	}
+}
+
+// ToProtoActivityTaskScheduledEventAttributes converts a thrift ActivityTaskScheduledEventAttributes to proto
+func ToProtoActivityTaskScheduledEventAttributes(t *shared.ActivityTaskScheduledEventAttributes) *apiv1.ActivityTaskScheduledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    t.GetActivityId(),
+		ActivityType:                  ToProtoActivityType(t.ActivityType),
+		Domain:                        t.GetDomain(),
+		TaskList:                      ToProtoTaskList(t.TaskList),
+		Input:                         t.Input,
+		ScheduleToCloseTimeoutSeconds: t.GetScheduleToCloseTimeoutSeconds(),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+		StartToCloseTimeoutSeconds:    t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:       t.GetHeartbeatTimeoutSeconds(),
+		DecisionTaskCompletedEventId:  t.GetDecisionTaskCompletedEventId(),
+		RetryPolicy:                   ToProtoRetryPolicy(t.RetryPolicy),
+	}
+}
+
+// FromProtoActivityTaskScheduledEventAttributes converts a proto ActivityTaskScheduledEventAttributes to thrift
+func FromProtoActivityTaskScheduledEventAttributes(p *apiv1.ActivityTaskScheduledEventAttributes) *shared.ActivityTaskScheduledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskScheduledEventAttributes{
+		ActivityId:                    stringPtr(p.ActivityId),
+		ActivityType:                  FromProtoActivityType(p.ActivityType),
+		Domain:                        stringPtr(p.Domain),
+		TaskList:                      FromProtoTaskList(p.TaskList),
+		Input:                         p.Input,
+		ScheduleToCloseTimeoutSeconds: int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		ScheduleToStartTimeoutSeconds: int32Ptr(p.ScheduleToStartTimeoutSeconds),
+		StartToCloseTimeoutSeconds:    int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:       int32Ptr(p.HeartbeatTimeoutSeconds),
+		DecisionTaskCompletedEventId:  int64Ptr(p.DecisionTaskCompletedEventId),
+		RetryPolicy:                   FromProtoRetryPolicy(p.RetryPolicy),
+	}
+}
+
+// ToProtoActivityTaskStartedEventAttributes converts a thrift ActivityTaskStartedEventAttributes to proto
+func ToProtoActivityTaskStartedEventAttributes(t *shared.ActivityTaskStartedEventAttributes) *apiv1.ActivityTaskStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: t.GetScheduledEventId(),
+		Identity:         t.GetIdentity(),
+		RequestId:        t.GetRequestId(),
+		Attempt:          t.GetAttempt(),
+	}
+}
+
+// FromProtoActivityTaskStartedEventAttributes converts a proto ActivityTaskStartedEventAttributes to thrift
+func FromProtoActivityTaskStartedEventAttributes(p *apiv1.ActivityTaskStartedEventAttributes) *shared.ActivityTaskStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskStartedEventAttributes{
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		Identity:         stringPtr(p.Identity),
+		RequestId:        stringPtr(p.RequestId),
+		Attempt:          int32Ptr(p.Attempt),
+	}
+}
+
+// ToProtoActivityTaskCompletedEventAttributes converts a thrift ActivityTaskCompletedEventAttributes to proto
+func ToProtoActivityTaskCompletedEventAttributes(t *shared.ActivityTaskCompletedEventAttributes) *apiv1.ActivityTaskCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCompletedEventAttributes{
+		Result:           t.Result,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// FromProtoActivityTaskCompletedEventAttributes converts a proto ActivityTaskCompletedEventAttributes to thrift
+func FromProtoActivityTaskCompletedEventAttributes(p *apiv1.ActivityTaskCompletedEventAttributes) *shared.ActivityTaskCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCompletedEventAttributes{
+		Result:           p.Result,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+	}
+}
+
+// ToProtoActivityTaskFailedEventAttributes converts a thrift ActivityTaskFailedEventAttributes to proto
+func ToProtoActivityTaskFailedEventAttributes(t *shared.ActivityTaskFailedEventAttributes) *apiv1.ActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskFailedEventAttributes{
+		Reason:           t.GetReason(),
+		Details:          t.Details,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		Identity:         t.GetIdentity(),
+	}
+}
+
+// FromProtoActivityTaskFailedEventAttributes converts a proto ActivityTaskFailedEventAttributes to thrift
+func FromProtoActivityTaskFailedEventAttributes(p *apiv1.ActivityTaskFailedEventAttributes) *shared.ActivityTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskFailedEventAttributes{
+		Reason:           stringPtr(p.Reason),
+		Details:          p.Details,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		Identity:         stringPtr(p.Identity),
+	}
+}
+
+// ToProtoActivityTaskTimedOutEventAttributes converts a thrift ActivityTaskTimedOutEventAttributes to proto
+func ToProtoActivityTaskTimedOutEventAttributes(t *shared.ActivityTaskTimedOutEventAttributes) *apiv1.ActivityTaskTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskTimedOutEventAttributes{
+		Details:          t.Details,
+		ScheduledEventId: t.GetScheduledEventId(),
+		StartedEventId:   t.GetStartedEventId(),
+		TimeoutType:      ToProtoTimeoutType(t.TimeoutType),
+	}
+}
+
+// FromProtoActivityTaskTimedOutEventAttributes converts a proto ActivityTaskTimedOutEventAttributes to thrift
+func FromProtoActivityTaskTimedOutEventAttributes(p *apiv1.ActivityTaskTimedOutEventAttributes) *shared.ActivityTaskTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskTimedOutEventAttributes{
+		Details:          p.Details,
+		ScheduledEventId: int64Ptr(p.ScheduledEventId),
+		StartedEventId:   int64Ptr(p.StartedEventId),
+		TimeoutType:      FromProtoTimeoutType(p.TimeoutType),
+	}
+}
+
+// ToProtoActivityTaskCancelRequestedEventAttributes converts a thrift ActivityTaskCancelRequestedEventAttributes to proto
+func ToProtoActivityTaskCancelRequestedEventAttributes(t *shared.ActivityTaskCancelRequestedEventAttributes) *apiv1.ActivityTaskCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromProtoActivityTaskCancelRequestedEventAttributes converts a proto ActivityTaskCancelRequestedEventAttributes to thrift
+func FromProtoActivityTaskCancelRequestedEventAttributes(p *apiv1.ActivityTaskCancelRequestedEventAttributes) *shared.ActivityTaskCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCancelRequestedEventAttributes{
+		ActivityId:                   stringPtr(p.ActivityId),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// ToProtoRequestCancelActivityTaskFailedEventAttributes converts a thrift RequestCancelActivityTaskFailedEventAttributes to proto
+func ToProtoRequestCancelActivityTaskFailedEventAttributes(t *shared.RequestCancelActivityTaskFailedEventAttributes) *apiv1.RequestCancelActivityTaskFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   t.GetActivityId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromProtoRequestCancelActivityTaskFailedEventAttributes converts a proto RequestCancelActivityTaskFailedEventAttributes to thrift
+func FromProtoRequestCancelActivityTaskFailedEventAttributes(p *apiv1.RequestCancelActivityTaskFailedEventAttributes) *shared.RequestCancelActivityTaskFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelActivityTaskFailedEventAttributes{
+		ActivityId:                   stringPtr(p.ActivityId),
+		Cause:                        stringPtr(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// ToProtoActivityTaskCanceledEventAttributes converts a thrift ActivityTaskCanceledEventAttributes to proto
+func ToProtoActivityTaskCanceledEventAttributes(t *shared.ActivityTaskCanceledEventAttributes) *apiv1.ActivityTaskCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ActivityTaskCanceledEventAttributes{
+		Details:                      t.Details,
+		LatestCancelRequestedEventId: t.GetLatestCancelRequestedEventId(),
+		ScheduledEventId:             t.GetScheduledEventId(),
+		StartedEventId:               t.GetStartedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// FromProtoActivityTaskCanceledEventAttributes converts a proto ActivityTaskCanceledEventAttributes to thrift
+func FromProtoActivityTaskCanceledEventAttributes(p *apiv1.ActivityTaskCanceledEventAttributes) *shared.ActivityTaskCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ActivityTaskCanceledEventAttributes{
+		Details:                      p.Details,
+		LatestCancelRequestedEventId: int64Ptr(p.LatestCancelRequestedEventId),
+		ScheduledEventId:             int64Ptr(p.ScheduledEventId),
+		StartedEventId:               int64Ptr(p.StartedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// ToProtoTimerStartedEventAttributes converts a thrift TimerStartedEventAttributes to proto
+func ToProtoTimerStartedEventAttributes(t *shared.TimerStartedEventAttributes) *apiv1.TimerStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerStartedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartToFireTimeoutSeconds:    t.GetStartToFireTimeoutSeconds(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromProtoTimerStartedEventAttributes converts a proto TimerStartedEventAttributes to thrift
+func FromProtoTimerStartedEventAttributes(p *apiv1.TimerStartedEventAttributes) *shared.TimerStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerStartedEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		StartToFireTimeoutSeconds:    int64Ptr(p.StartToFireTimeoutSeconds),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// ToProtoTimerFiredEventAttributes converts a thrift TimerFiredEventAttributes to proto
+func ToProtoTimerFiredEventAttributes(t *shared.TimerFiredEventAttributes) *apiv1.TimerFiredEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerFiredEventAttributes{
+		TimerId:        t.GetTimerId(),
+		StartedEventId: t.GetStartedEventId(),
+	}
+}
+
+// FromProtoTimerFiredEventAttributes converts a proto TimerFiredEventAttributes to thrift
+func FromProtoTimerFiredEventAttributes(p *apiv1.TimerFiredEventAttributes) *shared.TimerFiredEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerFiredEventAttributes{
+		TimerId:        stringPtr(p.TimerId),
+		StartedEventId: int64Ptr(p.StartedEventId),
+	}
+}
+
+// ToProtoTimerCanceledEventAttributes converts a thrift TimerCanceledEventAttributes to proto
+func ToProtoTimerCanceledEventAttributes(t *shared.TimerCanceledEventAttributes) *apiv1.TimerCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TimerCanceledEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		StartedEventId:               t.GetStartedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// FromProtoTimerCanceledEventAttributes converts a proto TimerCanceledEventAttributes to thrift
+func FromProtoTimerCanceledEventAttributes(p *apiv1.TimerCanceledEventAttributes) *shared.TimerCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.TimerCanceledEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		StartedEventId:               int64Ptr(p.StartedEventId),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// ToProtoCancelTimerFailedEventAttributes converts a thrift CancelTimerFailedEventAttributes to proto
+func ToProtoCancelTimerFailedEventAttributes(t *shared.CancelTimerFailedEventAttributes) *apiv1.CancelTimerFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CancelTimerFailedEventAttributes{
+		TimerId:                      t.GetTimerId(),
+		Cause:                        t.GetCause(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Identity:                     t.GetIdentity(),
+	}
+}
+
+// FromProtoCancelTimerFailedEventAttributes converts a proto CancelTimerFailedEventAttributes to thrift
+func FromProtoCancelTimerFailedEventAttributes(p *apiv1.CancelTimerFailedEventAttributes) *shared.CancelTimerFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.CancelTimerFailedEventAttributes{
+		TimerId:                      stringPtr(p.TimerId),
+		Cause:                        stringPtr(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Identity:                     stringPtr(p.Identity),
+	}
+}
+
+// ToProtoWorkflowExecutionCancelRequestedEventAttributes converts a thrift WorkflowExecutionCancelRequestedEventAttributes to proto
+func ToProtoWorkflowExecutionCancelRequestedEventAttributes(t *shared.WorkflowExecutionCancelRequestedEventAttributes) *apiv1.WorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     t.GetCause(),
+		ExternalInitiatedEventId:  t.GetExternalInitiatedEventId(),
+		ExternalWorkflowExecution: ToProtoWorkflowExecution(t.ExternalWorkflowExecution),
+		Identity:                  t.GetIdentity(),
+	}
+}
+
+// FromProtoWorkflowExecutionCancelRequestedEventAttributes converts a proto WorkflowExecutionCancelRequestedEventAttributes to thrift
+func FromProtoWorkflowExecutionCancelRequestedEventAttributes(p *apiv1.WorkflowExecutionCancelRequestedEventAttributes) *shared.WorkflowExecutionCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCancelRequestedEventAttributes{
+		Cause:                     stringPtr(p.Cause),
+		ExternalInitiatedEventId:  int64Ptr(p.ExternalInitiatedEventId),
+		ExternalWorkflowExecution: FromProtoWorkflowExecution(p.ExternalWorkflowExecution),
+		Identity:                  stringPtr(p.Identity),
+	}
+}
+
+// ToProtoWorkflowExecutionCanceledEventAttributes converts a thrift WorkflowExecutionCanceledEventAttributes to proto
+func ToProtoWorkflowExecutionCanceledEventAttributes(t *shared.WorkflowExecutionCanceledEventAttributes) *apiv1.WorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Details:                      t.Details,
+	}
+}
+
+// FromProtoWorkflowExecutionCanceledEventAttributes converts a proto WorkflowExecutionCanceledEventAttributes to thrift
+func FromProtoWorkflowExecutionCanceledEventAttributes(p *apiv1.WorkflowExecutionCanceledEventAttributes) *shared.WorkflowExecutionCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionCanceledEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Details:                      p.Details,
+	}
+}
+
+// ToProtoMarkerRecordedEventAttributes converts a thrift MarkerRecordedEventAttributes to proto
+func ToProtoMarkerRecordedEventAttributes(t *shared.MarkerRecordedEventAttributes) *apiv1.MarkerRecordedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.MarkerRecordedEventAttributes{
+		MarkerName:                   t.GetMarkerName(),
+		Details:                      t.Details,
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Header:                       ToProtoHeader(t.Header),
+	}
+}
+
+// FromProtoMarkerRecordedEventAttributes converts a proto MarkerRecordedEventAttributes to thrift
+func FromProtoMarkerRecordedEventAttributes(p *apiv1.MarkerRecordedEventAttributes) *shared.MarkerRecordedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.MarkerRecordedEventAttributes{
+		MarkerName:                   stringPtr(p.MarkerName),
+		Details:                      p.Details,
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Header:                       FromProtoHeader(p.Header),
+	}
+}
+
+// ToProtoWorkflowExecutionSignaledEventAttributes converts a thrift WorkflowExecutionSignaledEventAttributes to proto
+func ToProtoWorkflowExecutionSignaledEventAttributes(t *shared.WorkflowExecutionSignaledEventAttributes) *apiv1.WorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionSignaledEventAttributes{
+		SignalName: t.GetSignalName(),
+		Input:      t.Input,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromProtoWorkflowExecutionSignaledEventAttributes converts a proto WorkflowExecutionSignaledEventAttributes to thrift
+func FromProtoWorkflowExecutionSignaledEventAttributes(p *apiv1.WorkflowExecutionSignaledEventAttributes) *shared.WorkflowExecutionSignaledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionSignaledEventAttributes{
+		SignalName: stringPtr(p.SignalName),
+		Input:      p.Input,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// ToProtoWorkflowExecutionTerminatedEventAttributes converts a thrift WorkflowExecutionTerminatedEventAttributes to proto
+func ToProtoWorkflowExecutionTerminatedEventAttributes(t *shared.WorkflowExecutionTerminatedEventAttributes) *apiv1.WorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   t.GetReason(),
+		Details:  t.Details,
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromProtoWorkflowExecutionTerminatedEventAttributes converts a proto WorkflowExecutionTerminatedEventAttributes to thrift
+func FromProtoWorkflowExecutionTerminatedEventAttributes(p *apiv1.WorkflowExecutionTerminatedEventAttributes) *shared.WorkflowExecutionTerminatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionTerminatedEventAttributes{
+		Reason:   stringPtr(p.Reason),
+		Details:  p.Details,
+		Identity: stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts a thrift RequestCancelExternalWorkflowExecutionInitiatedEventAttributes to proto
+func ToProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            ToProtoWorkflowExecution(t.WorkflowExecution),
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// FromProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes converts a proto RequestCancelExternalWorkflowExecutionInitiatedEventAttributes to thrift
+func FromProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            FromProtoWorkflowExecution(p.WorkflowExecution),
+		Control:                      p.Control,
+		ChildWorkflowOnly:            boolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// ToProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes converts a thrift RequestCancelExternalWorkflowExecutionFailedEventAttributes to proto
+func ToProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes(t *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToProtoCancelExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            ToProtoWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.Control,
+	}
+}
+
+// FromProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes converts a proto RequestCancelExternalWorkflowExecutionFailedEventAttributes to thrift
+func FromProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes(p *apiv1.RequestCancelExternalWorkflowExecutionFailedEventAttributes) *shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromProtoCancelExternalWorkflowExecutionFailedCause(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            FromProtoWorkflowExecution(p.WorkflowExecution),
+		InitiatedEventId:             int64Ptr(p.InitiatedEventId),
+		Control:                      p.Control,
+	}
+}
+
+// ToProtoExternalWorkflowExecutionCancelRequestedEventAttributes converts a thrift ExternalWorkflowExecutionCancelRequestedEventAttributes to proto
+func ToProtoExternalWorkflowExecutionCancelRequestedEventAttributes(t *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes) *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+	}
+}
+
+// FromProtoExternalWorkflowExecutionCancelRequestedEventAttributes converts a proto ExternalWorkflowExecutionCancelRequestedEventAttributes to thrift
+func FromProtoExternalWorkflowExecutionCancelRequestedEventAttributes(p *apiv1.ExternalWorkflowExecutionCancelRequestedEventAttributes) *shared.ExternalWorkflowExecutionCancelRequestedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionCancelRequestedEventAttributes{
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+	}
+}
+
+// ToProtoSignalExternalWorkflowExecutionInitiatedEventAttributes converts a thrift SignalExternalWorkflowExecutionInitiatedEventAttributes to proto
+func ToProtoSignalExternalWorkflowExecutionInitiatedEventAttributes(t *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes) *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            ToProtoWorkflowExecution(t.WorkflowExecution),
+		SignalName:                   t.GetSignalName(),
+		Input:                        t.Input,
+		Control:                      t.Control,
+		ChildWorkflowOnly:            t.GetChildWorkflowOnly(),
+	}
+}
+
+// FromProtoSignalExternalWorkflowExecutionInitiatedEventAttributes converts a proto SignalExternalWorkflowExecutionInitiatedEventAttributes to thrift
+func FromProtoSignalExternalWorkflowExecutionInitiatedEventAttributes(p *apiv1.SignalExternalWorkflowExecutionInitiatedEventAttributes) *shared.SignalExternalWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionInitiatedEventAttributes{
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            FromProtoWorkflowExecution(p.WorkflowExecution),
+		SignalName:                   stringPtr(p.SignalName),
+		Input:                        p.Input,
+		Control:                      p.Control,
+		ChildWorkflowOnly:            boolPtr(p.ChildWorkflowOnly),
+	}
+}
+
+// ToProtoSignalExternalWorkflowExecutionFailedEventAttributes converts a thrift SignalExternalWorkflowExecutionFailedEventAttributes to proto
+func ToProtoSignalExternalWorkflowExecutionFailedEventAttributes(t *shared.SignalExternalWorkflowExecutionFailedEventAttributes) *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        ToProtoSignalExternalWorkflowExecutionFailedCause(t.Cause),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+		Domain:                       t.GetDomain(),
+		WorkflowExecution:            ToProtoWorkflowExecution(t.WorkflowExecution),
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		Control:                      t.Control,
+	}
+}
+
+// FromProtoSignalExternalWorkflowExecutionFailedEventAttributes converts a proto SignalExternalWorkflowExecutionFailedEventAttributes to thrift
+func FromProtoSignalExternalWorkflowExecutionFailedEventAttributes(p *apiv1.SignalExternalWorkflowExecutionFailedEventAttributes) *shared.SignalExternalWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalExternalWorkflowExecutionFailedEventAttributes{
+		Cause:                        FromProtoSignalExternalWorkflowExecutionFailedCause(p.Cause),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+		Domain:                       stringPtr(p.Domain),
+		WorkflowExecution:            FromProtoWorkflowExecution(p.WorkflowExecution),
+		InitiatedEventId:             int64Ptr(p.InitiatedEventId),
+		Control:                      p.Control,
+	}
+}
+
+// ToProtoExternalWorkflowExecutionSignaledEventAttributes converts a thrift ExternalWorkflowExecutionSignaledEventAttributes to proto
+func ToProtoExternalWorkflowExecutionSignaledEventAttributes(t *shared.ExternalWorkflowExecutionSignaledEventAttributes) *apiv1.ExternalWorkflowExecutionSignaledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		Control:           t.Control,
+	}
+}
+
+// FromProtoExternalWorkflowExecutionSignaledEventAttributes converts a proto ExternalWorkflowExecutionSignaledEventAttributes to thrift
+func FromProtoExternalWorkflowExecutionSignaledEventAttributes(p *apiv1.ExternalWorkflowExecutionSignaledEventAttributes) *shared.ExternalWorkflowExecutionSignaledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ExternalWorkflowExecutionSignaledEventAttributes{
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		Control:           p.Control,
+	}
+}
+
+// ToProtoStartChildWorkflowExecutionInitiatedEventAttributes converts a thrift StartChildWorkflowExecutionInitiatedEventAttributes to proto
+func ToProtoStartChildWorkflowExecutionInitiatedEventAttributes(t *shared.StartChildWorkflowExecutionInitiatedEventAttributes) *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        ToProtoWorkflowType(t.WorkflowType),
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		ChildPolicy:                         ToProtoChildPolicy(t.ChildPolicy),
+		Control:                             t.Control,
+		DecisionTaskCompletedEventId:        t.GetDecisionTaskCompletedEventId(),
+		WorkflowIdReusePolicy:               ToProtoWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		RetryPolicy:                         ToProtoRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+	}
+}
+
+// FromProtoStartChildWorkflowExecutionInitiatedEventAttributes converts a proto StartChildWorkflowExecutionInitiatedEventAttributes to thrift
+func FromProtoStartChildWorkflowExecutionInitiatedEventAttributes(p *apiv1.StartChildWorkflowExecutionInitiatedEventAttributes) *shared.StartChildWorkflowExecutionInitiatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionInitiatedEventAttributes{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        FromProtoWorkflowType(p.WorkflowType),
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		ChildPolicy:                         FromProtoChildPolicy(p.ChildPolicy),
+		Control:                             p.Control,
+		DecisionTaskCompletedEventId:        int64Ptr(p.DecisionTaskCompletedEventId),
+		WorkflowIdReusePolicy:               FromProtoWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		RetryPolicy:                         FromProtoRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+	}
+}
+
+// ToProtoStartChildWorkflowExecutionFailedEventAttributes converts a thrift StartChildWorkflowExecutionFailedEventAttributes to proto
+func ToProtoStartChildWorkflowExecutionFailedEventAttributes(t *shared.StartChildWorkflowExecutionFailedEventAttributes) *apiv1.StartChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       t.GetDomain(),
+		WorkflowId:                   t.GetWorkflowId(),
+		WorkflowType:                 ToProtoWorkflowType(t.WorkflowType),
+		Cause:                        ToProtoChildWorkflowExecutionFailedCause(t.Cause),
+		Control:                      t.Control,
+		InitiatedEventId:             t.GetInitiatedEventId(),
+		DecisionTaskCompletedEventId: t.GetDecisionTaskCompletedEventId(),
+	}
+}
+
+// FromProtoStartChildWorkflowExecutionFailedEventAttributes converts a proto StartChildWorkflowExecutionFailedEventAttributes to thrift
+func FromProtoStartChildWorkflowExecutionFailedEventAttributes(p *apiv1.StartChildWorkflowExecutionFailedEventAttributes) *shared.StartChildWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartChildWorkflowExecutionFailedEventAttributes{
+		Domain:                       stringPtr(p.Domain),
+		WorkflowId:                   stringPtr(p.WorkflowId),
+		WorkflowType:                 FromProtoWorkflowType(p.WorkflowType),
+		Cause:                        FromProtoChildWorkflowExecutionFailedCause(p.Cause),
+		Control:                      p.Control,
+		InitiatedEventId:             int64Ptr(p.InitiatedEventId),
+		DecisionTaskCompletedEventId: int64Ptr(p.DecisionTaskCompletedEventId),
+	}
+}
+
+// ToProtoChildWorkflowExecutionStartedEventAttributes converts a thrift ChildWorkflowExecutionStartedEventAttributes to proto
+func ToProtoChildWorkflowExecutionStartedEventAttributes(t *shared.ChildWorkflowExecutionStartedEventAttributes) *apiv1.ChildWorkflowExecutionStartedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            t.GetDomain(),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToProtoWorkflowType(t.WorkflowType),
+	}
+}
+
+// FromProtoChildWorkflowExecutionStartedEventAttributes converts a proto ChildWorkflowExecutionStartedEventAttributes to thrift
+func FromProtoChildWorkflowExecutionStartedEventAttributes(p *apiv1.ChildWorkflowExecutionStartedEventAttributes) *shared.ChildWorkflowExecutionStartedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionStartedEventAttributes{
+		Domain:            stringPtr(p.Domain),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      FromProtoWorkflowType(p.WorkflowType),
+	}
+}
+
+// ToProtoChildWorkflowExecutionCompletedEventAttributes converts a thrift ChildWorkflowExecutionCompletedEventAttributes to proto
+func ToProtoChildWorkflowExecutionCompletedEventAttributes(t *shared.ChildWorkflowExecutionCompletedEventAttributes) *apiv1.ChildWorkflowExecutionCompletedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            t.Result,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToProtoWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// FromProtoChildWorkflowExecutionCompletedEventAttributes converts a proto ChildWorkflowExecutionCompletedEventAttributes to thrift
+func FromProtoChildWorkflowExecutionCompletedEventAttributes(p *apiv1.ChildWorkflowExecutionCompletedEventAttributes) *shared.ChildWorkflowExecutionCompletedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCompletedEventAttributes{
+		Result:            p.Result,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      FromProtoWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// ToProtoChildWorkflowExecutionFailedEventAttributes converts a thrift ChildWorkflowExecutionFailedEventAttributes to proto
+func ToProtoChildWorkflowExecutionFailedEventAttributes(t *shared.ChildWorkflowExecutionFailedEventAttributes) *apiv1.ChildWorkflowExecutionFailedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            t.GetReason(),
+		Details:           t.Details,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToProtoWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// FromProtoChildWorkflowExecutionFailedEventAttributes converts a proto ChildWorkflowExecutionFailedEventAttributes to thrift
+func FromProtoChildWorkflowExecutionFailedEventAttributes(p *apiv1.ChildWorkflowExecutionFailedEventAttributes) *shared.ChildWorkflowExecutionFailedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionFailedEventAttributes{
+		Reason:            stringPtr(p.Reason),
+		Details:           p.Details,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      FromProtoWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// ToProtoChildWorkflowExecutionCanceledEventAttributes converts a thrift ChildWorkflowExecutionCanceledEventAttributes to proto
+func ToProtoChildWorkflowExecutionCanceledEventAttributes(t *shared.ChildWorkflowExecutionCanceledEventAttributes) *apiv1.ChildWorkflowExecutionCanceledEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           t.Details,
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToProtoWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// FromProtoChildWorkflowExecutionCanceledEventAttributes converts a proto ChildWorkflowExecutionCanceledEventAttributes to thrift
+func FromProtoChildWorkflowExecutionCanceledEventAttributes(p *apiv1.ChildWorkflowExecutionCanceledEventAttributes) *shared.ChildWorkflowExecutionCanceledEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionCanceledEventAttributes{
+		Details:           p.Details,
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      FromProtoWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// ToProtoChildWorkflowExecutionTimedOutEventAttributes converts a thrift ChildWorkflowExecutionTimedOutEventAttributes to proto
+func ToProtoChildWorkflowExecutionTimedOutEventAttributes(t *shared.ChildWorkflowExecutionTimedOutEventAttributes) *apiv1.ChildWorkflowExecutionTimedOutEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       ToProtoTimeoutType(t.TimeoutType),
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToProtoWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// FromProtoChildWorkflowExecutionTimedOutEventAttributes converts a proto ChildWorkflowExecutionTimedOutEventAttributes to thrift
+func FromProtoChildWorkflowExecutionTimedOutEventAttributes(p *apiv1.ChildWorkflowExecutionTimedOutEventAttributes) *shared.ChildWorkflowExecutionTimedOutEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTimedOutEventAttributes{
+		TimeoutType:       FromProtoTimeoutType(p.TimeoutType),
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      FromProtoWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// ToProtoChildWorkflowExecutionTerminatedEventAttributes converts a thrift ChildWorkflowExecutionTerminatedEventAttributes to proto
+func ToProtoChildWorkflowExecutionTerminatedEventAttributes(t *shared.ChildWorkflowExecutionTerminatedEventAttributes) *apiv1.ChildWorkflowExecutionTerminatedEventAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:      ToProtoWorkflowType(t.WorkflowType),
+		InitiatedEventId:  t.GetInitiatedEventId(),
+		StartedEventId:    t.GetStartedEventId(),
+	}
+}
+
+// FromProtoChildWorkflowExecutionTerminatedEventAttributes converts a proto ChildWorkflowExecutionTerminatedEventAttributes to thrift
+func FromProtoChildWorkflowExecutionTerminatedEventAttributes(p *apiv1.ChildWorkflowExecutionTerminatedEventAttributes) *shared.ChildWorkflowExecutionTerminatedEventAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.ChildWorkflowExecutionTerminatedEventAttributes{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:      FromProtoWorkflowType(p.WorkflowType),
+		InitiatedEventId:  int64Ptr(p.InitiatedEventId),
+		StartedEventId:    int64Ptr(p.StartedEventId),
+	}
+}
+
+// ToProtoHistoryEvent converts a thrift HistoryEvent to proto
+func ToProtoHistoryEvent(t *shared.HistoryEvent) *apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.HistoryEvent{
+		EventId:                                 t.GetEventId(),
+		Timestamp:                               t.GetTimestamp(),
+		EventType:                               ToProtoEventType(t.EventType),
+		Version:                                 t.GetVersion(),
+		TaskId:                                  t.GetTaskId(),
+		WorkflowExecutionStartedEventAttributes: ToProtoWorkflowExecutionStartedEventAttributes(t.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      ToProtoWorkflowExecutionCompletedEventAttributes(t.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         ToProtoWorkflowExecutionFailedEventAttributes(t.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       ToProtoWorkflowExecutionTimedOutEventAttributes(t.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           ToProtoDecisionTaskScheduledEventAttributes(t.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             ToProtoDecisionTaskStartedEventAttributes(t.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           ToProtoDecisionTaskCompletedEventAttributes(t.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            ToProtoDecisionTaskTimedOutEventAttributes(t.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              ToProtoDecisionTaskFailedEventAttributes(t.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           ToProtoActivityTaskScheduledEventAttributes(t.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             ToProtoActivityTaskStartedEventAttributes(t.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           ToProtoActivityTaskCompletedEventAttributes(t.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              ToProtoActivityTaskFailedEventAttributes(t.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            ToProtoActivityTaskTimedOutEventAttributes(t.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    ToProtoTimerStartedEventAttributes(t.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      ToProtoTimerFiredEventAttributes(t.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     ToProtoActivityTaskCancelRequestedEventAttributes(t.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 ToProtoRequestCancelActivityTaskFailedEventAttributes(t.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            ToProtoActivityTaskCanceledEventAttributes(t.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   ToProtoTimerCanceledEventAttributes(t.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               ToProtoCancelTimerFailedEventAttributes(t.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  ToProtoMarkerRecordedEventAttributes(t.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       ToProtoWorkflowExecutionSignaledEventAttributes(t.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     ToProtoWorkflowExecutionTerminatedEventAttributes(t.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                ToProtoWorkflowExecutionCancelRequestedEventAttributes(t.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       ToProtoWorkflowExecutionCanceledEventAttributes(t.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: ToProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(t.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    ToProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes(t.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        ToProtoExternalWorkflowExecutionCancelRequestedEventAttributes(t.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 ToProtoWorkflowExecutionContinuedAsNewEventAttributes(t.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            ToProtoStartChildWorkflowExecutionInitiatedEventAttributes(t.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               ToProtoStartChildWorkflowExecutionFailedEventAttributes(t.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   ToProtoChildWorkflowExecutionStartedEventAttributes(t.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 ToProtoChildWorkflowExecutionCompletedEventAttributes(t.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    ToProtoChildWorkflowExecutionFailedEventAttributes(t.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  ToProtoChildWorkflowExecutionCanceledEventAttributes(t.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  ToProtoChildWorkflowExecutionTimedOutEventAttributes(t.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                ToProtoChildWorkflowExecutionTerminatedEventAttributes(t.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        ToProtoSignalExternalWorkflowExecutionInitiatedEventAttributes(t.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           ToProtoSignalExternalWorkflowExecutionFailedEventAttributes(t.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               ToProtoExternalWorkflowExecutionSignaledEventAttributes(t.ExternalWorkflowExecutionSignaledEventAttributes),
+	}
+}
+
+// FromProtoHistoryEvent converts a proto HistoryEvent to thrift
+func FromProtoHistoryEvent(p *apiv1.HistoryEvent) *shared.HistoryEvent {
+	if p == nil {
+		return nil
+	}
+	return &shared.HistoryEvent{
+		EventId:                                 int64Ptr(p.EventId),
+		Timestamp:                               int64Ptr(p.Timestamp),
+		EventType:                               FromProtoEventType(p.EventType),
+		Version:                                 int64Ptr(p.Version),
+		TaskId:                                  int64Ptr(p.TaskId),
+		WorkflowExecutionStartedEventAttributes: FromProtoWorkflowExecutionStartedEventAttributes(p.WorkflowExecutionStartedEventAttributes),
+		WorkflowExecutionCompletedEventAttributes:                      FromProtoWorkflowExecutionCompletedEventAttributes(p.WorkflowExecutionCompletedEventAttributes),
+		WorkflowExecutionFailedEventAttributes:                         FromProtoWorkflowExecutionFailedEventAttributes(p.WorkflowExecutionFailedEventAttributes),
+		WorkflowExecutionTimedOutEventAttributes:                       FromProtoWorkflowExecutionTimedOutEventAttributes(p.WorkflowExecutionTimedOutEventAttributes),
+		DecisionTaskScheduledEventAttributes:                           FromProtoDecisionTaskScheduledEventAttributes(p.DecisionTaskScheduledEventAttributes),
+		DecisionTaskStartedEventAttributes:                             FromProtoDecisionTaskStartedEventAttributes(p.DecisionTaskStartedEventAttributes),
+		DecisionTaskCompletedEventAttributes:                           FromProtoDecisionTaskCompletedEventAttributes(p.DecisionTaskCompletedEventAttributes),
+		DecisionTaskTimedOutEventAttributes:                            FromProtoDecisionTaskTimedOutEventAttributes(p.DecisionTaskTimedOutEventAttributes),
+		DecisionTaskFailedEventAttributes:                              FromProtoDecisionTaskFailedEventAttributes(p.DecisionTaskFailedEventAttributes),
+		ActivityTaskScheduledEventAttributes:                           FromProtoActivityTaskScheduledEventAttributes(p.ActivityTaskScheduledEventAttributes),
+		ActivityTaskStartedEventAttributes:                             FromProtoActivityTaskStartedEventAttributes(p.ActivityTaskStartedEventAttributes),
+		ActivityTaskCompletedEventAttributes:                           FromProtoActivityTaskCompletedEventAttributes(p.ActivityTaskCompletedEventAttributes),
+		ActivityTaskFailedEventAttributes:                              FromProtoActivityTaskFailedEventAttributes(p.ActivityTaskFailedEventAttributes),
+		ActivityTaskTimedOutEventAttributes:                            FromProtoActivityTaskTimedOutEventAttributes(p.ActivityTaskTimedOutEventAttributes),
+		TimerStartedEventAttributes:                                    FromProtoTimerStartedEventAttributes(p.TimerStartedEventAttributes),
+		TimerFiredEventAttributes:                                      FromProtoTimerFiredEventAttributes(p.TimerFiredEventAttributes),
+		ActivityTaskCancelRequestedEventAttributes:                     FromProtoActivityTaskCancelRequestedEventAttributes(p.ActivityTaskCancelRequestedEventAttributes),
+		RequestCancelActivityTaskFailedEventAttributes:                 FromProtoRequestCancelActivityTaskFailedEventAttributes(p.RequestCancelActivityTaskFailedEventAttributes),
+		ActivityTaskCanceledEventAttributes:                            FromProtoActivityTaskCanceledEventAttributes(p.ActivityTaskCanceledEventAttributes),
+		TimerCanceledEventAttributes:                                   FromProtoTimerCanceledEventAttributes(p.TimerCanceledEventAttributes),
+		CancelTimerFailedEventAttributes:                               FromProtoCancelTimerFailedEventAttributes(p.CancelTimerFailedEventAttributes),
+		MarkerRecordedEventAttributes:                                  FromProtoMarkerRecordedEventAttributes(p.MarkerRecordedEventAttributes),
+		WorkflowExecutionSignaledEventAttributes:                       FromProtoWorkflowExecutionSignaledEventAttributes(p.WorkflowExecutionSignaledEventAttributes),
+		WorkflowExecutionTerminatedEventAttributes:                     FromProtoWorkflowExecutionTerminatedEventAttributes(p.WorkflowExecutionTerminatedEventAttributes),
+		WorkflowExecutionCancelRequestedEventAttributes:                FromProtoWorkflowExecutionCancelRequestedEventAttributes(p.WorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionCanceledEventAttributes:                       FromProtoWorkflowExecutionCanceledEventAttributes(p.WorkflowExecutionCanceledEventAttributes),
+		RequestCancelExternalWorkflowExecutionInitiatedEventAttributes: FromProtoRequestCancelExternalWorkflowExecutionInitiatedEventAttributes(p.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes),
+		RequestCancelExternalWorkflowExecutionFailedEventAttributes:    FromProtoRequestCancelExternalWorkflowExecutionFailedEventAttributes(p.RequestCancelExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionCancelRequestedEventAttributes:        FromProtoExternalWorkflowExecutionCancelRequestedEventAttributes(p.ExternalWorkflowExecutionCancelRequestedEventAttributes),
+		WorkflowExecutionContinuedAsNewEventAttributes:                 FromProtoWorkflowExecutionContinuedAsNewEventAttributes(p.WorkflowExecutionContinuedAsNewEventAttributes),
+		StartChildWorkflowExecutionInitiatedEventAttributes:            FromProtoStartChildWorkflowExecutionInitiatedEventAttributes(p.StartChildWorkflowExecutionInitiatedEventAttributes),
+		StartChildWorkflowExecutionFailedEventAttributes:               FromProtoStartChildWorkflowExecutionFailedEventAttributes(p.StartChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionStartedEventAttributes:                   FromProtoChildWorkflowExecutionStartedEventAttributes(p.ChildWorkflowExecutionStartedEventAttributes),
+		ChildWorkflowExecutionCompletedEventAttributes:                 FromProtoChildWorkflowExecutionCompletedEventAttributes(p.ChildWorkflowExecutionCompletedEventAttributes),
+		ChildWorkflowExecutionFailedEventAttributes:                    FromProtoChildWorkflowExecutionFailedEventAttributes(p.ChildWorkflowExecutionFailedEventAttributes),
+		ChildWorkflowExecutionCanceledEventAttributes:                  FromProtoChildWorkflowExecutionCanceledEventAttributes(p.ChildWorkflowExecutionCanceledEventAttributes),
+		ChildWorkflowExecutionTimedOutEventAttributes:                  FromProtoChildWorkflowExecutionTimedOutEventAttributes(p.ChildWorkflowExecutionTimedOutEventAttributes),
+		ChildWorkflowExecutionTerminatedEventAttributes:                FromProtoChildWorkflowExecutionTerminatedEventAttributes(p.ChildWorkflowExecutionTerminatedEventAttributes),
+		SignalExternalWorkflowExecutionInitiatedEventAttributes:        FromProtoSignalExternalWorkflowExecutionInitiatedEventAttributes(p.SignalExternalWorkflowExecutionInitiatedEventAttributes),
+		SignalExternalWorkflowExecutionFailedEventAttributes:           FromProtoSignalExternalWorkflowExecutionFailedEventAttributes(p.SignalExternalWorkflowExecutionFailedEventAttributes),
+		ExternalWorkflowExecutionSignaledEventAttributes:               FromProtoExternalWorkflowExecutionSignaledEventAttributes(p.ExternalWorkflowExecutionSignaledEventAttributes),
+	}
+}
+
+// ToProtoHistory converts a thrift History to proto
+func ToProtoHistory(t *shared.History) *apiv1.History {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.History{
+		Events: toProtoHistoryEventArray(t.Events),
+	}
+}
+
+// FromProtoHistory converts a proto History to thrift
+func FromProtoHistory(p *apiv1.History) *shared.History {
+	if p == nil {
+		return nil
+	}
+	return &shared.History{
+		Events: fromProtoHistoryEventArray(p.Events),
+	}
+}
+
+// ToProtoWorkflowExecutionFilter converts a thrift WorkflowExecutionFilter to proto
+func ToProtoWorkflowExecutionFilter(t *shared.WorkflowExecutionFilter) *apiv1.WorkflowExecutionFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowExecutionFilter{
+		WorkflowId: t.GetWorkflowId(),
+	}
+}
+
+// FromProtoWorkflowExecutionFilter converts a proto WorkflowExecutionFilter to thrift
+func FromProtoWorkflowExecutionFilter(p *apiv1.WorkflowExecutionFilter) *shared.WorkflowExecutionFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowExecutionFilter{
+		WorkflowId: stringPtr(p.WorkflowId),
+	}
+}
+
+// ToProtoWorkflowTypeFilter converts a thrift WorkflowTypeFilter to proto
+func ToProtoWorkflowTypeFilter(t *shared.WorkflowTypeFilter) *apiv1.WorkflowTypeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowTypeFilter{
+		Name: t.GetName(),
+	}
+}
+
+// FromProtoWorkflowTypeFilter converts a proto WorkflowTypeFilter to thrift
+func FromProtoWorkflowTypeFilter(p *apiv1.WorkflowTypeFilter) *shared.WorkflowTypeFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowTypeFilter{
+		Name: stringPtr(p.Name),
+	}
+}
+
+// ToProtoStartTimeFilter converts a thrift StartTimeFilter to proto
+func ToProtoStartTimeFilter(t *shared.StartTimeFilter) *apiv1.StartTimeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartTimeFilter{
+		EarliestTime: t.GetEarliestTime(),
+		LatestTime:   t.GetLatestTime(),
+	}
+}
+
+// FromProtoStartTimeFilter converts a proto StartTimeFilter to thrift
+func FromProtoStartTimeFilter(p *apiv1.StartTimeFilter) *shared.StartTimeFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartTimeFilter{
+		EarliestTime: int64Ptr(p.EarliestTime),
+		LatestTime:   int64Ptr(p.LatestTime),
+	}
+}
+
+// ToProtoDomainInfo converts a thrift DomainInfo to proto
+func ToProtoDomainInfo(t *shared.DomainInfo) *apiv1.DomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainInfo{
+		Name:        t.GetName(),
+		Status:      ToProtoDomainStatus(t.Status),
+		Description: t.GetDescription(),
+		OwnerEmail:  t.GetOwnerEmail(),
+		Data:        t.Data,
+		Uuid:        t.GetUUID(),
+	}
+}
+
+// FromProtoDomainInfo converts a proto DomainInfo to thrift
+func FromProtoDomainInfo(p *apiv1.DomainInfo) *shared.DomainInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainInfo{
+		Name:        stringPtr(p.Name),
+		Status:      FromProtoDomainStatus(p.Status),
+		Description: stringPtr(p.Description),
+		OwnerEmail:  stringPtr(p.OwnerEmail),
+		Data:        p.Data,
+		UUID:        stringPtr(p.Uuid),
+	}
+}
+
+// ToProtoDomainConfiguration converts a thrift DomainConfiguration to proto
+func ToProtoDomainConfiguration(t *shared.DomainConfiguration) *apiv1.DomainConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: toInt32Value(t.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             toBoolValue(t.EmitMetric),
+		ArchivalBucketName:                     toStringValue(t.ArchivalBucketName),
+		ArchivalRetentionPeriodInDays:          toInt32Value(t.ArchivalRetentionPeriodInDays),
+		ArchivalStatus:                         ToProtoArchivalStatus(t.ArchivalStatus),
+		ArchivalBucketOwner:                    toStringValue(t.ArchivalBucketOwner),
+	}
+}
+
+// FromProtoDomainConfiguration converts a proto DomainConfiguration to thrift
+func FromProtoDomainConfiguration(p *apiv1.DomainConfiguration) *shared.DomainConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainConfiguration{
+		WorkflowExecutionRetentionPeriodInDays: fromInt32Value(p.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             fromBoolValue(p.EmitMetric),
+		ArchivalBucketName:                     fromStringValue(p.ArchivalBucketName),
+		ArchivalRetentionPeriodInDays:          fromInt32Value(p.ArchivalRetentionPeriodInDays),
+		ArchivalStatus:                         FromProtoArchivalStatus(p.ArchivalStatus),
+		ArchivalBucketOwner:                    fromStringValue(p.ArchivalBucketOwner),
+	}
+}
+
+// ToProtoUpdateDomainInfo converts a thrift UpdateDomainInfo to proto
+func ToProtoUpdateDomainInfo(t *shared.UpdateDomainInfo) *apiv1.UpdateDomainInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainInfo{
+		Description: toStringValue(t.Description),
+		OwnerEmail:  toStringValue(t.OwnerEmail),
+		Data:        t.Data,
+	}
+}
+
+// FromProtoUpdateDomainInfo converts a proto UpdateDomainInfo to thrift
+func FromProtoUpdateDomainInfo(p *apiv1.UpdateDomainInfo) *shared.UpdateDomainInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainInfo{
+		Description: fromStringValue(p.Description),
+		OwnerEmail:  fromStringValue(p.OwnerEmail),
+		Data:        p.Data,
+	}
+}
+
+// ToProtoClusterReplicationConfiguration converts a thrift ClusterReplicationConfiguration to proto
+func ToProtoClusterReplicationConfiguration(t *shared.ClusterReplicationConfiguration) *apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ClusterReplicationConfiguration{
+		ClusterName: t.GetClusterName(),
+	}
+}
+
+// FromProtoClusterReplicationConfiguration converts a proto ClusterReplicationConfiguration to thrift
+func FromProtoClusterReplicationConfiguration(p *apiv1.ClusterReplicationConfiguration) *shared.ClusterReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.ClusterReplicationConfiguration{
+		ClusterName: stringPtr(p.ClusterName),
+	}
+}
+
+// ToProtoDomainReplicationConfiguration converts a thrift DomainReplicationConfiguration to proto
+func ToProtoDomainReplicationConfiguration(t *shared.DomainReplicationConfiguration) *apiv1.DomainReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DomainReplicationConfiguration{
+		ActiveClusterName: t.GetActiveClusterName(),
+		Clusters:          toProtoClusterReplicationConfigurationArray(t.Clusters),
+	}
+}
+
+// FromProtoDomainReplicationConfiguration converts a proto DomainReplicationConfiguration to thrift
+func FromProtoDomainReplicationConfiguration(p *apiv1.DomainReplicationConfiguration) *shared.DomainReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	return &shared.DomainReplicationConfiguration{
+		ActiveClusterName: stringPtr(p.ActiveClusterName),
+		Clusters:          fromProtoClusterReplicationConfigurationArray(p.Clusters),
+	}
+}
+
+// ToProtoRegisterDomainRequest converts a thrift RegisterDomainRequest to proto
+func ToProtoRegisterDomainRequest(t *shared.RegisterDomainRequest) *apiv1.RegisterDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RegisterDomainRequest{
+		Name:                                   t.GetName(),
+		Description:                            t.GetDescription(),
+		OwnerEmail:                             t.GetOwnerEmail(),
+		WorkflowExecutionRetentionPeriodInDays: t.GetWorkflowExecutionRetentionPeriodInDays(),
+		EmitMetric:                             t.GetEmitMetric(),
+		Clusters:                               toProtoClusterReplicationConfigurationArray(t.Clusters),
+		ActiveClusterName:                      t.GetActiveClusterName(),
+		Data:                                   t.Data,
+		SecurityToken:                          t.GetSecurityToken(),
+		ArchivalStatus:                         ToProtoArchivalStatus(t.ArchivalStatus),
+		ArchivalBucketName:                     t.GetArchivalBucketName(),
+	}
+}
+
+// FromProtoRegisterDomainRequest converts a proto RegisterDomainRequest to thrift
+func FromProtoRegisterDomainRequest(p *apiv1.RegisterDomainRequest) *shared.RegisterDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RegisterDomainRequest{
+		Name:                                   stringPtr(p.Name),
+		Description:                            stringPtr(p.Description),
+		OwnerEmail:                             stringPtr(p.OwnerEmail),
+		WorkflowExecutionRetentionPeriodInDays: int32Ptr(p.WorkflowExecutionRetentionPeriodInDays),
+		EmitMetric:                             boolPtr(p.EmitMetric),
+		Clusters:                               fromProtoClusterReplicationConfigurationArray(p.Clusters),
+		ActiveClusterName:                      stringPtr(p.ActiveClusterName),
+		Data:                                   p.Data,
+		SecurityToken:                          stringPtr(p.SecurityToken),
+		ArchivalStatus:                         FromProtoArchivalStatus(p.ArchivalStatus),
+		ArchivalBucketName:                     stringPtr(p.ArchivalBucketName),
+	}
+}
+
+// ToProtoListDomainsRequest converts a thrift ListDomainsRequest to proto
+func ToProtoListDomainsRequest(t *shared.ListDomainsRequest) *apiv1.ListDomainsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsRequest{
+		PageSize:      t.GetPageSize(),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromProtoListDomainsRequest converts a proto ListDomainsRequest to thrift
+func FromProtoListDomainsRequest(p *apiv1.ListDomainsRequest) *shared.ListDomainsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListDomainsRequest{
+		PageSize:      int32Ptr(p.PageSize),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// ToProtoListDomainsResponse converts a thrift ListDomainsResponse to proto
+func ToProtoListDomainsResponse(t *shared.ListDomainsResponse) *apiv1.ListDomainsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListDomainsResponse{
+		Domains:       toProtoDescribeDomainResponseArray(t.Domains),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromProtoListDomainsResponse converts a proto ListDomainsResponse to thrift
+func FromProtoListDomainsResponse(p *apiv1.ListDomainsResponse) *shared.ListDomainsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListDomainsResponse{
+		Domains:       fromProtoDescribeDomainResponseArray(p.Domains),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// ToProtoDescribeDomainRequest converts a thrift DescribeDomainRequest to proto
+func ToProtoDescribeDomainRequest(t *shared.DescribeDomainRequest) *apiv1.DescribeDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainRequest{
+		Name: t.GetName(),
+		Uuid: t.GetUUID(),
+	}
+}
+
+// FromProtoDescribeDomainRequest converts a proto DescribeDomainRequest to thrift
+func FromProtoDescribeDomainRequest(p *apiv1.DescribeDomainRequest) *shared.DescribeDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeDomainRequest{
+		Name: stringPtr(p.Name),
+		UUID: stringPtr(p.Uuid),
+	}
+}
+
+// ToProtoDescribeDomainResponse converts a thrift DescribeDomainResponse to proto
+func ToProtoDescribeDomainResponse(t *shared.DescribeDomainResponse) *apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeDomainResponse{
+		DomainInfo:               ToProtoDomainInfo(t.DomainInfo),
+		Configuration:            ToProtoDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: ToProtoDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+	}
+}
+
+// FromProtoDescribeDomainResponse converts a proto DescribeDomainResponse to thrift
+func FromProtoDescribeDomainResponse(p *apiv1.DescribeDomainResponse) *shared.DescribeDomainResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeDomainResponse{
+		DomainInfo:               FromProtoDomainInfo(p.DomainInfo),
+		Configuration:            FromProtoDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: FromProtoDomainReplicationConfiguration(p.ReplicationConfiguration),
+		FailoverVersion:          int64Ptr(p.FailoverVersion),
+		IsGlobalDomain:           boolPtr(p.IsGlobalDomain),
+	}
+}
+
+// ToProtoUpdateDomainRequest converts a thrift UpdateDomainRequest to proto
+func ToProtoUpdateDomainRequest(t *shared.UpdateDomainRequest) *apiv1.UpdateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainRequest{
+		Name:                     t.GetName(),
+		UpdatedInfo:              ToProtoUpdateDomainInfo(t.UpdatedInfo),
+		Configuration:            ToProtoDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: ToProtoDomainReplicationConfiguration(t.ReplicationConfiguration),
+		SecurityToken:            t.GetSecurityToken(),
+	}
+}
+
+// FromProtoUpdateDomainRequest converts a proto UpdateDomainRequest to thrift
+func FromProtoUpdateDomainRequest(p *apiv1.UpdateDomainRequest) *shared.UpdateDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainRequest{
+		Name:                     stringPtr(p.Name),
+		UpdatedInfo:              FromProtoUpdateDomainInfo(p.UpdatedInfo),
+		Configuration:            FromProtoDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: FromProtoDomainReplicationConfiguration(p.ReplicationConfiguration),
+		SecurityToken:            stringPtr(p.SecurityToken),
+	}
+}
+
+// ToProtoUpdateDomainResponse converts a thrift UpdateDomainResponse to proto
+func ToProtoUpdateDomainResponse(t *shared.UpdateDomainResponse) *apiv1.UpdateDomainResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.UpdateDomainResponse{
+		DomainInfo:               ToProtoDomainInfo(t.DomainInfo),
+		Configuration:            ToProtoDomainConfiguration(t.Configuration),
+		ReplicationConfiguration: ToProtoDomainReplicationConfiguration(t.ReplicationConfiguration),
+		FailoverVersion:          t.GetFailoverVersion(),
+		IsGlobalDomain:           t.GetIsGlobalDomain(),
+	}
+}
+
+// FromProtoUpdateDomainResponse converts a proto UpdateDomainResponse to thrift
+func FromProtoUpdateDomainResponse(p *apiv1.UpdateDomainResponse) *shared.UpdateDomainResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.UpdateDomainResponse{
+		DomainInfo:               FromProtoDomainInfo(p.DomainInfo),
+		Configuration:            FromProtoDomainConfiguration(p.Configuration),
+		ReplicationConfiguration: FromProtoDomainReplicationConfiguration(p.ReplicationConfiguration),
+		FailoverVersion:          int64Ptr(p.FailoverVersion),
+		IsGlobalDomain:           boolPtr(p.IsGlobalDomain),
+	}
+}
+
+// ToProtoDeprecateDomainRequest converts a thrift DeprecateDomainRequest to proto
+func ToProtoDeprecateDomainRequest(t *shared.DeprecateDomainRequest) *apiv1.DeprecateDomainRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DeprecateDomainRequest{
+		Name:          t.GetName(),
+		SecurityToken: t.GetSecurityToken(),
+	}
+}
+
+// FromProtoDeprecateDomainRequest converts a proto DeprecateDomainRequest to thrift
+func FromProtoDeprecateDomainRequest(p *apiv1.DeprecateDomainRequest) *shared.DeprecateDomainRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DeprecateDomainRequest{
+		Name:          stringPtr(p.Name),
+		SecurityToken: stringPtr(p.SecurityToken),
+	}
+}
+
+// ToProtoStartWorkflowExecutionRequest converts a thrift StartWorkflowExecutionRequest to proto
+func ToProtoStartWorkflowExecutionRequest(t *shared.StartWorkflowExecutionRequest) *apiv1.StartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        ToProtoWorkflowType(t.WorkflowType),
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               ToProtoWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		ChildPolicy:                         ToProtoChildPolicy(t.ChildPolicy),
+		RetryPolicy:                         ToProtoRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+	}
+}
+
+// FromProtoStartWorkflowExecutionRequest converts a proto StartWorkflowExecutionRequest to thrift
+func FromProtoStartWorkflowExecutionRequest(p *apiv1.StartWorkflowExecutionRequest) *shared.StartWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        FromProtoWorkflowType(p.WorkflowType),
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(p.Identity),
+		RequestId:                           stringPtr(p.RequestId),
+		WorkflowIdReusePolicy:               FromProtoWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		ChildPolicy:                         FromProtoChildPolicy(p.ChildPolicy),
+		RetryPolicy:                         FromProtoRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+	}
+}
+
+// ToProtoStartWorkflowExecutionResponse converts a thrift StartWorkflowExecutionResponse to proto
+func ToProtoStartWorkflowExecutionResponse(t *shared.StartWorkflowExecutionResponse) *apiv1.StartWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StartWorkflowExecutionResponse{
+		RunId: t.GetRunId(),
+	}
+}
+
+// FromProtoStartWorkflowExecutionResponse converts a proto StartWorkflowExecutionResponse to thrift
+func FromProtoStartWorkflowExecutionResponse(p *apiv1.StartWorkflowExecutionResponse) *shared.StartWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.StartWorkflowExecutionResponse{
+		RunId: stringPtr(p.RunId),
+	}
+}
+
+// ToProtoPollForDecisionTaskRequest converts a thrift PollForDecisionTaskRequest to proto
+func ToProtoPollForDecisionTaskRequest(t *shared.PollForDecisionTaskRequest) *apiv1.PollForDecisionTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskRequest{
+		Domain:   t.GetDomain(),
+		TaskList: ToProtoTaskList(t.TaskList),
+		Identity: t.GetIdentity(),
+	}
+}
+
+// FromProtoPollForDecisionTaskRequest converts a proto PollForDecisionTaskRequest to thrift
+func FromProtoPollForDecisionTaskRequest(p *apiv1.PollForDecisionTaskRequest) *shared.PollForDecisionTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskRequest{
+		Domain:   stringPtr(p.Domain),
+		TaskList: FromProtoTaskList(p.TaskList),
+		Identity: stringPtr(p.Identity),
+	}
+}
+
+// ToProtoPollForDecisionTaskResponse converts a thrift PollForDecisionTaskResponse to proto
+func ToProtoPollForDecisionTaskResponse(t *shared.PollForDecisionTaskResponse) *apiv1.PollForDecisionTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForDecisionTaskResponse{
+		TaskToken:                 t.TaskToken,
+		WorkflowExecution:         ToProtoWorkflowExecution(t.WorkflowExecution),
+		WorkflowType:              ToProtoWorkflowType(t.WorkflowType),
+		PreviousStartedEventId:    t.GetPreviousStartedEventId(),
+		StartedEventId:            t.GetStartedEventId(),
+		Attempt:                   t.GetAttempt(),
+		BacklogCountHint:          t.GetBacklogCountHint(),
+		History:                   ToProtoHistory(t.History),
+		NextPageToken:             t.NextPageToken,
+		Query:                     ToProtoWorkflowQuery(t.Query),
+		WorkflowExecutionTaskList: ToProtoTaskList(t.WorkflowExecutionTaskList),
+	}
+}
+
+// FromProtoPollForDecisionTaskResponse converts a proto PollForDecisionTaskResponse to thrift
+func FromProtoPollForDecisionTaskResponse(p *apiv1.PollForDecisionTaskResponse) *shared.PollForDecisionTaskResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForDecisionTaskResponse{
+		TaskToken:                 p.TaskToken,
+		WorkflowExecution:         FromProtoWorkflowExecution(p.WorkflowExecution),
+		WorkflowType:              FromProtoWorkflowType(p.WorkflowType),
+		PreviousStartedEventId:    int64Ptr(p.PreviousStartedEventId),
+		StartedEventId:            int64Ptr(p.StartedEventId),
+		Attempt:                   int64Ptr(p.Attempt),
+		BacklogCountHint:          int64Ptr(p.BacklogCountHint),
+		History:                   FromProtoHistory(p.History),
+		NextPageToken:             p.NextPageToken,
+		Query:                     FromProtoWorkflowQuery(p.Query),
+		WorkflowExecutionTaskList: FromProtoTaskList(p.WorkflowExecutionTaskList),
+	}
+}
+
+// ToProtoStickyExecutionAttributes converts a thrift StickyExecutionAttributes to proto
+func ToProtoStickyExecutionAttributes(t *shared.StickyExecutionAttributes) *apiv1.StickyExecutionAttributes {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.StickyExecutionAttributes{
+		WorkerTaskList:                ToProtoTaskList(t.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: t.GetScheduleToStartTimeoutSeconds(),
+	}
+}
+
+// FromProtoStickyExecutionAttributes converts a proto StickyExecutionAttributes to thrift
+func FromProtoStickyExecutionAttributes(p *apiv1.StickyExecutionAttributes) *shared.StickyExecutionAttributes {
+	if p == nil {
+		return nil
+	}
+	return &shared.StickyExecutionAttributes{
+		WorkerTaskList:                FromProtoTaskList(p.WorkerTaskList),
+		ScheduleToStartTimeoutSeconds: int32Ptr(p.ScheduleToStartTimeoutSeconds),
+	}
+}
+
+// ToProtoRespondDecisionTaskCompletedRequest converts a thrift RespondDecisionTaskCompletedRequest to proto
+func ToProtoRespondDecisionTaskCompletedRequest(t *shared.RespondDecisionTaskCompletedRequest) *apiv1.RespondDecisionTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  t.TaskToken,
+		Decisions:                  toProtoDecisionArray(t.Decisions),
+		ExecutionContext:           t.ExecutionContext,
+		Identity:                   t.GetIdentity(),
+		StickyAttributes:           ToProtoStickyExecutionAttributes(t.StickyAttributes),
+		ReturnNewDecisionTask:      t.GetReturnNewDecisionTask(),
+		ForceCreateNewDecisionTask: t.GetForceCreateNewDecisionTask(),
+		BinaryChecksum:             t.GetBinaryChecksum(),
+	}
+}
+
+// FromProtoRespondDecisionTaskCompletedRequest converts a proto RespondDecisionTaskCompletedRequest to thrift
+func FromProtoRespondDecisionTaskCompletedRequest(p *apiv1.RespondDecisionTaskCompletedRequest) *shared.RespondDecisionTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedRequest{
+		TaskToken:                  p.TaskToken,
+		Decisions:                  fromProtoDecisionArray(p.Decisions),
+		ExecutionContext:           p.ExecutionContext,
+		Identity:                   stringPtr(p.Identity),
+		StickyAttributes:           FromProtoStickyExecutionAttributes(p.StickyAttributes),
+		ReturnNewDecisionTask:      boolPtr(p.ReturnNewDecisionTask),
+		ForceCreateNewDecisionTask: boolPtr(p.ForceCreateNewDecisionTask),
+		BinaryChecksum:             stringPtr(p.BinaryChecksum),
+	}
+}
+
+// ToProtoRespondDecisionTaskCompletedResponse converts a thrift RespondDecisionTaskCompletedResponse to proto
+func ToProtoRespondDecisionTaskCompletedResponse(t *shared.RespondDecisionTaskCompletedResponse) *apiv1.RespondDecisionTaskCompletedResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskCompletedResponse{
+		DecisionTask: ToProtoPollForDecisionTaskResponse(t.DecisionTask),
+	}
+}
+
+// FromProtoRespondDecisionTaskCompletedResponse converts a proto RespondDecisionTaskCompletedResponse to thrift
+func FromProtoRespondDecisionTaskCompletedResponse(p *apiv1.RespondDecisionTaskCompletedResponse) *shared.RespondDecisionTaskCompletedResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskCompletedResponse{
+		DecisionTask: FromProtoPollForDecisionTaskResponse(p.DecisionTask),
+	}
+}
+
+// ToProtoRespondDecisionTaskFailedRequest converts a thrift RespondDecisionTaskFailedRequest to proto
+func ToProtoRespondDecisionTaskFailedRequest(t *shared.RespondDecisionTaskFailedRequest) *apiv1.RespondDecisionTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondDecisionTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Cause:     ToProtoDecisionTaskFailedCause(t.Cause),
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// FromProtoRespondDecisionTaskFailedRequest converts a proto RespondDecisionTaskFailedRequest to thrift
+func FromProtoRespondDecisionTaskFailedRequest(p *apiv1.RespondDecisionTaskFailedRequest) *shared.RespondDecisionTaskFailedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondDecisionTaskFailedRequest{
+		TaskToken: p.TaskToken,
+		Cause:     FromProtoDecisionTaskFailedCause(p.Cause),
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// ToProtoPollForActivityTaskRequest converts a thrift PollForActivityTaskRequest to proto
+func ToProtoPollForActivityTaskRequest(t *shared.PollForActivityTaskRequest) *apiv1.PollForActivityTaskRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskRequest{
+		Domain:           t.GetDomain(),
+		TaskList:         ToProtoTaskList(t.TaskList),
+		Identity:         t.GetIdentity(),
+		TaskListMetadata: ToProtoTaskListMetadata(t.TaskListMetadata),
+	}
+}
+
+// FromProtoPollForActivityTaskRequest converts a proto PollForActivityTaskRequest to thrift
+func FromProtoPollForActivityTaskRequest(p *apiv1.PollForActivityTaskRequest) *shared.PollForActivityTaskRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskRequest{
+		Domain:           stringPtr(p.Domain),
+		TaskList:         FromProtoTaskList(p.TaskList),
+		Identity:         stringPtr(p.Identity),
+		TaskListMetadata: FromProtoTaskListMetadata(p.TaskListMetadata),
+	}
+}
+
+// ToProtoPollForActivityTaskResponse converts a thrift PollForActivityTaskResponse to proto
+func ToProtoPollForActivityTaskResponse(t *shared.PollForActivityTaskResponse) *apiv1.PollForActivityTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollForActivityTaskResponse{
+		TaskToken:                       t.TaskToken,
+		WorkflowExecution:               ToProtoWorkflowExecution(t.WorkflowExecution),
+		ActivityId:                      t.GetActivityId(),
+		ActivityType:                    ToProtoActivityType(t.ActivityType),
+		Input:                           t.Input,
+		ScheduledTimestamp:              t.GetScheduledTimestamp(),
+		ScheduleToCloseTimeoutSeconds:   t.GetScheduleToCloseTimeoutSeconds(),
+		StartedTimestamp:                t.GetStartedTimestamp(),
+		StartToCloseTimeoutSeconds:      t.GetStartToCloseTimeoutSeconds(),
+		HeartbeatTimeoutSeconds:         t.GetHeartbeatTimeoutSeconds(),
+		Attempt:                         t.GetAttempt(),
+		ScheduledTimestampOfThisAttempt: t.GetScheduledTimestampOfThisAttempt(),
+		HeartbeatDetails:                t.HeartbeatDetails,
+		WorkflowType:                    ToProtoWorkflowType(t.WorkflowType),
+		WorkflowDomain:                  t.GetWorkflowDomain(),
+	}
+}
+
+// FromProtoPollForActivityTaskResponse converts a proto PollForActivityTaskResponse to thrift
+func FromProtoPollForActivityTaskResponse(p *apiv1.PollForActivityTaskResponse) *shared.PollForActivityTaskResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollForActivityTaskResponse{
+		TaskToken:                       p.TaskToken,
+		WorkflowExecution:               FromProtoWorkflowExecution(p.WorkflowExecution),
+		ActivityId:                      stringPtr(p.ActivityId),
+		ActivityType:                    FromProtoActivityType(p.ActivityType),
+		Input:                           p.Input,
+		ScheduledTimestamp:              int64Ptr(p.ScheduledTimestamp),
+		ScheduleToCloseTimeoutSeconds:   int32Ptr(p.ScheduleToCloseTimeoutSeconds),
+		StartedTimestamp:                int64Ptr(p.StartedTimestamp),
+		StartToCloseTimeoutSeconds:      int32Ptr(p.StartToCloseTimeoutSeconds),
+		HeartbeatTimeoutSeconds:         int32Ptr(p.HeartbeatTimeoutSeconds),
+		Attempt:                         int32Ptr(p.Attempt),
+		ScheduledTimestampOfThisAttempt: int64Ptr(p.ScheduledTimestampOfThisAttempt),
+		HeartbeatDetails:                p.HeartbeatDetails,
+		WorkflowType:                    FromProtoWorkflowType(p.WorkflowType),
+		WorkflowDomain:                  stringPtr(p.WorkflowDomain),
+	}
+}
+
+// ToProtoRecordActivityTaskHeartbeatRequest converts a thrift RecordActivityTaskHeartbeatRequest to proto
+func ToProtoRecordActivityTaskHeartbeatRequest(t *shared.RecordActivityTaskHeartbeatRequest) *apiv1.RecordActivityTaskHeartbeatRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatRequest{
+		TaskToken: t.TaskToken,
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// FromProtoRecordActivityTaskHeartbeatRequest converts a proto RecordActivityTaskHeartbeatRequest to thrift
+func FromProtoRecordActivityTaskHeartbeatRequest(p *apiv1.RecordActivityTaskHeartbeatRequest) *shared.RecordActivityTaskHeartbeatRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatRequest{
+		TaskToken: p.TaskToken,
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRecordActivityTaskHeartbeatByIDRequest converts a thrift RecordActivityTaskHeartbeatByIDRequest to proto
+func ToProtoRecordActivityTaskHeartbeatByIDRequest(t *shared.RecordActivityTaskHeartbeatByIDRequest) *apiv1.RecordActivityTaskHeartbeatByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromProtoRecordActivityTaskHeartbeatByIDRequest converts a proto RecordActivityTaskHeartbeatByIDRequest to thrift
+func FromProtoRecordActivityTaskHeartbeatByIDRequest(p *apiv1.RecordActivityTaskHeartbeatByIDRequest) *shared.RecordActivityTaskHeartbeatByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRecordActivityTaskHeartbeatResponse converts a thrift RecordActivityTaskHeartbeatResponse to proto
+func ToProtoRecordActivityTaskHeartbeatResponse(t *shared.RecordActivityTaskHeartbeatResponse) *apiv1.RecordActivityTaskHeartbeatResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: t.GetCancelRequested(),
+	}
+}
+
+// FromProtoRecordActivityTaskHeartbeatResponse converts a proto RecordActivityTaskHeartbeatResponse to thrift
+func FromProtoRecordActivityTaskHeartbeatResponse(p *apiv1.RecordActivityTaskHeartbeatResponse) *shared.RecordActivityTaskHeartbeatResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.RecordActivityTaskHeartbeatResponse{
+		CancelRequested: boolPtr(p.CancelRequested),
+	}
+}
+
+// ToProtoRespondActivityTaskCompletedRequest converts a thrift RespondActivityTaskCompletedRequest to proto
+func ToProtoRespondActivityTaskCompletedRequest(t *shared.RespondActivityTaskCompletedRequest) *apiv1.RespondActivityTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedRequest{
+		TaskToken: t.TaskToken,
+		Result:    t.Result,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// FromProtoRespondActivityTaskCompletedRequest converts a proto RespondActivityTaskCompletedRequest to thrift
+func FromProtoRespondActivityTaskCompletedRequest(p *apiv1.RespondActivityTaskCompletedRequest) *shared.RespondActivityTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedRequest{
+		TaskToken: p.TaskToken,
+		Result:    p.Result,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRespondActivityTaskFailedRequest converts a thrift RespondActivityTaskFailedRequest to proto
+func ToProtoRespondActivityTaskFailedRequest(t *shared.RespondActivityTaskFailedRequest) *apiv1.RespondActivityTaskFailedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedRequest{
+		TaskToken: t.TaskToken,
+		Reason:    t.GetReason(),
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// FromProtoRespondActivityTaskFailedRequest converts a proto RespondActivityTaskFailedRequest to thrift
+func FromProtoRespondActivityTaskFailedRequest(p *apiv1.RespondActivityTaskFailedRequest) *shared.RespondActivityTaskFailedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedRequest{
+		TaskToken: p.TaskToken,
+		Reason:    stringPtr(p.Reason),
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRespondActivityTaskCanceledRequest converts a thrift RespondActivityTaskCanceledRequest to proto
+func ToProtoRespondActivityTaskCanceledRequest(t *shared.RespondActivityTaskCanceledRequest) *apiv1.RespondActivityTaskCanceledRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledRequest{
+		TaskToken: t.TaskToken,
+		Details:   t.Details,
+		Identity:  t.GetIdentity(),
+	}
+}
+
+// FromProtoRespondActivityTaskCanceledRequest converts a proto RespondActivityTaskCanceledRequest to thrift
+func FromProtoRespondActivityTaskCanceledRequest(p *apiv1.RespondActivityTaskCanceledRequest) *shared.RespondActivityTaskCanceledRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledRequest{
+		TaskToken: p.TaskToken,
+		Details:   p.Details,
+		Identity:  stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRespondActivityTaskCompletedByIDRequest converts a thrift RespondActivityTaskCompletedByIDRequest to proto
+func ToProtoRespondActivityTaskCompletedByIDRequest(t *shared.RespondActivityTaskCompletedByIDRequest) *apiv1.RespondActivityTaskCompletedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Result:     t.Result,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromProtoRespondActivityTaskCompletedByIDRequest converts a proto RespondActivityTaskCompletedByIDRequest to thrift
+func FromProtoRespondActivityTaskCompletedByIDRequest(p *apiv1.RespondActivityTaskCompletedByIDRequest) *shared.RespondActivityTaskCompletedByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCompletedByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Result:     p.Result,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRespondActivityTaskFailedByIDRequest converts a thrift RespondActivityTaskFailedByIDRequest to proto
+func ToProtoRespondActivityTaskFailedByIDRequest(t *shared.RespondActivityTaskFailedByIDRequest) *apiv1.RespondActivityTaskFailedByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskFailedByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Reason:     t.GetReason(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromProtoRespondActivityTaskFailedByIDRequest converts a proto RespondActivityTaskFailedByIDRequest to thrift
+func FromProtoRespondActivityTaskFailedByIDRequest(p *apiv1.RespondActivityTaskFailedByIDRequest) *shared.RespondActivityTaskFailedByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskFailedByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Reason:     stringPtr(p.Reason),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRespondActivityTaskCanceledByIDRequest converts a thrift RespondActivityTaskCanceledByIDRequest to proto
+func ToProtoRespondActivityTaskCanceledByIDRequest(t *shared.RespondActivityTaskCanceledByIDRequest) *apiv1.RespondActivityTaskCanceledByIDRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDRequest{
+		Domain:     t.GetDomain(),
+		WorkflowId: t.GetWorkflowID(),
+		RunId:      t.GetRunID(),
+		ActivityId: t.GetActivityID(),
+		Details:    t.Details,
+		Identity:   t.GetIdentity(),
+	}
+}
+
+// FromProtoRespondActivityTaskCanceledByIDRequest converts a proto RespondActivityTaskCanceledByIDRequest to thrift
+func FromProtoRespondActivityTaskCanceledByIDRequest(p *apiv1.RespondActivityTaskCanceledByIDRequest) *shared.RespondActivityTaskCanceledByIDRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondActivityTaskCanceledByIDRequest{
+		Domain:     stringPtr(p.Domain),
+		WorkflowID: stringPtr(p.WorkflowId),
+		RunID:      stringPtr(p.RunId),
+		ActivityID: stringPtr(p.ActivityId),
+		Details:    p.Details,
+		Identity:   stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRequestCancelWorkflowExecutionRequest converts a thrift RequestCancelWorkflowExecutionRequest to proto
+func ToProtoRequestCancelWorkflowExecutionRequest(t *shared.RequestCancelWorkflowExecutionRequest) *apiv1.RequestCancelWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RequestCancelWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		Identity:          t.GetIdentity(),
+		RequestId:         t.GetRequestId(),
+	}
+}
+
+// FromProtoRequestCancelWorkflowExecutionRequest converts a proto RequestCancelWorkflowExecutionRequest to thrift
+func FromProtoRequestCancelWorkflowExecutionRequest(p *apiv1.RequestCancelWorkflowExecutionRequest) *shared.RequestCancelWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RequestCancelWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		Identity:          stringPtr(p.Identity),
+		RequestId:         stringPtr(p.RequestId),
+	}
+}
+
+// ToProtoGetWorkflowExecutionHistoryRequest converts a thrift GetWorkflowExecutionHistoryRequest to proto
+func ToProtoGetWorkflowExecutionHistoryRequest(t *shared.GetWorkflowExecutionHistoryRequest) *apiv1.GetWorkflowExecutionHistoryRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryRequest{
+		Domain:                 t.GetDomain(),
+		Execution:              ToProtoWorkflowExecution(t.Execution),
+		MaximumPageSize:        t.GetMaximumPageSize(),
+		NextPageToken:          t.NextPageToken,
+		WaitForNewEvent:        t.GetWaitForNewEvent(),
+		HistoryEventFilterType: ToProtoHistoryEventFilterType(t.HistoryEventFilterType),
+	}
+}
+
+// FromProtoGetWorkflowExecutionHistoryRequest converts a proto GetWorkflowExecutionHistoryRequest to thrift
+func FromProtoGetWorkflowExecutionHistoryRequest(p *apiv1.GetWorkflowExecutionHistoryRequest) *shared.GetWorkflowExecutionHistoryRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:                 stringPtr(p.Domain),
+		Execution:              FromProtoWorkflowExecution(p.Execution),
+		MaximumPageSize:        int32Ptr(p.MaximumPageSize),
+		NextPageToken:          p.NextPageToken,
+		WaitForNewEvent:        boolPtr(p.WaitForNewEvent),
+		HistoryEventFilterType: FromProtoHistoryEventFilterType(p.HistoryEventFilterType),
+	}
+}
+
+// ToProtoGetWorkflowExecutionHistoryResponse converts a thrift GetWorkflowExecutionHistoryResponse to proto
+func ToProtoGetWorkflowExecutionHistoryResponse(t *shared.GetWorkflowExecutionHistoryResponse) *apiv1.GetWorkflowExecutionHistoryResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.GetWorkflowExecutionHistoryResponse{
+		History:       ToProtoHistory(t.History),
+		NextPageToken: t.NextPageToken,
+		Archived:      t.GetArchived(),
+	}
+}
+
+// FromProtoGetWorkflowExecutionHistoryResponse converts a proto GetWorkflowExecutionHistoryResponse to thrift
+func FromProtoGetWorkflowExecutionHistoryResponse(p *apiv1.GetWorkflowExecutionHistoryResponse) *shared.GetWorkflowExecutionHistoryResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.GetWorkflowExecutionHistoryResponse{
+		History:       FromProtoHistory(p.History),
+		NextPageToken: p.NextPageToken,
+		Archived:      boolPtr(p.Archived),
+	}
+}
+
+// ToProtoSignalWorkflowExecutionRequest converts a thrift SignalWorkflowExecutionRequest to proto
+func ToProtoSignalWorkflowExecutionRequest(t *shared.SignalWorkflowExecutionRequest) *apiv1.SignalWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		SignalName:        t.GetSignalName(),
+		Input:             t.Input,
+		Identity:          t.GetIdentity(),
+		RequestId:         t.GetRequestId(),
+		Control:           t.Control,
+	}
+}
+
+// FromProtoSignalWorkflowExecutionRequest converts a proto SignalWorkflowExecutionRequest to thrift
+func FromProtoSignalWorkflowExecutionRequest(p *apiv1.SignalWorkflowExecutionRequest) *shared.SignalWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		SignalName:        stringPtr(p.SignalName),
+		Input:             p.Input,
+		Identity:          stringPtr(p.Identity),
+		RequestId:         stringPtr(p.RequestId),
+		Control:           p.Control,
+	}
+}
+
+// ToProtoSignalWithStartWorkflowExecutionRequest converts a thrift SignalWithStartWorkflowExecutionRequest to proto
+func ToProtoSignalWithStartWorkflowExecutionRequest(t *shared.SignalWithStartWorkflowExecutionRequest) *apiv1.SignalWithStartWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              t.GetDomain(),
+		WorkflowId:                          t.GetWorkflowId(),
+		WorkflowType:                        ToProtoWorkflowType(t.WorkflowType),
+		TaskList:                            ToProtoTaskList(t.TaskList),
+		Input:                               t.Input,
+		ExecutionStartToCloseTimeoutSeconds: t.GetExecutionStartToCloseTimeoutSeconds(),
+		TaskStartToCloseTimeoutSeconds:      t.GetTaskStartToCloseTimeoutSeconds(),
+		Identity:                            t.GetIdentity(),
+		RequestId:                           t.GetRequestId(),
+		WorkflowIdReusePolicy:               ToProtoWorkflowIdReusePolicy(t.WorkflowIdReusePolicy),
+		SignalName:                          t.GetSignalName(),
+		SignalInput:                         t.SignalInput,
+		Control:                             t.Control,
+		RetryPolicy:                         ToProtoRetryPolicy(t.RetryPolicy),
+		CronSchedule:                        t.GetCronSchedule(),
+	}
+}
+
+// FromProtoSignalWithStartWorkflowExecutionRequest converts a proto SignalWithStartWorkflowExecutionRequest to thrift
+func FromProtoSignalWithStartWorkflowExecutionRequest(p *apiv1.SignalWithStartWorkflowExecutionRequest) *shared.SignalWithStartWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.SignalWithStartWorkflowExecutionRequest{
+		Domain:                              stringPtr(p.Domain),
+		WorkflowId:                          stringPtr(p.WorkflowId),
+		WorkflowType:                        FromProtoWorkflowType(p.WorkflowType),
+		TaskList:                            FromProtoTaskList(p.TaskList),
+		Input:                               p.Input,
+		ExecutionStartToCloseTimeoutSeconds: int32Ptr(p.ExecutionStartToCloseTimeoutSeconds),
+		TaskStartToCloseTimeoutSeconds:      int32Ptr(p.TaskStartToCloseTimeoutSeconds),
+		Identity:                            stringPtr(p.Identity),
+		RequestId:                           stringPtr(p.RequestId),
+		WorkflowIdReusePolicy:               FromProtoWorkflowIdReusePolicy(p.WorkflowIdReusePolicy),
+		SignalName:                          stringPtr(p.SignalName),
+		SignalInput:                         p.SignalInput,
+		Control:                             p.Control,
+		RetryPolicy:                         FromProtoRetryPolicy(p.RetryPolicy),
+		CronSchedule:                        stringPtr(p.CronSchedule),
+	}
+}
+
+// ToProtoTerminateWorkflowExecutionRequest converts a thrift TerminateWorkflowExecutionRequest to proto
+func ToProtoTerminateWorkflowExecutionRequest(t *shared.TerminateWorkflowExecutionRequest) *apiv1.TerminateWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TerminateWorkflowExecutionRequest{
+		Domain:            t.GetDomain(),
+		WorkflowExecution: ToProtoWorkflowExecution(t.WorkflowExecution),
+		Reason:            t.GetReason(),
+		Details:           t.Details,
+		Identity:          t.GetIdentity(),
+	}
+}
+
+// FromProtoTerminateWorkflowExecutionRequest converts a proto TerminateWorkflowExecutionRequest to thrift
+func FromProtoTerminateWorkflowExecutionRequest(p *apiv1.TerminateWorkflowExecutionRequest) *shared.TerminateWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.TerminateWorkflowExecutionRequest{
+		Domain:            stringPtr(p.Domain),
+		WorkflowExecution: FromProtoWorkflowExecution(p.WorkflowExecution),
+		Reason:            stringPtr(p.Reason),
+		Details:           p.Details,
+		Identity:          stringPtr(p.Identity),
+	}
+}
+
+// ToProtoResetWorkflowExecutionRequest converts a thrift ResetWorkflowExecutionRequest to proto
+func ToProtoResetWorkflowExecutionRequest(t *shared.ResetWorkflowExecutionRequest) *apiv1.ResetWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetWorkflowExecutionRequest{
+		Domain:                t.GetDomain(),
+		WorkflowExecution:     ToProtoWorkflowExecution(t.WorkflowExecution),
+		Reason:                t.GetReason(),
+		DecisionFinishEventId: t.GetDecisionFinishEventId(),
+		RequestId:             t.GetRequestId(),
+	}
+}
+
+// FromProtoResetWorkflowExecutionRequest converts a proto ResetWorkflowExecutionRequest to thrift
+func FromProtoResetWorkflowExecutionRequest(p *apiv1.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionRequest{
+		Domain:                stringPtr(p.Domain),
+		WorkflowExecution:     FromProtoWorkflowExecution(p.WorkflowExecution),
+		Reason:                stringPtr(p.Reason),
+		DecisionFinishEventId: int64Ptr(p.DecisionFinishEventId),
+		RequestId:             stringPtr(p.RequestId),
+	}
+}
+
+// ToProtoResetWorkflowExecutionResponse converts a thrift ResetWorkflowExecutionResponse to proto
+func ToProtoResetWorkflowExecutionResponse(t *shared.ResetWorkflowExecutionResponse) *apiv1.ResetWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetWorkflowExecutionResponse{
+		RunId: t.GetRunId(),
+	}
+}
+
+// FromProtoResetWorkflowExecutionResponse converts a proto ResetWorkflowExecutionResponse to thrift
+func FromProtoResetWorkflowExecutionResponse(p *apiv1.ResetWorkflowExecutionResponse) *shared.ResetWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetWorkflowExecutionResponse{
+		RunId: stringPtr(p.RunId),
+	}
+}
+
+// ToProtoListOpenWorkflowExecutionsRequest converts a thrift ListOpenWorkflowExecutionsRequest to proto
+func ToProtoListOpenWorkflowExecutionsRequest(t *shared.ListOpenWorkflowExecutionsRequest) *apiv1.ListOpenWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: ToProtoStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: ToProtoWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      ToProtoWorkflowTypeFilter(t.TypeFilter),
+	}
+}
+
+// FromProtoListOpenWorkflowExecutionsRequest converts a proto ListOpenWorkflowExecutionsRequest to thrift
+func FromProtoListOpenWorkflowExecutionsRequest(p *apiv1.ListOpenWorkflowExecutionsRequest) *shared.ListOpenWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsRequest{
+		Domain:          stringPtr(p.Domain),
+		MaximumPageSize: int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+		StartTimeFilter: FromProtoStartTimeFilter(p.StartTimeFilter),
+		ExecutionFilter: FromProtoWorkflowExecutionFilter(p.ExecutionFilter),
+		TypeFilter:      FromProtoWorkflowTypeFilter(p.TypeFilter),
+	}
+}
+
+// ToProtoListOpenWorkflowExecutionsResponse converts a thrift ListOpenWorkflowExecutionsResponse to proto
+func ToProtoListOpenWorkflowExecutionsResponse(t *shared.ListOpenWorkflowExecutionsResponse) *apiv1.ListOpenWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListOpenWorkflowExecutionsResponse{
+		Executions:    toProtoWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromProtoListOpenWorkflowExecutionsResponse converts a proto ListOpenWorkflowExecutionsResponse to thrift
+func FromProtoListOpenWorkflowExecutionsResponse(p *apiv1.ListOpenWorkflowExecutionsResponse) *shared.ListOpenWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListOpenWorkflowExecutionsResponse{
+		Executions:    fromProtoWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// ToProtoListClosedWorkflowExecutionsRequest converts a thrift ListClosedWorkflowExecutionsRequest to proto
+func ToProtoListClosedWorkflowExecutionsRequest(t *shared.ListClosedWorkflowExecutionsRequest) *apiv1.ListClosedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+		StartTimeFilter: ToProtoStartTimeFilter(t.StartTimeFilter),
+		ExecutionFilter: ToProtoWorkflowExecutionFilter(t.ExecutionFilter),
+		TypeFilter:      ToProtoWorkflowTypeFilter(t.TypeFilter),
+		StatusFilter:    ToProtoWorkflowExecutionCloseStatus(t.StatusFilter),
+	}
+}
+
+// FromProtoListClosedWorkflowExecutionsRequest converts a proto ListClosedWorkflowExecutionsRequest to thrift
+func FromProtoListClosedWorkflowExecutionsRequest(p *apiv1.ListClosedWorkflowExecutionsRequest) *shared.ListClosedWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsRequest{
+		Domain:          stringPtr(p.Domain),
+		MaximumPageSize: int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+		StartTimeFilter: FromProtoStartTimeFilter(p.StartTimeFilter),
+		ExecutionFilter: FromProtoWorkflowExecutionFilter(p.ExecutionFilter),
+		TypeFilter:      FromProtoWorkflowTypeFilter(p.TypeFilter),
+		StatusFilter:    FromProtoWorkflowExecutionCloseStatus(p.StatusFilter),
+	}
+}
+
+// ToProtoListClosedWorkflowExecutionsResponse converts a thrift ListClosedWorkflowExecutionsResponse to proto
+func ToProtoListClosedWorkflowExecutionsResponse(t *shared.ListClosedWorkflowExecutionsResponse) *apiv1.ListClosedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListClosedWorkflowExecutionsResponse{
+		Executions:    toProtoWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromProtoListClosedWorkflowExecutionsResponse converts a proto ListClosedWorkflowExecutionsResponse to thrift
+func FromProtoListClosedWorkflowExecutionsResponse(p *apiv1.ListClosedWorkflowExecutionsResponse) *shared.ListClosedWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListClosedWorkflowExecutionsResponse{
+		Executions:    fromProtoWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// ToProtoCloseTimeFilter converts a thrift CloseTimeFilter to proto
+func ToProtoCloseTimeFilter(t *shared.CloseTimeFilter) *apiv1.CloseTimeFilter {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.CloseTimeFilter{
+		EarliestTime: t.GetEarliestTime(),
+		LatestTime:   t.GetLatestTime(),
+	}
+}
+
+// FromProtoCloseTimeFilter converts a proto CloseTimeFilter to thrift
+func FromProtoCloseTimeFilter(p *apiv1.CloseTimeFilter) *shared.CloseTimeFilter {
+	if p == nil {
+		return nil
+	}
+	return &shared.CloseTimeFilter{
+		EarliestTime: int64Ptr(p.EarliestTime),
+		LatestTime:   int64Ptr(p.LatestTime),
+	}
+}
+
+// ToProtoListArchivedWorkflowExecutionsRequest converts a thrift ListArchivedWorkflowExecutionsRequest to proto
+func ToProtoListArchivedWorkflowExecutionsRequest(t *shared.ListArchivedWorkflowExecutionsRequest) *apiv1.ListArchivedWorkflowExecutionsRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListArchivedWorkflowExecutionsRequest{
+		Domain:          t.GetDomain(),
+		MaximumPageSize: t.GetMaximumPageSize(),
+		NextPageToken:   t.NextPageToken,
+		CloseTimeFilter: ToProtoCloseTimeFilter(t.CloseTimeFilter),
+		TypeFilter:      ToProtoWorkflowTypeFilter(t.TypeFilter),
+		StatusFilter:    ToProtoWorkflowExecutionCloseStatus(t.StatusFilter),
+	}
+}
+
+// FromProtoListArchivedWorkflowExecutionsRequest converts a proto ListArchivedWorkflowExecutionsRequest to thrift
+func FromProtoListArchivedWorkflowExecutionsRequest(p *apiv1.ListArchivedWorkflowExecutionsRequest) *shared.ListArchivedWorkflowExecutionsRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListArchivedWorkflowExecutionsRequest{
+		Domain:          stringPtr(p.Domain),
+		MaximumPageSize: int32Ptr(p.MaximumPageSize),
+		NextPageToken:   p.NextPageToken,
+		CloseTimeFilter: FromProtoCloseTimeFilter(p.CloseTimeFilter),
+		TypeFilter:      FromProtoWorkflowTypeFilter(p.TypeFilter),
+		StatusFilter:    FromProtoWorkflowExecutionCloseStatus(p.StatusFilter),
+	}
+}
+
+// ToProtoListArchivedWorkflowExecutionsResponse converts a thrift ListArchivedWorkflowExecutionsResponse to proto
+func ToProtoListArchivedWorkflowExecutionsResponse(t *shared.ListArchivedWorkflowExecutionsResponse) *apiv1.ListArchivedWorkflowExecutionsResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ListArchivedWorkflowExecutionsResponse{
+		Executions:    toProtoWorkflowExecutionInfoArray(t.Executions),
+		NextPageToken: t.NextPageToken,
+	}
+}
+
+// FromProtoListArchivedWorkflowExecutionsResponse converts a proto ListArchivedWorkflowExecutionsResponse to thrift
+func FromProtoListArchivedWorkflowExecutionsResponse(p *apiv1.ListArchivedWorkflowExecutionsResponse) *shared.ListArchivedWorkflowExecutionsResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ListArchivedWorkflowExecutionsResponse{
+		Executions:    fromProtoWorkflowExecutionInfoArray(p.Executions),
+		NextPageToken: p.NextPageToken,
+	}
+}
+
+// ToProtoQueryWorkflowRequest converts a thrift QueryWorkflowRequest to proto
+func ToProtoQueryWorkflowRequest(t *shared.QueryWorkflowRequest) *apiv1.QueryWorkflowRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowRequest{
+		Domain:    t.GetDomain(),
+		Execution: ToProtoWorkflowExecution(t.Execution),
+		Query:     ToProtoWorkflowQuery(t.Query),
+	}
+}
+
+// FromProtoQueryWorkflowRequest converts a proto QueryWorkflowRequest to thrift
+func FromProtoQueryWorkflowRequest(p *apiv1.QueryWorkflowRequest) *shared.QueryWorkflowRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: FromProtoWorkflowExecution(p.Execution),
+		Query:     FromProtoWorkflowQuery(p.Query),
+	}
+}
+
+// ToProtoQueryWorkflowResponse converts a thrift QueryWorkflowResponse to proto
+func ToProtoQueryWorkflowResponse(t *shared.QueryWorkflowResponse) *apiv1.QueryWorkflowResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.QueryWorkflowResponse{
+		QueryResult: t.QueryResult,
+	}
+}
+
+// FromProtoQueryWorkflowResponse converts a proto QueryWorkflowResponse to thrift
+func FromProtoQueryWorkflowResponse(p *apiv1.QueryWorkflowResponse) *shared.QueryWorkflowResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.QueryWorkflowResponse{
+		QueryResult: p.QueryResult,
+	}
+}
+
+// ToProtoWorkflowQuery converts a thrift WorkflowQuery to proto
+func ToProtoWorkflowQuery(t *shared.WorkflowQuery) *apiv1.WorkflowQuery {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.WorkflowQuery{
+		QueryType: t.GetQueryType(),
+		QueryArgs: t.QueryArgs,
+	}
+}
+
+// FromProtoWorkflowQuery converts a proto WorkflowQuery to thrift
+func FromProtoWorkflowQuery(p *apiv1.WorkflowQuery) *shared.WorkflowQuery {
+	if p == nil {
+		return nil
+	}
+	return &shared.WorkflowQuery{
+		QueryType: stringPtr(p.QueryType),
+		QueryArgs: p.QueryArgs,
+	}
+}
+
+// ToProtoResetStickyTaskListRequest converts a thrift ResetStickyTaskListRequest to proto
+func ToProtoResetStickyTaskListRequest(t *shared.ResetStickyTaskListRequest) *apiv1.ResetStickyTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListRequest{
+		Domain:    t.GetDomain(),
+		Execution: ToProtoWorkflowExecution(t.Execution),
+	}
+}
+
+// FromProtoResetStickyTaskListRequest converts a proto ResetStickyTaskListRequest to thrift
+func FromProtoResetStickyTaskListRequest(p *apiv1.ResetStickyTaskListRequest) *shared.ResetStickyTaskListRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: FromProtoWorkflowExecution(p.Execution),
+	}
+}
+
+// ToProtoResetStickyTaskListResponse converts a thrift ResetStickyTaskListResponse to proto
+func ToProtoResetStickyTaskListResponse(t *shared.ResetStickyTaskListResponse) *apiv1.ResetStickyTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.ResetStickyTaskListResponse{}
+}
+
+// FromProtoResetStickyTaskListResponse converts a proto ResetStickyTaskListResponse to thrift
+func FromProtoResetStickyTaskListResponse(p *apiv1.ResetStickyTaskListResponse) *shared.ResetStickyTaskListResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.ResetStickyTaskListResponse{}
+}
+
+// ToProtoRespondQueryTaskCompletedRequest converts a thrift RespondQueryTaskCompletedRequest to proto
+func ToProtoRespondQueryTaskCompletedRequest(t *shared.RespondQueryTaskCompletedRequest) *apiv1.RespondQueryTaskCompletedRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RespondQueryTaskCompletedRequest{
+		TaskToken:     t.TaskToken,
+		CompletedType: ToProtoQueryTaskCompletedType(t.CompletedType),
+		QueryResult:   t.QueryResult,
+		ErrorMessage:  t.GetErrorMessage(),
+	}
+}
+
+// FromProtoRespondQueryTaskCompletedRequest converts a proto RespondQueryTaskCompletedRequest to thrift
+func FromProtoRespondQueryTaskCompletedRequest(p *apiv1.RespondQueryTaskCompletedRequest) *shared.RespondQueryTaskCompletedRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.RespondQueryTaskCompletedRequest{
+		TaskToken:     p.TaskToken,
+		CompletedType: FromProtoQueryTaskCompletedType(p.CompletedType),
+		QueryResult:   p.QueryResult,
+		ErrorMessage:  stringPtr(p.ErrorMessage),
+	}
+}
+
+// ToProtoDescribeWorkflowExecutionRequest converts a thrift DescribeWorkflowExecutionRequest to proto
+func ToProtoDescribeWorkflowExecutionRequest(t *shared.DescribeWorkflowExecutionRequest) *apiv1.DescribeWorkflowExecutionRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionRequest{
+		Domain:    t.GetDomain(),
+		Execution: ToProtoWorkflowExecution(t.Execution),
+	}
+}
+
+// FromProtoDescribeWorkflowExecutionRequest converts a proto DescribeWorkflowExecutionRequest to thrift
+func FromProtoDescribeWorkflowExecutionRequest(p *apiv1.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionRequest{
+		Domain:    stringPtr(p.Domain),
+		Execution: FromProtoWorkflowExecution(p.Execution),
+	}
+}
+
+// ToProtoPendingActivityInfo converts a thrift PendingActivityInfo to proto
+func ToProtoPendingActivityInfo(t *shared.PendingActivityInfo) *apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PendingActivityInfo{
+		ActivityId:             t.GetActivityID(),
+		ActivityType:           ToProtoActivityType(t.ActivityType),
+		State:                  ToProtoPendingActivityState(t.State),
+		HeartbeatDetails:       t.HeartbeatDetails,
+		LastHeartbeatTimestamp: t.GetLastHeartbeatTimestamp(),
+		LastStartedTimestamp:   t.GetLastStartedTimestamp(),
+		Attempt:                t.GetAttempt(),
+		MaximumAttempts:        t.GetMaximumAttempts(),
+		ScheduledTimestamp:     t.GetScheduledTimestamp(),
+		ExpirationTimestamp:    t.GetExpirationTimestamp(),
+	}
+}
+
+// FromProtoPendingActivityInfo converts a proto PendingActivityInfo to thrift
+func FromProtoPendingActivityInfo(p *apiv1.PendingActivityInfo) *shared.PendingActivityInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.PendingActivityInfo{
+		ActivityID:             stringPtr(p.ActivityId),
+		ActivityType:           FromProtoActivityType(p.ActivityType),
+		State:                  FromProtoPendingActivityState(p.State),
+		HeartbeatDetails:       p.HeartbeatDetails,
+		LastHeartbeatTimestamp: int64Ptr(p.LastHeartbeatTimestamp),
+		LastStartedTimestamp:   int64Ptr(p.LastStartedTimestamp),
+		Attempt:                int32Ptr(p.Attempt),
+		MaximumAttempts:        int32Ptr(p.MaximumAttempts),
+		ScheduledTimestamp:     int64Ptr(p.ScheduledTimestamp),
+		ExpirationTimestamp:    int64Ptr(p.ExpirationTimestamp),
+	}
+}
+
+// ToProtoDescribeWorkflowExecutionResponse converts a thrift DescribeWorkflowExecutionResponse to proto
+func ToProtoDescribeWorkflowExecutionResponse(t *shared.DescribeWorkflowExecutionResponse) *apiv1.DescribeWorkflowExecutionResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: ToProtoWorkflowExecutionConfiguration(t.ExecutionConfiguration),
+		WorkflowExecutionInfo:  ToProtoWorkflowExecutionInfo(t.WorkflowExecutionInfo),
+		PendingActivities:      toProtoPendingActivityInfoArray(t.PendingActivities),
+	}
+}
+
+// FromProtoDescribeWorkflowExecutionResponse converts a proto DescribeWorkflowExecutionResponse to thrift
+func FromProtoDescribeWorkflowExecutionResponse(p *apiv1.DescribeWorkflowExecutionResponse) *shared.DescribeWorkflowExecutionResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeWorkflowExecutionResponse{
+		ExecutionConfiguration: FromProtoWorkflowExecutionConfiguration(p.ExecutionConfiguration),
+		WorkflowExecutionInfo:  FromProtoWorkflowExecutionInfo(p.WorkflowExecutionInfo),
+		PendingActivities:      fromProtoPendingActivityInfoArray(p.PendingActivities),
+	}
+}
+
+// ToProtoDescribeTaskListRequest converts a thrift DescribeTaskListRequest to proto
+func ToProtoDescribeTaskListRequest(t *shared.DescribeTaskListRequest) *apiv1.DescribeTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListRequest{
+		Domain:                t.GetDomain(),
+		TaskList:              ToProtoTaskList(t.TaskList),
+		TaskListType:          ToProtoTaskListType(t.TaskListType),
+		IncludeTaskListStatus: t.GetIncludeTaskListStatus(),
+	}
+}
+
+// FromProtoDescribeTaskListRequest converts a proto DescribeTaskListRequest to thrift
+func FromProtoDescribeTaskListRequest(p *apiv1.DescribeTaskListRequest) *shared.DescribeTaskListRequest {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListRequest{
+		Domain:                stringPtr(p.Domain),
+		TaskList:              FromProtoTaskList(p.TaskList),
+		TaskListType:          FromProtoTaskListType(p.TaskListType),
+		IncludeTaskListStatus: boolPtr(p.IncludeTaskListStatus),
+	}
+}
+
+// ToProtoDescribeTaskListResponse converts a thrift DescribeTaskListResponse to proto
+func ToProtoDescribeTaskListResponse(t *shared.DescribeTaskListResponse) *apiv1.DescribeTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.DescribeTaskListResponse{
+		Pollers:        toProtoPollerInfoArray(t.Pollers),
+		TaskListStatus: ToProtoTaskListStatus(t.TaskListStatus),
+	}
+}
+
+// FromProtoDescribeTaskListResponse converts a proto DescribeTaskListResponse to thrift
+func FromProtoDescribeTaskListResponse(p *apiv1.DescribeTaskListResponse) *shared.DescribeTaskListResponse {
+	if p == nil {
+		return nil
+	}
+	return &shared.DescribeTaskListResponse{
+		Pollers:        fromProtoPollerInfoArray(p.Pollers),
+		TaskListStatus: FromProtoTaskListStatus(p.TaskListStatus),
+	}
+}
+
+// ToProtoTaskListStatus converts a thrift TaskListStatus to proto
+func ToProtoTaskListStatus(t *shared.TaskListStatus) *apiv1.TaskListStatus {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskListStatus{
+		BacklogCountHint: t.GetBacklogCountHint(),
+		ReadLevel:        t.GetReadLevel(),
+		AckLevel:         t.GetAckLevel(),
+		TaskIdBlock:      ToProtoTaskIDBlock(t.TaskIDBlock),
+	}
+}
+
+// FromProtoTaskListStatus converts a proto TaskListStatus to thrift
+func FromProtoTaskListStatus(p *apiv1.TaskListStatus) *shared.TaskListStatus {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskListStatus{
+		BacklogCountHint: int64Ptr(p.BacklogCountHint),
+		ReadLevel:        int64Ptr(p.ReadLevel),
+		AckLevel:         int64Ptr(p.AckLevel),
+		TaskIDBlock:      FromProtoTaskIDBlock(p.TaskIdBlock),
+	}
+}
+
+// ToProtoTaskIDBlock converts a thrift TaskIDBlock to proto
+func ToProtoTaskIDBlock(t *shared.TaskIDBlock) *apiv1.TaskIDBlock {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.TaskIDBlock{
+		StartId: t.GetStartID(),
+		EndId:   t.GetEndID(),
+	}
+}
+
+// FromProtoTaskIDBlock converts a proto TaskIDBlock to thrift
+func FromProtoTaskIDBlock(p *apiv1.TaskIDBlock) *shared.TaskIDBlock {
+	if p == nil {
+		return nil
+	}
+	return &shared.TaskIDBlock{
+		StartID: int64Ptr(p.StartId),
+		EndID:   int64Ptr(p.EndId),
+	}
+}
+
+// ToProtoTaskListType converts a thrift TaskListType to proto, the proto
+// values are shifted by one to leave room for TASK_LIST_TYPE_INVALID
+func ToProtoTaskListType(t *shared.TaskListType) apiv1.TaskListType {
+	if t == nil {
+		return apiv1.TASK_LIST_TYPE_INVALID
+	}
+	return apiv1.TaskListType(*t + 1)
+}
+
+// FromProtoTaskListType converts a proto TaskListType to thrift
+func FromProtoTaskListType(p apiv1.TaskListType) *shared.TaskListType {
+	if p == apiv1.TASK_LIST_TYPE_INVALID {
+		return nil
+	}
+	t := shared.TaskListType(p - 1)
+	return &t
+}
+
+// ToProtoPollerInfo converts a thrift PollerInfo to proto
+func ToProtoPollerInfo(t *shared.PollerInfo) *apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.PollerInfo{
+		LastAccessTime: t.GetLastAccessTime(),
+		Identity:       t.GetIdentity(),
+	}
+}
+
+// FromProtoPollerInfo converts a proto PollerInfo to thrift
+func FromProtoPollerInfo(p *apiv1.PollerInfo) *shared.PollerInfo {
+	if p == nil {
+		return nil
+	}
+	return &shared.PollerInfo{
+		LastAccessTime: int64Ptr(p.LastAccessTime),
+		Identity:       stringPtr(p.Identity),
+	}
+}
+
+// ToProtoRetryPolicy converts a thrift RetryPolicy to proto
+func ToProtoRetryPolicy(t *shared.RetryPolicy) *apiv1.RetryPolicy {
+	if t == nil {
+		return nil
+	}
+	return &apiv1.RetryPolicy{
+		InitialIntervalInSeconds:    t.GetInitialIntervalInSeconds(),
+		BackoffCoefficient:          t.GetBackoffCoefficient(),
+		MaximumIntervalInSeconds:    t.GetMaximumIntervalInSeconds(),
+		MaximumAttempts:             t.GetMaximumAttempts(),
+		NonRetriableErrorReasons:    t.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: t.GetExpirationIntervalInSeconds(),
+	}
+}
+
+// FromProtoRetryPolicy converts a proto RetryPolicy to thrift
+func FromProtoRetryPolicy(p *apiv1.RetryPolicy) *shared.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+	return &shared.RetryPolicy{
+		InitialIntervalInSeconds:    int32Ptr(p.InitialIntervalInSeconds),
+		BackoffCoefficient:          float64Ptr(p.BackoffCoefficient),
+		MaximumIntervalInSeconds:    int32Ptr(p.MaximumIntervalInSeconds),
+		MaximumAttempts:             int32Ptr(p.MaximumAttempts),
+		NonRetriableErrorReasons:    p.NonRetriableErrorReasons,
+		ExpirationIntervalInSeconds: int32Ptr(p.ExpirationIntervalInSeconds),
+	}
+}
+
+func toProtoHistoryEventArray(t []*shared.HistoryEvent) []*apiv1.HistoryEvent {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.HistoryEvent, len(t))
+	for i := range t {
+		v[i] = ToProtoHistoryEvent(t[i])
+	}
+	return v
+}
+
+func fromProtoHistoryEventArray(p []*apiv1.HistoryEvent) []*shared.HistoryEvent {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.HistoryEvent, len(p))
+	for i := range p {
+		v[i] = FromProtoHistoryEvent(p[i])
+	}
+	return v
+}
+
+func toProtoClusterReplicationConfigurationArray(t []*shared.ClusterReplicationConfiguration) []*apiv1.ClusterReplicationConfiguration {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.ClusterReplicationConfiguration, len(t))
+	for i := range t {
+		v[i] = ToProtoClusterReplicationConfiguration(t[i])
+	}
+	return v
+}
+
+func fromProtoClusterReplicationConfigurationArray(p []*apiv1.ClusterReplicationConfiguration) []*shared.ClusterReplicationConfiguration {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.ClusterReplicationConfiguration, len(p))
+	for i := range p {
+		v[i] = FromProtoClusterReplicationConfiguration(p[i])
+	}
+	return v
+}
+
+func toProtoDescribeDomainResponseArray(t []*shared.DescribeDomainResponse) []*apiv1.DescribeDomainResponse {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.DescribeDomainResponse, len(t))
+	for i := range t {
+		v[i] = ToProtoDescribeDomainResponse(t[i])
+	}
+	return v
+}
+
+func fromProtoDescribeDomainResponseArray(p []*apiv1.DescribeDomainResponse) []*shared.DescribeDomainResponse {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.DescribeDomainResponse, len(p))
+	for i := range p {
+		v[i] = FromProtoDescribeDomainResponse(p[i])
+	}
+	return v
+}
+
+func toProtoDecisionArray(t []*shared.Decision) []*apiv1.Decision {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.Decision, len(t))
+	for i := range t {
+		v[i] = ToProtoDecision(t[i])
+	}
+	return v
+}
+
+func fromProtoDecisionArray(p []*apiv1.Decision) []*shared.Decision {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.Decision, len(p))
+	for i := range p {
+		v[i] = FromProtoDecision(p[i])
+	}
+	return v
+}
+
+func toProtoWorkflowExecutionInfoArray(t []*shared.WorkflowExecutionInfo) []*apiv1.WorkflowExecutionInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.WorkflowExecutionInfo, len(t))
+	for i := range t {
+		v[i] = ToProtoWorkflowExecutionInfo(t[i])
+	}
+	return v
+}
+
+func fromProtoWorkflowExecutionInfoArray(p []*apiv1.WorkflowExecutionInfo) []*shared.WorkflowExecutionInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.WorkflowExecutionInfo, len(p))
+	for i := range p {
+		v[i] = FromProtoWorkflowExecutionInfo(p[i])
+	}
+	return v
+}
+
+func toProtoPendingActivityInfoArray(t []*shared.PendingActivityInfo) []*apiv1.PendingActivityInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PendingActivityInfo, len(t))
+	for i := range t {
+		v[i] = ToProtoPendingActivityInfo(t[i])
+	}
+	return v
+}
+
+func fromProtoPendingActivityInfoArray(p []*apiv1.PendingActivityInfo) []*shared.PendingActivityInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.PendingActivityInfo, len(p))
+	for i := range p {
+		v[i] = FromProtoPendingActivityInfo(p[i])
+	}
+	return v
+}
+
+func toProtoPollerInfoArray(t []*shared.PollerInfo) []*apiv1.PollerInfo {
+	if t == nil {
+		return nil
+	}
+	v := make([]*apiv1.PollerInfo, len(t))
+	for i := range t {
+		v[i] = ToProtoPollerInfo(t[i])
+	}
+	return v
+}
+
+func fromProtoPollerInfoArray(p []*apiv1.PollerInfo) []*shared.PollerInfo {
+	if p == nil {
+		return nil
+	}
+	v := make([]*shared.PollerInfo, len(p))
+	for i := range p {
+		v[i] = FromProtoPollerInfo(p[i])
+	}
+	return v
+}
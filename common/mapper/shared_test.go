@@ -0,0 +1,205 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package mapper
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	sharedSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+)
+
+func TestSharedSuite(t *testing.T) {
+	suite.Run(t, new(sharedSuite))
+}
+
+func (s *sharedSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *sharedSuite) TestRoundTrip() {
+	// the requests and responses of every WorkflowService method,
+	// which transitively reach all the mapped types
+	mappers := []struct {
+		toProto   interface{}
+		fromProto interface{}
+	}{
+		{ToProtoDeprecateDomainRequest, FromProtoDeprecateDomainRequest},
+		{ToProtoDescribeDomainRequest, FromProtoDescribeDomainRequest},
+		{ToProtoDescribeDomainResponse, FromProtoDescribeDomainResponse},
+		{ToProtoDescribeTaskListRequest, FromProtoDescribeTaskListRequest},
+		{ToProtoDescribeTaskListResponse, FromProtoDescribeTaskListResponse},
+		{ToProtoDescribeWorkflowExecutionRequest, FromProtoDescribeWorkflowExecutionRequest},
+		{ToProtoDescribeWorkflowExecutionResponse, FromProtoDescribeWorkflowExecutionResponse},
+		{ToProtoGetWorkflowExecutionHistoryRequest, FromProtoGetWorkflowExecutionHistoryRequest},
+		{ToProtoGetWorkflowExecutionHistoryResponse, FromProtoGetWorkflowExecutionHistoryResponse},
+		{ToProtoListArchivedWorkflowExecutionsRequest, FromProtoListArchivedWorkflowExecutionsRequest},
+		{ToProtoListArchivedWorkflowExecutionsResponse, FromProtoListArchivedWorkflowExecutionsResponse},
+		{ToProtoListClosedWorkflowExecutionsRequest, FromProtoListClosedWorkflowExecutionsRequest},
+		{ToProtoListClosedWorkflowExecutionsResponse, FromProtoListClosedWorkflowExecutionsResponse},
+		{ToProtoListDomainsRequest, FromProtoListDomainsRequest},
+		{ToProtoListDomainsResponse, FromProtoListDomainsResponse},
+		{ToProtoListOpenWorkflowExecutionsRequest, FromProtoListOpenWorkflowExecutionsRequest},
+		{ToProtoListOpenWorkflowExecutionsResponse, FromProtoListOpenWorkflowExecutionsResponse},
+		{ToProtoPollForActivityTaskRequest, FromProtoPollForActivityTaskRequest},
+		{ToProtoPollForActivityTaskResponse, FromProtoPollForActivityTaskResponse},
+		{ToProtoPollForDecisionTaskRequest, FromProtoPollForDecisionTaskRequest},
+		{ToProtoPollForDecisionTaskResponse, FromProtoPollForDecisionTaskResponse},
+		{ToProtoQueryWorkflowRequest, FromProtoQueryWorkflowRequest},
+		{ToProtoQueryWorkflowResponse, FromProtoQueryWorkflowResponse},
+		{ToProtoRecordActivityTaskHeartbeatRequest, FromProtoRecordActivityTaskHeartbeatRequest},
+		{ToProtoRecordActivityTaskHeartbeatResponse, FromProtoRecordActivityTaskHeartbeatResponse},
+		{ToProtoRecordActivityTaskHeartbeatByIDRequest, FromProtoRecordActivityTaskHeartbeatByIDRequest},
+		{ToProtoRegisterDomainRequest, FromProtoRegisterDomainRequest},
+		{ToProtoRequestCancelWorkflowExecutionRequest, FromProtoRequestCancelWorkflowExecutionRequest},
+		{ToProtoResetStickyTaskListRequest, FromProtoResetStickyTaskListRequest},
+		{ToProtoResetStickyTaskListResponse, FromProtoResetStickyTaskListResponse},
+		{ToProtoResetWorkflowExecutionRequest, FromProtoResetWorkflowExecutionRequest},
+		{ToProtoResetWorkflowExecutionResponse, FromProtoResetWorkflowExecutionResponse},
+		{ToProtoRespondActivityTaskCanceledRequest, FromProtoRespondActivityTaskCanceledRequest},
+		{ToProtoRespondActivityTaskCanceledByIDRequest, FromProtoRespondActivityTaskCanceledByIDRequest},
+		{ToProtoRespondActivityTaskCompletedRequest, FromProtoRespondActivityTaskCompletedRequest},
+		{ToProtoRespondActivityTaskCompletedByIDRequest, FromProtoRespondActivityTaskCompletedByIDRequest},
+		{ToProtoRespondActivityTaskFailedRequest, FromProtoRespondActivityTaskFailedRequest},
+		{ToProtoRespondActivityTaskFailedByIDRequest, FromProtoRespondActivityTaskFailedByIDRequest},
+		{ToProtoRespondDecisionTaskCompletedRequest, FromProtoRespondDecisionTaskCompletedRequest},
+		{ToProtoRespondDecisionTaskCompletedResponse, FromProtoRespondDecisionTaskCompletedResponse},
+		{ToProtoRespondDecisionTaskFailedRequest, FromProtoRespondDecisionTaskFailedRequest},
+		{ToProtoRespondQueryTaskCompletedRequest, FromProtoRespondQueryTaskCompletedRequest},
+		{ToProtoSignalWithStartWorkflowExecutionRequest, FromProtoSignalWithStartWorkflowExecutionRequest},
+		{ToProtoStartWorkflowExecutionResponse, FromProtoStartWorkflowExecutionResponse},
+		{ToProtoSignalWorkflowExecutionRequest, FromProtoSignalWorkflowExecutionRequest},
+		{ToProtoStartWorkflowExecutionRequest, FromProtoStartWorkflowExecutionRequest},
+		{ToProtoTerminateWorkflowExecutionRequest, FromProtoTerminateWorkflowExecutionRequest},
+		{ToProtoUpdateDomainRequest, FromProtoUpdateDomainRequest},
+		{ToProtoUpdateDomainResponse, FromProtoUpdateDomainResponse},
+	}
+
+	for _, m := range mappers {
+		toProto := reflect.ValueOf(m.toProto)
+		thriftType := toProto.Type().In(0).Elem()
+		value := reflect.New(thriftType)
+		fillValue(value.Elem())
+
+		message := toProto.Call([]reflect.Value{value})[0].Interface().(proto.Message)
+		data, err := proto.Marshal(message)
+		s.NoError(err, thriftType.Name())
+		decoded := reflect.New(reflect.TypeOf(message).Elem()).Interface().(proto.Message)
+		s.NoError(proto.Unmarshal(data, decoded), thriftType.Name())
+
+		result := reflect.ValueOf(m.fromProto).Call([]reflect.Value{reflect.ValueOf(decoded)})[0]
+		s.Equal(value.Interface(), result.Interface(), thriftType.Name())
+	}
+}
+
+func (s *sharedSuite) TestRoundTrip_Nil() {
+	s.Nil(ToProtoHistoryEvent(nil))
+	s.Nil(FromProtoHistoryEvent(nil))
+	s.Equal(&shared.HistoryEvent{}, FromProtoHistoryEvent(ToProtoHistoryEvent(&shared.HistoryEvent{})))
+}
+
+func (s *sharedSuite) TestEnum() {
+	s.Equal(apiv1.EVENT_TYPE_INVALID, ToProtoEventType(nil))
+	s.Nil(FromProtoEventType(apiv1.EVENT_TYPE_INVALID))
+	for _, eventType := range shared.EventType_Values() {
+		eventType := eventType
+		protoType := ToProtoEventType(&eventType)
+		s.Equal(strings.ToUpper(eventType.String()), strings.Replace(strings.TrimPrefix(protoType.String(), "EVENT_TYPE_"), "_", "", -1))
+		s.Equal(&eventType, FromProtoEventType(protoType))
+	}
+}
+
+func (s *sharedSuite) TestZeroValues() {
+	// proto3 can't tell an unset scalar from its zero value
+	request := &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(""),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(0),
+	}
+	s.Equal(&shared.StartWorkflowExecutionRequest{}, FromProtoStartWorkflowExecutionRequest(ToProtoStartWorkflowExecutionRequest(request)))
+
+	// except for the domain updates where the zero value is meaningful
+	update := &shared.UpdateDomainRequest{
+		UpdatedInfo: &shared.UpdateDomainInfo{
+			Description: common.StringPtr(""),
+		},
+		Configuration: &shared.DomainConfiguration{
+			EmitMetric:                             common.BoolPtr(false),
+			WorkflowExecutionRetentionPeriodInDays: common.Int32Ptr(0),
+		},
+	}
+	s.Equal(update, FromProtoUpdateDomainRequest(ToProtoUpdateDomainRequest(update)))
+}
+
+// fillValue sets every field reachable from the value to a non zero
+// value, so that a field missing from the mappers fails the round trip
+func fillValue(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		fillValue(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			fillValue(v.Field(i))
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes([]byte("data"))
+			return
+		}
+		slice := reflect.MakeSlice(v.Type(), 1, 1)
+		fillValue(slice.Index(0))
+		v.Set(slice)
+	case reflect.Map:
+		key := reflect.New(v.Type().Key()).Elem()
+		fillValue(key)
+		value := reflect.New(v.Type().Elem()).Elem()
+		fillValue(value)
+		m := reflect.MakeMap(v.Type())
+		m.SetMapIndex(key, value)
+		v.Set(m)
+	case reflect.String:
+		v.SetString("value")
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int32:
+		// enums use their first value as some only have one
+		if v.Type().Name() == "int32" {
+			v.SetInt(1)
+		}
+	case reflect.Int64:
+		v.SetInt(1)
+	case reflect.Float64:
+		v.SetFloat(1.5)
+	}
+}
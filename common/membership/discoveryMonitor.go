@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/ringpop-go/discovery"
+)
+
+type discoveryMonitor struct {
+	started  bool
+	stopped  bool
+	hostInfo *HostInfo
+	rings    map[string]*discoveryServiceResolver
+	logger   bark.Logger
+	mutex    sync.Mutex
+}
+
+var _ Monitor = (*discoveryMonitor)(nil)
+
+// NewDiscoveryMonitor returns a membership monitor whose members are not
+// gossiped but listed by the discovery provider of each service, such as
+// a static list of hosts or a DNS name, every refresh interval. The hosts
+// are mapped to keys by consistent hashing, as with ringpop.
+func NewDiscoveryMonitor(
+	serviceName string,
+	address string,
+	providers map[string]discovery.DiscoverProvider,
+	refreshInterval time.Duration,
+	logger bark.Logger,
+) Monitor {
+	if refreshInterval == 0 {
+		refreshInterval = defaultRefreshInterval
+	}
+	monitor := &discoveryMonitor{
+		hostInfo: NewHostInfo(address, map[string]string{RoleKey: serviceName}),
+		rings:    make(map[string]*discoveryServiceResolver),
+		logger:   logger,
+	}
+	for service, provider := range providers {
+		monitor.rings[service] = newDiscoveryServiceResolver(service, provider, refreshInterval, logger)
+	}
+	return monitor
+}
+
+func (m *discoveryMonitor) Start() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.started {
+		return nil
+	}
+
+	for _, ring := range m.rings {
+		ring.Start()
+	}
+
+	m.started = true
+	return nil
+}
+
+func (m *discoveryMonitor) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.stopped {
+		return
+	}
+
+	for _, ring := range m.rings {
+		ring.Stop()
+	}
+	m.stopped = true
+}
+
+func (m *discoveryMonitor) WhoAmI() (*HostInfo, error) {
+	return m.hostInfo, nil
+}
+
+// EvictSelf does nothing, the host leaves the rings
+// once the discovery providers stop listing it
+func (m *discoveryMonitor) EvictSelf() error {
+	return nil
+}
+
+func (m *discoveryMonitor) GetResolver(service string) (ServiceResolver, error) {
+	ring, found := m.rings[service]
+	if !found {
+		return nil, ErrUnknownService
+	}
+	return ring, nil
+}
+
+func (m *discoveryMonitor) Lookup(service string, key string) (*HostInfo, error) {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return nil, err
+	}
+	return ring.Lookup(key)
+}
+
+func (m *discoveryMonitor) AddListener(service string, name string, notifyChannel chan<- *ChangedEvent) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.AddListener(name, notifyChannel)
+}
+
+func (m *discoveryMonitor) RemoveListener(service string, name string) error {
+	ring, err := m.GetResolver(service)
+	if err != nil {
+		return err
+	}
+	return ring.RemoveListener(name)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/ringpop-go/discovery"
+	"github.com/uber/ringpop-go/discovery/statichosts"
+)
+
+type (
+	discoveryMonitorSuite struct {
+		*require.Assertions
+		suite.Suite
+		logger bark.Logger
+	}
+
+	fakeProvider struct {
+		sync.Mutex
+		hosts []string
+		err   error
+	}
+)
+
+func TestDiscoveryMonitorSuite(t *testing.T) {
+	suite.Run(t, new(discoveryMonitorSuite))
+}
+
+func (s *discoveryMonitorSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.logger = bark.NewLoggerFromLogrus(log.New())
+}
+
+func (s *discoveryMonitorSuite) TestStaticHosts() {
+	hosts := []string{"127.0.0.1:7934", "127.0.0.2:7934", "127.0.0.3:7934"}
+	monitor := NewDiscoveryMonitor("history", hosts[1], map[string]discovery.DiscoverProvider{
+		"history":  statichosts.New(hosts...),
+		"matching": statichosts.New(),
+	}, time.Hour, s.logger)
+	s.NoError(monitor.Start())
+	defer monitor.Stop()
+
+	self, err := monitor.WhoAmI()
+	s.NoError(err)
+	s.Equal(hosts[1], self.GetAddress())
+	role, _ := self.Label(RoleKey)
+	s.Equal("history", role)
+
+	resolver, err := monitor.GetResolver("history")
+	s.NoError(err)
+	s.Equal(3, resolver.MemberCount())
+	owners := make(map[string]bool)
+	for _, key := range []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10"} {
+		host, err := monitor.Lookup("history", key)
+		s.NoError(err)
+		s.Contains(hosts, host.GetAddress())
+		again, err := monitor.Lookup("history", key)
+		s.NoError(err)
+		s.Equal(host.GetAddress(), again.GetAddress())
+		owners[host.GetAddress()] = true
+	}
+	s.True(len(owners) > 1)
+
+	_, err = monitor.Lookup("matching", "key")
+	s.Equal(ErrInsufficientHosts, err)
+	_, err = monitor.Lookup("frontend", "key")
+	s.Equal(ErrUnknownService, err)
+}
+
+func (s *discoveryMonitorSuite) TestRefreshNotifiesListeners() {
+	provider := &fakeProvider{hosts: []string{"127.0.0.1:7934", "127.0.0.2:7934"}}
+	monitor := NewDiscoveryMonitor("history", "127.0.0.1:7934", map[string]discovery.DiscoverProvider{
+		"history": provider,
+	}, 10*time.Millisecond, s.logger)
+	s.NoError(monitor.Start())
+	defer monitor.Stop()
+
+	listenCh := make(chan *ChangedEvent, 5)
+	s.NoError(monitor.AddListener("history", "test-listener", listenCh))
+	s.Equal(ErrListenerAlreadyExist, monitor.AddListener("history", "test-listener", listenCh))
+
+	provider.set([]string{"127.0.0.1:7934", "127.0.0.3:7934"}, nil)
+	select {
+	case e := <-listenCh:
+		s.Len(e.HostsAdded, 1)
+		s.Equal("127.0.0.3:7934", e.HostsAdded[0].GetAddress())
+		s.Len(e.HostsRemoved, 1)
+		s.Equal("127.0.0.2:7934", e.HostsRemoved[0].GetAddress())
+		s.Nil(e.HostsUpdated)
+	case <-time.After(time.Second):
+		s.Fail("Timed out waiting for the hosts to be refreshed")
+	}
+
+	// failing to list the hosts keeps the current ones
+	provider.set(nil, errors.New("dns failure"))
+	time.Sleep(50 * time.Millisecond)
+	resolver, err := monitor.GetResolver("history")
+	s.NoError(err)
+	s.Equal(2, resolver.MemberCount())
+	s.Len(listenCh, 0)
+}
+
+func (p *fakeProvider) Hosts() ([]string, error) {
+	p.Lock()
+	defer p.Unlock()
+	return p.hosts, p.err
+}
+
+func (p *fakeProvider) set(hosts []string, err error) {
+	p.Lock()
+	defer p.Unlock()
+	p.hosts = hosts
+	p.err = err
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/ringpop-go/discovery"
+	"github.com/uber/ringpop-go/hashring"
+)
+
+type discoveryServiceResolver struct {
+	service         string
+	provider        discovery.DiscoverProvider
+	refreshInterval time.Duration
+	isStarted       bool
+	isStopped       bool
+	shutdownCh      chan struct{}
+	shutdownWG      sync.WaitGroup
+	logger          bark.Logger
+
+	ringLock sync.RWMutex
+	ring     *hashring.HashRing
+	members  map[string]struct{}
+
+	listenerLock sync.RWMutex
+	listeners    map[string]chan<- *ChangedEvent
+}
+
+var _ ServiceResolver = (*discoveryServiceResolver)(nil)
+
+func newDiscoveryServiceResolver(
+	service string,
+	provider discovery.DiscoverProvider,
+	refreshInterval time.Duration,
+	logger bark.Logger,
+) *discoveryServiceResolver {
+	return &discoveryServiceResolver{
+		service:         service,
+		provider:        provider,
+		refreshInterval: refreshInterval,
+		logger:          logger.WithFields(bark.Fields{"component": "ServiceResolver", RoleKey: service}),
+		ring:            hashring.New(farm.Fingerprint32, replicaPoints),
+		members:         make(map[string]struct{}),
+		listeners:       make(map[string]chan<- *ChangedEvent),
+		shutdownCh:      make(chan struct{}),
+	}
+}
+
+// Start lists the hosts of the service and keeps refreshing them,
+// a service whose hosts cannot be listed yet starts with no hosts
+func (r *discoveryServiceResolver) Start() {
+	r.ringLock.Lock()
+	if r.isStarted {
+		r.ringLock.Unlock()
+		return
+	}
+	r.isStarted = true
+	r.ringLock.Unlock()
+
+	r.refresh()
+	r.shutdownWG.Add(1)
+	go r.refreshRingWorker()
+}
+
+// Stop stops refreshing the hosts of the service
+func (r *discoveryServiceResolver) Stop() {
+	r.ringLock.Lock()
+	if r.isStopped || !r.isStarted {
+		r.isStopped = true
+		r.ringLock.Unlock()
+		return
+	}
+	r.isStopped = true
+	r.ringLock.Unlock()
+
+	close(r.shutdownCh)
+	if success := common.AwaitWaitGroup(&r.shutdownWG, time.Minute); !success {
+		r.logger.Warn("service resolver timed out on shutdown.")
+	}
+
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	r.listeners = make(map[string]chan<- *ChangedEvent)
+}
+
+// Lookup finds the host in the ring responsible for serving the given key
+func (r *discoveryServiceResolver) Lookup(key string) (*HostInfo, error) {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+	addr, found := r.ring.Lookup(key)
+	if !found {
+		return nil, ErrInsufficientHosts
+	}
+	return NewHostInfo(addr, r.getLabelsMap()), nil
+}
+
+// MemberCount returns the number of hosts in the ring
+func (r *discoveryServiceResolver) MemberCount() int {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+	return r.ring.ServerCount()
+}
+
+func (r *discoveryServiceResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	_, ok := r.listeners[name]
+	if ok {
+		return ErrListenerAlreadyExist
+	}
+	r.listeners[name] = notifyChannel
+	return nil
+}
+
+func (r *discoveryServiceResolver) RemoveListener(name string) error {
+	r.listenerLock.Lock()
+	defer r.listenerLock.Unlock()
+	delete(r.listeners, name)
+	return nil
+}
+
+// refresh lists the hosts of the service and updates
+// the ring, notifying the listeners of the changes
+func (r *discoveryServiceResolver) refresh() {
+	addrs, err := r.provider.Hosts()
+	if err != nil {
+		r.logger.Warnf("Error listing the hosts of the service.  Error: %v", err)
+		return
+	}
+
+	event := r.updateRing(addrs)
+	if event != nil {
+		r.emitEvent(event)
+	}
+}
+
+func (r *discoveryServiceResolver) updateRing(addrs []string) *ChangedEvent {
+	r.ringLock.Lock()
+	defer r.ringLock.Unlock()
+
+	event := &ChangedEvent{}
+	members := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		members[addr] = struct{}{}
+		if _, ok := r.members[addr]; !ok {
+			event.HostsAdded = append(event.HostsAdded, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	for addr := range r.members {
+		if _, ok := members[addr]; !ok {
+			event.HostsRemoved = append(event.HostsRemoved, NewHostInfo(addr, r.getLabelsMap()))
+		}
+	}
+	if len(event.HostsAdded) == 0 && len(event.HostsRemoved) == 0 {
+		return nil
+	}
+
+	for _, host := range event.HostsAdded {
+		r.ring.AddMembers(host)
+	}
+	for _, host := range event.HostsRemoved {
+		r.ring.RemoveMembers(host)
+	}
+	r.members = members
+	r.logger.Infof("Current hosts: %v", addrs)
+	return event
+}
+
+func (r *discoveryServiceResolver) emitEvent(event *ChangedEvent) {
+	r.listenerLock.RLock()
+	defer r.listenerLock.RUnlock()
+
+	for name, ch := range r.listeners {
+		select {
+		case ch <- event:
+		default:
+			r.logger.WithFields(bark.Fields{`listenerName`: name}).Error("Failed to send listener notification, channel full")
+		}
+	}
+}
+
+func (r *discoveryServiceResolver) refreshRingWorker() {
+	defer r.shutdownWG.Done()
+
+	refreshTicker := time.NewTicker(r.refreshInterval)
+	defer refreshTicker.Stop()
+
+	for {
+		select {
+		case <-r.shutdownCh:
+			return
+		case <-refreshTicker.C:
+			r.refresh()
+		}
+	}
+}
+
+func (r *discoveryServiceResolver) getLabelsMap() map[string]string {
+	labels := make(map[string]string)
+	labels[RoleKey] = r.service
+	return labels
+}
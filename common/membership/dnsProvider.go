@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/uber/ringpop-go/discovery"
+)
+
+type dnsProvider struct {
+	names      []string
+	lookupHost func(host string) ([]string, error)
+	lookupSRV  func(service, proto, name string) (string, []*net.SRV, error)
+}
+
+var _ discovery.DiscoverProvider = (*dnsProvider)(nil)
+
+// NewDNSProvider returns a discovery provider resolving the given names every
+// time the hosts are listed. A host:port name, such as the headless service of
+// a kubernetes StatefulSet, lists the addresses of the host with the port. A
+// name without port is resolved as a SRV record, listing the addresses of its
+// targets with their ports.
+func NewDNSProvider(names []string) discovery.DiscoverProvider {
+	return &dnsProvider{
+		names:      names,
+		lookupHost: net.LookupHost,
+		lookupSRV:  net.LookupSRV,
+	}
+}
+
+// Hosts returns the sorted ip:port addresses the names resolve to, the names
+// which fail to resolve are skipped unless none of them resolves
+func (p *dnsProvider) Hosts() ([]string, error) {
+	hostSet := make(map[string]struct{})
+	var errs []string
+	for _, name := range p.names {
+		hosts, err := p.resolve(name)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		for _, host := range hosts {
+			hostSet[host] = struct{}{}
+		}
+	}
+	if len(hostSet) == 0 && len(errs) > 0 {
+		return nil, fmt.Errorf("dns discovery failed: %v", strings.Join(errs, ", "))
+	}
+
+	hosts := make([]string, 0, len(hostSet))
+	for host := range hostSet {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	return hosts, nil
+}
+
+func (p *dnsProvider) resolve(name string) ([]string, error) {
+	if host, port, err := net.SplitHostPort(name); err == nil {
+		return p.resolveHost(host, port)
+	}
+
+	_, records, err := p.lookupSRV("", "", name)
+	if err != nil {
+		return nil, err
+	}
+	var hosts []string
+	for _, record := range records {
+		targetHosts, err := p.resolveHost(strings.TrimSuffix(record.Target, "."), strconv.Itoa(int(record.Port)))
+		if err != nil {
+			return nil, err
+		}
+		hosts = append(hosts, targetHosts...)
+	}
+	return hosts, nil
+}
+
+func (p *dnsProvider) resolveHost(host string, port string) ([]string, error) {
+	addrs, err := p.lookupHost(host)
+	if err != nil {
+		return nil, err
+	}
+	hosts := make([]string, 0, len(addrs))
+	for _, addr := range addrs {
+		hosts = append(hosts, net.JoinHostPort(addr, port))
+	}
+	return hosts, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package membership
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type dnsProviderSuite struct {
+	*require.Assertions
+	suite.Suite
+	hosts map[string][]string
+	srvs  map[string][]*net.SRV
+}
+
+func TestDNSProviderSuite(t *testing.T) {
+	suite.Run(t, new(dnsProviderSuite))
+}
+
+func (s *dnsProviderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.hosts = map[string][]string{
+		"cadence-history":         {"10.0.0.2", "10.0.0.1"},
+		"cadence-history-0.local": {"10.0.1.1"},
+		"cadence-history-1.local": {"10.0.1.2", "fd00::2"},
+	}
+	s.srvs = map[string][]*net.SRV{
+		"_tchannel._tcp.cadence-history": {
+			{Target: "cadence-history-0.local.", Port: 7934},
+			{Target: "cadence-history-1.local.", Port: 7934},
+		},
+	}
+}
+
+func (s *dnsProviderSuite) TestHostPort() {
+	hosts, err := s.newProvider("cadence-history:7934").Hosts()
+	s.NoError(err)
+	s.Equal([]string{"10.0.0.1:7934", "10.0.0.2:7934"}, hosts)
+}
+
+func (s *dnsProviderSuite) TestSRV() {
+	hosts, err := s.newProvider("_tchannel._tcp.cadence-history").Hosts()
+	s.NoError(err)
+	s.Equal([]string{"10.0.1.1:7934", "10.0.1.2:7934", "[fd00::2]:7934"}, hosts)
+}
+
+func (s *dnsProviderSuite) TestReresolves() {
+	provider := s.newProvider("cadence-history:7934")
+	hosts, err := provider.Hosts()
+	s.NoError(err)
+	s.Len(hosts, 2)
+
+	s.hosts["cadence-history"] = append(s.hosts["cadence-history"], "10.0.0.3")
+	hosts, err = provider.Hosts()
+	s.NoError(err)
+	s.Equal([]string{"10.0.0.1:7934", "10.0.0.2:7934", "10.0.0.3:7934"}, hosts)
+}
+
+func (s *dnsProviderSuite) TestPartialFailure() {
+	hosts, err := s.newProvider("unknown:7934", "cadence-history-0.local:7934").Hosts()
+	s.NoError(err)
+	s.Equal([]string{"10.0.1.1:7934"}, hosts)
+
+	_, err = s.newProvider("unknown:7934", "_unknown._tcp").Hosts()
+	s.Error(err)
+}
+
+func (s *dnsProviderSuite) newProvider(names ...string) *dnsProvider {
+	return &dnsProvider{
+		names: names,
+		lookupHost: func(host string) ([]string, error) {
+			if addrs, ok := s.hosts[host]; ok {
+				return addrs, nil
+			}
+			return nil, errors.New("no such host " + host)
+		},
+		lookupSRV: func(service, proto, name string) (string, []*net.SRV, error) {
+			if records, ok := s.srvs[name]; ok {
+				return name, records, nil
+			}
+			return "", nil, errors.New("no such host " + name)
+		},
+	}
+}
@@ -50,6 +50,9 @@ type (
 		Start() error
 		Stop()
 		WhoAmI() (*HostInfo, error)
+		// EvictSelf removes this host from the membership ring, the other members are
+		// notified of the change right away instead of detecting the host as faulty
+		EvictSelf() error
 		Lookup(service string, key string) (*HostInfo, error)
 		GetResolver(service string) (ServiceResolver, error)
 		// AddListener adds a listener for this service.
@@ -67,6 +70,8 @@ type (
 	// It can be used to resolve which member host is responsible for serving a given key.
 	ServiceResolver interface {
 		Lookup(key string) (*HostInfo, error)
+		// MemberCount returns the number of reachable hosts of the service
+		MemberCount() int
 		// AddListener adds a listener which will get notified on the given
 		// channel, whenever membership changes.
 		// @name: The name for identifying the listener
@@ -20,6 +20,10 @@
 
 package messaging
 
+import (
+	"context"
+)
+
 type (
 	// Client is the interface used to abstract out interaction with messaging system for replication
 	Client interface {
@@ -27,6 +31,8 @@ type (
 		NewConsumerWithClusterName(currentCluster, sourceCluster, consumerName string, concurrency int) (Consumer, error)
 		NewProducer(appName string) (Producer, error)
 		NewProducerWithClusterName(sourceCluster string) (Producer, error)
+		// Ping checks that a broker of every Kafka cluster accepts connections
+		Ping(ctx context.Context) error
 	}
 
 	// Consumer is the unified interface for both internal and external kafka clients
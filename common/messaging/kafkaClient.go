@@ -21,6 +21,9 @@
 package messaging
 
 import (
+	"context"
+	"fmt"
+	"net"
 	"strings"
 
 	"github.com/Shopify/sarama"
@@ -153,3 +156,23 @@ func (c *kafkaClient) newProducerHelper(topic string) (Producer, error) {
 	}
 	return NewKafkaProducer(topic, producer, c.logger), nil
 }
+
+// Ping dials the brokers of every cluster until one of them accepts
+// the connection, failing on the first cluster with no reachable broker
+func (c *kafkaClient) Ping(ctx context.Context) error {
+	var dialer net.Dialer
+	for cluster, cfg := range c.config.Clusters {
+		var err error
+		for _, broker := range cfg.Brokers {
+			var conn net.Conn
+			if conn, err = dialer.DialContext(ctx, "tcp", broker); err == nil {
+				conn.Close()
+				break
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("kafka cluster %v is not reachable: %v", cluster, err)
+		}
+	}
+	return nil
+}
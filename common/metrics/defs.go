@@ -20,6 +20,8 @@
 
 package metrics
 
+import "sort"
+
 // types used/defined by the package
 type (
 	// MetricName is the name of the metric
@@ -148,8 +150,14 @@ const (
 	PersistenceResetWorkflowExecutionScope
 	// PersistenceDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution calls made by service to persistence layer
 	PersistenceDeleteWorkflowExecutionScope
+	// PersistenceDeleteCurrentWorkflowExecutionScope tracks DeleteCurrentWorkflowExecution calls made by service to persistence layer
+	PersistenceDeleteCurrentWorkflowExecutionScope
 	// PersistenceGetCurrentExecutionScope tracks GetCurrentExecution calls made by service to persistence layer
 	PersistenceGetCurrentExecutionScope
+	// PersistenceListConcreteExecutionsScope tracks ListConcreteExecutions calls made by service to persistence layer
+	PersistenceListConcreteExecutionsScope
+	// PersistenceListCurrentExecutionsScope tracks ListCurrentExecutions calls made by service to persistence layer
+	PersistenceListCurrentExecutionsScope
 	// PersistenceGetTransferTasksScope tracks GetTransferTasks calls made by service to persistence layer
 	PersistenceGetTransferTasksScope
 	// PersistenceGetReplicationTasksScope tracks GetReplicationTasks calls made by service to persistence layer
@@ -166,6 +174,14 @@ const (
 	PersistenceCompleteTimerTaskScope
 	// PersistenceRangeCompleteTimerTaskScope tracks CompleteTimerTasks calls made by service to persistence layer
 	PersistenceRangeCompleteTimerTaskScope
+	// PersistencePutTaskToDLQScope tracks PutTaskToDLQ calls made by service to persistence layer
+	PersistencePutTaskToDLQScope
+	// PersistenceGetDLQTaskScope tracks GetDLQTask calls made by service to persistence layer
+	PersistenceGetDLQTaskScope
+	// PersistenceGetDLQTasksScope tracks GetDLQTasks calls made by service to persistence layer
+	PersistenceGetDLQTasksScope
+	// PersistenceDeleteDLQTaskScope tracks DeleteDLQTask calls made by service to persistence layer
+	PersistenceDeleteDLQTaskScope
 	// PersistenceCreateTaskScope tracks CreateTask calls made by service to persistence layer
 	PersistenceCreateTaskScope
 	// PersistenceGetTasksScope tracks GetTasks calls made by service to persistence layer
@@ -270,6 +286,26 @@ const (
 	HistoryClientSyncShardStatusScope
 	// HistoryClientSyncActivityScope tracks RPC calls to history service
 	HistoryClientSyncActivityScope
+	// HistoryClientListDLQTasksScope tracks RPC calls to history service
+	HistoryClientListDLQTasksScope
+	// HistoryClientRetryDLQTaskScope tracks RPC calls to history service
+	HistoryClientRetryDLQTaskScope
+	// HistoryClientDropDLQTaskScope tracks RPC calls to history service
+	HistoryClientDropDLQTaskScope
+	// HistoryClientDescribeShardScope tracks RPC calls to history service
+	HistoryClientDescribeShardScope
+	// HistoryClientListShardQueueTasksScope tracks RPC calls to history service
+	HistoryClientListShardQueueTasksScope
+	// HistoryClientRemoveShardQueueTaskScope tracks RPC calls to history service
+	HistoryClientRemoveShardQueueTaskScope
+	// HistoryClientCloseShardScope tracks RPC calls to history service
+	HistoryClientCloseShardScope
+	// HistoryClientReloadShardScope tracks RPC calls to history service
+	HistoryClientReloadShardScope
+	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
+	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientRebuildMutableStateScope tracks RPC calls to history service
+	HistoryClientRebuildMutableStateScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -296,6 +332,8 @@ const (
 	FrontendClientDescribeWorkflowExecutionScope
 	// FrontendClientGetWorkflowExecutionHistoryScope tracks RPC calls to frontend service
 	FrontendClientGetWorkflowExecutionHistoryScope
+	// FrontendClientListArchivedWorkflowExecutionsScope tracks RPC calls to frontend service
+	FrontendClientListArchivedWorkflowExecutionsScope
 	// FrontendClientListClosedWorkflowExecutionsScope tracks RPC calls to frontend service
 	FrontendClientListClosedWorkflowExecutionsScope
 	// FrontendClientListDomainsScope tracks RPC calls to frontend service
@@ -354,6 +392,26 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientListDLQTasksScope tracks RPC calls to admin service
+	AdminClientListDLQTasksScope
+	// AdminClientRetryDLQTaskScope tracks RPC calls to admin service
+	AdminClientRetryDLQTaskScope
+	// AdminClientDropDLQTaskScope tracks RPC calls to admin service
+	AdminClientDropDLQTaskScope
+	// AdminClientDescribeShardScope tracks RPC calls to admin service
+	AdminClientDescribeShardScope
+	// AdminClientListShardQueueTasksScope tracks RPC calls to admin service
+	AdminClientListShardQueueTasksScope
+	// AdminClientRemoveShardQueueTaskScope tracks RPC calls to admin service
+	AdminClientRemoveShardQueueTaskScope
+	// AdminClientCloseShardScope tracks RPC calls to admin service
+	AdminClientCloseShardScope
+	// AdminClientReloadShardScope tracks RPC calls to admin service
+	AdminClientReloadShardScope
+	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
+	AdminClientRefreshWorkflowTasksScope
+	// AdminClientRebuildMutableStateScope tracks RPC calls to admin service
+	AdminClientRebuildMutableStateScope
 	// PublicClientDeprecateDomainScope tracks RPC calls to frontend service
 	PublicClientDeprecateDomainScope
 	// PublicClientDescribeDomainScope tracks RPC calls to frontend service
@@ -445,6 +503,8 @@ const (
 	PersistenceCompleteForkBranchScope
 	// PersistenceGetHistoryTreeScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetHistoryTreeScope
+	// PersistenceGetAllHistoryTreeBranchesScope tracks GetAllHistoryTreeBranches calls made by service to persistence layer
+	PersistenceGetAllHistoryTreeBranchesScope
 
 	// BlobstoreClientUploadScope tracks Upload calls to blobstore
 	BlobstoreClientUploadScope
@@ -458,6 +518,8 @@ const (
 	BlobstoreClientDeleteScope
 	// BlobstoreClientListByPrefixScope tracks ListByPrefix calls to blobstore
 	BlobstoreClientListByPrefixScope
+	// BlobstoreClientListByPrefixPageScope tracks ListByPrefixPage calls to blobstore
+	BlobstoreClientListByPrefixPageScope
 	// BlobstoreClientBucketMetadataScope tracks BucketMetadata calls to blobstore
 	BlobstoreClientBucketMetadataScope
 
@@ -496,6 +558,26 @@ const (
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminListDLQTasksScope is the metric scope for admin.ListDLQTasks
+	AdminListDLQTasksScope
+	// AdminRetryDLQTaskScope is the metric scope for admin.RetryDLQTask
+	AdminRetryDLQTaskScope
+	// AdminDropDLQTaskScope is the metric scope for admin.DropDLQTask
+	AdminDropDLQTaskScope
+	// AdminDescribeShardScope is the metric scope for admin.DescribeShard
+	AdminDescribeShardScope
+	// AdminListShardQueueTasksScope is the metric scope for admin.ListShardQueueTasks
+	AdminListShardQueueTasksScope
+	// AdminRemoveShardQueueTaskScope is the metric scope for admin.RemoveShardQueueTask
+	AdminRemoveShardQueueTaskScope
+	// AdminCloseShardScope is the metric scope for admin.CloseShard
+	AdminCloseShardScope
+	// AdminReloadShardScope is the metric scope for admin.ReloadShard
+	AdminReloadShardScope
+	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
+	AdminRefreshWorkflowTasksScope
+	// AdminRebuildMutableStateScope is the metric scope for admin.RebuildMutableState
+	AdminRebuildMutableStateScope
 
 	NumAdminScopes
 )
@@ -544,6 +626,8 @@ const (
 	FrontendListOpenWorkflowExecutionsScope
 	// FrontendListClosedWorkflowExecutionsScope is the metric scope for frontend.ListClosedWorkflowExecutions
 	FrontendListClosedWorkflowExecutionsScope
+	// FrontendListArchivedWorkflowExecutionsScope is the metric scope for frontend.ListArchivedWorkflowExecutions
+	FrontendListArchivedWorkflowExecutionsScope
 	// FrontendRegisterDomainScope is the metric scope for frontend.RegisterDomain
 	FrontendRegisterDomainScope
 	// FrontendDescribeDomainScope is the metric scope for frontend.DescribeDomain
@@ -618,6 +702,26 @@ const (
 	HistorySyncActivityScope
 	// HistoryDescribeMutableStateScope tracks HistoryActivity API calls received by service
 	HistoryDescribeMutableStateScope
+	// HistoryListDLQTasksScope tracks ListDLQTasks API calls received by service
+	HistoryListDLQTasksScope
+	// HistoryRetryDLQTaskScope tracks RetryDLQTask API calls received by service
+	HistoryRetryDLQTaskScope
+	// HistoryDropDLQTaskScope tracks DropDLQTask API calls received by service
+	HistoryDropDLQTaskScope
+	// HistoryDescribeShardScope tracks DescribeShard API calls received by service
+	HistoryDescribeShardScope
+	// HistoryListShardQueueTasksScope tracks ListShardQueueTasks API calls received by service
+	HistoryListShardQueueTasksScope
+	// HistoryRemoveShardQueueTaskScope tracks RemoveShardQueueTask API calls received by service
+	HistoryRemoveShardQueueTaskScope
+	// HistoryCloseShardScope tracks CloseShard API calls received by service
+	HistoryCloseShardScope
+	// HistoryReloadShardScope tracks ReloadShard API calls received by service
+	HistoryReloadShardScope
+	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
+	HistoryRefreshWorkflowTasksScope
+	// HistoryRebuildMutableStateScope tracks RebuildMutableState API calls received by service
+	HistoryRebuildMutableStateScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -778,6 +882,8 @@ const (
 	ArchiverUploadHistoryActivityScope
 	// ArchiverDeleteHistoryActivityScope is scope used by all metrics emitted by archiver.DeleteHistoryActivity
 	ArchiverDeleteHistoryActivityScope
+	// ArchiverUploadVisibilityActivityScope is scope used by all metrics emitted by archiver.UploadVisibilityActivity
+	ArchiverUploadVisibilityActivityScope
 	// ArchiverScope is scope used by all metrics emitted by archiver.Archiver
 	ArchiverScope
 	// ArchiverPumpScope is scope used by all metrics emitted by archiver.Pump
@@ -786,8 +892,14 @@ const (
 	ArchiverArchivalWorkflowScope
 	// ArchiverClientScope is scope used by all metrics emitted by archiver.Client
 	ArchiverClientScope
+	// ArchiverVerifyArchivalActivityScope is scope used by all metrics emitted by archiver.VerifyArchivalActivity
+	ArchiverVerifyArchivalActivityScope
 	// TaskListScavengerScope is scope used by all metrics emitted by worker.tasklist.Scavenger module
 	TaskListScavengerScope
+	// ExecutionsScavengerScope is scope used by all metrics emitted by worker.executions.Scavenger module
+	ExecutionsScavengerScope
+	// HistoryScavengerScope is scope used by all metrics emitted by worker.history.Scavenger module
+	HistoryScavengerScope
 
 	NumWorkerScopes
 )
@@ -825,7 +937,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceResetMutableStateScope:                        {operation: "ResetMutableState"},
 		PersistenceResetWorkflowExecutionScope:                   {operation: "ResetWorkflowExecution"},
 		PersistenceDeleteWorkflowExecutionScope:                  {operation: "DeleteWorkflowExecution"},
+		PersistenceDeleteCurrentWorkflowExecutionScope:           {operation: "DeleteCurrentWorkflowExecution"},
 		PersistenceGetCurrentExecutionScope:                      {operation: "GetCurrentExecution"},
+		PersistenceListConcreteExecutionsScope:                   {operation: "ListConcreteExecutions"},
+		PersistenceListCurrentExecutionsScope:                    {operation: "ListCurrentExecutions"},
 		PersistenceGetTransferTasksScope:                         {operation: "GetTransferTasks"},
 		PersistenceGetReplicationTasksScope:                      {operation: "GetReplicationTasks"},
 		PersistenceCompleteTransferTaskScope:                     {operation: "CompleteTransferTask"},
@@ -834,6 +949,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceGetTimerIndexTasksScope:                       {operation: "GetTimerIndexTasks"},
 		PersistenceCompleteTimerTaskScope:                        {operation: "CompleteTimerTask"},
 		PersistenceRangeCompleteTimerTaskScope:                   {operation: "RangeCompleteTimerTask"},
+		PersistencePutTaskToDLQScope:                             {operation: "PutTaskToDLQ"},
+		PersistenceGetDLQTaskScope:                               {operation: "GetDLQTask"},
+		PersistenceGetDLQTasksScope:                              {operation: "GetDLQTasks"},
+		PersistenceDeleteDLQTaskScope:                            {operation: "DeleteDLQTask"},
 		PersistenceCreateTaskScope:                               {operation: "CreateTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetTasksScope:                                 {operation: "GetTasks", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceCompleteTaskScope:                             {operation: "CompleteTask", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
@@ -868,14 +987,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteHistoryBranchScope:                      {operation: "DeleteHistoryBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceCompleteForkBranchScope:                       {operation: "CompleteForkBranch", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 		PersistenceGetHistoryTreeScope:                           {operation: "GetHistoryTree", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
+		PersistenceGetAllHistoryTreeBranchesScope:                {operation: "GetAllHistoryTreeBranches", tags: map[string]string{ShardTagName: NoneShardsTagValue}},
 
-		BlobstoreClientUploadScope:         {operation: "BlobstoreClientUpload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
-		BlobstoreClientDownloadScope:       {operation: "BlobstoreClientDownload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
-		BlobstoreClientGetTagsScope:        {operation: "BlobstoreClientGetTags", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
-		BlobstoreClientExistsScope:         {operation: "BlobstoreClientExists", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
-		BlobstoreClientDeleteScope:         {operation: "BlobstoreClientDelete", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
-		BlobstoreClientListByPrefixScope:   {operation: "BlobstoreClientListByPrefix", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
-		BlobstoreClientBucketMetadataScope: {operation: "BlobstoreClientBucketMetadata", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientUploadScope:           {operation: "BlobstoreClientUpload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientDownloadScope:         {operation: "BlobstoreClientDownload", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientGetTagsScope:          {operation: "BlobstoreClientGetTags", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientExistsScope:           {operation: "BlobstoreClientExists", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientDeleteScope:           {operation: "BlobstoreClientDelete", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientListByPrefixScope:     {operation: "BlobstoreClientListByPrefix", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientListByPrefixPageScope: {operation: "BlobstoreClientListByPrefixPage", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
+		BlobstoreClientBucketMetadataScope:   {operation: "BlobstoreClientBucketMetadata", tags: map[string]string{CadenceRoleTagName: BlobstoreRoleTagValue}},
 
 		ClusterMetadataArchivalConfigScope: {operation: "ArchivalConfig"},
 
@@ -903,6 +1024,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientReplicateRawEventsScope:                {operation: "HistoryClientReplicateRawEvents", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncShardStatusScope:                   {operation: "HistoryClientSyncShardStatusScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSyncActivityScope:                      {operation: "HistoryClientSyncActivityScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientListDLQTasksScope:                      {operation: "HistoryClientListDLQTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRetryDLQTaskScope:                      {operation: "HistoryClientRetryDLQTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDropDLQTaskScope:                       {operation: "HistoryClientDropDLQTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDescribeShardScope:                     {operation: "HistoryClientDescribeShard", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientListShardQueueTasksScope:               {operation: "HistoryClientListShardQueueTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRemoveShardQueueTaskScope:              {operation: "HistoryClientRemoveShardQueueTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientCloseShardScope:                        {operation: "HistoryClientCloseShard", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientReloadShardScope:                       {operation: "HistoryClientReloadShard", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRefreshWorkflowTasksScope:              {operation: "HistoryClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientRebuildMutableStateScope:               {operation: "HistoryClientRebuildMutableState", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:              {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:              {operation: "MatchingClientPollForActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                  {operation: "MatchingClientAddActivityTask", tags: map[string]string{CadenceRoleTagName: MatchingRoleTagValue}},
@@ -916,6 +1047,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientDescribeTaskListScope:                 {operation: "FrontendClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeWorkflowExecutionScope:        {operation: "FrontendClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientGetWorkflowExecutionHistoryScope:      {operation: "FrontendClientGetWorkflowExecutionHistory", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientListArchivedWorkflowExecutionsScope:   {operation: "FrontendClientListArchivedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListClosedWorkflowExecutionsScope:     {operation: "FrontendClientListClosedWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListDomainsScope:                      {operation: "FrontendClientListDomains", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientListOpenWorkflowExecutionsScope:       {operation: "FrontendClientListOpenWorkflowExecutions", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
@@ -976,6 +1108,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListDLQTasksScope:                        {operation: "AdminClientListDLQTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRetryDLQTaskScope:                        {operation: "AdminClientRetryDLQTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDropDLQTaskScope:                         {operation: "AdminClientDropDLQTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeShardScope:                       {operation: "AdminClientDescribeShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListShardQueueTasksScope:                 {operation: "AdminClientListShardQueueTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRemoveShardQueueTaskScope:                {operation: "AdminClientRemoveShardQueueTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientCloseShardScope:                          {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientReloadShardScope:                         {operation: "AdminClientReloadShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRefreshWorkflowTasksScope:                {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRebuildMutableStateScope:                 {operation: "AdminClientRebuildMutableState", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
 		MessagingClientPublishBatchScope: {operation: "MessagingClientPublishBatch"},
@@ -1003,6 +1145,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminListDLQTasksScope:                   {operation: "ListDLQTasks"},
+		AdminRetryDLQTaskScope:                   {operation: "RetryDLQTask"},
+		AdminDropDLQTaskScope:                    {operation: "DropDLQTask"},
+		AdminDescribeShardScope:                  {operation: "DescribeShard"},
+		AdminListShardQueueTasksScope:            {operation: "ListShardQueueTasks"},
+		AdminRemoveShardQueueTaskScope:           {operation: "RemoveShardQueueTask"},
+		AdminCloseShardScope:                     {operation: "CloseShard"},
+		AdminReloadShardScope:                    {operation: "ReloadShard"},
+		AdminRefreshWorkflowTasksScope:           {operation: "RefreshWorkflowTasks"},
+		AdminRebuildMutableStateScope:            {operation: "RebuildMutableState"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -1026,6 +1178,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
 		FrontendListClosedWorkflowExecutionsScope:     {operation: "ListClosedWorkflowExecutions"},
+		FrontendListArchivedWorkflowExecutionsScope:   {operation: "ListArchivedWorkflowExecutions"},
 		FrontendRegisterDomainScope:                   {operation: "RegisterDomain"},
 		FrontendDescribeDomainScope:                   {operation: "DescribeDomain"},
 		FrontendListDomainsScope:                      {operation: "ListDomain"},
@@ -1064,6 +1217,16 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySyncShardStatusScope:                   {operation: "SyncShardStatus"},
 		HistorySyncActivityScope:                      {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:              {operation: "DescribeMutableState"},
+		HistoryListDLQTasksScope:                      {operation: "ListDLQTasks"},
+		HistoryRetryDLQTaskScope:                      {operation: "RetryDLQTask"},
+		HistoryDropDLQTaskScope:                       {operation: "DropDLQTask"},
+		HistoryDescribeShardScope:                     {operation: "DescribeShard"},
+		HistoryListShardQueueTasksScope:               {operation: "ListShardQueueTasks"},
+		HistoryRemoveShardQueueTaskScope:              {operation: "RemoveShardQueueTask"},
+		HistoryCloseShardScope:                        {operation: "CloseShard"},
+		HistoryReloadShardScope:                       {operation: "ReloadShard"},
+		HistoryRefreshWorkflowTasksScope:              {operation: "RefreshWorkflowTasks"},
+		HistoryRebuildMutableStateScope:               {operation: "RebuildMutableState"},
 		HistoryShardControllerScope:                   {operation: "ShardController"},
 		TransferQueueProcessorScope:                   {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:             {operation: "TransferActiveQueueProcessor"},
@@ -1133,20 +1296,24 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 	},
 	// Worker Scope Names
 	Worker: {
-		ReplicatorScope:                    {operation: "Replicator"},
-		DomainReplicationTaskScope:         {operation: "DomainReplicationTask"},
-		HistoryReplicationTaskScope:        {operation: "HistoryReplicationTask"},
-		SyncShardTaskScope:                 {operation: "SyncShardTask"},
-		SyncActivityTaskScope:              {operation: "SyncActivityTask"},
-		ESProcessorScope:                   {operation: "ESProcessor"},
-		IndexProcessorScope:                {operation: "IndexProcessor"},
-		ArchiverUploadHistoryActivityScope: {operation: "ArchiverUploadHistoryActivity"},
-		ArchiverDeleteHistoryActivityScope: {operation: "ArchiverDeleteHistoryActivity"},
-		ArchiverScope:                      {operation: "Archiver"},
-		ArchiverPumpScope:                  {operation: "ArchiverPump"},
-		ArchiverArchivalWorkflowScope:      {operation: "ArchiverArchivalWorkflow"},
-		ArchiverClientScope:                {operation: "ArchiverClient"},
-		TaskListScavengerScope:             {operation: "tasklistscavenger"},
+		ReplicatorScope:                       {operation: "Replicator"},
+		DomainReplicationTaskScope:            {operation: "DomainReplicationTask"},
+		HistoryReplicationTaskScope:           {operation: "HistoryReplicationTask"},
+		SyncShardTaskScope:                    {operation: "SyncShardTask"},
+		SyncActivityTaskScope:                 {operation: "SyncActivityTask"},
+		ESProcessorScope:                      {operation: "ESProcessor"},
+		IndexProcessorScope:                   {operation: "IndexProcessor"},
+		ArchiverUploadHistoryActivityScope:    {operation: "ArchiverUploadHistoryActivity"},
+		ArchiverDeleteHistoryActivityScope:    {operation: "ArchiverDeleteHistoryActivity"},
+		ArchiverUploadVisibilityActivityScope: {operation: "ArchiverUploadVisibilityActivity"},
+		ArchiverScope:                         {operation: "Archiver"},
+		ArchiverPumpScope:                     {operation: "ArchiverPump"},
+		ArchiverArchivalWorkflowScope:         {operation: "ArchiverArchivalWorkflow"},
+		ArchiverClientScope:                   {operation: "ArchiverClient"},
+		ArchiverVerifyArchivalActivityScope:   {operation: "ArchiverVerifyArchivalActivity"},
+		TaskListScavengerScope:                {operation: "tasklistscavenger"},
+		ExecutionsScavengerScope:              {operation: "executionsscavenger"},
+		HistoryScavengerScope:                 {operation: "historyscavenger"},
 	},
 	// Blobstore Scope Names
 	Blobstore: {
@@ -1223,10 +1390,13 @@ const (
 	TaskAttemptTimer
 	TaskStandbyRetryCounter
 	TaskNotActiveCounter
+	TaskMovedToDLQCounter
 	TaskLimitExceededCounter
 	TaskBatchCompleteCounter
 	TaskProcessingLatency
 	TaskQueueLatency
+	TaskScheduleLatency
+	TaskBacklogGauge
 
 	AckLevelUpdateCounter
 	AckLevelUpdateFailedCounter
@@ -1273,11 +1443,17 @@ const (
 	ShardInfoTimerFailoverInProgressTimer
 	ShardInfoTransferFailoverLatencyTimer
 	ShardInfoTimerFailoverLatencyTimer
+	ShardInfoTransferDLQDepthGauge
+	ShardInfoTimerDLQDepthGauge
+	ShardInfoTransferDLQDepthCappedGauge
+	ShardInfoTimerDLQDepthCappedGauge
 	MembershipChangedCounter
 	NumShardsGauge
 	GetEngineForShardErrorCounter
 	GetEngineForShardLatency
 	RemoveEngineForShardLatency
+	ShardHandoffLatency
+	ShardHandoffFailedCounter
 	CompleteDecisionWithStickyEnabledCounter
 	CompleteDecisionWithStickyDisabledCounter
 	HistoryEventNotificationQueueingLatency
@@ -1376,6 +1552,8 @@ const (
 	ArchiverDeleteLocalSuccessCount
 	ArchiverDeleteFailedAllRetriesCount
 	ArchiverDeleteSuccessCount
+	ArchiverUploadVisibilityFailedAllRetriesCount
+	ArchiverUploadVisibilitySuccessCount
 	ArchiverBacklogSizeGauge
 	ArchiverPumpTimeoutCount
 	ArchiverPumpSignalThresholdCount
@@ -1388,6 +1566,12 @@ const (
 	ArchiverHandleAllRequestsLatency
 	ArchiverWorkflowStoppingCount
 	ArchiverClientSendSignalFailureCount
+	ArchiverHistoryVerifiedCount
+	ArchiverHistoryMissingCount
+	ArchiverHistoryCorruptedCount
+	ArchiverHistoryReArchivedCount
+	ArchiverHistoryUnrecoverableCount
+	ArchiverVerificationFailedCount
 	TaskProcessedCount
 	TaskDeletedCount
 	TaskListProcessedCount
@@ -1397,6 +1581,23 @@ const (
 	StoppedCount
 	ExecutorTasksDeferredCount
 	ExecutorTasksDroppedCount
+	ExecutionProcessedCount
+	CurrentExecutionProcessedCount
+	ShardScanFailedCount
+	ExecutionMissingHistoryCount
+	ExecutionDanglingCurrentCount
+	ExecutionRetentionExpiredCount
+	ExecutionMissingVisibilityCount
+	ExecutionFixedCount
+	ExecutionFixFailedCount
+	ExecutionTasksRefreshedCount
+	ExecutionRefreshTasksFailedCount
+	HistoryBranchProcessedCount
+	HistoryBranchOrphanedCount
+	HistoryBranchDeletedCount
+	HistoryBranchDeleteFailedCount
+	HistoryBranchMalformedCount
+	HistoryBranchAwaitingArchivalCount
 	NumWorkerMetrics
 )
 
@@ -1456,9 +1657,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskDiscarded:                                {metricName: "task_errors_discarded", oldMetricName: "task.errors.discarded", metricType: Counter},
 		TaskStandbyRetryCounter:                      {metricName: "task_errors_standby_retry_counter", oldMetricName: "task.errors.standby-retry-counter", metricType: Counter},
 		TaskNotActiveCounter:                         {metricName: "task_errors_not_active_counter", oldMetricName: "task.errors.not-active-counter", metricType: Counter},
+		TaskMovedToDLQCounter:                        {metricName: "task_moved_to_dlq", oldMetricName: "task.moved-to-dlq", metricType: Counter},
 		TaskLimitExceededCounter:                     {metricName: "task_errors_limit_exceeded_counter", oldMetricName: "task.errors.limit-exceeded-counter", metricType: Counter},
 		TaskProcessingLatency:                        {metricName: "task_latency_processing", oldMetricName: "task.latency.processing", metricType: Timer},
 		TaskQueueLatency:                             {metricName: "task_latency_queue", oldMetricName: "task.latency.queue", metricType: Timer},
+		TaskScheduleLatency:                          {metricName: "task_latency_schedule", oldMetricName: "task.latency.schedule", metricType: Timer},
+		TaskBacklogGauge:                             {metricName: "task_backlog", oldMetricName: "task.backlog", metricType: Gauge},
 		TaskBatchCompleteCounter:                     {metricName: "task_batch_complete_counter", oldMetricName: "task.batch-complete-counter", metricType: Counter},
 		AckLevelUpdateCounter:                        {metricName: "ack_level_update", oldMetricName: "ack-level-update", metricType: Counter},
 		AckLevelUpdateFailedCounter:                  {metricName: "ack_level_update_failed", oldMetricName: "ack-level-update-failed", metricType: Counter},
@@ -1505,11 +1709,17 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ShardInfoTimerFailoverInProgressTimer:        {metricName: "shardinfo_timer_failover_in_progress", oldMetricName: "shardinfo-timer-failover-in-progress", metricType: Timer},
 		ShardInfoTransferFailoverLatencyTimer:        {metricName: "shardinfo_transfer_failover_latency", oldMetricName: "shardinfo-transfer-failover-latency", metricType: Timer},
 		ShardInfoTimerFailoverLatencyTimer:           {metricName: "shardinfo_timer_failover_latency", oldMetricName: "shardinfo-timer-failover-latency", metricType: Timer},
+		ShardInfoTransferDLQDepthGauge:               {metricName: "shardinfo_transfer_dlq_depth", oldMetricName: "shardinfo-transfer-dlq-depth", metricType: Gauge},
+		ShardInfoTimerDLQDepthGauge:                  {metricName: "shardinfo_timer_dlq_depth", oldMetricName: "shardinfo-timer-dlq-depth", metricType: Gauge},
+		ShardInfoTransferDLQDepthCappedGauge:         {metricName: "shardinfo_transfer_dlq_depth_capped", oldMetricName: "shardinfo-transfer-dlq-depth-capped", metricType: Gauge},
+		ShardInfoTimerDLQDepthCappedGauge:            {metricName: "shardinfo_timer_dlq_depth_capped", oldMetricName: "shardinfo-timer-dlq-depth-capped", metricType: Gauge},
 		MembershipChangedCounter:                     {metricName: "membership_changed_count", oldMetricName: "membership-changed-count", metricType: Counter},
 		NumShardsGauge:                               {metricName: "numshards_gauge", oldMetricName: "numshards-gauge", metricType: Gauge},
 		GetEngineForShardErrorCounter:                {metricName: "get_engine_for_shard_errors", oldMetricName: "get-engine-for-shard-errors", metricType: Counter},
 		GetEngineForShardLatency:                     {metricName: "get_engine_for_shard_latency", oldMetricName: "get-engine-for-shard-latency", metricType: Timer},
 		RemoveEngineForShardLatency:                  {metricName: "remove_engine_for_shard_latency", oldMetricName: "remove-engine-for-shard-latency", metricType: Timer},
+		ShardHandoffLatency:                          {metricName: "shard_handoff_latency", oldMetricName: "shard-handoff-latency", metricType: Timer},
+		ShardHandoffFailedCounter:                    {metricName: "shard_handoff_failed", oldMetricName: "shard-handoff-failed", metricType: Counter},
 		CompleteDecisionWithStickyEnabledCounter:     {metricName: "complete_decision_sticky_enabled_count", oldMetricName: "complete-decision-sticky-enabled-count", metricType: Counter},
 		CompleteDecisionWithStickyDisabledCounter:    {metricName: "complete_decision_sticky_disabled_count", oldMetricName: "complete-decision-sticky-disabled-count", metricType: Counter},
 		HistoryEventNotificationQueueingLatency:      {metricName: "history_event_notification_queueing_latency", oldMetricName: "history-event-notification-queueing-latency", metricType: Timer},
@@ -1600,6 +1810,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ArchiverDeleteLocalSuccessCount:                        {metricName: "archiver_delete_local_success", oldMetricName: "archiver.delete-local-success"},
 		ArchiverDeleteFailedAllRetriesCount:                    {metricName: "archiver_delete_failed_all_retries", oldMetricName: "archiver.delete-failed-all-retries"},
 		ArchiverDeleteSuccessCount:                             {metricName: "archiver_delete_success", oldMetricName: "archiver.delete-success"},
+		ArchiverUploadVisibilityFailedAllRetriesCount:          {metricName: "archiver_upload_visibility_failed_all_retries", oldMetricName: "archiver.upload-visibility-failed-all-retries"},
+		ArchiverUploadVisibilitySuccessCount:                   {metricName: "archiver_upload_visibility_success", oldMetricName: "archiver.upload-visibility-success"},
 		ArchiverBacklogSizeGauge:                               {metricName: "archiver_backlog_size", oldMetricName: "archiver.backlog-size"},
 		ArchiverPumpTimeoutCount:                               {metricName: "archiver_pump_timeout", oldMetricName: "archiver.pump-timeout"},
 		ArchiverPumpSignalThresholdCount:                       {metricName: "archiver_pump_signal_threshold", oldMetricName: "archiver.pump-signal-threshold"},
@@ -1612,6 +1824,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ArchiverHandleAllRequestsLatency:                       {metricName: "archiver_handle_all_requests_latency", oldMetricName: "archiver.handle-all-requests-latency"},
 		ArchiverWorkflowStoppingCount:                          {metricName: "archiver_workflow_stopping", oldMetricName: "archiver.workflow-stopping"},
 		ArchiverClientSendSignalFailureCount:                   {metricName: "archiver_client_send_signal_error", oldMetricName: "archiver.client-send-signal-error"},
+		ArchiverHistoryVerifiedCount:                           {metricName: "archiver_history_verified", oldMetricName: "archiver.history-verified"},
+		ArchiverHistoryMissingCount:                            {metricName: "archiver_history_missing", oldMetricName: "archiver.history-missing"},
+		ArchiverHistoryCorruptedCount:                          {metricName: "archiver_history_corrupted", oldMetricName: "archiver.history-corrupted"},
+		ArchiverHistoryReArchivedCount:                         {metricName: "archiver_history_rearchived", oldMetricName: "archiver.history-rearchived"},
+		ArchiverHistoryUnrecoverableCount:                      {metricName: "archiver_history_unrecoverable", oldMetricName: "archiver.history-unrecoverable"},
+		ArchiverVerificationFailedCount:                        {metricName: "archiver_verification_failed", oldMetricName: "archiver.verification-failed"},
 		TaskProcessedCount:                                     {metricName: "task_processed", metricType: Gauge},
 		TaskDeletedCount:                                       {metricName: "task_deleted", metricType: Gauge},
 		TaskListProcessedCount:                                 {metricName: "tasklist_processed", metricType: Gauge},
@@ -1621,9 +1839,60 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		StoppedCount:                                           {metricName: "stopped", metricType: Counter},
 		ExecutorTasksDeferredCount:                             {metricName: "executor_deferred", metricType: Counter},
 		ExecutorTasksDroppedCount:                              {metricName: "executor_dropped", metricType: Counter},
+		ExecutionProcessedCount:                                {metricName: "execution_processed", metricType: Gauge},
+		CurrentExecutionProcessedCount:                         {metricName: "current_execution_processed", metricType: Gauge},
+		ShardScanFailedCount:                                   {metricName: "shard_scan_failed", metricType: Counter},
+		ExecutionMissingHistoryCount:                           {metricName: "execution_missing_history", metricType: Counter},
+		ExecutionDanglingCurrentCount:                          {metricName: "execution_dangling_current", metricType: Counter},
+		ExecutionRetentionExpiredCount:                         {metricName: "execution_retention_expired", metricType: Counter},
+		ExecutionMissingVisibilityCount:                        {metricName: "execution_missing_visibility", metricType: Counter},
+		ExecutionFixedCount:                                    {metricName: "execution_fixed", metricType: Counter},
+		ExecutionFixFailedCount:                                {metricName: "execution_fix_failed", metricType: Counter},
+		ExecutionTasksRefreshedCount:                           {metricName: "execution_tasks_refreshed", metricType: Counter},
+		ExecutionRefreshTasksFailedCount:                       {metricName: "execution_refresh_tasks_failed", metricType: Counter},
+		HistoryBranchProcessedCount:                            {metricName: "history_branch_processed", metricType: Gauge},
+		HistoryBranchOrphanedCount:                             {metricName: "history_branch_orphaned", metricType: Gauge},
+		HistoryBranchDeletedCount:                              {metricName: "history_branch_deleted", metricType: Gauge},
+		HistoryBranchDeleteFailedCount:                         {metricName: "history_branch_delete_failed", metricType: Counter},
+		HistoryBranchMalformedCount:                            {metricName: "history_branch_malformed", metricType: Counter},
+		HistoryBranchAwaitingArchivalCount:                     {metricName: "history_branch_awaiting_archival", metricType: Gauge},
 	},
 }
 
+// TagKeys returns the sorted keys of all the tags the scopes and metrics
+// are reported with, for reporters which need every metric to carry the
+// same set of labels
+func TagKeys() []string {
+	keySet := map[string]struct{}{OperationTagName: {}, domain: {}}
+	for _, scopeDefs := range ScopeDefs {
+		for _, def := range scopeDefs {
+			for key := range def.tags {
+				keySet[key] = struct{}{}
+			}
+		}
+	}
+	keys := make([]string, 0, len(keySet))
+	for key := range keySet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// LegacyMetricNames returns the old names metrics are also reported
+// under, for reporters which would report them twice once sanitized
+func LegacyMetricNames() map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, metricDefs := range MetricDefs {
+		for _, def := range metricDefs {
+			if len(def.oldMetricName) > 0 {
+				names[string(def.oldMetricName)] = struct{}{}
+			}
+		}
+	}
+	return names
+}
+
 // ErrorClass is an enum to help with classifying SLA vs. non-SLA errors (SLA = "service level agreement")
 type ErrorClass uint8
 
@@ -0,0 +1,221 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prometheus
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	prom "github.com/m3db/prometheus_client_golang/prometheus"
+	"github.com/m3db/prometheus_client_golang/prometheus/promhttp"
+	"github.com/uber-go/tally"
+	tallyprom "github.com/uber-go/tally/prometheus"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// Configuration is the configuration for the prometheus reporter
+	Configuration struct {
+		// ListenAddress is the address the metrics are served on
+		ListenAddress string `yaml:"listenAddress" validate:"nonzero"`
+		// HandlerPath is the http path the metrics are served on, /metrics by default
+		HandlerPath string `yaml:"handlerPath"`
+		// TimerType is the prometheus type used for timers,
+		// either histogram (default) or summary
+		TimerType string `yaml:"timerType"`
+		// HistogramBuckets are the upper bounds, in seconds, of the
+		// buckets used for timers, DefaultLatencyBuckets by default
+		HistogramBuckets []float64 `yaml:"histogramBuckets"`
+	}
+
+	// cadencePrometheusReporter is a wrapper on top of
+	// "github.com/uber-go/tally/prometheus" which reports every
+	// metric with the same set of labels, as prometheus requires
+	// all the series of a metric to have the same label names
+	cadencePrometheusReporter struct {
+		tallyprom.Reporter
+		tagKeys     []string
+		legacyNames map[string]struct{}
+	}
+)
+
+const (
+	// DefaultHandlerPath is the default http path the metrics are served on
+	DefaultHandlerPath = "/metrics"
+	// noneTagValue is the label value of the tags a metric is not tagged with
+	noneTagValue = "none"
+
+	timerTypeHistogram = "histogram"
+	timerTypeSummary   = "summary"
+)
+
+// DefaultLatencyBuckets are the histogram buckets, in seconds,
+// used for timers when none are configured
+var DefaultLatencyBuckets = []float64{
+	0.001, 0.002, 0.005, 0.01, 0.02, 0.05, 0.1, 0.2, 0.5,
+	1, 2, 5, 10, 20, 30, 60, 120, 300,
+}
+
+var errNoListenAddress = errors.New("prometheus listenAddress is required")
+
+// NewReporter creates a reporter backed by its own prometheus registry and
+// starts serving the registry on the configured listen address. Errors
+// registering metrics or serving requests are passed to onError.
+func NewReporter(config Configuration, onError func(error)) (tally.CachedStatsReporter, error) {
+	if len(config.ListenAddress) == 0 {
+		return nil, errNoListenAddress
+	}
+	opts := tallyprom.Options{
+		DefaultHistogramBuckets: config.HistogramBuckets,
+		OnRegisterError:         onError,
+	}
+	switch config.TimerType {
+	case "", timerTypeHistogram:
+		opts.DefaultTimerType = tallyprom.HistogramTimerType
+	case timerTypeSummary:
+		opts.DefaultTimerType = tallyprom.SummaryTimerType
+	default:
+		return nil, fmt.Errorf("unknown prometheus timerType: %v", config.TimerType)
+	}
+	if len(opts.DefaultHistogramBuckets) == 0 {
+		opts.DefaultHistogramBuckets = DefaultLatencyBuckets
+	}
+	path := config.HandlerPath
+	if len(path) == 0 {
+		path = DefaultHandlerPath
+	}
+
+	listener, err := net.Listen("tcp", config.ListenAddress)
+	if err != nil {
+		return nil, err
+	}
+	registry := prom.NewRegistry()
+	opts.Registerer = registry
+	mux := http.NewServeMux()
+	mux.Handle(path, promhttp.HandlerFor(registry, promhttp.HandlerOpts{ErrorLog: errorLogger(onError)}))
+	go func() {
+		if err := http.Serve(listener, mux); err != nil {
+			onError(err)
+		}
+	}()
+
+	return newReporter(tallyprom.NewReporter(opts)), nil
+}
+
+func newReporter(reporter tallyprom.Reporter) *cadencePrometheusReporter {
+	tagKeys := metrics.TagKeys()
+	for i, key := range tagKeys {
+		tagKeys[i] = sanitize(key)
+	}
+	return &cadencePrometheusReporter{
+		Reporter:    reporter,
+		tagKeys:     tagKeys,
+		legacyNames: metrics.LegacyMetricNames(),
+	}
+}
+
+func (r *cadencePrometheusReporter) AllocateCounter(name string, tags map[string]string) tally.CachedCount {
+	if r.isLegacy(name) {
+		return noopMetric{}
+	}
+	return r.Reporter.AllocateCounter(sanitize(name), r.labels(tags))
+}
+
+func (r *cadencePrometheusReporter) AllocateGauge(name string, tags map[string]string) tally.CachedGauge {
+	if r.isLegacy(name) {
+		return noopMetric{}
+	}
+	return r.Reporter.AllocateGauge(sanitize(name), r.labels(tags))
+}
+
+func (r *cadencePrometheusReporter) AllocateTimer(name string, tags map[string]string) tally.CachedTimer {
+	if r.isLegacy(name) {
+		return noopMetric{}
+	}
+	return r.Reporter.AllocateTimer(sanitize(name), r.labels(tags))
+}
+
+func (r *cadencePrometheusReporter) AllocateHistogram(
+	name string,
+	tags map[string]string,
+	buckets tally.Buckets,
+) tally.CachedHistogram {
+	if r.isLegacy(name) {
+		return noopMetric{}
+	}
+	return r.Reporter.AllocateHistogram(sanitize(name), r.labels(tags), buckets)
+}
+
+// isLegacy returns true for metrics reported under their old name,
+// which would otherwise be reported twice once sanitized
+func (r *cadencePrometheusReporter) isLegacy(name string) bool {
+	_, ok := r.legacyNames[name]
+	return ok
+}
+
+// labels sanitizes the tags and adds every known tag
+// the metric is not tagged with, using noneTagValue
+func (r *cadencePrometheusReporter) labels(tags map[string]string) map[string]string {
+	labels := make(map[string]string, len(r.tagKeys)+len(tags))
+	for _, key := range r.tagKeys {
+		labels[key] = noneTagValue
+	}
+	for key, value := range tags {
+		labels[sanitize(key)] = value
+	}
+	return labels
+}
+
+// sanitize replaces the characters not allowed in
+// prometheus metric and label names with underscores
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+type errorLogger func(error)
+
+func (l errorLogger) Println(v ...interface{}) {
+	l(errors.New(fmt.Sprint(v...)))
+}
+
+type noopMetric struct{}
+
+func (m noopMetric) ReportCount(value int64)            {}
+func (m noopMetric) ReportGauge(value float64)          {}
+func (m noopMetric) ReportTimer(interval time.Duration) {}
+func (m noopMetric) ReportSamples(value int64)          {}
+func (m noopMetric) ValueBucket(lower, upper float64) tally.CachedHistogramBucket {
+	return m
+}
+func (m noopMetric) DurationBucket(lower, upper time.Duration) tally.CachedHistogramBucket {
+	return m
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package prometheus
+
+import (
+	"testing"
+	"time"
+
+	prom "github.com/m3db/prometheus_client_golang/prometheus"
+	dto "github.com/m3db/prometheus_client_model/go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	tallyprom "github.com/uber-go/tally/prometheus"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	reporterSuite struct {
+		*require.Assertions
+		suite.Suite
+		registry *prom.Registry
+		scope    tally.Scope
+		closer   func()
+	}
+)
+
+func TestReporterSuite(t *testing.T) {
+	suite.Run(t, new(reporterSuite))
+}
+
+func (s *reporterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.registry = prom.NewRegistry()
+	reporter := newReporter(tallyprom.NewReporter(tallyprom.Options{
+		Registerer:              s.registry,
+		DefaultTimerType:        tallyprom.HistogramTimerType,
+		DefaultHistogramBuckets: DefaultLatencyBuckets,
+	}))
+	scope, closer := tally.NewRootScope(tally.ScopeOptions{
+		Tags:           map[string]string{"cadence-cluster": "active"},
+		CachedReporter: reporter,
+	}, time.Hour)
+	s.scope = scope
+	s.closer = func() { closer.Close() }
+}
+
+func (s *reporterSuite) TearDownTest() {
+	s.closer()
+}
+
+func (s *reporterSuite) TestLabelsArePadded() {
+	client := metrics.NewClient(s.scope, metrics.Frontend)
+	client.IncCounter(metrics.FrontendStartWorkflowExecutionScope, metrics.CadenceRequests)
+	client.Scope(metrics.FrontendStartWorkflowExecutionScope, metrics.DomainTag("samples")).
+		IncCounter(metrics.CadenceRequests)
+	s.closer()
+
+	family := s.gather("cadence_requests")
+	s.Equal(dto.MetricType_COUNTER, family.GetType())
+	s.Len(family.Metric, 2)
+	for _, metric := range family.Metric {
+		labels := labelMap(metric)
+		s.Equal("active", labels["cadence_cluster"])
+		s.Equal("StartWorkflowExecution", labels["operation"])
+		s.Contains(labels, "cadence_role")
+		s.Contains(labels, "domain")
+		s.Equal(float64(1), metric.GetCounter().GetValue())
+	}
+	domains := []string{labelMap(family.Metric[0])["domain"], labelMap(family.Metric[1])["domain"]}
+	s.ElementsMatch([]string{noneTagValue, "samples"}, domains)
+}
+
+func (s *reporterSuite) TestLegacyNamesAreDropped() {
+	client := metrics.NewClient(s.scope, metrics.Frontend)
+	client.IncCounter(metrics.FrontendStartWorkflowExecutionScope, metrics.CadenceRequests)
+	s.closer()
+
+	// cadence.requests would be counted as cadence_requests as well
+	s.Equal(float64(1), s.gather("cadence_requests").Metric[0].GetCounter().GetValue())
+}
+
+func (s *reporterSuite) TestTimersAreHistograms() {
+	s.scope.Tagged(map[string]string{"cadence-role": "history"}).
+		Timer("cadence_latency").Record(30 * time.Millisecond)
+	s.closer()
+
+	family := s.gather("cadence_latency")
+	s.Equal(dto.MetricType_HISTOGRAM, family.GetType())
+	histogram := family.Metric[0].GetHistogram()
+	s.Equal(uint64(1), histogram.GetSampleCount())
+	s.Len(histogram.Bucket, len(DefaultLatencyBuckets))
+	s.Equal("history", labelMap(family.Metric[0])["cadence_role"])
+	s.Equal(noneTagValue, labelMap(family.Metric[0])["operation"])
+}
+
+func (s *reporterSuite) TestSanitize() {
+	s.Equal("cadence_errors_critical", sanitize("cadence.errors.critical"))
+	s.Equal("cadence_role", sanitize("cadence-role"))
+	s.Equal("num_goroutines", sanitize("num-goroutines"))
+}
+
+func (s *reporterSuite) TestNewReporterRequiresListenAddress() {
+	_, err := NewReporter(Configuration{}, func(error) {})
+	s.Equal(errNoListenAddress, err)
+	_, err = NewReporter(Configuration{ListenAddress: "127.0.0.1:0", TimerType: "gauge"}, func(error) {})
+	s.Error(err)
+}
+
+func (s *reporterSuite) gather(name string) *dto.MetricFamily {
+	families, err := s.registry.Gather()
+	s.NoError(err)
+	for _, family := range families {
+		if family.GetName() == name {
+			return family
+		}
+	}
+	s.FailNow("metric not found", name)
+	return nil
+}
+
+func labelMap(metric *dto.Metric) map[string]string {
+	labels := make(map[string]string)
+	for _, label := range metric.Label {
+		labels[label.GetName()] = label.GetValue()
+	}
+	return labels
+}
@@ -197,6 +197,38 @@ func (_m *BlobstoreClient) ListByPrefix(ctx context.Context, bucket string, pref
 	return r0, r1
 }
 
+// ListByPrefixPage provides a mock function with given fields: ctx, bucket, prefix, pageSize, pageToken
+func (_m *BlobstoreClient) ListByPrefixPage(ctx context.Context, bucket string, prefix string, pageSize int, pageToken []byte) ([]blob.Key, []byte, error) {
+	ret := _m.Called(ctx, bucket, prefix, pageSize, pageToken)
+
+	var r0 []blob.Key
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, []byte) []blob.Key); ok {
+		r0 = rf(ctx, bucket, prefix, pageSize, pageToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]blob.Key)
+		}
+	}
+
+	var r1 []byte
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int, []byte) []byte); ok {
+		r1 = rf(ctx, bucket, prefix, pageSize, pageToken)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]byte)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, int, []byte) error); ok {
+		r2 = rf(ctx, bucket, prefix, pageSize, pageToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Upload provides a mock function with given fields: ctx, bucket, key, _a3
 func (_m *BlobstoreClient) Upload(ctx context.Context, bucket string, key blob.Key, _a3 *blob.Blob) error {
 	ret := _m.Called(ctx, bucket, key, _a3)
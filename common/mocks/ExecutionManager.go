@@ -167,6 +167,20 @@ func (_m *ExecutionManager) DeleteWorkflowExecution(request *persistence.DeleteW
 	return r0
 }
 
+// DeleteCurrentWorkflowExecution provides a mock function with given fields: request
+func (_m *ExecutionManager) DeleteCurrentWorkflowExecution(request *persistence.DeleteCurrentWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteCurrentWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetCurrentExecution provides a mock function with given fields: request
 func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentExecutionRequest) (*persistence.GetCurrentExecutionResponse, error) {
 	ret := _m.Called(request)
@@ -190,6 +204,52 @@ func (_m *ExecutionManager) GetCurrentExecution(request *persistence.GetCurrentE
 	return r0, r1
 }
 
+// ListCurrentExecutions provides a mock function with given fields: request
+func (_m *ExecutionManager) ListCurrentExecutions(request *persistence.ListCurrentExecutionsRequest) (*persistence.ListCurrentExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListCurrentExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListCurrentExecutionsRequest) *persistence.ListCurrentExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListCurrentExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListCurrentExecutionsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListConcreteExecutions provides a mock function with given fields: request
+func (_m *ExecutionManager) ListConcreteExecutions(request *persistence.ListConcreteExecutionsRequest) (*persistence.ListConcreteExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListConcreteExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListConcreteExecutionsRequest) *persistence.ListConcreteExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListConcreteExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListConcreteExecutionsRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransferTasks provides a mock function with given fields: request
 func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTasksRequest) (*persistence.GetTransferTasksResponse, error) {
 	ret := _m.Called(request)
@@ -213,6 +273,52 @@ func (_m *ExecutionManager) GetTransferTasks(request *persistence.GetTransferTas
 	return r0, r1
 }
 
+// GetDLQTask provides a mock function with given fields: request
+func (_m *ExecutionManager) GetDLQTask(request *persistence.GetDLQTaskRequest) (*persistence.GetDLQTaskResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetDLQTaskResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetDLQTaskRequest) *persistence.GetDLQTaskResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetDLQTaskResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetDLQTaskRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDLQTasks provides a mock function with given fields: request
+func (_m *ExecutionManager) GetDLQTasks(request *persistence.GetDLQTasksRequest) (*persistence.GetDLQTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.GetDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(*persistence.GetDLQTasksRequest) *persistence.GetDLQTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.GetDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.GetDLQTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CompleteTransferTask provides a mock function with given fields: request
 func (_m *ExecutionManager) CompleteTransferTask(request *persistence.CompleteTransferTaskRequest) error {
 	ret := _m.Called(request)
@@ -329,6 +435,34 @@ func (_m *ExecutionManager) RangeCompleteTimerTask(request *persistence.RangeCom
 	return r0
 }
 
+// PutTaskToDLQ provides a mock function with given fields: request
+func (_m *ExecutionManager) PutTaskToDLQ(request *persistence.PutTaskToDLQRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.PutTaskToDLQRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteDLQTask provides a mock function with given fields: request
+func (_m *ExecutionManager) DeleteDLQTask(request *persistence.DeleteDLQTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.DeleteDLQTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *ExecutionManager) Close() {
 	_m.Called()
@@ -179,6 +179,36 @@ func (_m *FrontendClient) GetWorkflowExecutionHistory(ctx context.Context, GetRe
 	return r0, r1
 }
 
+// ListArchivedWorkflowExecutions provides a mock function with given fields: ctx, ListRequest, opts
+func (_m *FrontendClient) ListArchivedWorkflowExecutions(ctx context.Context, ListRequest *shared.ListArchivedWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, ListRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.ListArchivedWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListArchivedWorkflowExecutionsRequest, ...yarpc.CallOption) *shared.ListArchivedWorkflowExecutionsResponse); ok {
+		r0 = rf(ctx, ListRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListArchivedWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListArchivedWorkflowExecutionsRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, ListRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListClosedWorkflowExecutions provides a mock function with given fields: ctx, ListRequest, opts
 func (_m *FrontendClient) ListClosedWorkflowExecutions(ctx context.Context, ListRequest *shared.ListClosedWorkflowExecutionsRequest, opts ...yarpc.CallOption) (*shared.ListClosedWorkflowExecutionsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -511,3 +511,179 @@ func (_m *HistoryClient) SyncActivity(ctx context.Context, request *history.Sync
 
 	return r0
 }
+
+// ListDLQTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ListDLQTasks(ctx context.Context, request *shared.ListDLQTasksRequest, opts ...yarpc.CallOption) (*shared.ListDLQTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListDLQTasksRequest) *shared.ListDLQTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListDLQTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryDLQTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RetryDLQTask(ctx context.Context, request *shared.RetryDLQTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RetryDLQTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropDLQTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DropDLQTask(ctx context.Context, request *shared.DropDLQTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DropDLQTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeShard(ctx context.Context, request *shared.DescribeShardRequest, opts ...yarpc.CallOption) (*shared.DescribeShardResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeShardResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeShardRequest) *shared.DescribeShardResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeShardRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShardQueueTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ListShardQueueTasks(ctx context.Context, request *shared.ListShardQueueTasksRequest, opts ...yarpc.CallOption) (*shared.ListShardQueueTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListShardQueueTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListShardQueueTasksRequest) *shared.ListShardQueueTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardQueueTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListShardQueueTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShardQueueTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RemoveShardQueueTask(ctx context.Context, request *shared.RemoveShardQueueTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RemoveShardQueueTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) CloseShard(ctx context.Context, request *shared.CloseShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CloseShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReloadShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ReloadShard(ctx context.Context, request *shared.ReloadShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ReloadShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshWorkflowTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RefreshWorkflowTasks(ctx context.Context, request *history.RefreshWorkflowTasksRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RefreshWorkflowTasksRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RebuildMutableState provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RebuildMutableState(ctx context.Context, request *history.RebuildMutableStateRequest, opts ...yarpc.CallOption) (*shared.RebuildMutableStateResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.RebuildMutableStateResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RebuildMutableStateRequest) *shared.RebuildMutableStateResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.RebuildMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.RebuildMutableStateRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -21,6 +21,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/uber/cadence/common/messaging"
 )
 
@@ -57,6 +59,11 @@ func (c *MessagingClient) NewProducer(appName string) (messaging.Producer, error
 	return c.publisherMock, nil
 }
 
+// Ping always succeeds
+func (c *MessagingClient) Ping(ctx context.Context) error {
+	return nil
+}
+
 // NewProducerWithClusterName generates a dummy implementation of kafka producer
 func (c *MessagingClient) NewProducerWithClusterName(sourceCluster string) (messaging.Producer, error) {
 	return c.publisherMock, nil
@@ -0,0 +1,152 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package payload
+
+import (
+	"github.com/uber/cadence/.gen/go/shared"
+)
+
+// payloadFields returns pointers to the user payload fields of event which may be offloaded
+func payloadFields(event *shared.HistoryEvent) []*[]byte {
+	switch event.GetEventType() {
+	case shared.EventTypeWorkflowExecutionStarted:
+		attr := event.WorkflowExecutionStartedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Input, &attr.ContinuedFailureDetails, &attr.LastCompletionResult}
+	case shared.EventTypeWorkflowExecutionCompleted:
+		attr := event.WorkflowExecutionCompletedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Result}
+	case shared.EventTypeWorkflowExecutionFailed:
+		attr := event.WorkflowExecutionFailedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeWorkflowExecutionCanceled:
+		attr := event.WorkflowExecutionCanceledEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeWorkflowExecutionTerminated:
+		attr := event.WorkflowExecutionTerminatedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeWorkflowExecutionContinuedAsNew:
+		attr := event.WorkflowExecutionContinuedAsNewEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Input, &attr.FailureDetails, &attr.LastCompletionResult}
+	case shared.EventTypeWorkflowExecutionSignaled:
+		attr := event.WorkflowExecutionSignaledEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Input}
+	case shared.EventTypeDecisionTaskCompleted:
+		attr := event.DecisionTaskCompletedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.ExecutionContext}
+	case shared.EventTypeDecisionTaskFailed:
+		attr := event.DecisionTaskFailedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeActivityTaskScheduled:
+		attr := event.ActivityTaskScheduledEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Input}
+	case shared.EventTypeActivityTaskCompleted:
+		attr := event.ActivityTaskCompletedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Result}
+	case shared.EventTypeActivityTaskFailed:
+		attr := event.ActivityTaskFailedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeActivityTaskTimedOut:
+		attr := event.ActivityTaskTimedOutEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeActivityTaskCanceled:
+		attr := event.ActivityTaskCanceledEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeMarkerRecorded:
+		attr := event.MarkerRecordedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeStartChildWorkflowExecutionInitiated:
+		attr := event.StartChildWorkflowExecutionInitiatedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Input}
+	case shared.EventTypeChildWorkflowExecutionCompleted:
+		attr := event.ChildWorkflowExecutionCompletedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Result}
+	case shared.EventTypeChildWorkflowExecutionFailed:
+		attr := event.ChildWorkflowExecutionFailedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeChildWorkflowExecutionCanceled:
+		attr := event.ChildWorkflowExecutionCanceledEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Details}
+	case shared.EventTypeSignalExternalWorkflowExecutionInitiated:
+		attr := event.SignalExternalWorkflowExecutionInitiatedEventAttributes
+		if attr == nil {
+			return nil
+		}
+		return []*[]byte{&attr.Input}
+	default:
+		return nil
+	}
+}
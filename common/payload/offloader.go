@@ -0,0 +1,264 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package payload
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// Offloader moves large history event payloads into the blobstore and resolves them back.
+	// An offloaded payload is replaced by a reference of the form <prefix><bucket>/<key>,
+	// the reference carries the bucket so that it can be resolved after the bucket config changes.
+	// Payloads are kept per history tree rather than per run, since the runs reset from one another
+	// share the history tree and with it the references to the payloads offloaded before the reset.
+	Offloader interface {
+		// IsEnabled returns true if the payloads of the domain are offloaded
+		IsEnabled(domainName string) bool
+		// OffloadEvents replaces every payload of events which is larger than the offload threshold
+		// of the domain with a reference to a blob holding the payload, events are modified in place
+		OffloadEvents(domainID, domainName, treeID string, events []*shared.HistoryEvent) error
+		// ResolveEvents replaces every payload reference in events with the payload it refers to,
+		// events are modified in place
+		ResolveEvents(events []*shared.HistoryEvent) error
+		// Resolve returns the payload referred to by given payload, or the payload itself if it is not a reference
+		Resolve(payload []byte) ([]byte, error)
+		// DeletePayloads deletes all payloads offloaded for the given history tree
+		DeletePayloads(domainID, treeID string) error
+	}
+
+	// Config is the dynamic config used by Offloader
+	Config struct {
+		// Bucket is the blobstore bucket payloads are offloaded to, offloading is disabled if it is empty
+		Bucket dynamicconfig.StringPropertyFn
+		// Threshold is the size in bytes above which payloads of a domain are offloaded, 0 disables offloading
+		Threshold dynamicconfig.IntPropertyFnWithDomainFilter
+	}
+
+	offloaderImpl struct {
+		client blobstore.Client
+		config *Config
+	}
+)
+
+const (
+	// payloadBlobExtension is the extension used by all offloaded payload keys
+	payloadBlobExtension = "payload"
+	// referenceBucketSeparator separates bucket from key in a reference
+	referenceBucketSeparator = "/"
+	// keyPiecesSeparator is the separator blob.NewKey puts between key name pieces
+	keyPiecesSeparator = "_"
+
+	offloadOperationTimeout = 10 * time.Second
+)
+
+var (
+	// referencePrefix marks a payload as a reference to an offloaded payload
+	referencePrefix = []byte("\x00cadence-offloaded-payload:")
+
+	errBlobstoreNotConfigured = errors.New("payload references blobstore but no blobstore is configured")
+	errInvalidReference       = errors.New("invalid offloaded payload reference")
+)
+
+var _ Offloader = (*offloaderImpl)(nil)
+
+// NewOffloader returns a new Offloader, client may be nil in which case offloading is disabled.
+// Config may be nil for an Offloader which is only used to resolve payloads.
+func NewOffloader(client blobstore.Client, config *Config) Offloader {
+	return &offloaderImpl{
+		client: client,
+		config: config,
+	}
+}
+
+// IsReference returns true if payload is a reference to an offloaded payload
+func IsReference(payload []byte) bool {
+	return bytes.HasPrefix(payload, referencePrefix)
+}
+
+func (o *offloaderImpl) IsEnabled(domainName string) bool {
+	if o.client == nil || o.config == nil {
+		return false
+	}
+	return len(o.config.Bucket()) != 0 && o.config.Threshold(domainName) > 0
+}
+
+func (o *offloaderImpl) OffloadEvents(
+	domainID string,
+	domainName string,
+	treeID string,
+	events []*shared.HistoryEvent,
+) error {
+
+	if !o.IsEnabled(domainName) {
+		return nil
+	}
+	bucket := o.config.Bucket()
+	threshold := o.config.Threshold(domainName)
+
+	domainIDHash, treeIDHash := treeKeyPieces(domainID, treeID)
+	for _, event := range events {
+		for _, field := range payloadFields(event) {
+			if len(*field) <= threshold || IsReference(*field) {
+				continue
+			}
+			key, err := blob.NewKey(payloadBlobExtension, domainIDHash, treeIDHash, strings.Replace(uuid.New(), "-", "", -1))
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), offloadOperationTimeout)
+			err = o.client.Upload(ctx, bucket, key, blob.NewBlob(*field, nil))
+			cancel()
+			if err != nil {
+				return err
+			}
+			*field = newReference(bucket, key)
+		}
+	}
+	return nil
+}
+
+func (o *offloaderImpl) ResolveEvents(events []*shared.HistoryEvent) error {
+	for _, event := range events {
+		for _, field := range payloadFields(event) {
+			payload, err := o.Resolve(*field)
+			if err != nil {
+				return err
+			}
+			*field = payload
+		}
+	}
+	return nil
+}
+
+func (o *offloaderImpl) Resolve(payload []byte) ([]byte, error) {
+	if !IsReference(payload) {
+		return payload, nil
+	}
+	if o.client == nil {
+		return nil, errBlobstoreNotConfigured
+	}
+	bucket, key, err := parseReference(payload)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), offloadOperationTimeout)
+	defer cancel()
+	b, err := o.client.Download(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	return b.Body, nil
+}
+
+func (o *offloaderImpl) DeletePayloads(domainID, treeID string) error {
+	if o.client == nil || o.config == nil {
+		return nil
+	}
+	bucket := o.config.Bucket()
+	if len(bucket) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), offloadOperationTimeout)
+	defer cancel()
+	// the trailing separator keeps the prefix from matching trees whose hash extends this one
+	domainIDHash, treeIDHash := treeKeyPieces(domainID, treeID)
+	prefix := strings.Join([]string{domainIDHash, treeIDHash, ""}, keyPiecesSeparator)
+	keys, err := o.client.ListByPrefix(ctx, bucket, prefix)
+	if err != nil {
+		if err == blobstore.ErrBucketNotExists {
+			return nil
+		}
+		return err
+	}
+	for _, key := range keys {
+		if _, err := o.client.Delete(ctx, bucket, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteHistoryTreePayloads deletes the payloads offloaded for the history tree of the given branch once
+// the tree has no branches left, it is called after the branch is deleted
+func DeleteHistoryTreePayloads(
+	offloader Offloader,
+	historyV2Mgr persistence.HistoryV2Manager,
+	domainID string,
+	branchToken []byte,
+) error {
+
+	treeID, err := persistence.GetHistoryTreeID(branchToken)
+	if err != nil {
+		return err
+	}
+	resp, err := historyV2Mgr.GetHistoryTree(&persistence.GetHistoryTreeRequest{
+		TreeID: treeID,
+	})
+	if err != nil {
+		return err
+	}
+	if len(resp.Branches) > 0 || len(resp.ForkingInProgressBranches) > 0 {
+		// the payloads are still referenced by the history of other runs
+		return nil
+	}
+	return offloader.DeletePayloads(domainID, treeID)
+}
+
+// treeKeyPieces returns the key name pieces shared by all payloads offloaded for a history tree
+func treeKeyPieces(domainID, treeID string) (string, string) {
+	domainIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(domainID)))
+	treeIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(treeID)))
+	return domainIDHash, treeIDHash
+}
+
+func newReference(bucket string, key blob.Key) []byte {
+	reference := make([]byte, 0, len(referencePrefix)+len(bucket)+len(referenceBucketSeparator)+len(key.String()))
+	reference = append(reference, referencePrefix...)
+	reference = append(reference, bucket...)
+	reference = append(reference, referenceBucketSeparator...)
+	return append(reference, key.String()...)
+}
+
+func parseReference(reference []byte) (string, blob.Key, error) {
+	parts := strings.SplitN(string(reference[len(referencePrefix):]), referenceBucketSeparator, 2)
+	if len(parts) != 2 || len(parts[0]) == 0 {
+		return "", nil, errInvalidReference
+	}
+	key, err := blob.NewKeyFromString(parts[1])
+	if err != nil {
+		return "", nil, errInvalidReference
+	}
+	return parts[0], key, nil
+}
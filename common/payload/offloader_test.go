@@ -0,0 +1,187 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package payload
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	testBucket     = "test-bucket"
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain-name"
+	testTreeID     = "test-tree-id"
+	testThreshold  = 4
+)
+
+type OffloaderSuite struct {
+	suite.Suite
+	storeDir   string
+	client     blobstore.Client
+	offloader  Offloader
+	bucketName string
+}
+
+func TestOffloaderSuite(t *testing.T) {
+	suite.Run(t, new(OffloaderSuite))
+}
+
+func (s *OffloaderSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "TestOffloaderSuite")
+	s.NoError(err)
+	s.storeDir = dir
+	s.client, err = filestore.NewClient(&filestore.Config{
+		StoreDirectory: dir,
+		DefaultBucket: filestore.BucketConfig{
+			Name:          testBucket,
+			Owner:         "test-owner",
+			RetentionDays: 1,
+		},
+	})
+	s.NoError(err)
+	s.bucketName = testBucket
+	s.offloader = NewOffloader(s.client, &Config{
+		Bucket:    func(opts ...dynamicconfig.FilterOption) string { return s.bucketName },
+		Threshold: dynamicconfig.GetIntPropertyFilteredByDomain(testThreshold),
+	})
+}
+
+func (s *OffloaderSuite) TearDownTest() {
+	os.RemoveAll(s.storeDir)
+}
+
+func (s *OffloaderSuite) TestOffloadAndResolve() {
+	events := []*shared.HistoryEvent{
+		s.newSignaledEvent([]byte("1234")),
+		s.newSignaledEvent([]byte("large payload")),
+	}
+	s.NoError(s.offloader.OffloadEvents(testDomainID, testDomainName, testTreeID, events))
+	s.Equal([]byte("1234"), events[0].WorkflowExecutionSignaledEventAttributes.Input)
+	reference := events[1].WorkflowExecutionSignaledEventAttributes.Input
+	s.True(IsReference(reference))
+
+	// offloading an already offloaded payload is a no-op
+	s.NoError(s.offloader.OffloadEvents(testDomainID, testDomainName, testTreeID, events))
+	s.Equal(reference, events[1].WorkflowExecutionSignaledEventAttributes.Input)
+
+	payload, err := s.offloader.Resolve(reference)
+	s.NoError(err)
+	s.Equal([]byte("large payload"), payload)
+
+	s.NoError(s.offloader.ResolveEvents(events))
+	s.Equal([]byte("1234"), events[0].WorkflowExecutionSignaledEventAttributes.Input)
+	s.Equal([]byte("large payload"), events[1].WorkflowExecutionSignaledEventAttributes.Input)
+}
+
+func (s *OffloaderSuite) TestOffload_Disabled() {
+	s.True(s.offloader.IsEnabled(testDomainName))
+	s.bucketName = ""
+	s.False(s.offloader.IsEnabled(testDomainName))
+	events := []*shared.HistoryEvent{s.newSignaledEvent([]byte("large payload"))}
+	s.NoError(s.offloader.OffloadEvents(testDomainID, testDomainName, testTreeID, events))
+	s.Equal([]byte("large payload"), events[0].WorkflowExecutionSignaledEventAttributes.Input)
+
+	offloader := NewOffloader(nil, &Config{
+		Bucket:    dynamicconfig.GetStringPropertyFn(testBucket),
+		Threshold: dynamicconfig.GetIntPropertyFilteredByDomain(testThreshold),
+	})
+	s.NoError(offloader.OffloadEvents(testDomainID, testDomainName, testTreeID, events))
+	s.Equal([]byte("large payload"), events[0].WorkflowExecutionSignaledEventAttributes.Input)
+}
+
+func (s *OffloaderSuite) TestResolve_BucketConfigChanged() {
+	events := []*shared.HistoryEvent{s.newSignaledEvent([]byte("large payload"))}
+	s.NoError(s.offloader.OffloadEvents(testDomainID, testDomainName, testTreeID, events))
+
+	s.bucketName = ""
+	payload, err := s.offloader.Resolve(events[0].WorkflowExecutionSignaledEventAttributes.Input)
+	s.NoError(err)
+	s.Equal([]byte("large payload"), payload)
+}
+
+func (s *OffloaderSuite) TestResolve_InvalidReference() {
+	_, err := s.offloader.Resolve(append(referencePrefix, "no-key"...))
+	s.Equal(errInvalidReference, err)
+}
+
+func (s *OffloaderSuite) TestDeletePayloads() {
+	events := []*shared.HistoryEvent{
+		s.newSignaledEvent([]byte("large payload")),
+		s.newSignaledEvent([]byte("another large payload")),
+	}
+	s.NoError(s.offloader.OffloadEvents(testDomainID, testDomainName, testTreeID, events))
+	otherTreeEvents := []*shared.HistoryEvent{s.newSignaledEvent([]byte("large payload"))}
+	s.NoError(s.offloader.OffloadEvents(testDomainID, testDomainName, "other-tree-id", otherTreeEvents))
+
+	s.NoError(s.offloader.DeletePayloads(testDomainID, testTreeID))
+	for _, event := range events {
+		_, err := s.offloader.Resolve(event.WorkflowExecutionSignaledEventAttributes.Input)
+		s.Equal(blobstore.ErrBlobNotExists, err)
+	}
+	payload, err := s.offloader.Resolve(otherTreeEvents[0].WorkflowExecutionSignaledEventAttributes.Input)
+	s.NoError(err)
+	s.Equal([]byte("large payload"), payload)
+}
+
+func (s *OffloaderSuite) TestDeleteHistoryTreePayloads() {
+	branchToken, err := persistence.NewHistoryBranchToken(testTreeID)
+	s.NoError(err)
+	events := []*shared.HistoryEvent{s.newSignaledEvent([]byte("large payload"))}
+	s.NoError(s.offloader.OffloadEvents(testDomainID, testDomainName, testTreeID, events))
+	reference := events[0].WorkflowExecutionSignaledEventAttributes.Input
+
+	// a run reset from the deleted one still has a branch in the tree
+	historyV2Mgr := &mocks.HistoryV2Manager{}
+	historyV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{TreeID: testTreeID}).Return(
+		&persistence.GetHistoryTreeResponse{Branches: []*shared.HistoryBranch{{TreeID: common.StringPtr(testTreeID)}}}, nil).Once()
+	s.NoError(DeleteHistoryTreePayloads(s.offloader, historyV2Mgr, testDomainID, branchToken))
+	payload, err := s.offloader.Resolve(reference)
+	s.NoError(err)
+	s.Equal([]byte("large payload"), payload)
+
+	// the last branch of the tree is deleted
+	historyV2Mgr.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{TreeID: testTreeID}).Return(
+		&persistence.GetHistoryTreeResponse{}, nil).Once()
+	s.NoError(DeleteHistoryTreePayloads(s.offloader, historyV2Mgr, testDomainID, branchToken))
+	_, err = s.offloader.Resolve(reference)
+	s.Equal(blobstore.ErrBlobNotExists, err)
+	historyV2Mgr.AssertExpectations(s.T())
+}
+
+func (s *OffloaderSuite) newSignaledEvent(input []byte) *shared.HistoryEvent {
+	return &shared.HistoryEvent{
+		EventType: common.EventTypePtr(shared.EventTypeWorkflowExecutionSignaled),
+		WorkflowExecutionSignaledEventAttributes: &shared.WorkflowExecutionSignaledEventAttributes{
+			SignalName: common.StringPtr("test-signal"),
+			Input:      input,
+		},
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"strings"
 
 	"github.com/uber/cadence/tools/cassandra"
+	"github.com/uber/cadence/tools/common/schema"
 
 	"github.com/gocql/gocql"
 	log "github.com/sirupsen/logrus"
@@ -118,9 +119,11 @@ func LoadCassandraSchema(
 			CassPort:     port,
 			CassKeyspace: keyspace,
 		},
-		SchemaFilePath:    tmpFile.Name(),
-		Overwrite:         override,
-		DisableVersioning: true,
+		SetupConfig: schema.SetupConfig{
+			SchemaFilePath:    tmpFile.Name(),
+			Overwrite:         override,
+			DisableVersioning: true,
+		},
 	}
 
 	err = cassandra.SetupSchema(config)
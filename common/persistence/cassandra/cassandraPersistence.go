@@ -39,7 +39,7 @@ import (
 // Where x is any hexadecimal value, E represents the entity type valid values are:
 // E = {DomainID = 1, WorkflowID = 2, RunID = 3}
 // R represents row type in executions table, valid values are:
-// R = {Shard = 1, Execution = 2, Transfer = 3, Timer = 4, Replication = 5}
+// R = {Shard = 1, Execution = 2, Transfer = 3, Timer = 4, Replication = 5, DLQ = 6}
 const (
 	cassandraProtoVersion = 4
 	defaultSessionTimeout = 10 * time.Second
@@ -64,6 +64,10 @@ const (
 	rowTypeReplicationDomainID   = "10000000-5000-f000-f000-000000000000"
 	rowTypeReplicationWorkflowID = "20000000-5000-f000-f000-000000000000"
 	rowTypeReplicationRunID      = "30000000-5000-f000-f000-000000000000"
+	// Row Constants for DLQ Task Row
+	rowTypeDLQDomainID   = "10000000-6000-f000-f000-000000000000"
+	rowTypeDLQWorkflowID = "20000000-6000-f000-f000-000000000000"
+	rowTypeDLQRunID      = "30000000-6000-f000-f000-000000000000"
 	// Special TaskId constants
 	rowTypeExecutionTaskID  = int64(-10)
 	rowTypeShardTaskID      = int64(-11)
@@ -83,6 +87,8 @@ const (
 	rowTypeTransferTask
 	rowTypeTimerTask
 	rowTypeReplicationTask
+	rowTypeDLQTransferTask
+	rowTypeDLQTimerTask
 )
 
 const (
@@ -727,6 +733,11 @@ workflow_state = ? ` +
 
 	templateDeleteWorkflowExecutionCurrentRowQuery = templateDeleteWorkflowExecutionMutableStateQuery + " if current_run_id = ? "
 
+	templateListWorkflowExecutionQuery = `SELECT domain_id, workflow_id, run_id, current_run_id, execution ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ?`
+
 	templateDeleteWorkflowExecutionSignalRequestedQuery = `UPDATE executions ` +
 		`SET signal_requested = signal_requested - ? ` +
 		`WHERE shard_id = ? ` +
@@ -779,6 +790,25 @@ workflow_state = ? ` +
 		`and task_id > ? ` +
 		`and task_id <= ?`
 
+	templateGetDLQTaskQuery = `SELECT transfer, timer ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ?`
+
+	templateGetDLQTasksQuery = `SELECT transfer, timer ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ?`
+
 	templateGetTimerTasksQuery = `SELECT timer ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -904,6 +934,16 @@ type (
 		shardID            int
 		currentClusterName string
 	}
+
+	// executionRow is a single row of type execution read while scanning a shard,
+	// it is either a concrete execution or a current execution row
+	executionRow struct {
+		domainID     string
+		workflowID   string
+		runID        string
+		currentRunID string
+		info         *p.InternalWorkflowExecutionInfo
+	}
 )
 
 var _ p.ExecutionStore = (*cassandraPersistence)(nil)
@@ -2084,6 +2124,12 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 	cqlNowTimestamp := p.UnixNanoToDBTimestamp(time.Now().UnixNano())
 	executionInfo := request.ExecutionInfo
 	replicationState := request.ReplicationState
+	startVersion := common.EmptyVersion
+	lastWriteVersion := common.EmptyVersion
+	if replicationState != nil {
+		startVersion = replicationState.StartVersion
+		lastWriteVersion = replicationState.LastWriteVersion
+	}
 
 	batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
 		executionInfo.RunID,
@@ -2091,9 +2137,9 @@ func (d *cassandraPersistence) ResetMutableState(request *p.InternalResetMutable
 		executionInfo.CreateRequestID,
 		executionInfo.State,
 		executionInfo.CloseStatus,
-		replicationState.StartVersion,
-		replicationState.LastWriteVersion,
-		replicationState.LastWriteVersion,
+		startVersion,
+		lastWriteVersion,
+		lastWriteVersion,
 		executionInfo.State,
 		d.shardID,
 		rowTypeExecution,
@@ -2280,7 +2326,7 @@ func (d *cassandraPersistence) DeleteWorkflowExecution(request *p.DeleteWorkflow
 	return nil
 }
 
-func (d *cassandraPersistence) DeleteWorkflowCurrentRow(request *p.DeleteWorkflowExecutionRequest) error {
+func (d *cassandraPersistence) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
 	query := d.session.Query(templateDeleteWorkflowExecutionCurrentRowQuery,
 		d.shardID,
 		rowTypeExecution,
@@ -2295,11 +2341,11 @@ func (d *cassandraPersistence) DeleteWorkflowCurrentRow(request *p.DeleteWorkflo
 	if err != nil {
 		if isThrottlingError(err) {
 			return &workflow.ServiceBusyError{
-				Message: fmt.Sprintf("DeleteWorkflowCurrentRow operation failed. Error: %v", err),
+				Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
 			}
 		}
 		return &workflow.InternalServiceError{
-			Message: fmt.Sprintf("DeleteWorkflowCurrentRow operation failed. Error: %v", err),
+			Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
 		}
 	}
 
@@ -2347,6 +2393,110 @@ func (d *cassandraPersistence) GetCurrentExecution(request *p.GetCurrentExecutio
 	}, nil
 }
 
+// ListConcreteExecutions returns a page of workflow runs stored in this shard. Since current execution
+// rows live in the same partition and are filtered out from the result, a page may contain
+// less than PageSize executions even when there are more pages to be read
+func (d *cassandraPersistence) ListConcreteExecutions(request *p.ListConcreteExecutionsRequest) (*p.ListConcreteExecutionsResponse, error) {
+	response := &p.ListConcreteExecutionsResponse{}
+	nextPageToken, err := d.listExecutionRows("ListConcreteExecutions", request.PageSize, request.PageToken,
+		func(row *executionRow) {
+			if row.runID == permanentRunID {
+				return
+			}
+			info := row.info
+			response.Executions = append(response.Executions, &p.ConcreteExecutionInfo{
+				DomainID:          info.DomainID,
+				WorkflowID:        info.WorkflowID,
+				RunID:             info.RunID,
+				State:             info.State,
+				CloseStatus:       info.CloseStatus,
+				NextEventID:       info.NextEventID,
+				EventStoreVersion: info.EventStoreVersion,
+				BranchToken:       info.BranchToken,
+				StartTimestamp:    info.StartTimestamp,
+				LastUpdatedTime:   info.LastUpdatedTimestamp,
+			})
+		})
+	if err != nil {
+		return nil, err
+	}
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+// ListCurrentExecutions returns a page of current execution rows stored in this shard. Since concrete
+// executions live in the same partition and are filtered out from the result, a page may contain
+// less than PageSize executions even when there are more pages to be read
+func (d *cassandraPersistence) ListCurrentExecutions(request *p.ListCurrentExecutionsRequest) (*p.ListCurrentExecutionsResponse, error) {
+	response := &p.ListCurrentExecutionsResponse{}
+	nextPageToken, err := d.listExecutionRows("ListCurrentExecutions", request.PageSize, request.PageToken,
+		func(row *executionRow) {
+			if row.runID != permanentRunID {
+				return
+			}
+			response.Executions = append(response.Executions, &p.CurrentExecutionInfo{
+				DomainID:     row.domainID,
+				WorkflowID:   row.workflowID,
+				CurrentRunID: row.currentRunID,
+				State:        row.info.State,
+				CloseStatus:  row.info.CloseStatus,
+			})
+		})
+	if err != nil {
+		return nil, err
+	}
+	response.NextPageToken = nextPageToken
+	return response, nil
+}
+
+func (d *cassandraPersistence) listExecutionRows(operation string, pageSize int, pageToken []byte,
+	callback func(row *executionRow)) ([]byte, error) {
+	query := d.session.Query(templateListWorkflowExecutionQuery,
+		d.shardID,
+		rowTypeExecution,
+	).PageSize(pageSize).PageState(pageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed.  Not able to create query iterator.", operation),
+		}
+	}
+
+	result := make(map[string]interface{})
+	for iter.MapScan(result) {
+		row := &executionRow{
+			domainID:   result["domain_id"].(gocql.UUID).String(),
+			workflowID: result["workflow_id"].(string),
+			runID:      result["run_id"].(gocql.UUID).String(),
+			info:       createWorkflowExecutionInfo(result["execution"].(map[string]interface{})),
+		}
+		if id, ok := result["current_run_id"].(gocql.UUID); ok {
+			row.currentRunID = id.String()
+		}
+		callback(row)
+		result = make(map[string]interface{})
+	}
+	nextPageToken := iter.PageState()
+	token := make([]byte, len(nextPageToken))
+	copy(token, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("%v operation failed. Error: %v", operation, err),
+		}
+	}
+	if len(token) == 0 {
+		token = nil
+	}
+	return token, nil
+}
+
 func (d *cassandraPersistence) GetTransferTasks(request *p.GetTransferTasksRequest) (*p.GetTransferTasksResponse, error) {
 
 	// Reading transfer tasks need to be quorum level consistent, otherwise we could loose task
@@ -2565,6 +2715,211 @@ func (d *cassandraPersistence) RangeCompleteTimerTask(request *p.RangeCompleteTi
 	return nil
 }
 
+func (d *cassandraPersistence) PutTaskToDLQ(request *p.PutTaskToDLQRequest) error {
+	var query *gocql.Query
+	switch {
+	case request.TransferTask != nil:
+		task := request.TransferTask
+		targetRunID := task.TargetRunID
+		if targetRunID == "" {
+			targetRunID = p.TransferTaskTransferTargetRunID
+		}
+		query = d.session.Query(templateCreateTransferTaskQuery,
+			d.shardID,
+			rowTypeDLQTransferTask,
+			rowTypeDLQDomainID,
+			rowTypeDLQWorkflowID,
+			rowTypeDLQRunID,
+			task.DomainID,
+			task.WorkflowID,
+			task.RunID,
+			task.VisibilityTimestamp,
+			task.TaskID,
+			task.TargetDomainID,
+			task.TargetWorkflowID,
+			targetRunID,
+			task.TargetChildWorkflowOnly,
+			task.TaskList,
+			task.TaskType,
+			task.ScheduleID,
+			task.RecordVisibility,
+			task.Version,
+			defaultVisibilityTimestamp,
+			task.TaskID)
+	case request.TimerTask != nil:
+		task := request.TimerTask
+		// DLQ rows are keyed by task ID only, the visibility timestamp is kept in the timer task
+		query = d.session.Query(templateCreateTimerTaskQuery,
+			d.shardID,
+			rowTypeDLQTimerTask,
+			rowTypeDLQDomainID,
+			rowTypeDLQWorkflowID,
+			rowTypeDLQRunID,
+			task.DomainID,
+			task.WorkflowID,
+			task.RunID,
+			p.UnixNanoToDBTimestamp(task.VisibilityTimestamp.UnixNano()),
+			task.TaskID,
+			task.TaskType,
+			task.TimeoutType,
+			task.EventID,
+			task.ScheduleAttempt,
+			task.Version,
+			defaultVisibilityTimestamp,
+			task.TaskID)
+	default:
+		return &workflow.BadRequestError{
+			Message: "PutTaskToDLQ operation failed. No task provided.",
+		}
+	}
+
+	err := query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("PutTaskToDLQ operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+func (d *cassandraPersistence) GetDLQTask(request *p.GetDLQTaskRequest) (*p.GetDLQTaskResponse, error) {
+	rowType, err := getDLQRowType(request.Category)
+	if err != nil {
+		return nil, err
+	}
+	query := d.session.Query(templateGetDLQTaskQuery,
+		d.shardID,
+		rowType,
+		rowTypeDLQDomainID,
+		rowTypeDLQWorkflowID,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.TaskID)
+
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
+		if err == gocql.ErrNotFound {
+			return nil, &workflow.EntityNotExistsError{
+				Message: fmt.Sprintf("DLQ task not found.  TaskId: %v", request.TaskID),
+			}
+		} else if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetDLQTask operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDLQTask operation failed. Error: %v", err),
+		}
+	}
+
+	response := &p.GetDLQTaskResponse{}
+	if request.Category == p.DLQTaskCategoryTransfer {
+		response.TransferTask = createTransferTaskInfo(result["transfer"].(map[string]interface{}))
+	} else {
+		response.TimerTask = createTimerTaskInfo(result["timer"].(map[string]interface{}))
+	}
+	return response, nil
+}
+
+func (d *cassandraPersistence) GetDLQTasks(request *p.GetDLQTasksRequest) (*p.GetDLQTasksResponse, error) {
+	rowType, err := getDLQRowType(request.Category)
+	if err != nil {
+		return nil, err
+	}
+	query := d.session.Query(templateGetDLQTasksQuery,
+		d.shardID,
+		rowType,
+		rowTypeDLQDomainID,
+		rowTypeDLQWorkflowID,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+	).PageSize(request.PageSize).PageState(request.NextPageToken)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, &workflow.InternalServiceError{
+			Message: "GetDLQTasks operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	response := &p.GetDLQTasksResponse{}
+	task := make(map[string]interface{})
+	for iter.MapScan(task) {
+		if request.Category == p.DLQTaskCategoryTransfer {
+			response.TransferTasks = append(response.TransferTasks, createTransferTaskInfo(task["transfer"].(map[string]interface{})))
+		} else {
+			response.TimerTasks = append(response.TimerTasks, createTimerTaskInfo(task["timer"].(map[string]interface{})))
+		}
+		// Reset task map to get it ready for next scan
+		task = make(map[string]interface{})
+	}
+	nextPageToken := iter.PageState()
+	response.NextPageToken = make([]byte, len(nextPageToken))
+	copy(response.NextPageToken, nextPageToken)
+
+	if err := iter.Close(); err != nil {
+		if isThrottlingError(err) {
+			return nil, &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("GetDLQTasks operation failed. Error: %v", err),
+			}
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDLQTasks operation failed. Error: %v", err),
+		}
+	}
+
+	return response, nil
+}
+
+func (d *cassandraPersistence) DeleteDLQTask(request *p.DeleteDLQTaskRequest) error {
+	rowType, err := getDLQRowType(request.Category)
+	if err != nil {
+		return err
+	}
+	query := d.session.Query(templateCompleteTransferTaskQuery,
+		d.shardID,
+		rowType,
+		rowTypeDLQDomainID,
+		rowTypeDLQWorkflowID,
+		rowTypeDLQRunID,
+		defaultVisibilityTimestamp,
+		request.TaskID)
+
+	err = query.Exec()
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteDLQTask operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteDLQTask operation failed. Error: %v", err),
+		}
+	}
+
+	return nil
+}
+
+// getDLQRowType returns the executions table row type used to store the DLQ tasks of the given category
+func getDLQRowType(category int) (int, error) {
+	switch category {
+	case p.DLQTaskCategoryTransfer:
+		return rowTypeDLQTransferTask, nil
+	case p.DLQTaskCategoryTimer:
+		return rowTypeDLQTimerTask, nil
+	default:
+		return 0, &workflow.BadRequestError{
+			Message: fmt.Sprintf("Unknown DLQ task category: %v", category),
+		}
+	}
+}
+
 // From TaskManager interface
 func (d *cassandraPersistence) LeaseTaskList(request *p.LeaseTaskListRequest) (*p.LeaseTaskListResponse, error) {
 	if len(request.TaskList) == 0 {
@@ -122,6 +122,12 @@ const (
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
 const UnknownNumRowsAffected = -1
 
+// Categories of tasks in the task DLQ
+const (
+	DLQTaskCategoryTransfer = iota
+	DLQTaskCategoryTimer
+)
+
 // Types of workflow backoff timeout
 const (
 	WorkflowBackoffTimeoutTypeRetry = iota
@@ -728,6 +734,54 @@ type (
 		LastWriteVersion int64
 	}
 
+	// ListConcreteExecutionsRequest is request to ListConcreteExecutions
+	ListConcreteExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListConcreteExecutionsResponse is the response to ListConcreteExecutions
+	ListConcreteExecutionsResponse struct {
+		Executions    []*ConcreteExecutionInfo
+		NextPageToken []byte
+	}
+
+	// ConcreteExecutionInfo is a summary of a single workflow run, as stored in the executions table
+	ConcreteExecutionInfo struct {
+		DomainID          string
+		WorkflowID        string
+		RunID             string
+		State             int
+		CloseStatus       int
+		NextEventID       int64
+		EventStoreVersion int32
+		BranchToken       []byte
+		StartTimestamp    time.Time
+		LastUpdatedTime   time.Time
+	}
+
+	// ListCurrentExecutionsRequest is request to ListCurrentExecutions
+	ListCurrentExecutionsRequest struct {
+		PageSize  int
+		PageToken []byte
+	}
+
+	// ListCurrentExecutionsResponse is the response to ListCurrentExecutions
+	ListCurrentExecutionsResponse struct {
+		Executions    []*CurrentExecutionInfo
+		NextPageToken []byte
+	}
+
+	// CurrentExecutionInfo is a single row of current executions, which points
+	// to the current run of a workflow
+	CurrentExecutionInfo struct {
+		DomainID     string
+		WorkflowID   string
+		CurrentRunID string
+		State        int
+		CloseStatus  int
+	}
+
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
 	UpdateWorkflowExecutionRequest struct {
 		ExecutionInfo        *WorkflowExecutionInfo
@@ -827,6 +881,53 @@ type (
 		RunID      string
 	}
 
+	// PutTaskToDLQRequest is used to move a task that cannot be processed into the task DLQ
+	// of the shard, exactly one of TransferTask and TimerTask is set
+	PutTaskToDLQRequest struct {
+		TransferTask *TransferTaskInfo
+		TimerTask    *TimerTaskInfo
+	}
+
+	// GetDLQTaskRequest is used to read a single task from the task DLQ
+	GetDLQTaskRequest struct {
+		Category int
+		TaskID   int64
+	}
+
+	// GetDLQTaskResponse is the response to GetDLQTask, the task set depends on the requested category
+	GetDLQTaskResponse struct {
+		TransferTask *TransferTaskInfo
+		TimerTask    *TimerTaskInfo
+	}
+
+	// GetDLQTasksRequest is used to read the tasks of a category from the task DLQ, ordered by task ID
+	GetDLQTasksRequest struct {
+		Category      int
+		PageSize      int
+		NextPageToken []byte
+	}
+
+	// GetDLQTasksResponse is the response to GetDLQTasks, the tasks set depend on the requested category
+	GetDLQTasksResponse struct {
+		TransferTasks []*TransferTaskInfo
+		TimerTasks    []*TimerTaskInfo
+		NextPageToken []byte
+	}
+
+	// DeleteDLQTaskRequest is used to delete a single task from the task DLQ
+	DeleteDLQTaskRequest struct {
+		Category int
+		TaskID   int64
+	}
+
+	// DeleteCurrentWorkflowExecutionRequest is used to delete the current workflow execution
+	// row, the row is only deleted if it still points to the given run
+	DeleteCurrentWorkflowExecutionRequest struct {
+		DomainID   string
+		WorkflowID string
+		RunID      string
+	}
+
 	// GetTransferTasksRequest is used to read tasks from the transfer task queue
 	GetTransferTasksRequest struct {
 		ReadLevel     int64
@@ -1225,6 +1326,8 @@ type (
 		TransactionID int64
 		// It is to suggest a binary encoding type to serialize history events
 		Encoding common.EncodingType
+		// The domain of the workflow the events belong to, used to pick the encryption key of the events
+		DomainID string
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
@@ -1330,6 +1433,30 @@ type (
 		ForkingInProgressBranches []ForkingInProgressBranch
 	}
 
+	// GetAllHistoryTreeBranchesRequest is a request of GetAllHistoryTreeBranches
+	GetAllHistoryTreeBranchesRequest struct {
+		// pagination token
+		NextPageToken []byte
+		// maximum number of branches returned per page
+		PageSize int
+	}
+
+	// HistoryBranchDetail contains detailed information of a branch
+	HistoryBranchDetail struct {
+		TreeID   string
+		BranchID string
+		ForkTime time.Time
+		Info     string
+	}
+
+	// GetAllHistoryTreeBranchesResponse is a response to GetAllHistoryTreeBranches
+	GetAllHistoryTreeBranchesResponse struct {
+		// pagination token
+		NextPageToken []byte
+		// all branches of all trees
+		Branches []HistoryBranchDetail
+	}
+
 	// AppendHistoryEventsResponse is response for AppendHistoryEventsRequest
 	// Deprecated: uses V2 API-AppendHistoryNodesRequest
 	AppendHistoryEventsResponse struct {
@@ -1362,8 +1489,13 @@ type (
 		ResetMutableState(request *ResetMutableStateRequest) error
 		ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
+		// Scan related methods
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
+
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
 		CompleteTransferTask(request *CompleteTransferTaskRequest) error
@@ -1377,6 +1509,12 @@ type (
 		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 		CompleteTimerTask(request *CompleteTimerTaskRequest) error
 		RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error
+
+		// Task DLQ related methods
+		PutTaskToDLQ(request *PutTaskToDLQRequest) error
+		GetDLQTask(request *GetDLQTaskRequest) (*GetDLQTaskResponse, error)
+		GetDLQTasks(request *GetDLQTasksRequest) (*GetDLQTasksResponse, error)
+		DeleteDLQTask(request *DeleteDLQTaskRequest) error
 	}
 
 	// ExecutionManagerFactory creates an instance of ExecutionManager for a given shard
@@ -1449,6 +1587,8 @@ type (
 		DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+		// GetAllHistoryTreeBranches returns all branches of all trees
+		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
 	}
 
 	// MetadataManager is used to manage metadata CRUD for domain entities
@@ -2053,6 +2193,11 @@ func (a *SyncActivityTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetDomainID returns the domain ID for transfer task
+func (t *TransferTaskInfo) GetDomainID() string {
+	return t.DomainID
+}
+
 // GetTaskID returns the task ID for transfer task
 func (t *TransferTaskInfo) GetTaskID() int64 {
 	return t.TaskID
@@ -2081,6 +2226,11 @@ func (t *TransferTaskInfo) String() string {
 	)
 }
 
+// GetDomainID returns the domain ID for replication task
+func (t *ReplicationTaskInfo) GetDomainID() string {
+	return t.DomainID
+}
+
 // GetTaskID returns the task ID for replication task
 func (t *ReplicationTaskInfo) GetTaskID() int64 {
 	return t.TaskID
@@ -2101,6 +2251,11 @@ func (t *ReplicationTaskInfo) GetVisibilityTimestamp() time.Time {
 	return time.Time{}
 }
 
+// GetDomainID returns the domain ID for timer task
+func (t *TimerTaskInfo) GetDomainID() string {
+	return t.DomainID
+}
+
 // GetTaskID returns the task ID for timer task
 func (t *TimerTaskInfo) GetTaskID() int64 {
 	return t.TaskID
@@ -2218,6 +2373,20 @@ func NewHistoryBranchToken(treeID string) ([]byte, error) {
 	return token, nil
 }
 
+// NewHistoryBranchTokenByBranch returns the branch token of the given branch
+func NewHistoryBranchTokenByBranch(branch *workflow.HistoryBranch) ([]byte, error) {
+	return internalThriftEncoder.Encode(branch)
+}
+
+// GetHistoryTreeID returns the ID of the history tree the branch token belongs to
+func GetHistoryTreeID(branchToken []byte) (string, error) {
+	var branch workflow.HistoryBranch
+	if err := internalThriftEncoder.Decode(branchToken, &branch); err != nil {
+		return "", err
+	}
+	return branch.GetTreeID(), nil
+}
+
 // NewHistoryBranchTokenFromAnother make up a branchToken
 func NewHistoryBranchTokenFromAnother(branchID string, anotherToken []byte) ([]byte, error) {
 	var branch workflow.HistoryBranch
@@ -614,10 +614,22 @@ func (m *executionManagerImpl) DeleteWorkflowExecution(request *DeleteWorkflowEx
 	return m.persistence.DeleteWorkflowExecution(request)
 }
 
+func (m *executionManagerImpl) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	return m.persistence.DeleteCurrentWorkflowExecution(request)
+}
+
 func (m *executionManagerImpl) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	return m.persistence.GetCurrentExecution(request)
 }
 
+// Scan related methods
+func (m *executionManagerImpl) ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error) {
+	return m.persistence.ListConcreteExecutions(request)
+}
+func (m *executionManagerImpl) ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error) {
+	return m.persistence.ListCurrentExecutions(request)
+}
+
 // Transfer task related methods
 func (m *executionManagerImpl) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
 	return m.persistence.GetTransferTasks(request)
@@ -648,6 +660,20 @@ func (m *executionManagerImpl) RangeCompleteTimerTask(request *RangeCompleteTime
 	return m.persistence.RangeCompleteTimerTask(request)
 }
 
+// Task DLQ related methods
+func (m *executionManagerImpl) PutTaskToDLQ(request *PutTaskToDLQRequest) error {
+	return m.persistence.PutTaskToDLQ(request)
+}
+func (m *executionManagerImpl) GetDLQTask(request *GetDLQTaskRequest) (*GetDLQTaskResponse, error) {
+	return m.persistence.GetDLQTask(request)
+}
+func (m *executionManagerImpl) GetDLQTasks(request *GetDLQTasksRequest) (*GetDLQTasksResponse, error) {
+	return m.persistence.GetDLQTasks(request)
+}
+func (m *executionManagerImpl) DeleteDLQTask(request *DeleteDLQTaskRequest) error {
+	return m.persistence.DeleteDLQTask(request)
+}
+
 func (m *executionManagerImpl) Close() {
 	m.persistence.Close()
 }
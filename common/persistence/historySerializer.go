@@ -24,6 +24,8 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/DataDog/zstd"
+	"github.com/golang/snappy"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
@@ -79,7 +81,7 @@ func (t *serializerImpl) SerializeBatchEvents(events []*workflow.HistoryEvent, e
 	switch encodingType {
 	case common.EncodingTypeGob:
 		return nil, NewUnknownEncodingTypeError(encodingType)
-	case common.EncodingTypeThriftRW:
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWZstd:
 		history := &workflow.History{
 			Events: batch.Events,
 		}
@@ -87,6 +89,10 @@ func (t *serializerImpl) SerializeBatchEvents(events []*workflow.HistoryEvent, e
 		if err != nil {
 			return nil, NewHistorySerializationError(err.Error())
 		}
+		data, err = compress(data, encodingType)
+		if err != nil {
+			return nil, NewHistorySerializationError(err.Error())
+		}
 		return NewDataBlob(data, encodingType), nil
 	default:
 		fallthrough
@@ -117,9 +123,13 @@ func (t *serializerImpl) DeserializeBatchEvents(data *DataBlob) ([]*workflow.His
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeBatchEvents encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
 		return events, nil
-	case common.EncodingTypeThriftRW:
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWZstd:
 		var history workflow.History
-		err := t.thriftrwEncoder.Decode(data.Data, &history)
+		decompressed, err := decompress(data.Data, data.GetEncoding())
+		if err != nil {
+			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeBatchEvents encoding: \"%v\", error: %v", data.Encoding, err.Error()))
+		}
+		err = t.thriftrwEncoder.Decode(decompressed, &history)
 		if err != nil {
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeBatchEvents encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
@@ -136,11 +146,15 @@ func (t *serializerImpl) SerializeEvent(event *workflow.HistoryEvent, encodingTy
 	switch encodingType {
 	case common.EncodingTypeGob:
 		return nil, NewUnknownEncodingTypeError(encodingType)
-	case common.EncodingTypeThriftRW:
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWZstd:
 		data, err := t.thriftrwEncoder.Encode(event)
 		if err != nil {
 			return nil, NewHistorySerializationError(err.Error())
 		}
+		data, err = compress(data, encodingType)
+		if err != nil {
+			return nil, NewHistorySerializationError(err.Error())
+		}
 		return NewDataBlob(data, encodingType), nil
 	default:
 		fallthrough
@@ -171,8 +185,12 @@ func (t *serializerImpl) DeserializeEvent(data *DataBlob) (*workflow.HistoryEven
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeEvent encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
 		return &event, nil
-	case common.EncodingTypeThriftRW:
-		err := t.thriftrwEncoder.Decode(data.Data, &event)
+	case common.EncodingTypeThriftRW, common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWZstd:
+		decompressed, err := decompress(data.Data, data.GetEncoding())
+		if err != nil {
+			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeEvent encoding: \"%v\", error: %v", data.Encoding, err.Error()))
+		}
+		err = t.thriftrwEncoder.Decode(decompressed, &event)
 		if err != nil {
 			return nil, NewHistoryDeserializationError(fmt.Sprintf("DeserializeEvent encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 		}
@@ -182,6 +200,30 @@ func (t *serializerImpl) DeserializeEvent(data *DataBlob) (*workflow.HistoryEven
 	}
 }
 
+// compress compresses the thriftrw encoded data for the compressed encoding types
+func compress(data []byte, encodingType common.EncodingType) ([]byte, error) {
+	switch encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		return snappy.Encode(nil, data), nil
+	case common.EncodingTypeThriftRWZstd:
+		return zstd.Compress(nil, data)
+	default:
+		return data, nil
+	}
+}
+
+// decompress reverts compress
+func decompress(data []byte, encodingType common.EncodingType) ([]byte, error) {
+	switch encodingType {
+	case common.EncodingTypeThriftRWSnappy:
+		return snappy.Decode(nil, data)
+	case common.EncodingTypeThriftRWZstd:
+		return zstd.Decompress(nil, data)
+	default:
+		return data, nil
+	}
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType common.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
@@ -21,6 +21,7 @@
 package persistence
 
 import (
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -153,3 +154,43 @@ func (s *historySerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *historySerializerSuite) TestSerializer_Compressed() {
+	serializer := NewHistorySerializer()
+	event := &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(999),
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+		ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+			Result:           []byte(strings.Repeat("result-1-event-1", 100)),
+			ScheduledEventId: common.Int64Ptr(4),
+			StartedEventId:   common.Int64Ptr(5),
+			Identity:         common.StringPtr("event-1"),
+		},
+	}
+	events := []*workflow.HistoryEvent{event, event}
+
+	dThrift, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	for _, encoding := range []common.EncodingType{common.EncodingTypeThriftRWSnappy, common.EncodingTypeThriftRWZstd} {
+		dEvent, err := serializer.SerializeEvent(event, encoding)
+		s.NoError(err)
+		s.Equal(encoding, dEvent.Encoding)
+		event1, err := serializer.DeserializeEvent(dEvent)
+		s.NoError(err)
+		s.True(event.Equals(event1))
+
+		dBatch, err := serializer.SerializeBatchEvents(events, encoding)
+		s.NoError(err)
+		s.Equal(encoding, dBatch.Encoding)
+		s.True(len(dBatch.Data) < len(dThrift.Data))
+		events1, err := serializer.DeserializeBatchEvents(dBatch)
+		s.NoError(err)
+		s.Len(events1, 2)
+		s.True(event.Equals(events1[0]))
+
+		// the data is not readable with another compression
+		_, err = serializer.DeserializeBatchEvents(&DataBlob{Encoding: common.EncodingTypeThriftRW, Data: dBatch.Data})
+		s.Error(err)
+	}
+}
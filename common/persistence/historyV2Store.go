@@ -140,6 +140,11 @@ func (m *historyV2ManagerImpl) GetHistoryTree(request *GetHistoryTreeRequest) (*
 	return m.persistence.GetHistoryTree(request)
 }
 
+// GetAllHistoryTreeBranches returns all branches of all trees
+func (m *historyV2ManagerImpl) GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error) {
+	return m.persistence.GetAllHistoryTreeBranches(request)
+}
+
 // AppendHistoryNodes add(or override) a node to a history branch
 func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
 	var branch workflow.HistoryBranch
@@ -186,6 +191,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(request *AppendHistoryNodesReq
 		NodeID:        nodeID,
 		Events:        blob,
 		TransactionID: request.TransactionID,
+		DomainID:      request.DomainID,
 	}
 
 	err = m.persistence.AppendHistoryNodes(req)
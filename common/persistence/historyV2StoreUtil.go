@@ -21,6 +21,8 @@
 package persistence
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/uber-common/bark"
@@ -147,3 +149,20 @@ func GetBeginNodeID(bi shared.HistoryBranch) int64 {
 	idx := len(bi.Ancestors) - 1
 	return *bi.Ancestors[idx].EndNodeID
 }
+
+// BuildHistoryGarbageCleanupInfo combines the workflow identity into a string
+// that is stored along with a history branch for background cleanup
+func BuildHistoryGarbageCleanupInfo(domainID, workflowID, runID string) string {
+	return fmt.Sprintf("%v:%v:%v", domainID, workflowID, runID)
+}
+
+// SplitHistoryGarbageCleanupInfo returns the workflow identity stored along with a history branch,
+// domainID and runID are UUIDs so the workflowID is whatever is in between
+func SplitHistoryGarbageCleanupInfo(info string) (domainID, workflowID, runID string, err error) {
+	first := strings.Index(info, ":")
+	last := strings.LastIndex(info, ":")
+	if first < 0 || first == last {
+		return "", "", "", fmt.Errorf("unexpected history garbage cleanup info: %v", info)
+	}
+	return info[:first], info[first+1 : last], info[last+1:], nil
+}
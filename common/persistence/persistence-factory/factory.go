@@ -25,6 +25,8 @@ import (
 
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/encryption"
+	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
@@ -91,6 +93,7 @@ type (
 		metricsClient metrics.Client
 		logger        bark.Logger
 		datastores    map[storeType]Datastore
+		encryptor     encryption.Encryptor
 	}
 
 	storeType int
@@ -148,6 +151,13 @@ func New(
 		storeTypeHistory:    newStore(defaultCfg, limiters[cfg.DefaultStore], clusterName, cfg.HistoryMaxConns, logger),
 		storeTypeVisibility: newStore(visibilityCfg, limiters[cfg.VisibilityStore], clusterName, 0, logger),
 	}
+	if cfg.Encryption != nil {
+		provider, err := encryption.NewFileKeyProvider(cfg.Encryption.KeyFile)
+		if err != nil {
+			logger.WithField(logging.TagErr, err).Fatal("Unable to load encryption key file")
+		}
+		factory.encryptor = encryption.NewEncryptor(provider, cfg.Encryption.DataKeyTTL)
+	}
 	return factory
 }
 
@@ -191,6 +201,9 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.encryptor != nil {
+		store = p.NewHistoryStoreEncryptionClient(store, f.encryptor)
+	}
 	result := p.NewHistoryManagerImpl(store, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
@@ -208,6 +221,9 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.encryptor != nil {
+		store = p.NewHistoryV2StoreEncryptionClient(store, f.encryptor)
+	}
 	result := p.NewHistoryV2ManagerImpl(store, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
@@ -253,6 +269,9 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
+	if f.encryptor != nil {
+		store = p.NewExecutionStoreEncryptionClient(store, f.encryptor)
+	}
 	result := p.NewExecutionManagerImpl(store, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
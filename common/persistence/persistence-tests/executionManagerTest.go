@@ -864,6 +864,73 @@ func (s *ExecutionManagerSuite) TestDeleteCurrentWorkflow() {
 	s.NoError(err2)
 }
 
+// TestListExecutions verifies that both the concrete and the current execution rows
+// of a workflow are returned while paging through a shard, and that the current row
+// can be removed on its own
+func (s *ExecutionManagerSuite) TestListExecutions() {
+	domainID := "8a7d0b53-4c49-4f7b-9a0e-0a6f4d8e2e1c"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("list-executions-test"),
+		RunId:      common.StringPtr("1c3e5f0a-2b4d-4f6e-8a0c-2e4f6a8c0e2a"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err0)
+	s.NotNil(task0, "Expected non empty task identifier.")
+
+	var concrete *p.ConcreteExecutionInfo
+	var token []byte
+	for {
+		resp, err := s.ExecutionManager.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{PageSize: 2, PageToken: token})
+		s.NoError(err)
+		for _, e := range resp.Executions {
+			if e.DomainID == domainID && e.WorkflowID == workflowExecution.GetWorkflowId() {
+				concrete = e
+			}
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.NotNil(concrete)
+	s.Equal(workflowExecution.GetRunId(), concrete.RunID)
+	s.Equal(p.WorkflowStateCreated, concrete.State)
+	s.Equal(int64(3), concrete.NextEventID)
+
+	var current *p.CurrentExecutionInfo
+	token = nil
+	for {
+		resp, err := s.ExecutionManager.ListCurrentExecutions(&p.ListCurrentExecutionsRequest{PageSize: 2, PageToken: token})
+		s.NoError(err)
+		for _, e := range resp.Executions {
+			if e.DomainID == domainID && e.WorkflowID == workflowExecution.GetWorkflowId() {
+				current = e
+			}
+		}
+		token = resp.NextPageToken
+		if len(token) == 0 {
+			break
+		}
+	}
+	s.NotNil(current)
+	s.Equal(workflowExecution.GetRunId(), current.CurrentRunID)
+
+	err1 := s.ExecutionManager.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+		RunID:      workflowExecution.GetRunId(),
+	})
+	s.NoError(err1)
+
+	_, err2 := s.GetCurrentWorkflowRunID(domainID, workflowExecution.GetWorkflowId())
+	s.IsType(&gen.EntityNotExistsError{}, err2)
+
+	// execution record should still be there
+	_, err3 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err3)
+}
+
 // TestUpdateDeleteWorkflow verifies that an update workflow (with FinishExecution set to true)
 // followed by DeleteWorkflowExecution  clears all state associated with the workflow. The
 // reason for having this test is because cassandra deletes current_executions row with TTL
@@ -2275,6 +2342,45 @@ func (s *ExecutionManagerSuite) TestContinueAsNew() {
 	s.Equal(*newWorkflowExecution.RunId, newRunID)
 }
 
+// TestContinueAsNewStalePreviousRun test
+func (s *ExecutionManagerSuite) TestContinueAsNewStalePreviousRun() {
+	domainID := "8d4c3a58-5b49-4f3e-9b4f-1d2a8a5e3c7b"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("continue-as-new-stale-previous-run-test"),
+		RunId:      common.StringPtr("3e0f1c9a-7a4d-4a53-8d0e-6c2b5f9a1e24"),
+	}
+
+	_, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "queue1", "wType", 20, 13, nil, 3, 0, 2, nil)
+	s.NoError(err0)
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.NoError(err1)
+	info0 := state0.ExecutionInfo
+	continueAsNewInfo := copyWorkflowExecutionInfo(info0)
+	continueAsNewInfo.State = p.WorkflowStateCompleted
+	continueAsNewInfo.NextEventID = int64(5)
+	continueAsNewInfo.LastProcessedEvent = int64(2)
+
+	newWorkflowExecution := gen.WorkflowExecution{
+		WorkflowId: workflowExecution.WorkflowId,
+		RunId:      common.StringPtr("b7a1e2d4-2c6f-4e8b-9f3a-5d1c7e9b2a60"),
+	}
+	err2 := s.ContinueAsNewExecution(continueAsNewInfo, info0.NextEventID, newWorkflowExecution, int64(3), int64(2))
+	s.NoError(err2)
+
+	// the previous run of the second continue as new is no longer the current run
+	staleWorkflowExecution := gen.WorkflowExecution{
+		WorkflowId: workflowExecution.WorkflowId,
+		RunId:      common.StringPtr("f2c9d6b1-4e3a-4c8d-a7b5-0e6f3d1a9c82"),
+	}
+	err3 := s.ContinueAsNewExecution(continueAsNewInfo, continueAsNewInfo.NextEventID, staleWorkflowExecution, int64(3), int64(2))
+	s.IsType(&p.ConditionFailedError{}, err3)
+
+	currentRunID, err4 := s.GetCurrentWorkflowRunID(domainID, *workflowExecution.WorkflowId)
+	s.NoError(err4)
+	s.Equal(*newWorkflowExecution.RunId, currentRunID)
+}
+
 // TestReplicationTransferTaskTasks test
 func (s *ExecutionManagerSuite) TestReplicationTransferTaskTasks() {
 	domainID := "2466d7de-6602-4ad8-b939-fb8f8c36c711"
@@ -2348,6 +2454,97 @@ func (s *ExecutionManagerSuite) TestReplicationTransferTaskTasks() {
 	s.NoError(err)
 }
 
+// TestTaskDLQ test
+func (s *ExecutionManagerSuite) TestTaskDLQ() {
+	domainID := uuid.New()
+	workflowID := "task-dlq-test"
+	runID := uuid.New()
+	visibilityTimestamp := time.Unix(0, time.Now().UnixNano()/int64(time.Millisecond)*int64(time.Millisecond)).UTC()
+
+	transferTask := &p.TransferTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          workflowID,
+		RunID:               runID,
+		VisibilityTimestamp: visibilityTimestamp,
+		TaskID:              s.GetNextSequenceNumber(),
+		TargetDomainID:      domainID,
+		TargetWorkflowID:    p.TransferTaskTransferTargetWorkflowID,
+		TaskList:            "dlq-queue",
+		TaskType:            p.TransferTaskTypeDecisionTask,
+		ScheduleID:          2,
+		Version:             11,
+	}
+	timerTask := &p.TimerTaskInfo{
+		DomainID:            domainID,
+		WorkflowID:          workflowID,
+		RunID:               runID,
+		VisibilityTimestamp: visibilityTimestamp,
+		TaskID:              s.GetNextSequenceNumber(),
+		TaskType:            p.TaskTypeUserTimer,
+		EventID:             5,
+		Version:             11,
+	}
+	s.NoError(s.ExecutionManager.PutTaskToDLQ(&p.PutTaskToDLQRequest{TransferTask: transferTask}))
+	s.NoError(s.ExecutionManager.PutTaskToDLQ(&p.PutTaskToDLQRequest{TimerTask: timerTask}))
+
+	transferResp, err := s.ExecutionManager.GetDLQTask(&p.GetDLQTaskRequest{
+		Category: p.DLQTaskCategoryTransfer,
+		TaskID:   transferTask.TaskID,
+	})
+	s.NoError(err)
+	s.Nil(transferResp.TimerTask)
+	s.Equal(domainID, transferResp.TransferTask.DomainID)
+	s.Equal(workflowID, transferResp.TransferTask.WorkflowID)
+	s.Equal(runID, transferResp.TransferTask.RunID)
+	s.Equal(transferTask.TaskID, transferResp.TransferTask.TaskID)
+	s.Equal(transferTask.TaskType, transferResp.TransferTask.TaskType)
+	s.Equal(transferTask.TaskList, transferResp.TransferTask.TaskList)
+	s.Equal(transferTask.ScheduleID, transferResp.TransferTask.ScheduleID)
+	s.Equal(transferTask.Version, transferResp.TransferTask.Version)
+
+	timerResp, err := s.ExecutionManager.GetDLQTask(&p.GetDLQTaskRequest{
+		Category: p.DLQTaskCategoryTimer,
+		TaskID:   timerTask.TaskID,
+	})
+	s.NoError(err)
+	s.Nil(timerResp.TransferTask)
+	s.Equal(timerTask.TaskID, timerResp.TimerTask.TaskID)
+	s.Equal(timerTask.TaskType, timerResp.TimerTask.TaskType)
+	s.Equal(timerTask.EventID, timerResp.TimerTask.EventID)
+	s.True(visibilityTimestamp.Equal(timerResp.TimerTask.VisibilityTimestamp))
+
+	_, err = s.ExecutionManager.GetDLQTask(&p.GetDLQTaskRequest{
+		Category: p.DLQTaskCategoryTimer,
+		TaskID:   transferTask.TaskID,
+	})
+	s.IsType(&gen.EntityNotExistsError{}, err)
+
+	listResp, err := s.ExecutionManager.GetDLQTasks(&p.GetDLQTasksRequest{
+		Category: p.DLQTaskCategoryTransfer,
+		PageSize: 10,
+	})
+	s.NoError(err)
+	s.Equal(1, len(listResp.TransferTasks))
+	s.Equal(0, len(listResp.TimerTasks))
+	s.Equal(transferTask.TaskID, listResp.TransferTasks[0].TaskID)
+
+	s.NoError(s.ExecutionManager.DeleteDLQTask(&p.DeleteDLQTaskRequest{
+		Category: p.DLQTaskCategoryTransfer,
+		TaskID:   transferTask.TaskID,
+	}))
+	s.NoError(s.ExecutionManager.DeleteDLQTask(&p.DeleteDLQTaskRequest{
+		Category: p.DLQTaskCategoryTimer,
+		TaskID:   timerTask.TaskID,
+	}))
+
+	listResp, err = s.ExecutionManager.GetDLQTasks(&p.GetDLQTasksRequest{
+		Category: p.DLQTaskCategoryTimer,
+		PageSize: 10,
+	})
+	s.NoError(err)
+	s.Equal(0, len(listResp.TimerTasks))
+}
+
 // TestWorkflowReplicationState test
 func (s *ExecutionManagerSuite) TestWorkflowReplicationState() {
 	domainID := uuid.New()
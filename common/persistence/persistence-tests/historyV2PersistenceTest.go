@@ -21,6 +21,7 @@
 package persistencetests
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
@@ -270,6 +271,45 @@ func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 	s.Equal(0, len(resp.NextPageToken))
 }
 
+// TestGetAllHistoryTreeBranches test
+func (s *HistoryV2PersistenceSuite) TestGetAllHistoryTreeBranches() {
+	numBranches := 5
+	expected := make(map[string]string)
+	for i := 0; i < numBranches; i++ {
+		treeID := uuid.New()
+		bi, err := s.newHistoryBranch(treeID)
+		s.Nil(err)
+		info := fmt.Sprintf("branchInfo-%v", i)
+		err = s.appendNewBranchAndFirstNode(bi, s.genRandomEvents([]int64{1, 2, 3}, 1), 1, info)
+		s.Nil(err)
+		branches := s.descTree(treeID)
+		s.Equal(1, len(branches))
+		expected[branches[0].GetBranchID()] = info
+	}
+
+	found := make(map[string]string)
+	var pageToken []byte
+	for {
+		resp, err := s.HistoryV2Mgr.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
+			PageSize:      2,
+			NextPageToken: pageToken,
+		})
+		s.Nil(err)
+		s.True(len(resp.Branches) <= 2)
+		for _, br := range resp.Branches {
+			if _, ok := expected[br.BranchID]; ok {
+				s.False(br.ForkTime.IsZero())
+				found[br.BranchID] = br.Info
+			}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(expected, found)
+}
+
 //TestConcurrentlyCreateAndAppendBranches test
 func (s *HistoryV2PersistenceSuite) TestConcurrentlyCreateAndAppendBranches() {
 	treeID := uuid.New()
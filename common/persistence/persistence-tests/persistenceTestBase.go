@@ -118,6 +118,15 @@ func NewTestBaseWithSQL(options *TestBaseOptions) TestBase {
 	return newTestBase(options, testCluster)
 }
 
+// NewTestBaseWithSQLite returns a new persistence test base backed by a sqlite database file
+func NewTestBaseWithSQLite(options *TestBaseOptions) TestBase {
+	if options.DBName == "" {
+		options.DBName = GenerateRandomDBName(10)
+	}
+	testCluster := sql.NewSQLiteTestCluster(options.DBName)
+	return newTestBase(options, testCluster)
+}
+
 // NewTestBase returns a persistence test base backed by either cassandra or sql
 func NewTestBase(options *TestBaseOptions) TestBase {
 	switch options.StoreType {
@@ -0,0 +1,459 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+
+	"github.com/uber/cadence/common/encryption"
+)
+
+type (
+	// the encryption stores sit right above the datastores so that everything persisted
+	// by the managers, i.e. serialized events and payloads, is encrypted at rest
+	historyEncryptionStore struct {
+		HistoryStore
+		encryptor encryption.Encryptor
+	}
+
+	historyV2EncryptionStore struct {
+		HistoryV2Store
+		encryptor encryption.Encryptor
+	}
+
+	executionEncryptionStore struct {
+		ExecutionStore
+		encryptor encryption.Encryptor
+	}
+)
+
+const (
+	// encryptedPayloadPrefix is prepended to encrypted data blobs and payloads, so data written
+	// before encryption was enabled stays readable. The encoding type of a data blob is left
+	// untouched as it is stored in size limited columns by some of the datastores.
+	encryptedPayloadPrefix = "\x00cadence-encrypted:"
+)
+
+var _ HistoryStore = (*historyEncryptionStore)(nil)
+var _ HistoryV2Store = (*historyV2EncryptionStore)(nil)
+var _ ExecutionStore = (*executionEncryptionStore)(nil)
+
+// NewHistoryStoreEncryptionClient creates a HistoryStore which encrypts the history events
+func NewHistoryStoreEncryptionClient(persistence HistoryStore, encryptor encryption.Encryptor) HistoryStore {
+	return &historyEncryptionStore{
+		HistoryStore: persistence,
+		encryptor:    encryptor,
+	}
+}
+
+// NewHistoryV2StoreEncryptionClient creates a HistoryV2Store which encrypts the history events
+func NewHistoryV2StoreEncryptionClient(persistence HistoryV2Store, encryptor encryption.Encryptor) HistoryV2Store {
+	return &historyV2EncryptionStore{
+		HistoryV2Store: persistence,
+		encryptor:      encryptor,
+	}
+}
+
+// NewExecutionStoreEncryptionClient creates an ExecutionStore which encrypts the events and
+// payloads of the mutable state
+func NewExecutionStoreEncryptionClient(persistence ExecutionStore, encryptor encryption.Encryptor) ExecutionStore {
+	return &executionEncryptionStore{
+		ExecutionStore: persistence,
+		encryptor:      encryptor,
+	}
+}
+
+func (s *historyEncryptionStore) AppendHistoryEvents(request *InternalAppendHistoryEventsRequest) error {
+	events, err := encryptBlob(s.encryptor, request.DomainID, request.Events)
+	if err != nil {
+		return err
+	}
+	request.Events = events
+	return s.HistoryStore.AppendHistoryEvents(request)
+}
+
+func (s *historyEncryptionStore) GetWorkflowExecutionHistory(
+	request *InternalGetWorkflowExecutionHistoryRequest) (*InternalGetWorkflowExecutionHistoryResponse, error) {
+	response, err := s.HistoryStore.GetWorkflowExecutionHistory(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := decryptBlobs(s.encryptor, response.History); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *historyV2EncryptionStore) AppendHistoryNodes(request *InternalAppendHistoryNodesRequest) error {
+	events, err := encryptBlob(s.encryptor, request.DomainID, request.Events)
+	if err != nil {
+		return err
+	}
+	request.Events = events
+	return s.HistoryV2Store.AppendHistoryNodes(request)
+}
+
+func (s *historyV2EncryptionStore) ReadHistoryBranch(request *InternalReadHistoryBranchRequest) (*InternalReadHistoryBranchResponse, error) {
+	response, err := s.HistoryV2Store.ReadHistoryBranch(request)
+	if err != nil {
+		return nil, err
+	}
+	if err := decryptBlobs(s.encryptor, response.History); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *executionEncryptionStore) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*InternalGetWorkflowExecutionResponse, error) {
+	response, err := s.ExecutionStore.GetWorkflowExecution(request)
+	if err != nil {
+		return nil, err
+	}
+
+	state := response.State
+	if err := s.decryptExecutionInfo(state.ExecutionInfo); err != nil {
+		return nil, err
+	}
+	for _, info := range state.ActivitInfos {
+		if err := s.decryptActivityInfo(info); err != nil {
+			return nil, err
+		}
+	}
+	for _, info := range state.ChildExecutionInfos {
+		if err := s.decryptChildExecutionInfo(info); err != nil {
+			return nil, err
+		}
+	}
+	for _, info := range state.SignalInfos {
+		if err := s.decryptSignalInfo(info); err != nil {
+			return nil, err
+		}
+	}
+	if err := decryptBlobs(s.encryptor, state.BufferedEvents); err != nil {
+		return nil, err
+	}
+	for _, task := range state.BufferedReplicationTasks {
+		if err := s.decryptBufferedReplicationTask(task); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *executionEncryptionStore) UpdateWorkflowExecution(request *InternalUpdateWorkflowExecutionRequest) error {
+	domainID := request.ExecutionInfo.DomainID
+	if err := s.encryptExecutionInfo(request.ExecutionInfo); err != nil {
+		return err
+	}
+	if err := s.encryptActivityInfos(domainID, request.UpsertActivityInfos); err != nil {
+		return err
+	}
+	if err := s.encryptChildExecutionInfos(domainID, request.UpsertChildExecutionInfos); err != nil {
+		return err
+	}
+	signalInfos, err := s.encryptSignalInfos(domainID, request.UpsertSignalInfos)
+	if err != nil {
+		return err
+	}
+	request.UpsertSignalInfos = signalInfos
+	bufferedEvents, err := encryptBlob(s.encryptor, domainID, request.NewBufferedEvents)
+	if err != nil {
+		return err
+	}
+	request.NewBufferedEvents = bufferedEvents
+	if err := s.encryptBufferedReplicationTask(domainID, request.NewBufferedReplicationTask); err != nil {
+		return err
+	}
+	return s.ExecutionStore.UpdateWorkflowExecution(request)
+}
+
+func (s *executionEncryptionStore) ResetMutableState(request *InternalResetMutableStateRequest) error {
+	domainID := request.ExecutionInfo.DomainID
+	if err := s.encryptExecutionInfo(request.ExecutionInfo); err != nil {
+		return err
+	}
+	if err := s.encryptActivityInfos(domainID, request.InsertActivityInfos); err != nil {
+		return err
+	}
+	if err := s.encryptChildExecutionInfos(domainID, request.InsertChildExecutionInfos); err != nil {
+		return err
+	}
+	signalInfos, err := s.encryptSignalInfos(domainID, request.InsertSignalInfos)
+	if err != nil {
+		return err
+	}
+	request.InsertSignalInfos = signalInfos
+	return s.ExecutionStore.ResetMutableState(request)
+}
+
+func (s *executionEncryptionStore) ResetWorkflowExecution(request *InternalResetWorkflowExecutionRequest) error {
+	domainID := request.InsertExecutionInfo.DomainID
+	if request.UpdateCurr {
+		if err := s.encryptExecutionInfo(request.CurrExecutionInfo); err != nil {
+			return err
+		}
+	}
+	if err := s.encryptExecutionInfo(request.InsertExecutionInfo); err != nil {
+		return err
+	}
+	if err := s.encryptActivityInfos(domainID, request.InsertActivityInfos); err != nil {
+		return err
+	}
+	if err := s.encryptChildExecutionInfos(domainID, request.InsertChildExecutionInfos); err != nil {
+		return err
+	}
+	signalInfos, err := s.encryptSignalInfos(domainID, request.InsertSignalInfos)
+	if err != nil {
+		return err
+	}
+	request.InsertSignalInfos = signalInfos
+	return s.ExecutionStore.ResetWorkflowExecution(request)
+}
+
+func (s *executionEncryptionStore) encryptExecutionInfo(info *InternalWorkflowExecutionInfo) error {
+	if info == nil {
+		return nil
+	}
+	completionEvent, err := encryptBlob(s.encryptor, info.DomainID, info.CompletionEvent)
+	if err != nil {
+		return err
+	}
+	executionContext, err := encryptPayload(s.encryptor, info.DomainID, info.ExecutionContext)
+	if err != nil {
+		return err
+	}
+	info.CompletionEvent = completionEvent
+	info.ExecutionContext = executionContext
+	return nil
+}
+
+func (s *executionEncryptionStore) decryptExecutionInfo(info *InternalWorkflowExecutionInfo) error {
+	if info == nil {
+		return nil
+	}
+	completionEvent, err := decryptBlob(s.encryptor, info.CompletionEvent)
+	if err != nil {
+		return err
+	}
+	executionContext, err := decryptPayload(s.encryptor, info.ExecutionContext)
+	if err != nil {
+		return err
+	}
+	info.CompletionEvent = completionEvent
+	info.ExecutionContext = executionContext
+	return nil
+}
+
+func (s *executionEncryptionStore) encryptActivityInfos(domainID string, infos []*InternalActivityInfo) error {
+	for _, info := range infos {
+		scheduledEvent, err := encryptBlob(s.encryptor, domainID, info.ScheduledEvent)
+		if err != nil {
+			return err
+		}
+		startedEvent, err := encryptBlob(s.encryptor, domainID, info.StartedEvent)
+		if err != nil {
+			return err
+		}
+		details, err := encryptPayload(s.encryptor, domainID, info.Details)
+		if err != nil {
+			return err
+		}
+		info.ScheduledEvent = scheduledEvent
+		info.StartedEvent = startedEvent
+		info.Details = details
+	}
+	return nil
+}
+
+func (s *executionEncryptionStore) decryptActivityInfo(info *InternalActivityInfo) error {
+	scheduledEvent, err := decryptBlob(s.encryptor, info.ScheduledEvent)
+	if err != nil {
+		return err
+	}
+	startedEvent, err := decryptBlob(s.encryptor, info.StartedEvent)
+	if err != nil {
+		return err
+	}
+	details, err := decryptPayload(s.encryptor, info.Details)
+	if err != nil {
+		return err
+	}
+	info.ScheduledEvent = scheduledEvent
+	info.StartedEvent = startedEvent
+	info.Details = details
+	return nil
+}
+
+func (s *executionEncryptionStore) encryptChildExecutionInfos(domainID string, infos []*InternalChildExecutionInfo) error {
+	for _, info := range infos {
+		initiatedEvent, err := encryptBlob(s.encryptor, domainID, info.InitiatedEvent)
+		if err != nil {
+			return err
+		}
+		startedEvent, err := encryptBlob(s.encryptor, domainID, info.StartedEvent)
+		if err != nil {
+			return err
+		}
+		info.InitiatedEvent = initiatedEvent
+		info.StartedEvent = startedEvent
+	}
+	return nil
+}
+
+func (s *executionEncryptionStore) decryptChildExecutionInfo(info *InternalChildExecutionInfo) error {
+	initiatedEvent, err := decryptBlob(s.encryptor, info.InitiatedEvent)
+	if err != nil {
+		return err
+	}
+	startedEvent, err := decryptBlob(s.encryptor, info.StartedEvent)
+	if err != nil {
+		return err
+	}
+	info.InitiatedEvent = initiatedEvent
+	info.StartedEvent = startedEvent
+	return nil
+}
+
+// encryptSignalInfos returns the encrypted copies of the signal infos, the signal infos are not
+// encrypted in place as they are shared with the mutable state of the caller
+func (s *executionEncryptionStore) encryptSignalInfos(domainID string, infos []*SignalInfo) ([]*SignalInfo, error) {
+	if infos == nil {
+		return nil, nil
+	}
+	encrypted := make([]*SignalInfo, 0, len(infos))
+	for _, info := range infos {
+		input, err := encryptPayload(s.encryptor, domainID, info.Input)
+		if err != nil {
+			return nil, err
+		}
+		control, err := encryptPayload(s.encryptor, domainID, info.Control)
+		if err != nil {
+			return nil, err
+		}
+		encryptedInfo := *info
+		encryptedInfo.Input = input
+		encryptedInfo.Control = control
+		encrypted = append(encrypted, &encryptedInfo)
+	}
+	return encrypted, nil
+}
+
+func (s *executionEncryptionStore) decryptSignalInfo(info *SignalInfo) error {
+	input, err := decryptPayload(s.encryptor, info.Input)
+	if err != nil {
+		return err
+	}
+	control, err := decryptPayload(s.encryptor, info.Control)
+	if err != nil {
+		return err
+	}
+	info.Input = input
+	info.Control = control
+	return nil
+}
+
+func (s *executionEncryptionStore) encryptBufferedReplicationTask(domainID string, task *InternalBufferedReplicationTask) error {
+	if task == nil {
+		return nil
+	}
+	history, err := encryptBlob(s.encryptor, domainID, task.History)
+	if err != nil {
+		return err
+	}
+	newRunHistory, err := encryptBlob(s.encryptor, domainID, task.NewRunHistory)
+	if err != nil {
+		return err
+	}
+	task.History = history
+	task.NewRunHistory = newRunHistory
+	return nil
+}
+
+func (s *executionEncryptionStore) decryptBufferedReplicationTask(task *InternalBufferedReplicationTask) error {
+	history, err := decryptBlob(s.encryptor, task.History)
+	if err != nil {
+		return err
+	}
+	newRunHistory, err := decryptBlob(s.encryptor, task.NewRunHistory)
+	if err != nil {
+		return err
+	}
+	task.History = history
+	task.NewRunHistory = newRunHistory
+	return nil
+}
+
+func encryptBlob(encryptor encryption.Encryptor, domainID string, blob *DataBlob) (*DataBlob, error) {
+	if blob == nil || len(blob.Data) == 0 || domainID == "" {
+		return blob, nil
+	}
+	data, err := encryptPayload(encryptor, domainID, blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &DataBlob{
+		Encoding: blob.Encoding,
+		Data:     data,
+	}, nil
+}
+
+func decryptBlob(encryptor encryption.Encryptor, blob *DataBlob) (*DataBlob, error) {
+	if blob == nil || !bytes.HasPrefix(blob.Data, []byte(encryptedPayloadPrefix)) {
+		return blob, nil
+	}
+	data, err := decryptPayload(encryptor, blob.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &DataBlob{
+		Encoding: blob.Encoding,
+		Data:     data,
+	}, nil
+}
+
+func decryptBlobs(encryptor encryption.Encryptor, blobs []*DataBlob) error {
+	for i, blob := range blobs {
+		decrypted, err := decryptBlob(encryptor, blob)
+		if err != nil {
+			return err
+		}
+		blobs[i] = decrypted
+	}
+	return nil
+}
+
+func encryptPayload(encryptor encryption.Encryptor, domainID string, payload []byte) ([]byte, error) {
+	if len(payload) == 0 || domainID == "" {
+		return payload, nil
+	}
+	data, err := encryptor.Encrypt(domainID, payload)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(encryptedPayloadPrefix), data...), nil
+}
+
+func decryptPayload(encryptor encryption.Encryptor, payload []byte) ([]byte, error) {
+	if !bytes.HasPrefix(payload, []byte(encryptedPayloadPrefix)) {
+		return payload, nil
+	}
+	return encryptor.Decrypt(payload[len(encryptedPayloadPrefix):])
+}
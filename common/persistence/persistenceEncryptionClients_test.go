@@ -0,0 +1,156 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
+)
+
+type (
+	encryptionClientsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		dir       string
+		encryptor encryption.Encryptor
+	}
+)
+
+func TestEncryptionClientsSuite(t *testing.T) {
+	suite.Run(t, new(encryptionClientsSuite))
+}
+
+func (s *encryptionClientsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dir, err := ioutil.TempDir("", "encryptionClientsSuite")
+	s.NoError(err)
+	s.dir = dir
+	keyFile := filepath.Join(dir, "keys.yaml")
+	_, err = encryption.RotateKeyFile(keyFile)
+	s.NoError(err)
+	provider, err := encryption.NewFileKeyProvider(keyFile)
+	s.NoError(err)
+	s.encryptor = encryption.NewEncryptor(provider, 0)
+}
+
+func (s *encryptionClientsSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *encryptionClientsSuite) TestBlob() {
+	blob := &DataBlob{Encoding: common.EncodingTypeThriftRW, Data: []byte("some events")}
+	encrypted, err := encryptBlob(s.encryptor, "some-domain", blob)
+	s.NoError(err)
+	s.Equal(blob.Encoding, encrypted.Encoding)
+	s.NotEqual(blob.Data, encrypted.Data)
+
+	decrypted, err := decryptBlob(s.encryptor, encrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	// data written before encryption was enabled is passed through
+	decrypted, err = decryptBlob(s.encryptor, blob)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	// events without a domain cannot be encrypted
+	encrypted, err = encryptBlob(s.encryptor, "", blob)
+	s.NoError(err)
+	s.Equal(blob, encrypted)
+}
+
+func (s *encryptionClientsSuite) TestBlobs() {
+	plain := &DataBlob{Encoding: common.EncodingTypeThriftRW, Data: []byte("old events")}
+	blob := &DataBlob{Encoding: common.EncodingTypeThriftRW, Data: []byte("new events")}
+	encrypted, err := encryptBlob(s.encryptor, "some-domain", blob)
+	s.NoError(err)
+
+	blobs := []*DataBlob{plain, encrypted}
+	s.NoError(decryptBlobs(s.encryptor, blobs))
+	s.Equal([]*DataBlob{plain, blob}, blobs)
+}
+
+func (s *encryptionClientsSuite) TestPayload() {
+	payload := []byte("some heartbeat details")
+	encrypted, err := encryptPayload(s.encryptor, "some-domain", payload)
+	s.NoError(err)
+	s.NotEqual(payload, encrypted)
+
+	decrypted, err := decryptPayload(s.encryptor, encrypted)
+	s.NoError(err)
+	s.Equal(payload, decrypted)
+
+	decrypted, err = decryptPayload(s.encryptor, payload)
+	s.NoError(err)
+	s.Equal(payload, decrypted)
+
+	encrypted, err = encryptPayload(s.encryptor, "some-domain", nil)
+	s.NoError(err)
+	s.Nil(encrypted)
+}
+
+func (s *encryptionClientsSuite) TestSignalInfos() {
+	store := &signalInfosExecutionStore{}
+	client := NewExecutionStoreEncryptionClient(store, s.encryptor)
+	info := &SignalInfo{InitiatedID: 5, SignalName: "some-signal", Input: []byte("some input"), Control: []byte("some control")}
+	expected := *info
+
+	s.NoError(client.UpdateWorkflowExecution(&InternalUpdateWorkflowExecutionRequest{
+		ExecutionInfo:     &InternalWorkflowExecutionInfo{DomainID: "some-domain"},
+		UpsertSignalInfos: []*SignalInfo{info},
+	}))
+	// the signal info of the caller is not encrypted in place
+	s.Equal(expected, *info)
+	s.Len(store.signalInfos, 1)
+	s.NotEqual(info.Input, store.signalInfos[0].Input)
+	s.NotEqual(info.Control, store.signalInfos[0].Control)
+
+	response, err := client.GetWorkflowExecution(&GetWorkflowExecutionRequest{DomainID: "some-domain"})
+	s.NoError(err)
+	s.Equal(map[int64]*SignalInfo{5: &expected}, response.State.SignalInfos)
+}
+
+type signalInfosExecutionStore struct {
+	ExecutionStore
+	signalInfos []*SignalInfo
+}
+
+func (s *signalInfosExecutionStore) UpdateWorkflowExecution(request *InternalUpdateWorkflowExecutionRequest) error {
+	s.signalInfos = request.UpsertSignalInfos
+	return nil
+}
+
+func (s *signalInfosExecutionStore) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*InternalGetWorkflowExecutionResponse, error) {
+	signalInfos := make(map[int64]*SignalInfo)
+	for _, info := range s.signalInfos {
+		stored := *info
+		signalInfos[info.InitiatedID] = &stored
+	}
+	return &InternalGetWorkflowExecutionResponse{State: &InternalWorkflowMutableState{SignalInfos: signalInfos}}, nil
+}
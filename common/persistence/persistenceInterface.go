@@ -58,8 +58,13 @@ type (
 
 		CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error)
 		DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error
+		DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error
 		GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error)
 
+		// Scan related methods
+		ListConcreteExecutions(request *ListConcreteExecutionsRequest) (*ListConcreteExecutionsResponse, error)
+		ListCurrentExecutions(request *ListCurrentExecutionsRequest) (*ListCurrentExecutionsResponse, error)
+
 		// Transfer task related methods
 		GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error)
 		CompleteTransferTask(request *CompleteTransferTaskRequest) error
@@ -73,6 +78,12 @@ type (
 		GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error)
 		CompleteTimerTask(request *CompleteTimerTaskRequest) error
 		RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error
+
+		// Task DLQ related methods
+		PutTaskToDLQ(request *PutTaskToDLQRequest) error
+		GetDLQTask(request *GetDLQTaskRequest) (*GetDLQTaskResponse, error)
+		GetDLQTasks(request *GetDLQTasksRequest) (*GetDLQTasksResponse, error)
+		DeleteDLQTask(request *DeleteDLQTaskRequest) error
 	}
 
 	// HistoryStore is used to manage Workflow Execution HistoryEventBatch for Persistence layer
@@ -110,6 +121,8 @@ type (
 		CompleteForkBranch(request *InternalCompleteForkBranchRequest) error
 		// GetHistoryTree returns all branch information of a tree
 		GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error)
+		// GetAllHistoryTreeBranches returns all branches of all trees
+		GetAllHistoryTreeBranches(request *GetAllHistoryTreeBranchesRequest) (*GetAllHistoryTreeBranchesResponse, error)
 	}
 
 	// DataBlob represents a blob for any binary data.
@@ -364,6 +377,8 @@ type (
 		Events *DataBlob
 		// requested TransactionID for conditional update
 		TransactionID int64
+		// the domain of the workflow the events belong to
+		DomainID string
 	}
 
 	// InternalGetWorkflowExecutionResponse is the response to GetworkflowExecutionRequest for Persistence Interface
@@ -460,7 +475,8 @@ func NewDataBlob(data []byte, encodingType common.EncodingType) *DataBlob {
 	if data == nil || len(data) == 0 {
 		return nil
 	}
-	if encodingType != "thriftrw" && data[0] == 'Y' {
+	// compressed encodings can start with any byte
+	if encodingType != "thriftrw" && !isCompressedEncoding(encodingType) && data[0] == 'Y' {
 		panic(fmt.Sprintf("Invlid incoding: \"%v\"", encodingType))
 	}
 	return &DataBlob{
@@ -469,6 +485,10 @@ func NewDataBlob(data []byte, encodingType common.EncodingType) *DataBlob {
 	}
 }
 
+func isCompressedEncoding(encodingType common.EncodingType) bool {
+	return encodingType == common.EncodingTypeThriftRWSnappy || encodingType == common.EncodingTypeThriftRWZstd
+}
+
 // FromDataBlob decodes a datablob into a (payload, encodingType) tuple
 func FromDataBlob(blob *DataBlob) ([]byte, string) {
 	if blob == nil || len(blob.Data) == 0 {
@@ -488,6 +508,10 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeThriftRWSnappy:
+		return common.EncodingTypeThriftRWSnappy
+	case common.EncodingTypeThriftRWZstd:
+		return common.EncodingTypeThriftRWZstd
 	default:
 		return common.EncodingTypeUnknown
 	}
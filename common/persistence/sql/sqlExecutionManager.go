@@ -637,6 +637,12 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 	replicationState := request.ReplicationState
 	domainID := sqldb.MustParseUUID(info.DomainID)
 	runID := sqldb.MustParseUUID(info.RunID)
+	startVersion := common.EmptyVersion
+	lastWriteVersion := common.EmptyVersion
+	if replicationState != nil {
+		startVersion = replicationState.StartVersion
+		lastWriteVersion = replicationState.LastWriteVersion
+	}
 
 	if err := updateCurrentExecution(tx,
 		m.shardID,
@@ -646,8 +652,8 @@ func (m *sqlExecutionManager) resetMutableStateTx(tx sqldb.Tx, request *p.Intern
 		info.CreateRequestID,
 		info.State,
 		info.CloseStatus,
-		replicationState.StartVersion,
-		replicationState.LastWriteVersion,
+		startVersion,
+		lastWriteVersion,
 	); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to continue as new. Error: %v", err),
@@ -858,6 +864,126 @@ func (m *sqlExecutionManager) DeleteWorkflowExecution(request *p.DeleteWorkflowE
 	})
 }
 
+func (m *sqlExecutionManager) DeleteCurrentWorkflowExecution(request *p.DeleteCurrentWorkflowExecutionRequest) error {
+	_, err := m.db.DeleteFromCurrentExecutions(&sqldb.CurrentExecutionsFilter{
+		ShardID:    int64(m.shardID),
+		DomainID:   sqldb.MustParseUUID(request.DomainID),
+		WorkflowID: request.WorkflowID,
+		RunID:      sqldb.MustParseUUID(request.RunID),
+	})
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteCurrentWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+type executionPageToken struct {
+	DomainID   string
+	WorkflowID string
+	RunID      string
+}
+
+func (m *sqlExecutionManager) ListConcreteExecutions(request *p.ListConcreteExecutionsRequest) (*p.ListConcreteExecutionsResponse, error) {
+	pageToken := executionPageToken{DomainID: minUUID, RunID: minUUID}
+	if request.PageToken != nil {
+		if err := gobDeserialize(request.PageToken, &pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+	}
+	rows, err := m.db.RangeSelectFromExecutions(&sqldb.ExecutionsFilter{
+		ShardID:    m.shardID,
+		DomainID:   sqldb.MustParseUUID(pageToken.DomainID),
+		WorkflowID: pageToken.WorkflowID,
+		RunID:      sqldb.MustParseUUID(pageToken.RunID),
+		PageSize:   &request.PageSize,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListConcreteExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	var nextPageToken []byte
+	if len(rows) >= request.PageSize {
+		lastRow := &rows[len(rows)-1]
+		nextPageToken, err = gobSerialize(&executionPageToken{
+			DomainID:   lastRow.DomainID.String(),
+			WorkflowID: lastRow.WorkflowID,
+			RunID:      lastRow.RunID.String(),
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+	}
+
+	resp := &p.ListConcreteExecutionsResponse{
+		Executions:    make([]*p.ConcreteExecutionInfo, len(rows)),
+		NextPageToken: nextPageToken,
+	}
+	for i, row := range rows {
+		resp.Executions[i] = &p.ConcreteExecutionInfo{
+			DomainID:        row.DomainID.String(),
+			WorkflowID:      row.WorkflowID,
+			RunID:           row.RunID.String(),
+			State:           int(row.State),
+			CloseStatus:     int(row.CloseStatus),
+			NextEventID:     row.NextEventID,
+			StartTimestamp:  row.StartTime,
+			LastUpdatedTime: row.LastUpdatedTime,
+		}
+	}
+	return resp, nil
+}
+
+func (m *sqlExecutionManager) ListCurrentExecutions(request *p.ListCurrentExecutionsRequest) (*p.ListCurrentExecutionsResponse, error) {
+	pageToken := executionPageToken{DomainID: minUUID}
+	if request.PageToken != nil {
+		if err := gobDeserialize(request.PageToken, &pageToken); err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+	}
+	rows, err := m.db.RangeSelectFromCurrentExecutions(&sqldb.CurrentExecutionsFilter{
+		ShardID:    int64(m.shardID),
+		DomainID:   sqldb.MustParseUUID(pageToken.DomainID),
+		WorkflowID: pageToken.WorkflowID,
+		PageSize:   &request.PageSize,
+	})
+	if err != nil {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListCurrentExecutions operation failed. Error: %v", err),
+		}
+	}
+
+	var nextPageToken []byte
+	if len(rows) >= request.PageSize {
+		lastRow := &rows[len(rows)-1]
+		nextPageToken, err = gobSerialize(&executionPageToken{
+			DomainID:   lastRow.DomainID.String(),
+			WorkflowID: lastRow.WorkflowID,
+		})
+		if err != nil {
+			return nil, &workflow.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+	}
+
+	resp := &p.ListCurrentExecutionsResponse{
+		Executions:    make([]*p.CurrentExecutionInfo, len(rows)),
+		NextPageToken: nextPageToken,
+	}
+	for i, row := range rows {
+		resp.Executions[i] = &p.CurrentExecutionInfo{
+			DomainID:     row.DomainID.String(),
+			WorkflowID:   row.WorkflowID,
+			CurrentRunID: row.RunID.String(),
+			State:        row.State,
+			CloseStatus:  row.CloseStatus,
+		}
+	}
+	return resp, nil
+}
+
 func (m *sqlExecutionManager) GetCurrentExecution(request *p.GetCurrentExecutionRequest) (*p.GetCurrentExecutionResponse, error) {
 	row, err := m.db.SelectFromCurrentExecutions(&sqldb.CurrentExecutionsFilter{
 		ShardID:    int64(m.shardID),
@@ -1114,6 +1240,193 @@ func (m *sqlExecutionManager) RangeCompleteTimerTask(request *p.RangeCompleteTim
 	return nil
 }
 
+func (m *sqlExecutionManager) PutTaskToDLQ(request *p.PutTaskToDLQRequest) error {
+	var err error
+	switch {
+	case request.TransferTask != nil:
+		task := request.TransferTask
+		row := &sqldb.TransferTasksRow{
+			ShardID:                 m.shardID,
+			TaskID:                  task.TaskID,
+			DomainID:                sqldb.MustParseUUID(task.DomainID),
+			WorkflowID:              task.WorkflowID,
+			RunID:                   sqldb.MustParseUUID(task.RunID),
+			TaskType:                task.TaskType,
+			TargetDomainID:          sqldb.MustParseUUID(task.TargetDomainID),
+			TargetWorkflowID:        task.TargetWorkflowID,
+			TargetChildWorkflowOnly: task.TargetChildWorkflowOnly,
+			TaskList:                task.TaskList,
+			ScheduleID:              task.ScheduleID,
+			Version:                 task.Version,
+			VisibilityTimestamp:     task.VisibilityTimestamp,
+		}
+		if task.TargetRunID != "" {
+			row.TargetRunID = sqldb.MustParseUUID(task.TargetRunID)
+		}
+		_, err = m.db.InsertIntoTransferTasksDLQ(row)
+	case request.TimerTask != nil:
+		task := request.TimerTask
+		_, err = m.db.InsertIntoTimerTasksDLQ(&sqldb.TimerTasksRow{
+			ShardID:             m.shardID,
+			VisibilityTimestamp: task.VisibilityTimestamp,
+			TaskID:              task.TaskID,
+			DomainID:            sqldb.MustParseUUID(task.DomainID),
+			WorkflowID:          task.WorkflowID,
+			RunID:               sqldb.MustParseUUID(task.RunID),
+			TaskType:            task.TaskType,
+			TimeoutType:         task.TimeoutType,
+			EventID:             task.EventID,
+			ScheduleAttempt:     task.ScheduleAttempt,
+			Version:             task.Version,
+		})
+	default:
+		return &workflow.BadRequestError{
+			Message: "PutTaskToDLQ operation failed. No task provided.",
+		}
+	}
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("PutTaskToDLQ operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) GetDLQTask(request *p.GetDLQTaskRequest) (*p.GetDLQTaskResponse, error) {
+	response, err := m.selectDLQTasks(request.Category, &sqldb.TasksDLQFilter{
+		ShardID: m.shardID,
+		TaskID:  &request.TaskID,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.BadRequestError); ok {
+			return nil, err
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDLQTask operation failed. Select failed. Error: %v", err),
+		}
+	}
+	switch {
+	case len(response.TransferTasks) > 0:
+		return &p.GetDLQTaskResponse{TransferTask: response.TransferTasks[0]}, nil
+	case len(response.TimerTasks) > 0:
+		return &p.GetDLQTaskResponse{TimerTask: response.TimerTasks[0]}, nil
+	default:
+		return nil, &workflow.EntityNotExistsError{
+			Message: fmt.Sprintf("DLQ task not found.  TaskId: %v", request.TaskID),
+		}
+	}
+}
+
+func (m *sqlExecutionManager) GetDLQTasks(request *p.GetDLQTasksRequest) (*p.GetDLQTasksResponse, error) {
+	minTaskID := int64(math.MinInt64)
+	if len(request.NextPageToken) > 0 {
+		var err error
+		if minTaskID, err = deserializePageToken(request.NextPageToken); err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetDLQTasks operation failed. Error deserializing page token: %v", err),
+			}
+		}
+	}
+
+	response, err := m.selectDLQTasks(request.Category, &sqldb.TasksDLQFilter{
+		ShardID:   m.shardID,
+		MinTaskID: &minTaskID,
+		PageSize:  &request.PageSize,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.BadRequestError); ok {
+			return nil, err
+		}
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("GetDLQTasks operation failed. Select failed. Error: %v", err),
+		}
+	}
+
+	switch {
+	case len(response.TransferTasks) == request.PageSize:
+		response.NextPageToken = serializePageToken(response.TransferTasks[request.PageSize-1].TaskID)
+	case len(response.TimerTasks) == request.PageSize:
+		response.NextPageToken = serializePageToken(response.TimerTasks[request.PageSize-1].TaskID)
+	}
+	return response, nil
+}
+
+func (m *sqlExecutionManager) DeleteDLQTask(request *p.DeleteDLQTaskRequest) error {
+	filter := &sqldb.TasksDLQFilter{
+		ShardID: m.shardID,
+		TaskID:  &request.TaskID,
+	}
+	var err error
+	switch request.Category {
+	case p.DLQTaskCategoryTransfer:
+		_, err = m.db.DeleteFromTransferTasksDLQ(filter)
+	case p.DLQTaskCategoryTimer:
+		_, err = m.db.DeleteFromTimerTasksDLQ(filter)
+	default:
+		return &workflow.BadRequestError{
+			Message: fmt.Sprintf("Unknown DLQ task category: %v", request.Category),
+		}
+	}
+	if err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteDLQTask operation failed. Error: %v", err),
+		}
+	}
+	return nil
+}
+
+func (m *sqlExecutionManager) selectDLQTasks(category int, filter *sqldb.TasksDLQFilter) (*p.GetDLQTasksResponse, error) {
+	response := &p.GetDLQTasksResponse{}
+	switch category {
+	case p.DLQTaskCategoryTransfer:
+		rows, err := m.db.SelectFromTransferTasksDLQ(filter)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		for _, row := range rows {
+			response.TransferTasks = append(response.TransferTasks, &p.TransferTaskInfo{
+				DomainID:                row.DomainID.String(),
+				WorkflowID:              row.WorkflowID,
+				RunID:                   row.RunID.String(),
+				VisibilityTimestamp:     row.VisibilityTimestamp,
+				TaskID:                  row.TaskID,
+				TargetDomainID:          row.TargetDomainID.String(),
+				TargetWorkflowID:        row.TargetWorkflowID,
+				TargetRunID:             row.TargetRunID.String(),
+				TargetChildWorkflowOnly: row.TargetChildWorkflowOnly,
+				TaskList:                row.TaskList,
+				TaskType:                row.TaskType,
+				ScheduleID:              row.ScheduleID,
+				Version:                 row.Version,
+			})
+		}
+	case p.DLQTaskCategoryTimer:
+		rows, err := m.db.SelectFromTimerTasksDLQ(filter)
+		if err != nil && err != sql.ErrNoRows {
+			return nil, err
+		}
+		for _, row := range rows {
+			response.TimerTasks = append(response.TimerTasks, &p.TimerTaskInfo{
+				DomainID:            row.DomainID.String(),
+				WorkflowID:          row.WorkflowID,
+				RunID:               row.RunID.String(),
+				VisibilityTimestamp: row.VisibilityTimestamp,
+				TaskID:              row.TaskID,
+				TaskType:            row.TaskType,
+				TimeoutType:         row.TimeoutType,
+				EventID:             row.EventID,
+				ScheduleAttempt:     row.ScheduleAttempt,
+				Version:             row.Version,
+			})
+		}
+	default:
+		return nil, &workflow.BadRequestError{
+			Message: fmt.Sprintf("Unknown DLQ task category: %v", category),
+		}
+	}
+	return response, nil
+}
+
 // NewSQLExecutionStore creates an instance of ExecutionStore
 func NewSQLExecutionStore(db sqldb.Interface, logger bark.Logger, shardID int) (p.ExecutionStore, error) {
 	return &sqlExecutionManager{
@@ -1264,16 +1577,22 @@ func createOrUpdateCurrentExecution(
 
 	switch request.CreateWorkflowMode {
 	case p.CreateWorkflowModeContinueAsNew:
-		if err := updateCurrentExecution(tx,
+		// the current run must still be the run continuing as new, otherwise a concurrent
+		// continue as new of the same run already replaced it
+		if err := continueAsNew(tx,
 			shardID,
 			domainID,
 			*request.Execution.WorkflowId,
 			runID,
+			request.PreviousRunID,
 			request.RequestID,
 			p.WorkflowStateRunning,
 			p.WorkflowCloseStatusNone,
 			row.StartVersion,
 			row.LastWriteVersion); err != nil {
+			if _, ok := err.(*p.ConditionFailedError); ok {
+				return err
+			}
 			return &workflow.InternalServiceError{
 				Message: fmt.Sprintf("CreateWorkflowExecution operation failed. Failed to continue as new. Error: %v", err),
 			}
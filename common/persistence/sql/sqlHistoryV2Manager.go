@@ -500,3 +500,55 @@ func (m *sqlHistoryV2Manager) GetHistoryTree(request *p.GetHistoryTreeRequest) (
 		ForkingInProgressBranches: forkingBranches,
 	}, nil
 }
+
+type historyTreePageToken struct {
+	TreeID   string
+	BranchID string
+}
+
+// GetAllHistoryTreeBranches returns all branches of all trees
+func (m *sqlHistoryV2Manager) GetAllHistoryTreeBranches(request *p.GetAllHistoryTreeBranchesRequest) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	pageToken := historyTreePageToken{TreeID: minUUID, BranchID: minUUID}
+	if request.NextPageToken != nil {
+		if err := gobDeserialize(request.NextPageToken, &pageToken); err != nil {
+			return nil, &shared.InternalServiceError{Message: fmt.Sprintf("error deserializing page token: %v", err)}
+		}
+	}
+	branchID := sqldb.MustParseUUID(pageToken.BranchID)
+	rows, err := m.db.RangeSelectFromHistoryTree(&sqldb.HistoryTreeFilter{
+		TreeID:   sqldb.MustParseUUID(pageToken.TreeID),
+		BranchID: &branchID,
+		PageSize: &request.PageSize,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &shared.InternalServiceError{
+			Message: fmt.Sprintf("GetAllHistoryTreeBranches operation failed. Error: %v", err),
+		}
+	}
+
+	var nextPageToken []byte
+	if len(rows) >= request.PageSize {
+		lastRow := &rows[len(rows)-1]
+		nextPageToken, err = gobSerialize(&historyTreePageToken{
+			TreeID:   lastRow.TreeID.String(),
+			BranchID: lastRow.BranchID.String(),
+		})
+		if err != nil {
+			return nil, &shared.InternalServiceError{Message: fmt.Sprintf("error serializing nextPageToken:%v", err)}
+		}
+	}
+
+	branches := make([]p.HistoryBranchDetail, len(rows))
+	for i, row := range rows {
+		branches[i] = p.HistoryBranchDetail{
+			TreeID:   row.TreeID.String(),
+			BranchID: row.BranchID.String(),
+			ForkTime: row.CreatedTs,
+			Info:     row.Info,
+		}
+	}
+	return &p.GetAllHistoryTreeBranchesResponse{
+		NextPageToken: nextPageToken,
+		Branches:      branches,
+	}, nil
+}
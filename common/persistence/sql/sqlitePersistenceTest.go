@@ -0,0 +1,101 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
+	"github.com/uber/cadence/common/service/config"
+)
+
+// SQLiteTestCluster allows executing the persistence tests against a sqlite database file.
+// The schema is created by the sqlite storage itself, so there is nothing to load.
+type SQLiteTestCluster struct {
+	dbName string
+	dir    string
+	cfg    config.SQL
+}
+
+// NewSQLiteTestCluster returns a new sqlite test cluster
+func NewSQLiteTestCluster(dbName string) *SQLiteTestCluster {
+	return &SQLiteTestCluster{dbName: dbName}
+}
+
+// DatabaseName from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) DatabaseName() string {
+	return s.dbName
+}
+
+// SetupTestDatabase from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) SetupTestDatabase() {
+	s.CreateDatabase()
+}
+
+// Config returns the persistence config for connecting to this test cluster
+func (s *SQLiteTestCluster) Config() config.Persistence {
+	cfg := s.cfg
+	return config.Persistence{
+		DefaultStore:    "test",
+		VisibilityStore: "test",
+		DataStores: map[string]config.DataStore{
+			"test": {SQL: &cfg},
+		},
+	}
+}
+
+// TearDownTestDatabase from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) TearDownTestDatabase() {
+	s.DropDatabase()
+}
+
+// CreateSession from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) CreateSession() {}
+
+// CreateDatabase from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) CreateDatabase() {
+	dir, err := ioutil.TempDir("", "cadence-sqlite-")
+	if err != nil {
+		log.Fatal(err)
+	}
+	s.dir = dir
+	s.cfg = config.SQL{
+		DriverName:   sqlite.DriverName,
+		DatabaseName: filepath.Join(dir, s.dbName+".db"),
+		NumShards:    4,
+	}
+}
+
+// DropDatabase from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) DropDatabase() {
+	if err := os.RemoveAll(s.dir); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// LoadSchema from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) LoadSchema(fileNames []string, schemaDir string) {}
+
+// LoadVisibilitySchema from PersistenceTestCluster interface
+func (s *SQLiteTestCluster) LoadVisibilitySchema(fileNames []string, schemaDir string) {}
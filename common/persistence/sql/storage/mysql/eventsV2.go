@@ -44,6 +44,9 @@ const (
 
 	getHistoryTreeQry = `SELECT branch_id, ancestors, in_progress, created_ts, info FROM history_tree WHERE tree_id = ? `
 
+	rangeGetHistoryTreeQry = `SELECT tree_id, branch_id, in_progress, created_ts, info FROM history_tree ` +
+		`WHERE (tree_id, branch_id) > (?, ?) ORDER BY tree_id, branch_id LIMIT ? `
+
 	deleteHistoryTreeQry = `DELETE FROM history_tree WHERE tree_id = ? AND branch_id = ? `
 
 	updateHistoryTreeQry = `UPDATE history_tree set in_progress = :in_progress WHERE tree_id = :tree_id AND branch_id = :branch_id `
@@ -89,6 +92,13 @@ func (mdb *DB) SelectFromHistoryTree(filter *sqldb.HistoryTreeFilter) ([]sqldb.H
 	return rows, err
 }
 
+// RangeSelectFromHistoryTree reads a page of rows from history_tree table
+func (mdb *DB) RangeSelectFromHistoryTree(filter *sqldb.HistoryTreeFilter) ([]sqldb.HistoryTreeRow, error) {
+	var rows []sqldb.HistoryTreeRow
+	err := mdb.conn.Select(&rows, rangeGetHistoryTreeQry, filter.TreeID, *filter.BranchID, *filter.PageSize)
+	return rows, err
+}
+
 // UpdateHistoryTree updates a row in history_tree table
 func (mdb *DB) UpdateHistoryTree(row *sqldb.HistoryTreeRow) (sql.Result, error) {
 	return mdb.conn.NamedExec(updateHistoryTreeQry, row)
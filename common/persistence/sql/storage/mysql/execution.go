@@ -229,6 +229,13 @@ domain_id = ? AND
 workflow_id = ? AND
 run_id = ?`
 
+	rangeGetExecutionQry = `SELECT
+shard_id, domain_id, workflow_id, run_id, state, close_status, next_event_id, start_time, last_updated_time
+FROM executions WHERE
+shard_id = ? AND
+(domain_id, workflow_id, run_id) > (?, ?, ?)
+ORDER BY domain_id, workflow_id, run_id LIMIT ?`
+
 	deleteExecutionQry = `DELETE FROM executions WHERE
 shard_id = ? AND
 domain_id = ? AND
@@ -286,6 +293,11 @@ task_id <= ?
 shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status, start_version, last_write_version
 FROM current_executions WHERE shard_id = ? AND domain_id = ? AND workflow_id = ?`
 
+	rangeGetCurrentExecutionQry = `SELECT
+shard_id, domain_id, workflow_id, run_id, create_request_id, state, close_status, start_version, last_write_version
+FROM current_executions WHERE shard_id = ? AND (domain_id, workflow_id) > (?, ?)
+ORDER BY domain_id, workflow_id LIMIT ?`
+
 	lockCurrentExecutionJoinExecutionsQry = `SELECT
 ce.shard_id, ce.domain_id, ce.workflow_id, ce.run_id, ce.create_request_id, ce.state, ce.close_status, ce.start_version, e.last_write_version
 FROM current_executions ce
@@ -393,6 +405,24 @@ workflow_id = ? AND
 run_id = ?
 FOR UPDATE`
 
+	createTransferTasksDLQQry = `INSERT INTO transfer_tasks_dlq (` +
+		transferTasksColumns + `) VALUES (` +
+		transferTasksColumnsTags + `)`
+	getTransferTaskDLQQry = `SELECT ` + transferTaskInfoColumns +
+		` FROM transfer_tasks_dlq WHERE shard_id = ? AND task_id = ?`
+	rangeGetTransferTasksDLQQry = `SELECT ` + transferTaskInfoColumns +
+		` FROM transfer_tasks_dlq WHERE shard_id = ? AND task_id > ? ORDER BY task_id LIMIT ?`
+	deleteTransferTaskDLQQry = `DELETE FROM transfer_tasks_dlq WHERE shard_id = ? AND task_id = ?`
+
+	createTimerTasksDLQQry = `INSERT INTO timer_tasks_dlq (` +
+		timerTasksColumns + `) VALUES (` +
+		timerTasksColumnsTags + `)`
+	getTimerTaskDLQQry = `SELECT ` + timerTaskInfoColumns +
+		` FROM timer_tasks_dlq WHERE shard_id = ? AND task_id = ?`
+	rangeGetTimerTasksDLQQry = `SELECT ` + timerTaskInfoColumns +
+		` FROM timer_tasks_dlq WHERE shard_id = ? AND task_id > ? ORDER BY task_id LIMIT ?`
+	deleteTimerTaskDLQQry = `DELETE FROM timer_tasks_dlq WHERE shard_id = ? AND task_id = ?`
+
 	bufferedEventsColumns    = `shard_id, domain_id, workflow_id, run_id, data, data_encoding`
 	createBufferedEventsQury = `INSERT INTO buffered_events(` + bufferedEventsColumns + `)
 VALUES (:shard_id, :domain_id, :workflow_id, :run_id, :data, :data_encoding)`
@@ -431,6 +461,21 @@ func (mdb *DB) SelectFromExecutions(filter *sqldb.ExecutionsFilter) (*sqldb.Exec
 	return &row, err
 }
 
+// RangeSelectFromExecutions reads a page of rows from executions table
+func (mdb *DB) RangeSelectFromExecutions(filter *sqldb.ExecutionsFilter) ([]sqldb.ExecutionsRow, error) {
+	var rows []sqldb.ExecutionsRow
+	err := mdb.conn.Select(&rows, rangeGetExecutionQry,
+		filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
+		rows[i].LastUpdatedTime = mdb.converter.FromMySQLDateTime(rows[i].LastUpdatedTime)
+	}
+	return rows, nil
+}
+
 // DeleteFromExecutions deletes a single row from executions table
 func (mdb *DB) DeleteFromExecutions(filter *sqldb.ExecutionsFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
@@ -460,6 +505,14 @@ func (mdb *DB) SelectFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter
 	return &row, err
 }
 
+// RangeSelectFromCurrentExecutions reads a page of rows from current_executions table
+func (mdb *DB) RangeSelectFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) ([]sqldb.CurrentExecutionsRow, error) {
+	var rows []sqldb.CurrentExecutionsRow
+	err := mdb.conn.Select(&rows, rangeGetCurrentExecutionQry,
+		filter.ShardID, filter.DomainID, filter.WorkflowID, *filter.PageSize)
+	return rows, err
+}
+
 // DeleteFromCurrentExecutions deletes a single row in current_executions table
 func (mdb *DB) DeleteFromCurrentExecutions(filter *sqldb.CurrentExecutionsFilter) (sql.Result, error) {
 	return mdb.conn.Exec(deleteCurrentExecutionQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
@@ -544,6 +597,66 @@ func (mdb *DB) DeleteFromTimerTasks(filter *sqldb.TimerTasksFilter) (sql.Result,
 	return mdb.conn.Exec(deleteTimerTaskQry, filter.ShardID, *filter.VisibilityTimestamp, filter.TaskID)
 }
 
+// InsertIntoTransferTasksDLQ inserts a single row into transfer_tasks_dlq table
+func (mdb *DB) InsertIntoTransferTasksDLQ(row *sqldb.TransferTasksRow) (sql.Result, error) {
+	row.VisibilityTimestamp = mdb.converter.ToMySQLDateTime(row.VisibilityTimestamp)
+	return mdb.conn.NamedExec(createTransferTasksDLQQry, row)
+}
+
+// SelectFromTransferTasksDLQ reads one or more rows from transfer_tasks_dlq table
+func (mdb *DB) SelectFromTransferTasksDLQ(filter *sqldb.TasksDLQFilter) ([]sqldb.TransferTasksRow, error) {
+	var rows []sqldb.TransferTasksRow
+	var err error
+	if filter.TaskID != nil {
+		err = mdb.conn.Select(&rows, getTransferTaskDLQQry, filter.ShardID, *filter.TaskID)
+	} else {
+		err = mdb.conn.Select(&rows, rangeGetTransferTasksDLQQry, filter.ShardID, *filter.MinTaskID, *filter.PageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].ShardID = filter.ShardID
+		rows[i].VisibilityTimestamp = mdb.converter.FromMySQLDateTime(rows[i].VisibilityTimestamp)
+	}
+	return rows, err
+}
+
+// DeleteFromTransferTasksDLQ deletes a single row from transfer_tasks_dlq table
+func (mdb *DB) DeleteFromTransferTasksDLQ(filter *sqldb.TasksDLQFilter) (sql.Result, error) {
+	return mdb.conn.Exec(deleteTransferTaskDLQQry, filter.ShardID, *filter.TaskID)
+}
+
+// InsertIntoTimerTasksDLQ inserts a single row into timer_tasks_dlq table
+func (mdb *DB) InsertIntoTimerTasksDLQ(row *sqldb.TimerTasksRow) (sql.Result, error) {
+	row.VisibilityTimestamp = mdb.converter.ToMySQLDateTime(row.VisibilityTimestamp)
+	return mdb.conn.NamedExec(createTimerTasksDLQQry, row)
+}
+
+// SelectFromTimerTasksDLQ reads one or more rows from timer_tasks_dlq table
+func (mdb *DB) SelectFromTimerTasksDLQ(filter *sqldb.TasksDLQFilter) ([]sqldb.TimerTasksRow, error) {
+	var rows []sqldb.TimerTasksRow
+	var err error
+	if filter.TaskID != nil {
+		err = mdb.conn.Select(&rows, getTimerTaskDLQQry, filter.ShardID, *filter.TaskID)
+	} else {
+		err = mdb.conn.Select(&rows, rangeGetTimerTasksDLQQry, filter.ShardID, *filter.MinTaskID, *filter.PageSize)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		rows[i].ShardID = filter.ShardID
+		rows[i].VisibilityTimestamp = mdb.converter.FromMySQLDateTime(rows[i].VisibilityTimestamp)
+	}
+	return rows, err
+}
+
+// DeleteFromTimerTasksDLQ deletes a single row from timer_tasks_dlq table
+func (mdb *DB) DeleteFromTimerTasksDLQ(filter *sqldb.TasksDLQFilter) (sql.Result, error) {
+	return mdb.conn.Exec(deleteTimerTaskDLQQry, filter.ShardID, *filter.TaskID)
+}
+
 // InsertIntoBufferedEvents inserts one or more rows into buffered_events table
 func (mdb *DB) InsertIntoBufferedEvents(rows []sqldb.BufferedEventsRow) (sql.Result, error) {
 	return mdb.conn.NamedExec(createBufferedEventsQury, rows)
@@ -177,6 +177,7 @@ type (
 		DomainID   UUID
 		WorkflowID string
 		RunID      UUID
+		PageSize   *int
 	}
 
 	// CurrentExecutionsRow represents a row in current_executions table
@@ -199,6 +200,7 @@ type (
 		DomainID   UUID
 		WorkflowID string
 		RunID      UUID
+		PageSize   *int
 	}
 
 	// BufferedEventsRow represents a row in buffered_events table
@@ -324,6 +326,15 @@ type (
 		PageSize               *int
 	}
 
+	// TasksDLQFilter contains the column names within transfer_tasks_dlq and timer_tasks_dlq
+	// tables that can be used to filter results through a WHERE clause
+	TasksDLQFilter struct {
+		ShardID   int
+		TaskID    *int64
+		MinTaskID *int64
+		PageSize  *int
+	}
+
 	// EventsRow represents a row in events table
 	EventsRow struct {
 		DomainID     UUID
@@ -386,6 +397,7 @@ type (
 	HistoryTreeFilter struct {
 		TreeID   UUID
 		BranchID *UUID
+		PageSize *int
 	}
 
 	// ActivityInfoMapsRow represents a row in activity_info_maps table
@@ -665,12 +677,20 @@ type (
 		DeleteFromHistoryNode(filter *HistoryNodeFilter) (sql.Result, error)
 		InsertIntoHistoryTree(row *HistoryTreeRow) (sql.Result, error)
 		SelectFromHistoryTree(filter *HistoryTreeFilter) ([]HistoryTreeRow, error)
+		// RangeSelectFromHistoryTree returns a page of rows from history_tree table, sorted by
+		// {treeID, branchID} and starting right after the key given in the filter
+		// Required params - {treeID, branchID, pageSize}
+		RangeSelectFromHistoryTree(filter *HistoryTreeFilter) ([]HistoryTreeRow, error)
 		UpdateHistoryTree(row *HistoryTreeRow) (sql.Result, error)
 		DeleteFromHistoryTree(filter *HistoryTreeFilter) (sql.Result, error)
 
 		InsertIntoExecutions(row *ExecutionsRow) (sql.Result, error)
 		UpdateExecutions(row *ExecutionsRow) (sql.Result, error)
 		SelectFromExecutions(filter *ExecutionsFilter) (*ExecutionsRow, error)
+		// RangeSelectFromExecutions returns a page of rows from executions table, sorted by
+		// {domainID, workflowID, runID} and starting right after the key given in the filter
+		// Required params - {shardID, domainID, workflowID, runID, pageSize}
+		RangeSelectFromExecutions(filter *ExecutionsFilter) ([]ExecutionsRow, error)
 		DeleteFromExecutions(filter *ExecutionsFilter) (sql.Result, error)
 		LockExecutions(filter *ExecutionsFilter) (int, error)
 
@@ -681,6 +701,10 @@ type (
 		// SelectFromCurrentExecutions returns one or more rows from current_executions table
 		// Required params - {shardID, domainID, workflowID}
 		SelectFromCurrentExecutions(filter *CurrentExecutionsFilter) (*CurrentExecutionsRow, error)
+		// RangeSelectFromCurrentExecutions returns a page of rows from current_executions table, sorted by
+		// {domainID, workflowID} and starting right after the key given in the filter
+		// Required params - {shardID, domainID, workflowID, pageSize}
+		RangeSelectFromCurrentExecutions(filter *CurrentExecutionsFilter) ([]CurrentExecutionsRow, error)
 		// DeleteFromCurrentExecutions deletes a single row that matches the filter criteria
 		// If a row exist, that row will be deleted and this method will return success
 		// If there is no row matching the filter criteria, this method will still return success
@@ -708,6 +732,26 @@ type (
 		//  - to delete multiple rows - {shardID, minVisibilityTimestamp, maxVisibilityTimestamp}
 		DeleteFromTimerTasks(filter *TimerTasksFilter) (sql.Result, error)
 
+		InsertIntoTransferTasksDLQ(row *TransferTasksRow) (sql.Result, error)
+		// SelectFromTransferTasksDLQ returns one or more rows from transfer_tasks_dlq table
+		// Required filter params:
+		//  - to read one row - {shardID, taskID}
+		//  - to read a page of rows - {shardID, minTaskID, pageSize}
+		SelectFromTransferTasksDLQ(filter *TasksDLQFilter) ([]TransferTasksRow, error)
+		// DeleteFromTransferTasksDLQ deletes a single row from transfer_tasks_dlq table
+		// Required filter params - {shardID, taskID}
+		DeleteFromTransferTasksDLQ(filter *TasksDLQFilter) (sql.Result, error)
+
+		InsertIntoTimerTasksDLQ(row *TimerTasksRow) (sql.Result, error)
+		// SelectFromTimerTasksDLQ returns one or more rows from timer_tasks_dlq table
+		// Required filter params:
+		//  - to read one row - {shardID, taskID}
+		//  - to read a page of rows - {shardID, minTaskID, pageSize}
+		SelectFromTimerTasksDLQ(filter *TasksDLQFilter) ([]TimerTasksRow, error)
+		// DeleteFromTimerTasksDLQ deletes a single row from timer_tasks_dlq table
+		// Required filter params - {shardID, taskID}
+		DeleteFromTimerTasksDLQ(filter *TasksDLQFilter) (sql.Result, error)
+
 		InsertIntoBufferedEvents(rows []BufferedEventsRow) (sql.Result, error)
 		SelectFromBufferedEvents(filter *BufferedEventsFilter) ([]BufferedEventsRow, error)
 		DeleteFromBufferedEvents(filter *BufferedEventsFilter) (sql.Result, error)
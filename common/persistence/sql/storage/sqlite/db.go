@@ -0,0 +1,64 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	"github.com/uber/cadence/common/persistence/sql/storage/mysql"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/service/config"
+)
+
+const (
+	// DriverName is the config driverName selecting the sqlite storage
+	DriverName = "sqlite3"
+
+	// writes lock the whole database, so concurrent writers wait on each other
+	// instead of failing; transactions take the write lock upfront to avoid deadlocks
+	dataSourceName = "file:%v?_busy_timeout=%v&_journal_mode=WAL&_txlock=immediate"
+	busyTimeoutMs  = 30000
+)
+
+// NewDB returns a logical connection to the sqlite database file named by the
+// config's DatabaseName. The cadence schema is created if the database lacks it.
+// The queries of the mysql storage are reused, the driver rewrites the few
+// clauses sqlite does not understand.
+func NewDB(cfg *config.SQL) (sqldb.Interface, error) {
+	db, err := sql.Open(wrappedDriverName, fmt.Sprintf(dataSourceName, cfg.DatabaseName, busyTimeoutMs))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxConns)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("error creating sqlite schema: %v", err)
+	}
+	xdb := sqlx.NewDb(db, DriverName)
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	xdb.MapperFunc(strcase.ToSnake)
+	return mysql.NewDB(xdb, nil), nil
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	// wrappedDriverName is the name under which the query rewriting driver is registered
+	wrappedDriverName = "cadence-sqlite3"
+	// errDupEntry is the MySQL error number the sql persistence checks for duplicate rows
+	errDupEntry = 1062
+	// timeFormat is fixed width and in UTC, so that datetime columns compare and sort as text
+	timeFormat = "2006-01-02 15:04:05.000000000"
+)
+
+type (
+	// sqliteDriver opens sqlite connections which accept the queries
+	// written for the mysql storage
+	sqliteDriver struct {
+		sqlite3.SQLiteDriver
+	}
+
+	sqliteConn struct {
+		*sqlite3.SQLiteConn
+	}
+)
+
+var (
+	// sqlite locks the whole database on write, row locks have no equivalent
+	lockClauseRegex = regexp.MustCompile(`\s+(FOR UPDATE|LOCK IN SHARE MODE)`)
+	insertIgnore    = strings.NewReplacer("INSERT IGNORE", "INSERT OR IGNORE")
+	// sqlite is built without support for ORDER BY and LIMIT on DELETE
+	limitedDeleteRegex = regexp.MustCompile(`(?s)^DELETE FROM (\w+) (WHERE .* LIMIT \?)$`)
+)
+
+func init() {
+	sql.Register(wrappedDriverName, &sqliteDriver{})
+}
+
+// Open returns a new connection to the sqlite database
+func (d *sqliteDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.SQLiteDriver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &sqliteConn{SQLiteConn: conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+// Prepare rewrites and prepares the given query
+func (c *sqliteConn) Prepare(query string) (driver.Stmt, error) {
+	stmt, err := c.SQLiteConn.Prepare(rewriteQuery(query))
+	return stmt, convertError(err)
+}
+
+// PrepareContext rewrites and prepares the given query
+func (c *sqliteConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	stmt, err := c.SQLiteConn.PrepareContext(ctx, rewriteQuery(query))
+	return stmt, convertError(err)
+}
+
+// ExecContext rewrites and executes the given query
+func (c *sqliteConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result, err := c.SQLiteConn.ExecContext(ctx, rewriteQuery(query), convertArgs(args))
+	return result, convertError(err)
+}
+
+// QueryContext rewrites and runs the given query
+func (c *sqliteConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	rows, err := c.SQLiteConn.QueryContext(ctx, rewriteQuery(query), convertArgs(args))
+	return rows, convertError(err)
+}
+
+// rewriteQuery translates the mysql specific clauses of a query
+func rewriteQuery(query string) string {
+	query = lockClauseRegex.ReplaceAllString(query, "")
+	query = limitedDeleteRegex.ReplaceAllString(query, "DELETE FROM $1 WHERE rowid IN (SELECT rowid FROM $1 $2)")
+	return insertIgnore.Replace(query)
+}
+
+func convertArgs(args []driver.NamedValue) []driver.NamedValue {
+	result := make([]driver.NamedValue, len(args))
+	for i, arg := range args {
+		if t, ok := arg.Value.(time.Time); ok {
+			arg.Value = t.UTC().Format(timeFormat)
+		}
+		result[i] = arg
+	}
+	return result
+}
+
+// convertError reports unique constraint violations as the mysql
+// duplicate entry error the sql persistence managers check for
+func convertError(err error) error {
+	sqliteErr, ok := err.(sqlite3.Error)
+	if !ok || sqliteErr.Code != sqlite3.ErrConstraint {
+		return err
+	}
+	switch sqliteErr.ExtendedCode {
+	case sqlite3.ErrConstraintPrimaryKey, sqlite3.ErrConstraintUnique:
+		return &mysql.MySQLError{Number: errDupEntry, Message: sqliteErr.Error()}
+	}
+	return err
+}
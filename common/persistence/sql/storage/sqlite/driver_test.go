@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	driverSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestDriverSuite(t *testing.T) {
+	suite.Run(t, new(driverSuite))
+}
+
+func (s *driverSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *driverSuite) TestRewriteQuery() {
+	s.Equal("SELECT range_id FROM shards WHERE shard_id = ?",
+		rewriteQuery("SELECT range_id FROM shards WHERE shard_id = ? FOR UPDATE"))
+	s.Equal("SELECT range_id FROM shards WHERE shard_id = ?",
+		rewriteQuery("SELECT range_id FROM shards WHERE shard_id = ? LOCK IN SHARE MODE"))
+	s.Equal("INSERT OR IGNORE INTO signals_requested_sets (shard_id) VALUES (?)",
+		rewriteQuery("INSERT IGNORE INTO signals_requested_sets (shard_id) VALUES (?)"))
+	s.Equal("DELETE FROM tasks WHERE rowid IN (SELECT rowid FROM tasks WHERE task_id <= ? ORDER BY task_id LIMIT ?)",
+		rewriteQuery("DELETE FROM tasks WHERE task_id <= ? ORDER BY task_id LIMIT ?"))
+
+	unchanged := "REPLACE INTO task_lists (shard_id) VALUES (?)"
+	s.Equal(unchanged, rewriteQuery(unchanged))
+}
+
+func (s *driverSuite) TestConvertArgs() {
+	t := time.Date(2019, 3, 4, 5, 6, 7, 8000, time.FixedZone("test", 3600))
+	args := []driver.NamedValue{{Ordinal: 1, Value: t}, {Ordinal: 2, Value: int64(1)}}
+
+	converted := convertArgs(args)
+	s.Equal("2019-03-04 04:06:07.000008000", converted[0].Value)
+	s.Equal(int64(1), converted[1].Value)
+	s.Equal(t, args[0].Value)
+}
+
+func (s *driverSuite) TestConvertError() {
+	dupErr := sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintPrimaryKey}
+	mysqlErr, ok := convertError(dupErr).(*mysql.MySQLError)
+	s.True(ok)
+	s.Equal(uint16(errDupEntry), mysqlErr.Number)
+
+	notNullErr := sqlite3.Error{Code: sqlite3.ErrConstraint, ExtendedCode: sqlite3.ErrConstraintNotNull}
+	s.Equal(notNullErr, convertError(notNullErr))
+
+	otherErr := errors.New("other")
+	s.Equal(otherErr, convertError(otherErr))
+	s.Nil(convertError(nil))
+}
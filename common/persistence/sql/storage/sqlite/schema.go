@@ -0,0 +1,438 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+// schema is the sqlite version of the mysql cadence and visibility schemas.
+// It's applied every time a database is opened, so all the statements are idempotent.
+const schema = `
+CREATE TABLE IF NOT EXISTS domains(
+  id BLOB PRIMARY KEY NOT NULL,
+  name TEXT UNIQUE NOT NULL,
+  status INTEGER NOT NULL,
+  description TEXT NOT NULL,
+  owner_email TEXT NOT NULL,
+  data BLOB,
+  retention INTEGER NOT NULL,
+  emit_metric INTEGER NOT NULL,
+  archival_bucket TEXT NOT NULL,
+  archival_status INTEGER NOT NULL,
+  config_version INTEGER NOT NULL,
+  notification_version INTEGER NOT NULL,
+  failover_notification_version INTEGER NOT NULL,
+  failover_version INTEGER NOT NULL,
+  is_global_domain INTEGER NOT NULL,
+  active_cluster_name TEXT NOT NULL,
+  clusters BLOB
+);
+
+CREATE TABLE IF NOT EXISTS domain_metadata (
+  notification_version INTEGER NOT NULL
+);
+
+INSERT INTO domain_metadata (notification_version)
+  SELECT 1 WHERE NOT EXISTS (SELECT 1 FROM domain_metadata);
+
+CREATE TABLE IF NOT EXISTS shards (
+  shard_id INTEGER NOT NULL,
+  owner TEXT NOT NULL,
+  range_id INTEGER NOT NULL,
+  stolen_since_renew INTEGER NOT NULL,
+  updated_at DATETIME NOT NULL,
+  replication_ack_level INTEGER NOT NULL,
+  transfer_ack_level INTEGER NOT NULL,
+  timer_ack_level DATETIME NOT NULL,
+  cluster_transfer_ack_level BLOB NOT NULL,
+  cluster_timer_ack_level BLOB NOT NULL,
+  domain_notification_version INTEGER NOT NULL,
+  PRIMARY KEY (shard_id)
+);
+
+CREATE TABLE IF NOT EXISTS transfer_tasks(
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  task_id INTEGER NOT NULL,
+  task_type INTEGER NOT NULL,
+  target_domain_id BLOB NOT NULL,
+  target_workflow_id TEXT NOT NULL,
+  target_run_id BLOB,
+  target_child_workflow_only INTEGER NOT NULL,
+  task_list TEXT NOT NULL,
+  schedule_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  visibility_timestamp DATETIME NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS executions(
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  parent_domain_id BLOB,
+  parent_workflow_id TEXT,
+  parent_run_id BLOB,
+  initiated_id INTEGER,
+  completion_event_batch_id INTEGER,
+  completion_event BLOB,
+  completion_event_encoding TEXT,
+  task_list TEXT NOT NULL,
+  workflow_type_name TEXT NOT NULL,
+  workflow_timeout_seconds INTEGER NOT NULL,
+  decision_task_timeout_minutes INTEGER NOT NULL,
+  execution_context BLOB,
+  state INTEGER NOT NULL,
+  close_status INTEGER NOT NULL,
+  start_version INTEGER NOT NULL,
+  current_version INTEGER NOT NULL,
+  last_write_version INTEGER NOT NULL,
+  last_write_event_id INTEGER,
+  last_replication_info BLOB,
+  last_event_task_id INTEGER NOT NULL,
+  last_first_event_id INTEGER NOT NULL,
+  next_event_id INTEGER NOT NULL,
+  last_processed_event INTEGER NOT NULL,
+  start_time DATETIME NOT NULL,
+  last_updated_time DATETIME NOT NULL,
+  create_request_id TEXT NOT NULL,
+  decision_version INTEGER NOT NULL,
+  decision_schedule_id INTEGER NOT NULL,
+  decision_started_id INTEGER NOT NULL,
+  decision_request_id TEXT,
+  decision_timeout INTEGER NOT NULL,
+  decision_attempt INTEGER NOT NULL,
+  decision_timestamp INTEGER NOT NULL,
+  cancel_requested INTEGER,
+  cancel_request_id TEXT,
+  sticky_task_list TEXT NOT NULL,
+  sticky_schedule_to_start_timeout INTEGER NOT NULL,
+  client_library_version TEXT NOT NULL,
+  client_feature_version TEXT NOT NULL,
+  client_impl TEXT NOT NULL,
+  signal_count INTEGER NOT NULL,
+  history_size INTEGER NOT NULL,
+  cron_schedule TEXT,
+  has_retry_policy INTEGER NOT NULL,
+  attempt INTEGER NOT NULL,
+  initial_interval INTEGER NOT NULL,
+  backoff_coefficient REAL NOT NULL,
+  maximum_interval INTEGER NOT NULL,
+  maximum_attempts INTEGER NOT NULL,
+  expiration_seconds INTEGER NOT NULL,
+  expiration_time DATETIME NOT NULL,
+  non_retryable_errors BLOB,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id)
+);
+
+CREATE TABLE IF NOT EXISTS current_executions(
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  create_request_id TEXT NOT NULL,
+  state INTEGER NOT NULL,
+  close_status INTEGER NOT NULL,
+  start_version INTEGER NOT NULL,
+  last_write_version INTEGER NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id)
+);
+
+CREATE TABLE IF NOT EXISTS buffered_events (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS buffered_events_by_events_ids ON buffered_events(shard_id, domain_id, workflow_id, run_id);
+
+CREATE TABLE IF NOT EXISTS tasks (
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  schedule_id INTEGER NOT NULL,
+  task_list_name TEXT NOT NULL,
+  task_type INTEGER NOT NULL,
+  task_id INTEGER NOT NULL,
+  expiry_ts DATETIME NOT NULL,
+  PRIMARY KEY (domain_id, task_list_name, task_type, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS task_lists (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  range_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  task_type INTEGER NOT NULL,
+  ack_level INTEGER NOT NULL DEFAULT 0,
+  kind INTEGER NOT NULL,
+  expiry_ts DATETIME NOT NULL,
+  last_updated DATETIME NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, name, task_type)
+);
+
+CREATE TABLE IF NOT EXISTS replication_tasks (
+  shard_id INTEGER NOT NULL,
+  task_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  task_type INTEGER NOT NULL,
+  first_event_id INTEGER NOT NULL,
+  next_event_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  last_replication_info BLOB NOT NULL,
+  scheduled_id INTEGER NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS timer_tasks (
+  shard_id INTEGER NOT NULL,
+  visibility_timestamp DATETIME NOT NULL,
+  task_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  task_type INTEGER NOT NULL,
+  timeout_type INTEGER NOT NULL,
+  event_id INTEGER NOT NULL,
+  schedule_attempt INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  PRIMARY KEY (shard_id, visibility_timestamp, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS transfer_tasks_dlq(
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  task_id INTEGER NOT NULL,
+  task_type INTEGER NOT NULL,
+  target_domain_id BLOB NOT NULL,
+  target_workflow_id TEXT NOT NULL,
+  target_run_id BLOB,
+  target_child_workflow_only INTEGER NOT NULL,
+  task_list TEXT NOT NULL,
+  schedule_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  visibility_timestamp DATETIME NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS timer_tasks_dlq (
+  shard_id INTEGER NOT NULL,
+  visibility_timestamp DATETIME NOT NULL,
+  task_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  task_type INTEGER NOT NULL,
+  timeout_type INTEGER NOT NULL,
+  event_id INTEGER NOT NULL,
+  schedule_attempt INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE IF NOT EXISTS events (
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  first_event_id INTEGER NOT NULL,
+  batch_version INTEGER,
+  range_id INTEGER NOT NULL,
+  tx_id INTEGER NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding TEXT NOT NULL,
+  PRIMARY KEY (domain_id, workflow_id, run_id, first_event_id)
+);
+
+CREATE TABLE IF NOT EXISTS activity_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  schedule_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  scheduled_event_batch_id INTEGER NOT NULL,
+  scheduled_event BLOB,
+  scheduled_event_encoding TEXT,
+  scheduled_time DATETIME NOT NULL,
+  started_id INTEGER NOT NULL,
+  started_event BLOB,
+  started_event_encoding TEXT,
+  started_time DATETIME NOT NULL,
+  activity_id TEXT NOT NULL,
+  request_id TEXT NOT NULL,
+  details BLOB,
+  schedule_to_start_timeout INTEGER NOT NULL,
+  schedule_to_close_timeout INTEGER NOT NULL,
+  start_to_close_timeout INTEGER NOT NULL,
+  heartbeat_timeout INTEGER NOT NULL,
+  cancel_requested INTEGER,
+  cancel_request_id INTEGER NOT NULL,
+  last_heartbeat_updated_time DATETIME NOT NULL,
+  timer_task_status INTEGER NOT NULL,
+  attempt INTEGER NOT NULL,
+  task_list TEXT NOT NULL,
+  started_identity TEXT NOT NULL,
+  has_retry_policy INTEGER NOT NULL,
+  init_interval INTEGER NOT NULL,
+  backoff_coefficient REAL NOT NULL,
+  max_interval INTEGER NOT NULL,
+  expiration_time DATETIME NOT NULL,
+  max_attempts INTEGER NOT NULL,
+  non_retriable_errors BLOB,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, schedule_id)
+);
+
+CREATE TABLE IF NOT EXISTS timer_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  timer_id TEXT NOT NULL,
+  version INTEGER NOT NULL,
+  started_id INTEGER NOT NULL,
+  expiry_time DATETIME NOT NULL,
+  task_id INTEGER NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, timer_id)
+);
+
+CREATE TABLE IF NOT EXISTS child_execution_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  initiated_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  initiated_event_batch_id INTEGER NOT NULL,
+  initiated_event BLOB,
+  initiated_event_encoding TEXT,
+  started_id INTEGER NOT NULL,
+  started_workflow_id TEXT NOT NULL,
+  started_run_id BLOB,
+  started_event BLOB,
+  started_event_encoding TEXT,
+  create_request_id TEXT,
+  domain_name TEXT NOT NULL,
+  workflow_type_name TEXT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS request_cancel_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  initiated_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  cancel_request_id TEXT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS signal_info_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  initiated_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  signal_request_id TEXT NOT NULL,
+  signal_name TEXT NOT NULL,
+  input BLOB,
+  control BLOB,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, initiated_id)
+);
+
+CREATE TABLE IF NOT EXISTS buffered_replication_task_maps (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  first_event_id INTEGER NOT NULL,
+  version INTEGER NOT NULL,
+  next_event_id INTEGER NOT NULL,
+  history BLOB,
+  history_encoding TEXT NOT NULL,
+  new_run_history BLOB,
+  new_run_history_encoding TEXT NOT NULL DEFAULT 'json',
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, first_event_id)
+);
+
+CREATE TABLE IF NOT EXISTS signals_requested_sets (
+  shard_id INTEGER NOT NULL,
+  domain_id BLOB NOT NULL,
+  workflow_id TEXT NOT NULL,
+  run_id BLOB NOT NULL,
+  signal_id TEXT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, signal_id)
+);
+
+CREATE TABLE IF NOT EXISTS history_node (
+  tree_id BLOB NOT NULL,
+  branch_id BLOB NOT NULL,
+  node_id INTEGER NOT NULL,
+  txn_id INTEGER NOT NULL,
+  data BLOB NOT NULL,
+  data_encoding TEXT NOT NULL,
+  PRIMARY KEY (tree_id, branch_id, node_id, txn_id)
+);
+
+CREATE TABLE IF NOT EXISTS history_tree (
+  tree_id BLOB NOT NULL,
+  branch_id BLOB NOT NULL,
+  ancestors BLOB NOT NULL,
+  in_progress INTEGER NOT NULL,
+  created_ts DATETIME NOT NULL,
+  info TEXT NOT NULL,
+  PRIMARY KEY (tree_id, branch_id)
+);
+
+INSERT OR IGNORE INTO domains(
+  id, name, status, description, owner_email, retention, emit_metric, archival_bucket, archival_status,
+  config_version, notification_version, failover_notification_version, failover_version, is_global_domain, active_cluster_name
+) VALUES (
+  X'32049b68787240948e63d0dd59896a83',
+  'cadence-system', 0, 'cadence system workflow domain', 'cadence-dev-group@uber.com', 3, 0, '', 0, 0, 0, 0, 0, 0, ''
+);
+
+CREATE TABLE IF NOT EXISTS executions_visibility (
+  domain_id TEXT NOT NULL,
+  run_id TEXT NOT NULL,
+  start_time DATETIME NOT NULL,
+  execution_time DATETIME NOT NULL,
+  workflow_id TEXT NOT NULL,
+  workflow_type_name TEXT NOT NULL,
+  close_status INTEGER,
+  close_time DATETIME NULL,
+  history_length INTEGER,
+  PRIMARY KEY (domain_id, run_id)
+);
+
+CREATE INDEX IF NOT EXISTS by_type_start_time ON executions_visibility (domain_id, workflow_type_name, close_status, start_time DESC, run_id);
+CREATE INDEX IF NOT EXISTS by_workflow_id_start_time ON executions_visibility (domain_id, workflow_id, close_status, start_time DESC, run_id);
+CREATE INDEX IF NOT EXISTS by_status_by_close_time ON executions_visibility (domain_id, close_status, start_time DESC, run_id);
+`
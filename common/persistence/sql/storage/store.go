@@ -27,6 +27,7 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/uber/cadence/common/persistence/sql/storage/mysql"
 	"github.com/uber/cadence/common/persistence/sql/storage/sqldb"
+	"github.com/uber/cadence/common/persistence/sql/storage/sqlite"
 	"github.com/uber/cadence/common/service/config"
 )
 
@@ -39,6 +40,9 @@ const (
 // SQL database and the object can be used to perform CRUD operations on
 // the tables in the database
 func NewSQLDB(cfg *config.SQL) (sqldb.Interface, error) {
+	if cfg.DriverName == sqlite.DriverName {
+		return sqlite.NewDB(cfg)
+	}
 	db, err := sqlx.Connect(cfg.DriverName,
 		fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, cfg.ConnectProtocol, cfg.ConnectAddr, cfg.DatabaseName))
 	if err != nil {
@@ -21,6 +21,8 @@
 package common
 
 import (
+	"net"
+
 	"go.uber.org/yarpc"
 	"golang.org/x/net/context"
 )
@@ -50,6 +52,12 @@ type (
 	RPCFactory interface {
 		CreateDispatcher() *yarpc.Dispatcher
 		CreateDispatcherForOutbound(callerName, serviceName, hostName string) *yarpc.Dispatcher
+		// CreateHTTPListener returns the listener of the HTTP gateway,
+		// nil when the service is not configured to serve HTTP
+		CreateHTTPListener() net.Listener
+		// CreateHealthListener returns the listener the health of the
+		// service is served on, nil when no health port is configured
+		CreateHealthListener() net.Listener
 	}
 )
 
@@ -25,11 +25,14 @@ import (
 	"time"
 
 	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/s3store"
 
 	"github.com/uber-go/tally/m3"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics/tally/prometheus"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
 	"github.com/uber/ringpop-go/discovery"
 )
 
@@ -38,6 +41,8 @@ type (
 	Config struct {
 		// Ringpop is the ringpop related configuration
 		Ringpop Ringpop `yaml:"ringpop"`
+		// Membership is the configuration of the membership when not gossiped by ringpop
+		Membership Membership `yaml:"membership"`
 		// Persistence contains the configuration for cadence datastores
 		Persistence Persistence `yaml:"persistence"`
 		// Log is the logging config
@@ -64,6 +69,15 @@ type (
 		Metrics Metrics `yaml:"metrics"`
 		// PProf is the PProf configuration
 		PProf PProf `yaml:"pprof"`
+		// Tracing is the distributed tracing configuration
+		Tracing Tracing `yaml:"tracing"`
+	}
+
+	// Tracing contains the config items for distributed tracing,
+	// spans are created but never reported when no exporter is set
+	Tracing struct {
+		// Jaeger is the configuration for the jaeger exporter
+		Jaeger *jaegercfg.Configuration `yaml:"jaeger"`
 	}
 
 	// PProf contains the rpc config items
@@ -76,6 +90,15 @@ type (
 	RPC struct {
 		// Port is the port  on which the channel will bind to
 		Port int `yaml:"port"`
+		// GRPCPort is the port on which the gRPC inbound will bind to,
+		// no gRPC inbound is created when it is not set
+		GRPCPort int `yaml:"grpcPort"`
+		// HTTPPort is the port on which the HTTP/JSON gateway will bind to,
+		// the gateway is only served by the frontend when it is set
+		HTTPPort int `yaml:"httpPort"`
+		// HealthPort is the port on which the plain HTTP health endpoint
+		// will bind to, no endpoint is served when it is not set
+		HealthPort int `yaml:"healthPort"`
 		// BindOnLocalHost is true if localhost is the bind address
 		BindOnLocalHost bool `yaml:"bindOnLocalHost"`
 		// BindOnIP can be used to bind service on specific ip (eg. `0.0.0.0`) -
@@ -86,6 +109,35 @@ type (
 		DisableLogging bool `yaml:"disableLogging"`
 		// LogLevel is the desired log level
 		LogLevel string `yaml:"logLevel"`
+		// TLS is the transport security config for inbound and
+		// outbound rpc, traffic is plaintext when this is not set
+		TLS *TLS `yaml:"tls"`
+	}
+
+	// TLS contains the certificates used to secure rpc traffic,
+	// the files are reloaded when they change on disk
+	TLS struct {
+		// CertFile is the path to the PEM encoded certificate presented by the server
+		CertFile string `yaml:"certFile" validate:"nonzero"`
+		// KeyFile is the path to the PEM encoded private key of CertFile
+		KeyFile string `yaml:"keyFile" validate:"nonzero"`
+		// CaFile is the path to the PEM encoded CA bundle used to verify
+		// peer certificates, the system roots are used when this is empty
+		CaFile string `yaml:"caFile"`
+		// RequireClientAuth turns on mutual TLS, inbound connections
+		// must present a certificate signed by CaFile
+		RequireClientAuth bool `yaml:"requireClientAuth"`
+		// ClientCertFile is the path to the PEM encoded certificate presented
+		// on outbound connections, defaults to CertFile
+		ClientCertFile string `yaml:"clientCertFile"`
+		// ClientKeyFile is the path to the PEM encoded private key of
+		// ClientCertFile, defaults to KeyFile
+		ClientKeyFile string `yaml:"clientKeyFile"`
+		// ServerName is the name used to verify server certificates
+		// on outbound connections, defaults to the dialed host
+		ServerName string `yaml:"serverName"`
+		// RefreshInterval is how often the files are checked for changes
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
 	}
 
 	// Ringpop contains the ringpop config items
@@ -94,7 +146,8 @@ type (
 		Name string `yaml:"name" validate:"nonzero"`
 		// BootstrapMode is a enum that defines the ringpop bootstrap method
 		BootstrapMode BootstrapMode `yaml:"bootstrapMode"`
-		// BootstrapHosts is a list of seed hosts to be used for ringpop bootstrap
+		// BootstrapHosts is a list of seed hosts to be used for ringpop bootstrap,
+		// or the DNS names resolving to them in dns mode, see membership.NewDNSProvider
 		BootstrapHosts []string `yaml:"bootstrapHosts"`
 		// BootstrapFile is the file path to be used for ringpop bootstrap
 		BootstrapFile string `yaml:"bootstrapFile"`
@@ -104,6 +157,18 @@ type (
 		DiscoveryProvider discovery.DiscoverProvider `yaml:"-"`
 	}
 
+	// Membership contains the config items for the membership of the
+	// hosts of the services when it is not gossiped by ringpop
+	Membership struct {
+		// Mode is the membership mode, ringpop unless set to static or dns
+		Mode MembershipMode `yaml:"mode"`
+		// Hosts are, for each service, the addresses of its hosts in static
+		// mode, or the DNS names resolving to them in dns mode
+		Hosts map[string][]string `yaml:"hosts"`
+		// RefreshInterval is the interval the hosts are listed at
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+
 	// Persistence contains the configuration for data store / persistence layer
 	Persistence struct {
 		// DefaultStore is the name of the default data store to use
@@ -120,6 +185,17 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// VisibilityConfig is config for visibility sampling
 		VisibilityConfig *VisibilityConfig
+		// Encryption is the config for encrypting history events and payloads at rest,
+		// nothing is encrypted if unset
+		Encryption *Encryption `yaml:"encryption"`
+	}
+
+	// Encryption is the config for encrypting history events and payloads at rest
+	Encryption struct {
+		// KeyFile is the path of the yaml file holding the master keys
+		KeyFile string `yaml:"keyFile" validate:"nonzero"`
+		// DataKeyTTL is how long a per-domain data key is used before a new one is generated
+		DataKeyTTL time.Duration `yaml:"dataKeyTTL"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -242,6 +318,8 @@ type (
 		M3 *m3.Configuration `yaml:"m3"`
 		// Statsd is the configuration for statsd reporter
 		Statsd *Statsd `yaml:"statsd"`
+		// Prometheus is the configuration for prometheus reporter
+		Prometheus *prometheus.Configuration `yaml:"prometheus"`
 		// Tags is the set of key-value pairs to be reported
 		// as part of every metric
 		Tags map[string]string `yaml:"tags"`
@@ -272,10 +350,15 @@ type (
 		DefaultBucket string `yaml:"defaultBucket"`
 		// Filestore the configuration for file based blobstore
 		Filestore filestore.Config `yaml:"filestore"`
+		// S3store the configuration for S3 based blobstore, when set it is used instead of Filestore
+		S3store *s3store.Config `yaml:"s3store"`
 	}
 
 	// BootstrapMode is an enum type for ringpop bootstrap mode
 	BootstrapMode int
+
+	// MembershipMode is an enum type for the membership mode
+	MembershipMode int
 )
 
 // Validate validates this config
@@ -0,0 +1,150 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/membership"
+	"github.com/uber/ringpop-go/discovery"
+	"github.com/uber/ringpop-go/discovery/statichosts"
+	"go.uber.org/yarpc"
+)
+
+const (
+	// MembershipModeRingpop represents the membership gossiped by ringpop
+	MembershipModeRingpop MembershipMode = iota
+	// MembershipModeStatic represents a fixed list of hosts for each service
+	MembershipModeStatic
+	// MembershipModeDNS represents DNS names resolving to the hosts of each service
+	MembershipModeDNS
+)
+
+// MembershipFactory implements the MembershipMonitorFactory
+// interface for the membership modes other than ringpop
+type MembershipFactory struct {
+	config      *Membership
+	logger      bark.Logger
+	serviceName string
+}
+
+// NewFactory builds a membership factory conforming
+// to the underlying configuration
+func (m *Membership) NewFactory(logger bark.Logger, serviceName string) (*MembershipFactory, error) {
+	if err := m.validate(); err != nil {
+		return nil, err
+	}
+	return &MembershipFactory{config: m, logger: logger, serviceName: serviceName}, nil
+}
+
+func (m *Membership) validate() error {
+	switch m.Mode {
+	case MembershipModeStatic, MembershipModeDNS:
+		if len(m.Hosts) == 0 {
+			return fmt.Errorf("membership config missing hosts param")
+		}
+		for service := range m.Hosts {
+			if !isCadenceService(service) {
+				return fmt.Errorf("membership config with unknown service %v", service)
+			}
+		}
+	default:
+		return fmt.Errorf("membership config with unknown mode")
+	}
+	return nil
+}
+
+// UnmarshalYAML is called by the yaml package to convert
+// the config YAML into a MembershipMode.
+func (m *MembershipMode) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	var err error
+	*m, err = parseMembershipMode(s)
+	return err
+}
+
+// parseMembershipMode reads a string value and returns a membership mode.
+func parseMembershipMode(s string) (MembershipMode, error) {
+	switch strings.ToLower(s) {
+	case "", "ringpop":
+		return MembershipModeRingpop, nil
+	case "static":
+		return MembershipModeStatic, nil
+	case "dns":
+		return MembershipModeDNS, nil
+	}
+	return MembershipModeRingpop, errors.New("invalid membership mode")
+}
+
+// Create is the implementation for MembershipMonitorFactory.Create
+func (factory *MembershipFactory) Create(dispatcher *yarpc.Dispatcher) (membership.Monitor, error) {
+	// the address of the host is the one the channel listens on, as with ringpop
+	ch, err := getChannel(dispatcher)
+	if err != nil {
+		return nil, err
+	}
+	address := ch.PeerInfo().HostPort
+
+	providers := make(map[string]discovery.DiscoverProvider, len(CadenceServices))
+	for _, service := range CadenceServices {
+		hosts := factory.config.Hosts[service]
+		switch factory.config.Mode {
+		case MembershipModeStatic:
+			providers[service] = statichosts.New(hosts...)
+		case MembershipModeDNS:
+			providers[service] = membership.NewDNSProvider(hosts)
+		}
+	}
+	if factory.config.Mode == MembershipModeStatic && !containsHost(factory.config.Hosts[factory.serviceName], address) {
+		factory.logger.Warnf("Host %v is not listed in the static membership of %v", address, factory.serviceName)
+	}
+
+	membershipMonitor := membership.NewDiscoveryMonitor(
+		factory.serviceName, address, providers, factory.config.RefreshInterval, factory.logger)
+	if err = membershipMonitor.Start(); err != nil {
+		return nil, err
+	}
+	return membershipMonitor, nil
+}
+
+func isCadenceService(service string) bool {
+	for _, s := range CadenceServices {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHost(hosts []string, host string) bool {
+	for _, h := range hosts {
+		if h == host {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/tchannel"
+	"gopkg.in/yaml.v2"
+)
+
+type MembershipSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestMembershipSuite(t *testing.T) {
+	suite.Run(t, new(MembershipSuite))
+}
+
+func (s *MembershipSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *MembershipSuite) TestDefaultMode() {
+	var cfg Config
+	s.Nil(yaml.Unmarshal([]byte(`ringpop: {name: "test"}`), &cfg))
+	s.Equal(MembershipModeRingpop, cfg.Membership.Mode)
+	_, err := cfg.Membership.NewFactory(bark.NewNopLogger(), common.HistoryServiceName)
+	s.NotNil(err)
+}
+
+func (s *MembershipSuite) TestDNSMode() {
+	var cfg Membership
+	err := yaml.Unmarshal([]byte(`mode: "dns"
+hosts:
+  cadence-history: ["_tchannel._tcp.cadence-history"]
+  cadence-matching: ["cadence-matching:7935"]
+refreshInterval: 5s`), &cfg)
+	s.Nil(err)
+	s.Equal(MembershipModeDNS, cfg.Mode)
+	s.Equal([]string{"cadence-matching:7935"}, cfg.Hosts[common.MatchingServiceName])
+	s.Equal(5*time.Second, cfg.RefreshInterval)
+	f, err := cfg.NewFactory(bark.NewNopLogger(), common.HistoryServiceName)
+	s.Nil(err)
+	s.NotNil(f)
+}
+
+func (s *MembershipSuite) TestInvalidConfig() {
+	var cfg Membership
+	s.NotNil(yaml.Unmarshal([]byte(`mode: "gossip"`), &cfg))
+	cfg.Mode = MembershipModeStatic
+	s.NotNil(cfg.validate())
+	cfg.Hosts = map[string][]string{"history": {"127.0.0.1:7934"}}
+	s.NotNil(cfg.validate())
+	cfg.Hosts = map[string][]string{common.HistoryServiceName: {"127.0.0.1:7934"}}
+	s.Nil(cfg.validate())
+}
+
+func (s *MembershipSuite) TestStaticMode() {
+	ch, err := tchannel.NewChannelTransport(
+		tchannel.ServiceName(common.HistoryServiceName),
+		tchannel.ListenAddr("127.0.0.1:0"))
+	s.Nil(err)
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name:     common.HistoryServiceName,
+		Inbounds: yarpc.Inbounds{ch.NewInbound()},
+	})
+	s.Nil(dispatcher.Start())
+	defer dispatcher.Stop()
+	address := ch.ListenAddr()
+
+	cfg := Membership{
+		Mode: MembershipModeStatic,
+		Hosts: map[string][]string{
+			common.HistoryServiceName:  {address, "127.0.0.2:7934"},
+			common.MatchingServiceName: {"127.0.0.1:7935"},
+		},
+	}
+	f, err := cfg.NewFactory(bark.NewNopLogger(), common.HistoryServiceName)
+	s.Nil(err)
+	monitor, err := f.Create(dispatcher)
+	s.Nil(err)
+	defer monitor.Stop()
+
+	self, err := monitor.WhoAmI()
+	s.Nil(err)
+	s.Equal(address, self.GetAddress())
+	host, err := monitor.Lookup(common.MatchingServiceName, "tasklist")
+	s.Nil(err)
+	s.Equal("127.0.0.1:7935", host.GetAddress())
+	resolver, err := monitor.GetResolver(common.FrontendServiceName)
+	s.Nil(err)
+	s.Equal(0, resolver.MemberCount())
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cactus/go-statsd-client/statsd"
 	"github.com/uber-go/tally"
 	tallystatsdreporter "github.com/uber-go/tally/statsd"
+	"github.com/uber/cadence/common/metrics/tally/prometheus"
 	statsdreporter "github.com/uber/cadence/common/metrics/tally/statsd"
 	"log"
 	"time"
@@ -36,7 +37,8 @@ import (
 // valid for multiple reporter types,
 // only one of them will be used for
 // reporting. Currently, m3 is preferred
-// over statsd
+// over statsd, which is preferred over
+// prometheus
 func (c *Metrics) NewScope() tally.Scope {
 	if c.M3 != nil {
 		return c.newM3Scope()
@@ -44,6 +46,9 @@ func (c *Metrics) NewScope() tally.Scope {
 	if c.Statsd != nil {
 		return c.newStatsdScope()
 	}
+	if c.Prometheus != nil {
+		return c.newPrometheusScope()
+	}
 	return tally.NoopScope
 }
 
@@ -83,3 +88,20 @@ func (c *Metrics) newStatsdScope() tally.Scope {
 	scope, _ := tally.NewRootScope(scopeOpts, time.Second)
 	return scope
 }
+
+// newPrometheusScope returns a new prometheus scope
+// served on the configured listen address
+func (c *Metrics) newPrometheusScope() tally.Scope {
+	reporter, err := prometheus.NewReporter(*c.Prometheus, func(err error) {
+		log.Printf("prometheus reporter error, err=%v", err)
+	})
+	if err != nil {
+		log.Fatalf("error creating prometheus reporter, err=%v", err)
+	}
+	scopeOpts := tally.ScopeOptions{
+		Tags:           c.Tags,
+		CachedReporter: reporter,
+	}
+	scope, _ := tally.NewRootScope(scopeOpts, time.Second)
+	return scope
+}
@@ -49,6 +49,9 @@ const (
 	BootstrapModeHosts
 	// BootstrapModeCustom represents a custom bootstrap mode
 	BootstrapModeCustom
+	// BootstrapModeDNS represents a list of DNS names passed in the configuration,
+	// resolved to the seed hosts every time ringpop tries to join
+	BootstrapModeDNS
 )
 
 const (
@@ -104,6 +107,8 @@ func parseBootstrapMode(s string) (BootstrapMode, error) {
 		return BootstrapModeFile, nil
 	case "custom":
 		return BootstrapModeCustom, nil
+	case "dns":
+		return BootstrapModeDNS, nil
 	}
 	return BootstrapModeNone, errors.New("invalid or no ringpop bootstrap mode")
 }
@@ -114,7 +119,7 @@ func validateBootstrapMode(rpConfig *Ringpop) error {
 		if len(rpConfig.BootstrapFile) == 0 {
 			return fmt.Errorf("ringpop config missing bootstrap file param")
 		}
-	case BootstrapModeHosts:
+	case BootstrapModeHosts, BootstrapModeDNS:
 		if len(rpConfig.BootstrapHosts) == 0 {
 			return fmt.Errorf("ringpop config missing boostrap hosts param")
 		}
@@ -165,7 +170,7 @@ func (factory *RingpopFactory) Create(dispatcher *yarpc.Dispatcher) (membership.
 func (factory *RingpopFactory) createRingpop(dispatcher *yarpc.Dispatcher) (*ringpop.Ringpop, error) {
 	var ch *tcg.Channel
 	var err error
-	if ch, err = factory.getChannel(dispatcher); err != nil {
+	if ch, err = getChannel(dispatcher); err != nil {
 		return nil, err
 	}
 
@@ -191,7 +196,7 @@ func (factory *RingpopFactory) createRingpop(dispatcher *yarpc.Dispatcher) (*rin
 	return rp, nil
 }
 
-func (factory *RingpopFactory) getChannel(dispatcher *yarpc.Dispatcher) (*tcg.Channel, error) {
+func getChannel(dispatcher *yarpc.Dispatcher) (*tcg.Channel, error) {
 	t := dispatcher.Inbounds()[0].Transports()[0].(*tchannel.ChannelTransport)
 	ty := reflect.ValueOf(t.Channel())
 	var ch *tcg.Channel
@@ -214,6 +219,8 @@ func newDiscoveryProvider(cfg *Ringpop) (discovery.DiscoverProvider, error) {
 		return statichosts.New(cfg.BootstrapHosts...), nil
 	case BootstrapModeFile:
 		return jsonfile.New(cfg.BootstrapFile), nil
+	case BootstrapModeDNS:
+		return membership.NewDNSProvider(cfg.BootstrapHosts), nil
 	}
 	return nil, fmt.Errorf("unknown bootstrap mode")
 }
@@ -76,6 +76,20 @@ func (s *RingpopSuite) TestFileMode() {
 	s.NotNil(f)
 }
 
+func (s *RingpopSuite) TestDNSMode() {
+	var cfg Ringpop
+	err := yaml.Unmarshal([]byte(getDNSConfig()), &cfg)
+	s.Nil(err)
+	s.Equal(BootstrapModeDNS, cfg.BootstrapMode)
+	s.Equal([]string{"cadence-0.cadence:7933", "_tchannel._tcp.cadence"}, cfg.BootstrapHosts)
+	s.Nil(cfg.validate())
+	provider, err := newDiscoveryProvider(&cfg)
+	s.Nil(err)
+	s.NotNil(provider)
+	cfg.BootstrapHosts = nil
+	s.NotNil(cfg.validate())
+}
+
 func (s *RingpopSuite) TestCustomMode() {
 	var cfg Ringpop
 	err := yaml.Unmarshal([]byte(getCustomConfig()), &cfg)
@@ -115,6 +129,13 @@ bootstrapHosts: ["127.0.0.1:1111"]
 maxJoinDuration: 30s`
 }
 
+func getDNSConfig() string {
+	return `name: "test"
+bootstrapMode: "dns"
+bootstrapHosts: ["cadence-0.cadence:7933", "_tchannel._tcp.cadence"]
+maxJoinDuration: 30s`
+}
+
 func getCustomConfig() string {
 	return `name: "test"
 bootstrapMode: "custom"
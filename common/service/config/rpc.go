@@ -21,12 +21,19 @@
 package config
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+	tcg "github.com/uber/tchannel-go"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
+	"google.golang.org/grpc/credentials"
 )
 
 // RPCFactory is an implementation of service.RPCFactory interface
@@ -34,17 +41,28 @@ type RPCFactory struct {
 	config      *RPC
 	serviceName string
 	ch          *tchannel.ChannelTransport
+	tls         *tlsFiles
+	tracer      opentracing.Tracer
 	logger      bark.Logger
 }
 
 // NewFactory builds a new RPCFactory
-// conforming to the underlying configuration
-func (cfg *RPC) NewFactory(sName string, logger bark.Logger) *RPCFactory {
-	return newRPCFactory(cfg, sName, logger)
+// conforming to the underlying configuration,
+// the spans of the calls it serves and makes
+// are propagated and reported by the tracer
+func (cfg *RPC) NewFactory(sName string, tracer opentracing.Tracer, logger bark.Logger) *RPCFactory {
+	return newRPCFactory(cfg, sName, tracer, logger)
 }
 
-func newRPCFactory(cfg *RPC, sName string, logger bark.Logger) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger}
+func newRPCFactory(cfg *RPC, sName string, tracer opentracing.Tracer, logger bark.Logger) *RPCFactory {
+	factory := &RPCFactory{config: cfg, serviceName: sName, tracer: tracer, logger: logger}
+	if cfg.TLS != nil {
+		files, err := newTLSFiles(cfg.TLS, logger)
+		if err != nil {
+			logger.WithField(logging.TagErr, err).Fatal("Failed to load TLS certificates")
+		}
+		factory.tls = files
+	}
 	return factory
 }
 
@@ -53,20 +71,82 @@ func (d *RPCFactory) CreateDispatcher() *yarpc.Dispatcher {
 	// Setup dispatcher for onebox
 	var err error
 	hostAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.Port)
-	d.ch, err = tchannel.NewChannelTransport(
-		tchannel.ServiceName(d.serviceName),
-		tchannel.ListenAddr(hostAddress))
+	if d.tls != nil {
+		d.ch, err = d.newTLSChannelTransport(hostAddress)
+	} else {
+		d.ch, err = tchannel.NewChannelTransport(
+			tchannel.ServiceName(d.serviceName),
+			tchannel.ListenAddr(hostAddress),
+			tchannel.Tracer(d.tracer))
+	}
 	if err != nil {
 		d.logger.WithField("error", err).Fatal("Failed to create transport channel")
 	}
 	d.logger.Infof("Created RPC dispatcher for '%v' and listening at '%v'",
 		d.serviceName, hostAddress)
+	inbounds := yarpc.Inbounds{d.ch.NewInbound()}
+	if d.config.GRPCPort != 0 {
+		inbounds = append(inbounds, d.createGRPCInbound())
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
 		Name:     d.serviceName,
-		Inbounds: yarpc.Inbounds{d.ch.NewInbound()},
+		Inbounds: inbounds,
 	})
 }
 
+// createGRPCInbound creates the inbound serving the procedures
+// of the dispatcher over gRPC, secured by the same certificates
+// as the channel when TLS is configured
+func (d *RPCFactory) createGRPCInbound() transport.Inbound {
+	grpcAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.GRPCPort)
+	listener, err := net.Listen("tcp", grpcAddress)
+	if err != nil {
+		d.logger.WithField("error", err).Fatal("Failed to listen on gRPC port")
+	}
+	var options []grpc.InboundOption
+	if d.tls != nil {
+		options = append(options, grpc.InboundCredentials(credentials.NewTLS(d.tls.serverConfig("h2"))))
+	}
+	d.logger.Infof("Created gRPC inbound for '%v' and listening at '%v'",
+		d.serviceName, grpcAddress)
+	return grpc.NewTransport(grpc.Tracer(d.tracer)).NewInbound(listener, options...)
+}
+
+// CreateHTTPListener creates the listener of the HTTP gateway, secured
+// by the same certificates as the channel when TLS is configured
+func (d *RPCFactory) CreateHTTPListener() net.Listener {
+	if d.config.HTTPPort == 0 {
+		return nil
+	}
+	httpAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.HTTPPort)
+	listener, err := net.Listen("tcp", httpAddress)
+	if err != nil {
+		d.logger.WithField("error", err).Fatal("Failed to listen on HTTP port")
+	}
+	d.logger.Infof("Created HTTP listener for '%v' and listening at '%v'",
+		d.serviceName, httpAddress)
+	if d.tls != nil {
+		return tls.NewListener(listener, d.tls.serverConfig())
+	}
+	return listener
+}
+
+// CreateHealthListener creates the listener of the health endpoint, it
+// is never secured by TLS so that it can be probed by the orchestrator
+func (d *RPCFactory) CreateHealthListener() net.Listener {
+	if d.config.HealthPort == 0 {
+		return nil
+	}
+	healthAddress := fmt.Sprintf("%v:%v", d.getListenIP(), d.config.HealthPort)
+	listener, err := net.Listen("tcp", healthAddress)
+	if err != nil {
+		d.logger.WithField("error", err).Fatal("Failed to listen on health port")
+	}
+	d.logger.Infof("Created health listener for '%v' and listening at '%v'",
+		d.serviceName, healthAddress)
+	return listener
+}
+
 // CreateDispatcherForOutbound creates a dispatcher for outbound connection
 func (d *RPCFactory) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
@@ -85,6 +165,24 @@ func (d *RPCFactory) CreateDispatcherForOutbound(
 	return dispatcher
 }
 
+// newTLSChannelTransport creates a transport whose channel accepts
+// and dials connections over TLS, the channel is shared with
+// ringpop so gossip traffic is secured as well
+func (d *RPCFactory) newTLSChannelTransport(hostAddress string) (*tchannel.ChannelTransport, error) {
+	ch, err := tcg.NewChannel(d.serviceName, &tcg.ChannelOptions{Dialer: d.tls.dial, Tracer: d.tracer})
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", hostAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := ch.Serve(tls.NewListener(listener, d.tls.serverConfig())); err != nil {
+		return nil, err
+	}
+	return tchannel.NewChannelTransport(tchannel.WithChannel(ch))
+}
+
 func (d *RPCFactory) getListenIP() net.IP {
 	if d.config.BindOnLocalHost && len(d.config.BindOnIP) > 0 {
 		d.logger.Fatalf("ListenIP failed, bindOnLocalHost and bindOnIP are mutually exclusive")
@@ -0,0 +1,250 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/logging"
+)
+
+type (
+	// TLSDialer establishes outbound connections, it
+	// matches the signature of tchannel's Dialer option
+	TLSDialer func(ctx context.Context, network, hostPort string) (net.Conn, error)
+
+	// tlsFiles holds the certificates named by a TLS config
+	// and reloads them when the files change on disk
+	tlsFiles struct {
+		sync.Mutex
+		config      *TLS
+		logger      bark.Logger
+		lastRefresh time.Time
+		modTimes    map[string]time.Time
+		serverCert  tls.Certificate
+		clientCert  tls.Certificate
+		caPool      *x509.CertPool
+	}
+)
+
+const defaultTLSRefreshInterval = time.Minute
+
+var errNoCACerts = errors.New("no certificates found in CA file")
+
+// NewDialer returns a dialer that establishes TLS connections using
+// the client certificate and CA bundle of the config
+func (cfg *TLS) NewDialer(logger bark.Logger) (TLSDialer, error) {
+	files, err := newTLSFiles(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+	return files.dial, nil
+}
+
+func newTLSFiles(cfg *TLS, logger bark.Logger) (*tlsFiles, error) {
+	files := &tlsFiles{
+		config:   cfg,
+		logger:   logger,
+		modTimes: make(map[string]time.Time),
+	}
+	if err := files.load(); err != nil {
+		return nil, err
+	}
+	files.lastRefresh = time.Now()
+	return files, nil
+}
+
+// serverConfig returns the TLS config for inbound connections, the
+// certificates are looked up on every handshake so that reloads apply
+// to new connections, nextProtos are the protocols offered over ALPN
+func (f *tlsFiles) serverConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			f.refresh()
+			f.Lock()
+			defer f.Unlock()
+			config := &tls.Config{
+				Certificates: []tls.Certificate{f.serverCert},
+				ClientCAs:    f.caPool,
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   nextProtos,
+			}
+			if f.config.RequireClientAuth {
+				config.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return config, nil
+		},
+	}
+}
+
+// clientConfig returns the TLS config for an outbound connection
+func (f *tlsFiles) clientConfig(host string) *tls.Config {
+	f.refresh()
+	f.Lock()
+	defer f.Unlock()
+	serverName := f.config.ServerName
+	if len(serverName) == 0 {
+		serverName = host
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{f.clientCert},
+		RootCAs:      f.caPool,
+		ServerName:   serverName,
+		MinVersion:   tls.VersionTLS12,
+	}
+}
+
+// dial connects to the given address and completes
+// the TLS handshake before returning the connection
+func (f *tlsFiles) dial(ctx context.Context, network, hostPort string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		return nil, err
+	}
+	d := net.Dialer{}
+	conn, err := d.DialContext(ctx, network, hostPort)
+	if err != nil {
+		return nil, err
+	}
+	tlsConn := tls.Client(conn, f.clientConfig(host))
+	if deadline, ok := ctx.Deadline(); ok {
+		tlsConn.SetDeadline(deadline)
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	tlsConn.SetDeadline(time.Time{})
+	return tlsConn, nil
+}
+
+// refresh reloads the files if any of them has changed since the last load,
+// the previous certificates are kept when the new ones cannot be loaded
+func (f *tlsFiles) refresh() {
+	f.Lock()
+	defer f.Unlock()
+
+	interval := f.config.RefreshInterval
+	if interval <= 0 {
+		interval = defaultTLSRefreshInterval
+	}
+	if time.Since(f.lastRefresh) < interval {
+		return
+	}
+	f.lastRefresh = time.Now()
+
+	changed := false
+	for _, path := range f.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			f.logger.WithFields(bark.Fields{
+				logging.TagErr: err,
+				"file":         path,
+			}).Warn("Unable to stat TLS file")
+			return
+		}
+		if !info.ModTime().Equal(f.modTimes[path]) {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+
+	if err := f.load(); err != nil {
+		f.logger.WithField(logging.TagErr, err).Error("Unable to reload TLS certificates")
+		return
+	}
+	f.logger.Info("Reloaded TLS certificates")
+}
+
+// load reads all the files, the caller must hold the lock
+// unless the files are not shared yet
+func (f *tlsFiles) load() error {
+	modTimes := make(map[string]time.Time)
+	for _, path := range f.paths() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		modTimes[path] = info.ModTime()
+	}
+
+	serverCert, err := tls.LoadX509KeyPair(f.config.CertFile, f.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("error loading server certificate: %v", err)
+	}
+	clientCert := serverCert
+	if certFile, keyFile := f.clientFiles(); certFile != f.config.CertFile || keyFile != f.config.KeyFile {
+		clientCert, err = tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return fmt.Errorf("error loading client certificate: %v", err)
+		}
+	}
+
+	var caPool *x509.CertPool
+	if len(f.config.CaFile) > 0 {
+		pem, err := ioutil.ReadFile(f.config.CaFile)
+		if err != nil {
+			return err
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(pem) {
+			return errNoCACerts
+		}
+	}
+
+	f.serverCert = serverCert
+	f.clientCert = clientCert
+	f.caPool = caPool
+	f.modTimes = modTimes
+	return nil
+}
+
+func (f *tlsFiles) clientFiles() (string, string) {
+	certFile, keyFile := f.config.ClientCertFile, f.config.ClientKeyFile
+	if len(certFile) == 0 {
+		certFile = f.config.CertFile
+	}
+	if len(keyFile) == 0 {
+		keyFile = f.config.KeyFile
+	}
+	return certFile, keyFile
+}
+
+func (f *tlsFiles) paths() []string {
+	certFile, keyFile := f.clientFiles()
+	paths := []string{f.config.CertFile, f.config.KeyFile, certFile, keyFile}
+	if len(f.config.CaFile) > 0 {
+		paths = append(paths, f.config.CaFile)
+	}
+	return paths
+}
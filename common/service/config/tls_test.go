@@ -0,0 +1,308 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	tcg "github.com/uber/tchannel-go"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/encoding/raw"
+	"go.uber.org/yarpc/peer"
+	"go.uber.org/yarpc/peer/hostport"
+	"go.uber.org/yarpc/transport/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type (
+	TLSSuite struct {
+		*require.Assertions
+		suite.Suite
+		dir    string
+		caCert *x509.Certificate
+		caKey  *ecdsa.PrivateKey
+		config *TLS
+	}
+)
+
+func TestTLSSuite(t *testing.T) {
+	suite.Run(t, new(TLSSuite))
+}
+
+func (s *TLSSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	dir, err := ioutil.TempDir("", "tls_test")
+	s.NoError(err)
+	s.dir = dir
+
+	s.caKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &s.caKey.PublicKey, s.caKey)
+	s.NoError(err)
+	s.caCert, err = x509.ParseCertificate(der)
+	s.NoError(err)
+	s.writePEM("ca.pem", "CERTIFICATE", der)
+
+	s.writeCert("host", "host-1")
+	s.config = &TLS{
+		CertFile:          filepath.Join(dir, "host.pem"),
+		KeyFile:           filepath.Join(dir, "host-key.pem"),
+		CaFile:            filepath.Join(dir, "ca.pem"),
+		RequireClientAuth: true,
+	}
+}
+
+func (s *TLSSuite) TearDownTest() {
+	os.RemoveAll(s.dir)
+}
+
+func (s *TLSSuite) TestDial_MutualTLS() {
+	files, err := newTLSFiles(s.config, bark.NewNopLogger())
+	s.NoError(err)
+	listener := s.startEchoServer(files)
+	defer listener.Close()
+	addr := listener.Addr().String()
+
+	conn, err := files.dial(context.Background(), "tcp", addr)
+	s.NoError(err)
+	defer conn.Close()
+	s.Equal("host-1", s.echo(conn))
+}
+
+func (s *TLSSuite) TestDial_ClientCertRequired() {
+	files, err := newTLSFiles(s.config, bark.NewNopLogger())
+	s.NoError(err)
+	listener := s.startEchoServer(files)
+	defer listener.Close()
+	addr := listener.Addr().String()
+
+	pool := x509.NewCertPool()
+	pool.AddCert(s.caCert)
+	conn, err := tls.Dial("tcp", addr, &tls.Config{RootCAs: pool})
+	if err == nil {
+		// the server rejects the handshake after the client has finished its side of it
+		defer conn.Close()
+		_, err = conn.Read(make([]byte, 1))
+	}
+	s.Error(err)
+}
+
+func (s *TLSSuite) TestDial_UnknownAuthority() {
+	files, err := newTLSFiles(s.config, bark.NewNopLogger())
+	s.NoError(err)
+	listener := s.startEchoServer(files)
+	defer listener.Close()
+	addr := listener.Addr().String()
+
+	config := *s.config
+	config.CaFile = ""
+	clientFiles, err := newTLSFiles(&config, bark.NewNopLogger())
+	s.NoError(err)
+	_, err = clientFiles.dial(context.Background(), "tcp", addr)
+	s.Error(err)
+}
+
+func (s *TLSSuite) TestReload() {
+	s.config.RefreshInterval = time.Millisecond
+	files, err := newTLSFiles(s.config, bark.NewNopLogger())
+	s.NoError(err)
+	listener := s.startEchoServer(files)
+	defer listener.Close()
+	addr := listener.Addr().String()
+
+	conn, err := files.dial(context.Background(), "tcp", addr)
+	s.NoError(err)
+	s.Equal("host-1", s.echo(conn))
+	conn.Close()
+
+	s.writeCert("host", "host-2")
+	future := time.Now().Add(time.Minute)
+	s.NoError(os.Chtimes(s.config.CertFile, future, future))
+	time.Sleep(2 * time.Millisecond)
+
+	conn, err = files.dial(context.Background(), "tcp", addr)
+	s.NoError(err)
+	defer conn.Close()
+	s.Equal("host-2", s.echo(conn))
+}
+
+func (s *TLSSuite) TestNewTLSFiles_MissingFile() {
+	s.config.CaFile = filepath.Join(s.dir, "missing.pem")
+	_, err := newTLSFiles(s.config, bark.NewNopLogger())
+	s.Error(err)
+}
+
+func (s *TLSSuite) TestTChannel() {
+	files, err := newTLSFiles(s.config, bark.NewNopLogger())
+	s.NoError(err)
+
+	server, err := tcg.NewChannel("server", &tcg.ChannelOptions{Dialer: files.dial})
+	s.NoError(err)
+	defer server.Close()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	s.NoError(server.Serve(tls.NewListener(listener, files.serverConfig())))
+
+	client, err := tcg.NewChannel("client", &tcg.ChannelOptions{Dialer: files.dial})
+	s.NoError(err)
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.NoError(client.Ping(ctx, server.PeerInfo().HostPort))
+
+	plain, err := tcg.NewChannel("plain", nil)
+	s.NoError(err)
+	defer plain.Close()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	s.Error(plain.Ping(ctx, server.PeerInfo().HostPort))
+}
+
+func (s *TLSSuite) TestGRPCInbound() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	grpcPort := listener.Addr().(*net.TCPAddr).Port
+	s.NoError(listener.Close())
+
+	factory := newRPCFactory(&RPC{BindOnLocalHost: true, GRPCPort: grpcPort, TLS: s.config}, "server", opentracing.NoopTracer{}, bark.NewNopLogger())
+	server := factory.CreateDispatcher()
+	server.Register(raw.Procedure("echo", func(ctx context.Context, body []byte) ([]byte, error) {
+		return body, nil
+	}))
+	s.NoError(server.Start())
+	defer server.Stop()
+
+	grpcAddress := fmt.Sprintf("127.0.0.1:%v", grpcPort)
+	tlsClient := s.newGRPCClient(grpcAddress, grpc.DialerCredentials(credentials.NewTLS(factory.tls.clientConfig("127.0.0.1"))))
+	defer tlsClient.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := raw.New(tlsClient.ClientConfig("server")).Call(ctx, "echo", []byte("hello"))
+	s.NoError(err)
+	s.Equal("hello", string(response))
+
+	plainClient := s.newGRPCClient(grpcAddress)
+	defer plainClient.Stop()
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = raw.New(plainClient.ClientConfig("server")).Call(ctx, "echo", []byte("hello"))
+	s.Error(err)
+}
+
+func (s *TLSSuite) newGRPCClient(address string, options ...grpc.DialOption) *yarpc.Dispatcher {
+	transport := grpc.NewTransport()
+	chooser := peer.NewSingle(hostport.PeerIdentifier(address), transport.NewDialer(options...))
+	dispatcher := yarpc.NewDispatcher(yarpc.Config{
+		Name: "client",
+		Outbounds: yarpc.Outbounds{
+			"server": {Unary: transport.NewOutbound(chooser)},
+		},
+	})
+	s.NoError(dispatcher.Start())
+	return dispatcher
+}
+
+// startEchoServer accepts TLS connections and echoes back
+// the common name of the client certificate
+func (s *TLSSuite) startEchoServer(files *tlsFiles) net.Listener {
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", files.serverConfig())
+	s.NoError(err)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				tlsConn := conn.(*tls.Conn)
+				if err := tlsConn.Handshake(); err != nil {
+					return
+				}
+				state := tlsConn.ConnectionState()
+				tlsConn.Write([]byte(state.PeerCertificates[0].Subject.CommonName + "\n"))
+			}()
+		}
+	}()
+	return listener
+}
+
+func (s *TLSSuite) echo(conn net.Conn) string {
+	buf := make([]byte, 64)
+	n, err := conn.Read(buf)
+	s.NoError(err)
+	return string(buf[:n-1])
+}
+
+func (s *TLSSuite) writeCert(name string, commonName string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, s.caCert, &key.PublicKey, s.caKey)
+	s.NoError(err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	s.NoError(err)
+	s.writePEM(name+".pem", "CERTIFICATE", der)
+	s.writePEM(name+"-key.pem", "EC PRIVATE KEY", keyDer)
+}
+
+func (s *TLSSuite) writePEM(name string, blockType string, der []byte) {
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	s.NoError(ioutil.WriteFile(filepath.Join(s.dir, name), data, 0600))
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-common/bark"
+	jaegercfg "github.com/uber/jaeger-client-go/config"
+)
+
+type jaegerLogger struct {
+	logger bark.Logger
+}
+
+// NewTracer builds a new opentracing tracer for this tracing
+// configuration, along with the closer flushing the spans
+// it reports. The tracer is a noop unless an exporter is set
+func (c *Tracing) NewTracer(serviceName string, logger bark.Logger) (opentracing.Tracer, io.Closer, error) {
+	if c.Jaeger == nil {
+		return opentracing.NoopTracer{}, ioutil.NopCloser(nil), nil
+	}
+	jaeger := *c.Jaeger
+	if len(jaeger.ServiceName) == 0 {
+		jaeger.ServiceName = serviceName
+	}
+	return jaeger.NewTracer(jaegercfg.Logger(&jaegerLogger{logger: logger}))
+}
+
+func (l *jaegerLogger) Error(msg string) {
+	l.logger.Error(msg)
+}
+
+func (l *jaegerLogger) Infof(msg string, args ...interface{}) {
+	l.logger.Infof(msg, args...)
+}
@@ -67,6 +67,10 @@ var keys = map[Key]string{
 	HistoryCountLimitWarn:  "limit.historyCount.warn",
 	MaxIDLengthLimit:       "limit.maxIDLength",
 
+	// payload offload
+	PayloadOffloadBucket:    "system.payloadOffloadBucket",
+	PayloadOffloadThreshold: "limit.payloadOffloadThreshold",
+
 	// frontend settings
 	FrontendPersistenceMaxQPS:      "frontend.persistenceMaxQPS",
 	FrontendVisibilityMaxPageSize:  "frontend.visibilityMaxPageSize",
@@ -75,6 +79,10 @@ var keys = map[Key]string{
 	FrontendESIndexMaxResultWindow: "frontend.esIndexMaxResultWindow",
 	FrontendHistoryMaxPageSize:     "frontend.historyMaxPageSize",
 	FrontendRPS:                    "frontend.rps",
+	FrontendDomainRPS:              "frontend.domainrps",
+	FrontendDomainRPSWriteWeight:   "frontend.domainrpsWriteWeight",
+	FrontendDomainRPSPollWeight:    "frontend.domainrpsPollWeight",
+	FrontendDomainRPSReadWeight:    "frontend.domainrpsReadWeight",
 	FrontendHistoryMgrNumConns:     "frontend.historyMgrNumConns",
 	MaxDecisionStartToCloseTimeout: "frontend.maxDecisionStartToCloseTimeout",
 	DisableListVisibilityByFilter:  "frontend.disableListVisibilityByFilter",
@@ -108,10 +116,15 @@ var keys = map[Key]string{
 	EventsCacheMaxSize:                                    "history.eventsCacheMaxSize",
 	EventsCacheTTL:                                        "history.eventsCacheTTL",
 	AcquireShardInterval:                                  "history.acquireShardInterval",
+	EnableGracefulShardHandoff:                            "history.enableGracefulShardHandoff",
+	ShardHandoffTimeout:                                   "history.shardHandoffTimeout",
 	StandbyClusterDelay:                                   "history.standbyClusterDelay",
 	TimerTaskBatchSize:                                    "history.timerTaskBatchSize",
 	TimerTaskWorkerCount:                                  "history.timerTaskWorkerCount",
+	TimerProcessorDomainWeight:                            "history.timerProcessorDomainWeight",
+	TimerProcessorSchedulerBufferSize:                     "history.timerProcessorSchedulerBufferSize",
 	TimerTaskMaxRetryCount:                                "history.timerTaskMaxRetryCount",
+	TimerTaskDLQEnabled:                                   "history.timerTaskDLQEnabled",
 	TimerProcessorStartDelay:                              "history.timerProcessorStartDelay",
 	TimerProcessorFailoverStartDelay:                      "history.timerProcessorFailoverStartDelay",
 	TimerProcessorGetFailureRetryCount:                    "history.timerProcessorGetFailureRetryCount",
@@ -129,7 +142,10 @@ var keys = map[Key]string{
 	TransferProcessorFailoverMaxPollRPS:                   "history.transferProcessorFailoverMaxPollRPS",
 	TransferProcessorMaxPollRPS:                           "history.transferProcessorMaxPollRPS",
 	TransferTaskWorkerCount:                               "history.transferTaskWorkerCount",
+	TransferProcessorDomainWeight:                         "history.transferProcessorDomainWeight",
+	TransferProcessorSchedulerBufferSize:                  "history.transferProcessorSchedulerBufferSize",
 	TransferTaskMaxRetryCount:                             "history.transferTaskMaxRetryCount",
+	TransferTaskDLQEnabled:                                "history.transferTaskDLQEnabled",
 	TransferProcessorStartDelay:                           "history.transferProcessorStartDelay",
 	TransferProcessorFailoverStartDelay:                   "history.transferProcessorFailoverStartDelay",
 	TransferProcessorCompleteTransferFailureRetryCount:    "history.transferProcessorCompleteTransferFailureRetryCount",
@@ -180,8 +196,16 @@ var keys = map[Key]string{
 	WorkerArchiverConcurrency:                       "worker.ArchiverConcurrency",
 	WorkerArchivalsPerIteration:                     "worker.ArchivalsPerIteration",
 	WorkerDeterministicConstructionCheckProbability: "worker.DeterministicConstructionCheckProbability",
+	WorkerEnableArchivalVerification:                "worker.EnableArchivalVerification",
+	WorkerArchivalVerificationLookback:              "worker.ArchivalVerificationLookback",
 	WorkerThrottledLogRPS:                           "worker.throttledLogRPS",
 	ScannerPersistenceMaxQPS:                        "worker.scannerPersistenceMaxQPS",
+	ExecutionsScannerEnabled:                        "worker.executionsScannerEnabled",
+	ExecutionsScannerFixEnabled:                     "worker.executionsScannerFixEnabled",
+	ExecutionsScannerRefreshTasksEnabled:            "worker.executionsScannerRefreshTasksEnabled",
+	HistoryScannerEnabled:                           "worker.historyScannerEnabled",
+	HistoryScannerDryRun:                            "worker.historyScannerDryRun",
+	HistoryScannerOrphanAge:                         "worker.historyScannerOrphanAge",
 }
 
 const (
@@ -236,6 +260,11 @@ const (
 	// HistoryCountLimitWarn is the per workflow execution history event count limit for warning
 	HistoryCountLimitWarn
 
+	// PayloadOffloadBucket is the blobstore bucket large history event payloads are offloaded to
+	PayloadOffloadBucket
+	// PayloadOffloadThreshold is the per event payload size above which the payload is offloaded to blobstore
+	PayloadOffloadThreshold
+
 	// MaxIDLengthLimit is the length limit for various IDs, including: Domain, TaskList, WorkflowID, ActivityID, TimerID,
 	// WorkflowType, ActivityType, SignalName, MarkerName, ErrorReason/FailureReason/CancelCause, Identity, RequestID
 	MaxIDLengthLimit
@@ -256,6 +285,15 @@ const (
 	FrontendHistoryMaxPageSize
 	// FrontendRPS is workflow rate limit per second
 	FrontendRPS
+	// FrontendDomainRPS is workflow rate limit per second of a domain, zero disables the limit. The limit is
+	// shared by all the requests of the domain unless any of the weights below is set
+	FrontendDomainRPS
+	// FrontendDomainRPSWriteWeight is the weight of the share of the domain rps quota for start, signal and other write requests
+	FrontendDomainRPSWriteWeight
+	// FrontendDomainRPSPollWeight is the weight of the share of the domain rps quota for poll requests and the responses to polled tasks
+	FrontendDomainRPSPollWeight
+	// FrontendDomainRPSReadWeight is the weight of the share of the domain rps quota for history, visibility and other read requests
+	FrontendDomainRPSReadWeight
 	// FrontendHistoryMgrNumConns is for persistence cluster.NumConns
 	FrontendHistoryMgrNumConns
 	// FrontendThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
@@ -318,14 +356,25 @@ const (
 	EventsCacheTTL
 	// AcquireShardInterval is interval that timer used to acquire shard
 	AcquireShardInterval
+	// EnableGracefulShardHandoff is whether a stopping history host hands its shards off to their new owners
+	EnableGracefulShardHandoff
+	// ShardHandoffTimeout is the max time a stopping history host spends handing off its shards
+	ShardHandoffTimeout
 	// StandbyClusterDelay is the atrificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay
 	// TimerTaskBatchSize is batch size for timer processor to process tasks
 	TimerTaskBatchSize
 	// TimerTaskWorkerCount is number of task workers for timer processor
 	TimerTaskWorkerCount
+	// TimerProcessorDomainWeight is the weight of a domain when timer processor schedules tasks across domains
+	TimerProcessorDomainWeight
+	// TimerProcessorSchedulerBufferSize is the number of timer tasks read ahead and scheduled across domains,
+	// the domain weights only apply to the tasks within the buffer as the tasks are read in order
+	TimerProcessorSchedulerBufferSize
 	// TimerTaskMaxRetryCount is max retry count for timer processor
 	TimerTaskMaxRetryCount
+	// TimerTaskDLQEnabled is whether timer tasks exceeding max retry count are moved into the task DLQ
+	TimerTaskDLQEnabled
 	// TimerProcessorStartDelay is the start delay
 	TimerProcessorStartDelay
 	// TimerProcessorFailoverStartDelay is the failover start delay
@@ -360,8 +409,15 @@ const (
 	TransferProcessorMaxPollRPS
 	// TransferTaskWorkerCount is number of worker for transferQueueProcessor
 	TransferTaskWorkerCount
+	// TransferProcessorDomainWeight is the weight of a domain when transferQueueProcessor schedules tasks across domains
+	TransferProcessorDomainWeight
+	// TransferProcessorSchedulerBufferSize is the number of transfer tasks read ahead and scheduled across domains,
+	// the domain weights only apply to the tasks within the buffer as the tasks are read in order
+	TransferProcessorSchedulerBufferSize
 	// TransferTaskMaxRetryCount is max times of retry for transferQueueProcessor
 	TransferTaskMaxRetryCount
+	// TransferTaskDLQEnabled is whether transfer tasks exceeding max retry count are moved into the task DLQ
+	TransferTaskDLQEnabled
 	// TransferProcessorStartDelay is the start delay
 	TransferProcessorStartDelay
 	// TransferProcessorFailoverStartDelay is the failover start delay
@@ -412,7 +468,7 @@ const (
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events: json, thriftrw, thriftrw+snappy or thriftrw+zstd
 	DefaultEventEncoding
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
@@ -463,10 +519,26 @@ const (
 	WorkerArchivalsPerIteration
 	// WorkerDeterministicConstructionCheckProbability controls the probability of running a deterministic construction check for any given archival
 	WorkerDeterministicConstructionCheckProbability
+	// WorkerEnableArchivalVerification controls whether the archival verifier system workflow verifies archived histories
+	WorkerEnableArchivalVerification
+	// WorkerArchivalVerificationLookback is the close time range, counted back from now, of workflow runs checked by each archival verification
+	WorkerArchivalVerificationLookback
 	// WorkerThrottledLogRPS is the rate limit on number of log messages emitted per second for throttled logger
 	WorkerThrottledLogRPS
 	// ScannerPersistenceMaxQPS is the maximum rate of persistence calls from worker.Scanner
 	ScannerPersistenceMaxQPS
+	// ExecutionsScannerEnabled indicates if the executions scanner should be started as part of worker.Scanner
+	ExecutionsScannerEnabled
+	// ExecutionsScannerFixEnabled indicates if the executions scanner should fix (delete) the corrupted executions it finds
+	ExecutionsScannerFixEnabled
+	// ExecutionsScannerRefreshTasksEnabled indicates if the executions scanner should regenerate the transfer and timer tasks of the open executions it scans
+	ExecutionsScannerRefreshTasksEnabled
+	// HistoryScannerEnabled indicates if the history scanner should be started as part of worker.Scanner
+	HistoryScannerEnabled
+	// HistoryScannerDryRun indicates if the history scanner should only report the orphaned history branches it finds, without deleting them
+	HistoryScannerDryRun
+	// HistoryScannerOrphanAge is the minimum age of a history branch before the history scanner considers it for deletion
+	HistoryScannerOrphanAge
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
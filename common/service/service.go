@@ -22,6 +22,7 @@ package service
 
 import (
 	"math/rand"
+	"net/http"
 	"os"
 	"sync/atomic"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
@@ -38,6 +40,7 @@ import (
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
 	es "github.com/uber/cadence/common/elasticsearch"
@@ -73,6 +76,7 @@ type (
 		DispatcherProvider  client.DispatcherProvider
 		BlobstoreClient     blobstore.Client
 		DCRedirectionPolicy config.DCRedirectionPolicy
+		Tracer              opentracing.Tracer
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -101,8 +105,12 @@ type (
 		metricsClient          metrics.Client
 		clusterMetadata        cluster.Metadata
 		messagingClient        messaging.Client
+		blobstoreClient        blobstore.Client
 		dynamicCollection      *dynamicconfig.Collection
 		dispatcherProvider     client.DispatcherProvider
+		healthRegistry         *healthcheck.Registry
+		healthServer           *http.Server
+		tracer                 opentracing.Tracer
 	}
 )
 
@@ -122,8 +130,14 @@ func New(params *BootstrapParams) Service {
 		clusterMetadata:       params.ClusterMetadata,
 		metricsClient:         params.MetricsClient,
 		messagingClient:       params.MessagingClient,
+		blobstoreClient:       params.BlobstoreClient,
 		dispatcherProvider:    params.DispatcherProvider,
 		dynamicCollection:     dynamicconfig.NewCollection(params.DynamicConfig, params.Logger),
+		healthRegistry:        healthcheck.NewRegistry(params.Name, healthcheck.DefaultTimeout),
+		tracer:                params.Tracer,
+	}
+	if sVice.tracer == nil {
+		sVice.tracer = opentracing.NoopTracer{}
 	}
 
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.logger)
@@ -185,6 +199,9 @@ func (h *serviceImpl) Start() {
 		h.logger.WithFields(bark.Fields{logging.TagErr: err}).Fatal("failed to get host info from membership monitor")
 	}
 	h.hostInfo = hostInfo
+	h.healthRegistry.Register(healthcheck.MembershipCheckName,
+		healthcheck.MembershipCheck(h.membershipMonitor, h.sName, cadenceServices))
+	h.startHealthServer()
 
 	h.clientBean, err = client.NewClientBean(
 		client.NewRPCClientFactory(h.rpcFactory, h.membershipMonitor, h.metricsClient, h.numberOfHistoryShards),
@@ -208,6 +225,10 @@ func (h *serviceImpl) Stop() {
 		return
 	}
 
+	if h.healthServer != nil {
+		h.healthServer.Close()
+	}
+
 	if h.membershipMonitor != nil {
 		h.membershipMonitor.Stop()
 	}
@@ -258,6 +279,35 @@ func (h *serviceImpl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
+// GetBlobstoreClient returns the blobstore client, nil if no blobstore is configured
+func (h *serviceImpl) GetBlobstoreClient() blobstore.Client {
+	return h.blobstoreClient
+}
+
+// GetHealthRegistry returns the registry of the checks reported by the health endpoints
+func (h *serviceImpl) GetHealthRegistry() *healthcheck.Registry {
+	return h.healthRegistry
+}
+
+// GetTracer returns the tracer of the spans created by the service
+func (h *serviceImpl) GetTracer() opentracing.Tracer {
+	return h.tracer
+}
+
+// startHealthServer serves the health checks over plain HTTP when a health port is configured
+func (h *serviceImpl) startHealthServer() {
+	listener := h.rpcFactory.CreateHealthListener()
+	if listener == nil {
+		return
+	}
+	h.healthServer = &http.Server{Handler: healthcheck.NewHTTPHandler(h.healthRegistry)}
+	go func() {
+		if err := h.healthServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			h.logger.WithFields(bark.Fields{logging.TagErr: err}).Error("health server stopped")
+		}
+	}()
+}
+
 // GetMetricsServiceIdx returns the metrics name
 func GetMetricsServiceIdx(serviceName string, logger bark.Logger) metrics.ServiceIdx {
 	switch serviceName {
@@ -22,11 +22,14 @@ package service
 
 import (
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber-common/bark"
 
 	"github.com/uber/cadence/common/logging"
@@ -43,6 +46,7 @@ type (
 		kafkaClient       messaging.Client
 		clientBean        client.Bean
 		membershipMonitor membership.Monitor
+		healthRegistry    *healthcheck.Registry
 
 		metrics metrics.Client
 		logger  bark.Logger
@@ -69,6 +73,7 @@ func NewTestService(clusterMetadata cluster.Metadata, messagingClient messaging.
 		metrics:         metrics,
 		clientBean:      clientBean,
 		logger:          logger,
+		healthRegistry:  healthcheck.NewRegistry(testHostName, healthcheck.DefaultTimeout),
 	}
 }
 
@@ -128,3 +133,18 @@ func (s *serviceTestBase) GetClusterMetadata() cluster.Metadata {
 func (s *serviceTestBase) GetMessagingClient() messaging.Client {
 	return s.messagingClient
 }
+
+// GetBlobstoreClient returns the blobstore client, test service has no blobstore
+func (s *serviceTestBase) GetBlobstoreClient() blobstore.Client {
+	return nil
+}
+
+// GetHealthRegistry returns the registry of the health checks
+func (s *serviceTestBase) GetHealthRegistry() *healthcheck.Registry {
+	return s.healthRegistry
+}
+
+// GetTracer returns the tracer of the spans, test service does not trace
+func (s *serviceTestBase) GetTracer() opentracing.Tracer {
+	return opentracing.NoopTracer{}
+}
@@ -21,9 +21,12 @@
 package service
 
 import (
+	"github.com/opentracing/opentracing-go"
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
@@ -61,5 +64,14 @@ type (
 
 		// GetMessagingClient returns the messaging client against Kafka
 		GetMessagingClient() messaging.Client
+
+		// GetBlobstoreClient returns the blobstore client, nil if no blobstore is configured
+		GetBlobstoreClient() blobstore.Client
+
+		// GetHealthRegistry returns the registry of the checks reported by the health endpoints
+		GetHealthRegistry() *healthcheck.Registry
+
+		// GetTracer returns the tracer of the spans created by the service
+		GetTracer() opentracing.Tracer
 	}
 )
@@ -23,10 +23,16 @@ package host
 import (
 	"github.com/uber/cadence/.gen/go/admin/adminserviceclient"
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceclient"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
+	"github.com/uber/cadence/client/frontend"
 	"github.com/uber/cadence/common"
 	"go.uber.org/yarpc"
 )
 
+// frontendGRPCOutbound is the name of the outbound calling
+// the frontend of the test cluster over gRPC
+const frontendGRPCOutbound = "cadence-frontend-grpc"
+
 // AdminClient is the interface exposed by admin service client
 type AdminClient interface {
 	adminserviceclient.Interface
@@ -46,3 +52,9 @@ func NewAdminClient(d *yarpc.Dispatcher) AdminClient {
 func NewFrontendClient(d *yarpc.Dispatcher) FrontendClient {
 	return workflowserviceclient.New(d.ClientConfig(common.FrontendServiceName))
 }
+
+// NewFrontendGRPCClient creates a client to cadence frontend client
+// which calls the proto WorkflowService over gRPC
+func NewFrontendGRPCClient(d *yarpc.Dispatcher) FrontendClient {
+	return frontend.NewGRPCClient(apiv1.NewWorkflowServiceYARPCClient(d.ClientConfig(frontendGRPCOutbound)))
+}
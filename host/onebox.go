@@ -22,6 +22,7 @@ package host
 
 import (
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -53,6 +54,8 @@ import (
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/replicator"
 	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/api/transport"
+	"go.uber.org/yarpc/transport/grpc"
 	"go.uber.org/yarpc/transport/tchannel"
 )
 
@@ -225,6 +228,13 @@ func (c *cadenceImpl) FrontendAddress() string {
 	return cluster.TestCurrentClusterFrontendAddress
 }
 
+func (c *cadenceImpl) FrontendGRPCAddress() string {
+	if c.clusterNo != 0 {
+		return "127.0.0.1:8110"
+	}
+	return "127.0.0.1:7110"
+}
+
 func (c *cadenceImpl) FrontendPProfPort() int {
 	if c.clusterNo != 0 {
 		return 8105
@@ -295,6 +305,9 @@ func (c *cadenceImpl) GetAdminClient() adminserviceclient.Interface {
 }
 
 func (c *cadenceImpl) GetFrontendClient() workflowserviceclient.Interface {
+	if TestFlags.FrontendGRPC {
+		return NewFrontendGRPCClient(c.frontEndService.GetDispatcher())
+	}
 	return NewFrontendClient(c.frontEndService.GetDispatcher())
 }
 
@@ -310,7 +323,9 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.Logger = c.logger
 	params.ThrottledLogger = logging.NewThrottledLogger(c.logger, func(...dynamicconfig.FilterOption) int { return 10 })
 	params.PProfInitializer = newPProfInitializerImpl(c.logger, c.FrontendPProfPort())
-	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), c.logger)
+	rpcFactory := newRPCFactoryImpl(common.FrontendServiceName, c.FrontendAddress(), c.logger)
+	rpcFactory.grpcHostPort = c.FrontendGRPCAddress()
+	params.RPCFactory = rpcFactory
 	params.MetricScope = tally.NewTestScope(common.FrontendServiceName, make(map[string]string))
 	params.MembershipFactory = newMembershipFactory(params.Name, hosts)
 	params.ClusterMetadata = c.clusterMetadata
@@ -374,6 +389,7 @@ func (c *cadenceImpl) startHistory(hosts map[string][]string, startWG *sync.Wait
 		params.ClusterMetadata = c.clusterMetadata
 		params.DispatcherProvider = c.dispatcherProvider
 		params.MessagingClient = c.messagingClient
+		params.BlobstoreClient = c.blobstoreClient
 		params.PersistenceConfig = c.persistenceConfig
 		params.MetricsClient = metrics.NewClient(params.MetricScope, service.GetMetricsServiceIdx(params.Name, params.Logger))
 		params.DynamicConfig = dynamicconfig.NewNopClient()
@@ -538,13 +554,14 @@ func newPProfInitializerImpl(logger bark.Logger, port int) common.PProfInitializ
 }
 
 type rpcFactoryImpl struct {
-	ch          *tchannel.ChannelTransport
-	serviceName string
-	hostPort    string
-	logger      bark.Logger
+	ch           *tchannel.ChannelTransport
+	serviceName  string
+	hostPort     string
+	grpcHostPort string
+	logger       bark.Logger
 }
 
-func newRPCFactoryImpl(sName string, hostPort string, logger bark.Logger) common.RPCFactory {
+func newRPCFactoryImpl(sName string, hostPort string, logger bark.Logger) *rpcFactoryImpl {
 	return &rpcFactoryImpl{
 		serviceName: sName,
 		hostPort:    hostPort,
@@ -560,16 +577,38 @@ func (c *rpcFactoryImpl) CreateDispatcher() *yarpc.Dispatcher {
 	if err != nil {
 		c.logger.WithField("error", err).Fatal("Failed to create transport channel")
 	}
+	inbounds := yarpc.Inbounds{c.ch.NewInbound()}
+	// For integration tests to generate client out of the same outbound.
+	outbounds := yarpc.Outbounds{
+		c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
+	}
+	if c.grpcHostPort != "" {
+		listener, err := net.Listen("tcp", c.grpcHostPort)
+		if err != nil {
+			c.logger.WithField("error", err).Fatal("Failed to listen on gRPC port")
+		}
+		grpcTransport := grpc.NewTransport()
+		inbounds = append(inbounds, grpcTransport.NewInbound(listener))
+		outbounds[frontendGRPCOutbound] = transport.Outbounds{
+			ServiceName: c.serviceName,
+			Unary:       grpcTransport.NewSingleOutbound(c.grpcHostPort),
+		}
+	}
 	return yarpc.NewDispatcher(yarpc.Config{
-		Name:     c.serviceName,
-		Inbounds: yarpc.Inbounds{c.ch.NewInbound()},
-		// For integration tests to generate client out of the same outbound.
-		Outbounds: yarpc.Outbounds{
-			c.serviceName: {Unary: c.ch.NewSingleOutbound(c.hostPort)},
-		},
+		Name:      c.serviceName,
+		Inbounds:  inbounds,
+		Outbounds: outbounds,
 	})
 }
 
+func (c *rpcFactoryImpl) CreateHTTPListener() net.Listener {
+	return nil
+}
+
+func (c *rpcFactoryImpl) CreateHealthListener() net.Listener {
+	return nil
+}
+
 func (c *rpcFactoryImpl) CreateDispatcherForOutbound(
 	callerName, serviceName, hostName string) *yarpc.Dispatcher {
 	// Setup dispatcher(outbound) for onebox
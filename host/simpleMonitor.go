@@ -52,6 +52,10 @@ func (s *simpleMonitor) WhoAmI() (*membership.HostInfo, error) {
 	return s.hostInfo, nil
 }
 
+func (s *simpleMonitor) EvictSelf() error {
+	return nil
+}
+
 func (s *simpleMonitor) GetResolver(service string) (membership.ServiceResolver, error) {
 	return s.resolvers[service], nil
 }
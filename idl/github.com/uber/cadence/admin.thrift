@@ -58,6 +58,122 @@ service AdminService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: shared.ServiceBusyError serviceBusyError,
     )
+
+  /**
+  * ListDLQTasks returns the transfer or timer tasks moved into the task DLQ of a history shard.
+  **/
+  shared.ListDLQTasksResponse ListDLQTasks(1: shared.ListDLQTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * RetryDLQTask processes a task from the task DLQ of a history shard once, the task is removed from the DLQ on success.
+  **/
+  void RetryDLQTask(1: shared.RetryDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DropDLQTask removes a task from the task DLQ of a history shard without processing it.
+  **/
+  void DropDLQTask(1: shared.DropDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * DescribeShard returns the ack levels, read levels, failover levels and outstanding task counts of the queues of a history shard.
+  **/
+  shared.DescribeShardResponse DescribeShard(1: shared.DescribeShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ListShardQueueTasks returns the pending tasks of a queue of a history shard within a range.
+  **/
+  shared.ListShardQueueTasksResponse ListShardQueueTasks(1: shared.ListShardQueueTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * RemoveShardQueueTask deletes a task from a queue of a history shard.
+  **/
+  void RemoveShardQueueTask(1: shared.RemoveShardQueueTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * CloseShard unloads a history shard from its owning history host.
+  **/
+  void CloseShard(1: shared.CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * ReloadShard unloads a history shard from its owning history host and acquires it again.
+  **/
+  void ReloadShard(1: shared.ReloadShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
+      4: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * RefreshWorkflowTasks regenerates all outstanding transfer and timer tasks of a workflow execution from its mutable state.
+  **/
+  void RefreshWorkflowTasks(1: shared.RefreshWorkflowTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.AccessDeniedError accessDeniedError,
+    )
+
+  /**
+  * RebuildMutableState replays the history of a workflow execution, compares the result with the stored mutable state
+  * and, unless dry run is requested, overwrites the stored mutable state with the rebuilt one.
+  **/
+  shared.RebuildMutableStateResponse RebuildMutableState(1: shared.RebuildMutableStateRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.DomainNotActiveError domainNotActiveError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.AccessDeniedError accessDeniedError,
+    )
 }
 
 struct DescribeWorkflowExecutionRequest {
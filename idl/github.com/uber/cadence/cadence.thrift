@@ -428,6 +428,18 @@ service WorkflowService {
       4: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * ListArchivedWorkflowExecutions is a visibility API to list the closed executions in a specific domain
+  * whose visibility records have been archived to the domain's archival bucket.
+  **/
+  shared.ListArchivedWorkflowExecutionsResponse ListArchivedWorkflowExecutions(1: shared.ListArchivedWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)
   * as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'
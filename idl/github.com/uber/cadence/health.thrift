@@ -22,9 +22,18 @@ namespace java com.uber.cadence
 
 /* ==================== Health Check ==================== */
 
+struct DependencyStatus {
+    1: required string name
+    2: required bool ok
+    3: optional string msg
+    4: optional i64 latencyNanos
+    5: optional map<string, string> details
+}
+
 struct HealthStatus {
     1: required bool ok
     2: optional string msg
+    3: optional list<DependencyStatus> dependencies
 }
 
 service Meta {
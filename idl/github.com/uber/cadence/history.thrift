@@ -279,6 +279,16 @@ struct SyncActivityRequest {
   110: optional i32 attempt
 }
 
+struct RefreshWorkflowTasksRequest {
+  10: optional string domainUUID
+  20: optional shared.RefreshWorkflowTasksRequest request
+}
+
+struct RebuildMutableStateRequest {
+  10: optional string domainUUID
+  20: optional shared.RebuildMutableStateRequest request
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -686,4 +696,120 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+
+  /**
+  * ListDLQTasks returns the transfer or timer tasks moved into the task DLQ of a shard.
+  **/
+  shared.ListDLQTasksResponse ListDLQTasks(1: shared.ListDLQTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RetryDLQTask processes a task from the task DLQ of a shard once, the task is removed from the DLQ on success.
+  **/
+  void RetryDLQTask(1: shared.RetryDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DropDLQTask removes a task from the task DLQ of a shard without processing it.
+  **/
+  void DropDLQTask(1: shared.DropDLQTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * DescribeShard returns the ack levels, read levels, failover levels and outstanding task counts of the queues of a shard.
+  **/
+  shared.DescribeShardResponse DescribeShard(1: shared.DescribeShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ListShardQueueTasks returns the pending tasks of a queue of a shard within a range.
+  **/
+  shared.ListShardQueueTasksResponse ListShardQueueTasks(1: shared.ListShardQueueTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RemoveShardQueueTask deletes a task from a queue of a shard.
+  **/
+  void RemoveShardQueueTask(1: shared.RemoveShardQueueTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * CloseShard unloads a shard from its owning history host.
+  **/
+  void CloseShard(1: shared.CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ReloadShard unloads a shard from its owning history host and acquires it again.
+  **/
+  void ReloadShard(1: shared.ReloadShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: ShardOwnershipLostError shardOwnershipLostError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RefreshWorkflowTasks regenerates all outstanding transfer and timer tasks of a workflow execution from its mutable state.
+  **/
+  void RefreshWorkflowTasks(1: RefreshWorkflowTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.DomainNotActiveError domainNotActiveError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+      6: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RebuildMutableState replays the history of a workflow execution, compares the result with the stored mutable state
+  * and, unless dry run is requested, overwrites the stored mutable state with the rebuilt one.
+  **/
+  shared.RebuildMutableStateResponse RebuildMutableState(1: RebuildMutableStateRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.DomainNotActiveError domainNotActiveError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+      6: shared.ServiceBusyError serviceBusyError,
+    )
 }
@@ -1179,6 +1179,25 @@ struct ListClosedWorkflowExecutionsResponse {
   20: optional binary nextPageToken
 }
 
+struct CloseTimeFilter {
+  10: optional i64 (js.type = "Long") earliestTime
+  20: optional i64 (js.type = "Long") latestTime
+}
+
+struct ListArchivedWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i32 maximumPageSize
+  30: optional binary nextPageToken
+  40: optional CloseTimeFilter closeTimeFilter
+  50: optional WorkflowTypeFilter typeFilter
+  60: optional WorkflowExecutionCloseStatus statusFilter
+}
+
+struct ListArchivedWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
 struct QueryWorkflowRequest {
   10: optional string domain
   20: optional WorkflowExecution execution
@@ -1279,6 +1298,152 @@ struct DomainCacheInfo{
   20: optional i64 numOfItemsInCacheByName
 }
 
+enum DLQTaskCategory {
+  Transfer,
+  Timer,
+}
+
+struct DLQTaskInfo {
+  10: optional i64 (js.type = "Long") taskId
+  20: optional i32 taskType
+  30: optional string domainId
+  40: optional string workflowId
+  50: optional string runId
+  // Unix Nano
+  60: optional i64 (js.type = "Long") visibilityTimestamp
+  70: optional i64 (js.type = "Long") version
+  // scheduleID of transfer tasks, eventID of timer tasks
+  80: optional i64 (js.type = "Long") eventId
+}
+
+struct ListDLQTasksRequest {
+  10: optional i32 shardId
+  20: optional DLQTaskCategory category
+  30: optional i32 maximumPageSize
+  40: optional binary nextPageToken
+}
+
+struct ListDLQTasksResponse {
+  10: optional list<DLQTaskInfo> tasks
+  20: optional binary nextPageToken
+}
+
+struct RetryDLQTaskRequest {
+  10: optional i32 shardId
+  20: optional DLQTaskCategory category
+  30: optional i64 (js.type = "Long") taskId
+}
+
+struct DropDLQTaskRequest {
+  10: optional i32 shardId
+  20: optional DLQTaskCategory category
+  30: optional i64 (js.type = "Long") taskId
+}
+
+enum ShardQueueType {
+  Transfer,
+  Timer,
+  Replication,
+}
+
+struct ShardQueueFailoverLevel {
+  // Unix Nano
+  10: optional i64 (js.type = "Long") startTime
+  20: optional i64 (js.type = "Long") minLevel
+  30: optional i64 (js.type = "Long") currentLevel
+  40: optional i64 (js.type = "Long") maxLevel
+  50: optional list<string> domainIds
+}
+
+// levels are task IDs for the transfer and replication queues, and Unix Nano for the timer queue
+struct ShardQueueState {
+  10: optional i64 (js.type = "Long") ackLevel
+  20: optional i64 (js.type = "Long") maxReadLevel
+  30: optional map<string, i64> clusterAckLevels
+  // failover ID -> failover level
+  40: optional map<string, ShardQueueFailoverLevel> failoverLevels
+  // number of persisted tasks between the ack level and the max read level
+  50: optional i32 outstandingTaskCount
+  // true if counting the outstanding tasks stopped at the limit
+  60: optional bool outstandingTaskCountTruncated
+}
+
+struct DescribeShardRequest {
+  10: optional i32 shardId
+}
+
+struct DescribeShardResponse {
+  10: optional i32 shardId
+  20: optional string owner
+  30: optional i64 (js.type = "Long") rangeId
+  40: optional ShardQueueState transferQueue
+  50: optional ShardQueueState timerQueue
+  60: optional ShardQueueState replicationQueue
+}
+
+struct ShardQueueTaskInfo {
+  10: optional i64 (js.type = "Long") taskId
+  20: optional i32 taskType
+  30: optional string domainId
+  40: optional string workflowId
+  50: optional string runId
+  // Unix Nano
+  60: optional i64 (js.type = "Long") visibilityTimestamp
+  70: optional i64 (js.type = "Long") version
+  // scheduleID of transfer tasks, eventID of timer tasks, firstEventID of replication tasks
+  80: optional i64 (js.type = "Long") eventId
+}
+
+struct ListShardQueueTasksRequest {
+  10: optional i32 shardId
+  20: optional ShardQueueType queueType
+  // exclusive task ID for the transfer and replication queues, inclusive Unix Nano for the timer queue,
+  // defaults to the ack level of the queue
+  30: optional i64 (js.type = "Long") minLevel
+  // inclusive task ID for the transfer and replication queues, exclusive Unix Nano for the timer queue,
+  // defaults to the max read level of the queue
+  40: optional i64 (js.type = "Long") maxLevel
+  50: optional i32 maximumPageSize
+  60: optional binary nextPageToken
+}
+
+struct ListShardQueueTasksResponse {
+  10: optional list<ShardQueueTaskInfo> tasks
+  20: optional binary nextPageToken
+}
+
+struct RemoveShardQueueTaskRequest {
+  10: optional i32 shardId
+  20: optional ShardQueueType queueType
+  30: optional i64 (js.type = "Long") taskId
+  // Unix Nano, required for the timer queue
+  40: optional i64 (js.type = "Long") visibilityTimestamp
+}
+
+struct CloseShardRequest {
+  10: optional i32 shardId
+}
+
+struct ReloadShardRequest {
+  10: optional i32 shardId
+}
+
+struct RefreshWorkflowTasksRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+}
+
+struct RebuildMutableStateRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional bool dryRun
+}
+
+struct RebuildMutableStateResponse {
+  10: optional list<string> diff
+  20: optional bool applied
+}
+
 enum TaskListType {
   /*
    * Decision type of tasklist
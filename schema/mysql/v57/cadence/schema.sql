@@ -210,6 +210,40 @@ CREATE TABLE timer_tasks (
 	PRIMARY KEY (shard_id, visibility_timestamp, task_id)
 );
 
+CREATE TABLE transfer_tasks_dlq(
+	shard_id INT NOT NULL,
+	domain_id BINARY(16) NOT NULL,
+	workflow_id VARCHAR(255) NOT NULL,
+	run_id BINARY(16) NOT NULL,
+	task_id BIGINT NOT NULL,
+	task_type TINYINT NOT NULL,
+	target_domain_id BINARY(16) NOT NULL,
+	target_workflow_id VARCHAR(255) NOT NULL,
+	target_run_id BINARY(16),
+	target_child_workflow_only TINYINT(1) NOT NULL,
+	task_list VARCHAR(255) NOT NULL,
+	schedule_id BIGINT NOT NULL,
+	version BIGINT NOT NULL,
+	visibility_timestamp DATETIME(6) NOT NULL,
+	PRIMARY KEY (shard_id, task_id)
+);
+
+CREATE TABLE timer_tasks_dlq (
+	shard_id INT NOT NULL,
+	visibility_timestamp DATETIME(6) NOT NULL,
+	task_id BIGINT NOT NULL,
+	--
+	domain_id BINARY(16) NOT NULL,
+	workflow_id VARCHAR(255) NOT NULL,
+	run_id BINARY(16) NOT NULL,
+	task_type TINYINT NOT NULL,
+	timeout_type TINYINT NOT NULL,
+	event_id BIGINT NOT NULL,
+	schedule_attempt BIGINT NOT NULL,
+	version BIGINT NOT NULL,
+	PRIMARY KEY (shard_id, task_id)
+);
+
 -- Deprecated in favor of history eventsV2
 CREATE TABLE events (
 	domain_id      BINARY(16) NOT NULL,
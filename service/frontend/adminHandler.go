@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	historyService "github.com/uber/cadence/service/history"
@@ -51,12 +52,13 @@ type (
 		status                int32
 		numberOfHistoryShards int
 		service.Service
-		history       history.Client
-		domainCache   cache.DomainCache
-		metricsClient metrics.Client
-		historyMgr    persistence.HistoryManager
-		historyV2Mgr  persistence.HistoryV2Manager
-		startWG       sync.WaitGroup
+		history          history.Client
+		domainCache      cache.DomainCache
+		metricsClient    metrics.Client
+		historyMgr       persistence.HistoryManager
+		historyV2Mgr     persistence.HistoryV2Manager
+		payloadOffloader payload.Offloader
+		startWG          sync.WaitGroup
 	}
 )
 
@@ -71,6 +73,7 @@ func NewAdminHandler(
 		domainCache:           cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
 		historyMgr:            historyMgr,
 		historyV2Mgr:          historyV2Mgr,
+		payloadOffloader:      payload.NewOffloader(sVice.GetBlobstoreClient(), nil),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -159,6 +162,209 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 	return resp, err
 }
 
+// ListDLQTasks lists the tasks moved into the task DLQ of a shard
+func (adh *AdminHandler) ListDLQTasks(ctx context.Context, request *gen.ListDLQTasksRequest) (resp *gen.ListDLQTasksResponse, retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminListDLQTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.history.ListDLQTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// RetryDLQTask processes a task from the task DLQ of a shard again, removing it from the DLQ on success
+func (adh *AdminHandler) RetryDLQTask(ctx context.Context, request *gen.RetryDLQTaskRequest) (retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRetryDLQTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil || request.TaskId == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.history.RetryDLQTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DropDLQTask removes a task from the task DLQ of a shard without processing it
+func (adh *AdminHandler) DropDLQTask(ctx context.Context, request *gen.DropDLQTaskRequest) (retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminDropDLQTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil || request.TaskId == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.history.DropDLQTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeShard returns the ack levels, read levels, failover levels and outstanding task counts of the queues of a history shard
+func (adh *AdminHandler) DescribeShard(ctx context.Context, request *gen.DescribeShardRequest) (resp *gen.DescribeShardResponse, retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminDescribeShardScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.history.DescribeShard(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ListShardQueueTasks lists the pending tasks of a queue of a history shard within a range
+func (adh *AdminHandler) ListShardQueueTasks(ctx context.Context, request *gen.ListShardQueueTasksRequest) (resp *gen.ListShardQueueTasksResponse, retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminListShardQueueTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+
+	resp, err := adh.history.ListShardQueueTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// RemoveShardQueueTask deletes a task from a queue of a history shard
+func (adh *AdminHandler) RemoveShardQueueTask(ctx context.Context, request *gen.RemoveShardQueueTaskRequest) (retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRemoveShardQueueTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil || request.TaskId == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.history.RemoveShardQueueTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// CloseShard unloads a history shard from its owning history host
+func (adh *AdminHandler) CloseShard(ctx context.Context, request *gen.CloseShardRequest) (retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminCloseShardScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.history.CloseShard(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// ReloadShard unloads a history shard from its owning history host and acquires it again
+func (adh *AdminHandler) ReloadShard(ctx context.Context, request *gen.ReloadShardRequest) (retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminReloadShardScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil || request.ShardId == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+
+	if err := adh.history.ReloadShard(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RefreshWorkflowTasks regenerates all outstanding transfer and timer tasks of a workflow execution
+func (adh *AdminHandler) RefreshWorkflowTasks(ctx context.Context, request *gen.RefreshWorkflowTasksRequest) (retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRefreshWorkflowTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.RefreshWorkflowTasks(ctx, &hist.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request:    request,
+	}); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// RebuildMutableState rebuilds the mutable state of a workflow execution from its history
+func (adh *AdminHandler) RebuildMutableState(ctx context.Context,
+	request *gen.RebuildMutableStateRequest) (resp *gen.RebuildMutableStateResponse, retError error) {
+	defer logging.CapturePanic(adh.GetLogger(), &retError)
+	scope := metrics.AdminRebuildMutableStateScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err = adh.history.RebuildMutableState(ctx, &hist.RebuildMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request:    request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
 // GetWorkflowExecutionRawHistory - retrieves the history of workflow execution
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest) (resp *admin.GetWorkflowExecutionRawHistoryResponse, retError error) {
@@ -300,6 +506,10 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	serializer := persistence.NewHistorySerializer()
 	blobs := []*gen.DataBlob{}
 	for _, historyBatch := range historyBatches {
+		// the raw history is applied by remote clusters, which do not necessarily share the blobstore
+		if err := adh.payloadOffloader.ResolveEvents(historyBatch.Events); err != nil {
+			return nil, err
+		}
 		blob, err := serializer.SerializeBatchEvents(historyBatch.Events, common.EncodingTypeThriftRW)
 		if err != nil {
 			return nil, err
@@ -175,6 +175,24 @@ func (handler *DCRedirectionHandlerImpl) GetWorkflowExecutionHistory(
 	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).GetWorkflowExecutionHistory(ctx, request)
 }
 
+// ListArchivedWorkflowExecutions API call
+func (handler *DCRedirectionHandlerImpl) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListArchivedWorkflowExecutionsRequest,
+) (*shared.ListArchivedWorkflowExecutionsResponse, error) {
+
+	targetDC, err := handler.redirectionPolicy.GetTargetDataCenterByName(request.GetDomain())
+	if err != nil {
+		return nil, err
+	}
+
+	if targetDC == handler.currentClusterName {
+		return handler.frontendHandler.ListArchivedWorkflowExecutions(ctx, request)
+	}
+
+	return handler.service.GetClientBean().GetRemoteFrontendClient(targetDC).ListArchivedWorkflowExecutions(ctx, request)
+}
+
 // ListClosedWorkflowExecutions API call
 func (handler *DCRedirectionHandlerImpl) ListClosedWorkflowExecutions(
 	ctx context.Context,
@@ -0,0 +1,197 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/tokenbucket"
+)
+
+type (
+	apiClass int
+
+	// domainRateLimiter enforces the rps quota of each domain, domains without a quota are not limited.
+	// All the requests of a domain share its quota unless the weights of the api classes are set, in
+	// which case the quota is split across the classes by their weights and each class has a token
+	// bucket of its own, so a burst of requests of one class does not starve the other classes.
+	domainRateLimiter struct {
+		rps        dynamicconfig.IntPropertyFnWithDomainFilter
+		weights    []dynamicconfig.IntPropertyFn
+		timeSource clock.TimeSource
+
+		sync.RWMutex
+		buckets       map[string]*domainTokenBuckets
+		lastEvictTime int64
+	}
+
+	domainTokenBuckets struct {
+		sync.Mutex
+		rps     []int
+		buckets []tokenbucket.TokenBucket
+		// lastAccessTime is used to evict the buckets of idle domains
+		lastAccessTime int64
+	}
+)
+
+const (
+	// apiClassWrite is the class of the APIs starting, signaling or otherwise changing workflows
+	apiClassWrite apiClass = iota
+	// apiClassPoll is the class of the task list poll APIs and the APIs workers use to report on
+	// the polled tasks
+	apiClassPoll
+	// apiClassRead is the class of the history, visibility and other read APIs
+	apiClassRead
+	numAPIClasses
+)
+
+const (
+	// domainBucket is the bucket shared by all the api classes when the quota of the domain is not split
+	domainBucket = int(numAPIClasses)
+	numBuckets   = domainBucket + 1
+)
+
+const (
+	// domainTokenBucketsIdleTimeout is the time after which the buckets of a domain without requests
+	// are evicted
+	domainTokenBucketsIdleTimeout = 10 * time.Minute
+)
+
+func newDomainRateLimiter(config *Config) *domainRateLimiter {
+	weights := make([]dynamicconfig.IntPropertyFn, numAPIClasses)
+	weights[apiClassWrite] = config.DomainRPSWriteWeight
+	weights[apiClassPoll] = config.DomainRPSPollWeight
+	weights[apiClassRead] = config.DomainRPSReadWeight
+
+	return &domainRateLimiter{
+		rps:        config.DomainRPS,
+		weights:    weights,
+		timeSource: clock.NewRealTimeSource(),
+		buckets:    make(map[string]*domainTokenBuckets),
+	}
+}
+
+// allow takes a token for a request of the api class from the quota of the domain, it returns
+// false if the domain, or the api class when the quota is split, is over its quota
+func (l *domainRateLimiter) allow(domain string, class apiClass) bool {
+	rps := l.rps(domain)
+	if rps <= 0 {
+		return true
+	}
+
+	bucket := domainBucket
+	if l.isSplit() {
+		bucket = int(class)
+		rps = l.getClassRPS(rps, class)
+	}
+	now := l.timeSource.Now().UnixNano()
+	ok, _ := l.getBuckets(domain, now).getBucket(bucket, rps, now).TryConsume(1)
+	return ok
+}
+
+// isSplit returns true if the weight of any api class is set
+func (l *domainRateLimiter) isSplit() bool {
+	for _, weight := range l.weights {
+		if weight != nil && weight() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getClassRPS returns the share of the domain quota of the api class, every class gets at least
+// one rps
+func (l *domainRateLimiter) getClassRPS(rps int, class apiClass) int {
+	totalWeight := 0
+	for c := range l.weights {
+		totalWeight += l.getWeight(apiClass(c))
+	}
+	classRPS := rps * l.getWeight(class) / totalWeight
+	if classRPS < 1 {
+		classRPS = 1
+	}
+	return classRPS
+}
+
+// getWeight returns the weight of the api class, a class without a weight gets a weight of one
+func (l *domainRateLimiter) getWeight(class apiClass) int {
+	if l.weights[class] != nil && l.weights[class]() > 1 {
+		return l.weights[class]()
+	}
+	return 1
+}
+
+func (l *domainRateLimiter) getBuckets(domain string, now int64) *domainTokenBuckets {
+	if now-atomic.LoadInt64(&l.lastEvictTime) >= int64(domainTokenBucketsIdleTimeout) {
+		l.evictIdleBuckets(now)
+	}
+
+	l.RLock()
+	buckets, ok := l.buckets[domain]
+	l.RUnlock()
+	if ok {
+		return buckets
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if buckets, ok := l.buckets[domain]; ok { // read again to ensure no duplicate create
+		return buckets
+	}
+	buckets = &domainTokenBuckets{
+		rps:     make([]int, numBuckets),
+		buckets: make([]tokenbucket.TokenBucket, numBuckets),
+	}
+	for class := range buckets.buckets {
+		buckets.buckets[class] = tokenbucket.New(0, l.timeSource)
+	}
+	l.buckets[domain] = buckets
+	return buckets
+}
+
+// evictIdleBuckets removes the buckets of the domains without requests during the idle timeout
+func (l *domainRateLimiter) evictIdleBuckets(now int64) {
+	l.Lock()
+	defer l.Unlock()
+	atomic.StoreInt64(&l.lastEvictTime, now)
+	for domain, buckets := range l.buckets {
+		if now-atomic.LoadInt64(&buckets.lastAccessTime) >= int64(domainTokenBucketsIdleTimeout) {
+			delete(l.buckets, domain)
+		}
+	}
+}
+
+// getBucket returns the bucket, the bucket is reset when its quota is changed
+func (b *domainTokenBuckets) getBucket(bucket int, rps int, now int64) tokenbucket.TokenBucket {
+	atomic.StoreInt64(&b.lastAccessTime, now)
+
+	b.Lock()
+	defer b.Unlock()
+	if b.rps[bucket] != rps {
+		b.buckets[bucket].Reset(rps)
+		b.rps[bucket] = rps
+	}
+	return b.buckets[bucket]
+}
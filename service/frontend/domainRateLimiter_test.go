@@ -0,0 +1,144 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	domainRateLimiterSuite struct {
+		suite.Suite
+		config *Config
+	}
+)
+
+func TestDomainRateLimiterSuite(t *testing.T) {
+	s := new(domainRateLimiterSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainRateLimiterSuite) SetupTest() {
+	logger := bark.NewLoggerFromLogrus(logrus.New())
+	s.config = NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), logger), false)
+	// 10 rps fills the bucket with a single token every 100 milliseconds
+	s.config.DomainRPS = dynamicconfig.GetIntPropertyFilteredByDomain(10)
+}
+
+func (s *domainRateLimiterSuite) TestNotLimitedByDefault() {
+	s.config = NewConfig(dynamicconfig.NewCollection(dynamicconfig.NewNopClient(), bark.NewLoggerFromLogrus(logrus.New())), false)
+	limiter := newDomainRateLimiter(s.config)
+
+	for i := 0; i < 100; i++ {
+		s.True(limiter.allow("domain1", apiClassWrite))
+	}
+	s.Empty(limiter.buckets)
+}
+
+func (s *domainRateLimiterSuite) TestDomainsLimitedIndependently() {
+	limiter := newDomainRateLimiter(s.config)
+
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.False(limiter.allow("domain1", apiClassWrite))
+	s.True(limiter.allow("domain2", apiClassWrite))
+}
+
+func (s *domainRateLimiterSuite) TestQuotaSharedByClasses() {
+	limiter := newDomainRateLimiter(s.config)
+
+	s.True(limiter.allow("domain1", apiClassPoll))
+	s.False(limiter.allow("domain1", apiClassWrite))
+	s.False(limiter.allow("domain1", apiClassRead))
+}
+
+func (s *domainRateLimiterSuite) TestClassesLimitedIndependently() {
+	// 30 rps is split evenly across the api classes
+	s.config.DomainRPS = dynamicconfig.GetIntPropertyFilteredByDomain(30)
+	s.config.DomainRPSPollWeight = dynamicconfig.GetIntPropertyFn(1)
+	limiter := newDomainRateLimiter(s.config)
+
+	s.True(limiter.allow("domain1", apiClassPoll))
+	s.False(limiter.allow("domain1", apiClassPoll))
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.True(limiter.allow("domain1", apiClassRead))
+}
+
+func (s *domainRateLimiterSuite) TestLowQuotaDoesNotStarveClasses() {
+	s.config.DomainRPS = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+	s.config.DomainRPSPollWeight = dynamicconfig.GetIntPropertyFn(1)
+	limiter := newDomainRateLimiter(s.config)
+
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.True(limiter.allow("domain1", apiClassPoll))
+	s.True(limiter.allow("domain1", apiClassRead))
+	s.False(limiter.allow("domain1", apiClassRead))
+}
+
+func (s *domainRateLimiterSuite) TestWeight() {
+	s.config.DomainRPS = dynamicconfig.GetIntPropertyFilteredByDomain(40)
+	s.config.DomainRPSWriteWeight = dynamicconfig.GetIntPropertyFn(2)
+	limiter := newDomainRateLimiter(s.config)
+
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.False(limiter.allow("domain1", apiClassWrite))
+	s.True(limiter.allow("domain1", apiClassRead))
+	s.False(limiter.allow("domain1", apiClassRead))
+}
+
+func (s *domainRateLimiterSuite) TestQuotaChanged() {
+	rps := 10
+	s.config.DomainRPS = func(domain string) int {
+		return rps
+	}
+	limiter := newDomainRateLimiter(s.config)
+
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.False(limiter.allow("domain1", apiClassWrite))
+	rps = 20
+	time.Sleep(100 * time.Millisecond)
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.True(limiter.allow("domain1", apiClassWrite))
+	s.False(limiter.allow("domain1", apiClassWrite))
+}
+
+func (s *domainRateLimiterSuite) TestIdleBucketsEvicted() {
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	limiter := newDomainRateLimiter(s.config)
+	limiter.timeSource = timeSource
+
+	s.True(limiter.allow("domain1", apiClassWrite))
+	timeSource.Update(timeSource.Now().Add(domainTokenBucketsIdleTimeout / 2))
+	s.True(limiter.allow("domain2", apiClassWrite))
+	s.Len(limiter.buckets, 2)
+
+	timeSource.Update(timeSource.Now().Add(domainTokenBucketsIdleTimeout / 2))
+	s.True(limiter.allow("domain2", apiClassWrite))
+	s.Len(limiter.buckets, 1)
+	s.Contains(limiter.buckets, "domain2")
+}
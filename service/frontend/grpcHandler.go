@@ -0,0 +1,463 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
+	"github.com/uber/cadence/common/mapper"
+)
+
+var _ apiv1.WorkflowServiceYARPCServer = (*GRPCHandler)(nil)
+
+type (
+	// GRPCHandler serves the proto WorkflowService by converting the
+	// requests to thrift and calling the handler behind the TChannel
+	// endpoint, so both transports share the same handler and errors
+	GRPCHandler struct {
+		handler workflowserviceserver.Interface
+	}
+)
+
+// NewGRPCHandler creates a gRPC handler for the cadence service, frontend
+func NewGRPCHandler(handler workflowserviceserver.Interface) *GRPCHandler {
+	return &GRPCHandler{
+		handler: handler,
+	}
+}
+
+// DeprecateDomain API call
+func (g *GRPCHandler) DeprecateDomain(
+	ctx context.Context,
+	request *apiv1.DeprecateDomainRequest,
+) (*apiv1.DeprecateDomainResponse, error) {
+
+	err := g.handler.DeprecateDomain(ctx, mapper.FromProtoDeprecateDomainRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.DeprecateDomainResponse{}, nil
+}
+
+// DescribeDomain API call
+func (g *GRPCHandler) DescribeDomain(
+	ctx context.Context,
+	request *apiv1.DescribeDomainRequest,
+) (*apiv1.DescribeDomainResponse, error) {
+
+	response, err := g.handler.DescribeDomain(ctx, mapper.FromProtoDescribeDomainRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoDescribeDomainResponse(response), nil
+}
+
+// DescribeTaskList API call
+func (g *GRPCHandler) DescribeTaskList(
+	ctx context.Context,
+	request *apiv1.DescribeTaskListRequest,
+) (*apiv1.DescribeTaskListResponse, error) {
+
+	response, err := g.handler.DescribeTaskList(ctx, mapper.FromProtoDescribeTaskListRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoDescribeTaskListResponse(response), nil
+}
+
+// DescribeWorkflowExecution API call
+func (g *GRPCHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.DescribeWorkflowExecutionRequest,
+) (*apiv1.DescribeWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.DescribeWorkflowExecution(ctx, mapper.FromProtoDescribeWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoDescribeWorkflowExecutionResponse(response), nil
+}
+
+// GetWorkflowExecutionHistory API call
+func (g *GRPCHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *apiv1.GetWorkflowExecutionHistoryRequest,
+) (*apiv1.GetWorkflowExecutionHistoryResponse, error) {
+
+	response, err := g.handler.GetWorkflowExecutionHistory(ctx, mapper.FromProtoGetWorkflowExecutionHistoryRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoGetWorkflowExecutionHistoryResponse(response), nil
+}
+
+// ListArchivedWorkflowExecutions API call
+func (g *GRPCHandler) ListArchivedWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListArchivedWorkflowExecutionsRequest,
+) (*apiv1.ListArchivedWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListArchivedWorkflowExecutions(ctx, mapper.FromProtoListArchivedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoListArchivedWorkflowExecutionsResponse(response), nil
+}
+
+// ListClosedWorkflowExecutions API call
+func (g *GRPCHandler) ListClosedWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListClosedWorkflowExecutionsRequest,
+) (*apiv1.ListClosedWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListClosedWorkflowExecutions(ctx, mapper.FromProtoListClosedWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoListClosedWorkflowExecutionsResponse(response), nil
+}
+
+// ListDomains API call
+func (g *GRPCHandler) ListDomains(
+	ctx context.Context,
+	request *apiv1.ListDomainsRequest,
+) (*apiv1.ListDomainsResponse, error) {
+
+	response, err := g.handler.ListDomains(ctx, mapper.FromProtoListDomainsRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoListDomainsResponse(response), nil
+}
+
+// ListOpenWorkflowExecutions API call
+func (g *GRPCHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *apiv1.ListOpenWorkflowExecutionsRequest,
+) (*apiv1.ListOpenWorkflowExecutionsResponse, error) {
+
+	response, err := g.handler.ListOpenWorkflowExecutions(ctx, mapper.FromProtoListOpenWorkflowExecutionsRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoListOpenWorkflowExecutionsResponse(response), nil
+}
+
+// PollForActivityTask API call
+func (g *GRPCHandler) PollForActivityTask(
+	ctx context.Context,
+	request *apiv1.PollForActivityTaskRequest,
+) (*apiv1.PollForActivityTaskResponse, error) {
+
+	response, err := g.handler.PollForActivityTask(ctx, mapper.FromProtoPollForActivityTaskRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoPollForActivityTaskResponse(response), nil
+}
+
+// PollForDecisionTask API call
+func (g *GRPCHandler) PollForDecisionTask(
+	ctx context.Context,
+	request *apiv1.PollForDecisionTaskRequest,
+) (*apiv1.PollForDecisionTaskResponse, error) {
+
+	response, err := g.handler.PollForDecisionTask(ctx, mapper.FromProtoPollForDecisionTaskRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoPollForDecisionTaskResponse(response), nil
+}
+
+// QueryWorkflow API call
+func (g *GRPCHandler) QueryWorkflow(
+	ctx context.Context,
+	request *apiv1.QueryWorkflowRequest,
+) (*apiv1.QueryWorkflowResponse, error) {
+
+	response, err := g.handler.QueryWorkflow(ctx, mapper.FromProtoQueryWorkflowRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoQueryWorkflowResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeat API call
+func (g *GRPCHandler) RecordActivityTaskHeartbeat(
+	ctx context.Context,
+	request *apiv1.RecordActivityTaskHeartbeatRequest,
+) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := g.handler.RecordActivityTaskHeartbeat(ctx, mapper.FromProtoRecordActivityTaskHeartbeatRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RecordActivityTaskHeartbeatByID API call
+func (g *GRPCHandler) RecordActivityTaskHeartbeatByID(
+	ctx context.Context,
+	request *apiv1.RecordActivityTaskHeartbeatByIDRequest,
+) (*apiv1.RecordActivityTaskHeartbeatResponse, error) {
+
+	response, err := g.handler.RecordActivityTaskHeartbeatByID(ctx, mapper.FromProtoRecordActivityTaskHeartbeatByIDRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoRecordActivityTaskHeartbeatResponse(response), nil
+}
+
+// RegisterDomain API call
+func (g *GRPCHandler) RegisterDomain(
+	ctx context.Context,
+	request *apiv1.RegisterDomainRequest,
+) (*apiv1.RegisterDomainResponse, error) {
+
+	err := g.handler.RegisterDomain(ctx, mapper.FromProtoRegisterDomainRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RegisterDomainResponse{}, nil
+}
+
+// RequestCancelWorkflowExecution API call
+func (g *GRPCHandler) RequestCancelWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.RequestCancelWorkflowExecutionRequest,
+) (*apiv1.RequestCancelWorkflowExecutionResponse, error) {
+
+	err := g.handler.RequestCancelWorkflowExecution(ctx, mapper.FromProtoRequestCancelWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RequestCancelWorkflowExecutionResponse{}, nil
+}
+
+// ResetStickyTaskList API call
+func (g *GRPCHandler) ResetStickyTaskList(
+	ctx context.Context,
+	request *apiv1.ResetStickyTaskListRequest,
+) (*apiv1.ResetStickyTaskListResponse, error) {
+
+	response, err := g.handler.ResetStickyTaskList(ctx, mapper.FromProtoResetStickyTaskListRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoResetStickyTaskListResponse(response), nil
+}
+
+// ResetWorkflowExecution API call
+func (g *GRPCHandler) ResetWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.ResetWorkflowExecutionRequest,
+) (*apiv1.ResetWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.ResetWorkflowExecution(ctx, mapper.FromProtoResetWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoResetWorkflowExecutionResponse(response), nil
+}
+
+// RespondActivityTaskCanceled API call
+func (g *GRPCHandler) RespondActivityTaskCanceled(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCanceledRequest,
+) (*apiv1.RespondActivityTaskCanceledResponse, error) {
+
+	err := g.handler.RespondActivityTaskCanceled(ctx, mapper.FromProtoRespondActivityTaskCanceledRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondActivityTaskCanceledResponse{}, nil
+}
+
+// RespondActivityTaskCanceledByID API call
+func (g *GRPCHandler) RespondActivityTaskCanceledByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCanceledByIDRequest,
+) (*apiv1.RespondActivityTaskCanceledByIDResponse, error) {
+
+	err := g.handler.RespondActivityTaskCanceledByID(ctx, mapper.FromProtoRespondActivityTaskCanceledByIDRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondActivityTaskCanceledByIDResponse{}, nil
+}
+
+// RespondActivityTaskCompleted API call
+func (g *GRPCHandler) RespondActivityTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCompletedRequest,
+) (*apiv1.RespondActivityTaskCompletedResponse, error) {
+
+	err := g.handler.RespondActivityTaskCompleted(ctx, mapper.FromProtoRespondActivityTaskCompletedRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondActivityTaskCompletedResponse{}, nil
+}
+
+// RespondActivityTaskCompletedByID API call
+func (g *GRPCHandler) RespondActivityTaskCompletedByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskCompletedByIDRequest,
+) (*apiv1.RespondActivityTaskCompletedByIDResponse, error) {
+
+	err := g.handler.RespondActivityTaskCompletedByID(ctx, mapper.FromProtoRespondActivityTaskCompletedByIDRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondActivityTaskCompletedByIDResponse{}, nil
+}
+
+// RespondActivityTaskFailed API call
+func (g *GRPCHandler) RespondActivityTaskFailed(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskFailedRequest,
+) (*apiv1.RespondActivityTaskFailedResponse, error) {
+
+	err := g.handler.RespondActivityTaskFailed(ctx, mapper.FromProtoRespondActivityTaskFailedRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondActivityTaskFailedResponse{}, nil
+}
+
+// RespondActivityTaskFailedByID API call
+func (g *GRPCHandler) RespondActivityTaskFailedByID(
+	ctx context.Context,
+	request *apiv1.RespondActivityTaskFailedByIDRequest,
+) (*apiv1.RespondActivityTaskFailedByIDResponse, error) {
+
+	err := g.handler.RespondActivityTaskFailedByID(ctx, mapper.FromProtoRespondActivityTaskFailedByIDRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondActivityTaskFailedByIDResponse{}, nil
+}
+
+// RespondDecisionTaskCompleted API call
+func (g *GRPCHandler) RespondDecisionTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondDecisionTaskCompletedRequest,
+) (*apiv1.RespondDecisionTaskCompletedResponse, error) {
+
+	response, err := g.handler.RespondDecisionTaskCompleted(ctx, mapper.FromProtoRespondDecisionTaskCompletedRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoRespondDecisionTaskCompletedResponse(response), nil
+}
+
+// RespondDecisionTaskFailed API call
+func (g *GRPCHandler) RespondDecisionTaskFailed(
+	ctx context.Context,
+	request *apiv1.RespondDecisionTaskFailedRequest,
+) (*apiv1.RespondDecisionTaskFailedResponse, error) {
+
+	err := g.handler.RespondDecisionTaskFailed(ctx, mapper.FromProtoRespondDecisionTaskFailedRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondDecisionTaskFailedResponse{}, nil
+}
+
+// RespondQueryTaskCompleted API call
+func (g *GRPCHandler) RespondQueryTaskCompleted(
+	ctx context.Context,
+	request *apiv1.RespondQueryTaskCompletedRequest,
+) (*apiv1.RespondQueryTaskCompletedResponse, error) {
+
+	err := g.handler.RespondQueryTaskCompleted(ctx, mapper.FromProtoRespondQueryTaskCompletedRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.RespondQueryTaskCompletedResponse{}, nil
+}
+
+// SignalWithStartWorkflowExecution API call
+func (g *GRPCHandler) SignalWithStartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWithStartWorkflowExecutionRequest,
+) (*apiv1.StartWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.SignalWithStartWorkflowExecution(ctx, mapper.FromProtoSignalWithStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoStartWorkflowExecutionResponse(response), nil
+}
+
+// SignalWorkflowExecution API call
+func (g *GRPCHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.SignalWorkflowExecutionRequest,
+) (*apiv1.SignalWorkflowExecutionResponse, error) {
+
+	err := g.handler.SignalWorkflowExecution(ctx, mapper.FromProtoSignalWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.SignalWorkflowExecutionResponse{}, nil
+}
+
+// StartWorkflowExecution API call
+func (g *GRPCHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.StartWorkflowExecutionRequest,
+) (*apiv1.StartWorkflowExecutionResponse, error) {
+
+	response, err := g.handler.StartWorkflowExecution(ctx, mapper.FromProtoStartWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoStartWorkflowExecutionResponse(response), nil
+}
+
+// TerminateWorkflowExecution API call
+func (g *GRPCHandler) TerminateWorkflowExecution(
+	ctx context.Context,
+	request *apiv1.TerminateWorkflowExecutionRequest,
+) (*apiv1.TerminateWorkflowExecutionResponse, error) {
+
+	err := g.handler.TerminateWorkflowExecution(ctx, mapper.FromProtoTerminateWorkflowExecutionRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return &apiv1.TerminateWorkflowExecutionResponse{}, nil
+}
+
+// UpdateDomain API call
+func (g *GRPCHandler) UpdateDomain(
+	ctx context.Context,
+	request *apiv1.UpdateDomainRequest,
+) (*apiv1.UpdateDomainResponse, error) {
+
+	response, err := g.handler.UpdateDomain(ctx, mapper.FromProtoUpdateDomainRequest(request))
+	if err != nil {
+		return nil, mapper.ToProtoError(ctx, err)
+	}
+	return mapper.ToProtoUpdateDomainResponse(response), nil
+}
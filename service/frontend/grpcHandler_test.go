@@ -0,0 +1,153 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
+	frontendclient "github.com/uber/cadence/client/frontend"
+	"github.com/uber/cadence/common"
+	"go.uber.org/yarpc"
+	"go.uber.org/yarpc/transport/grpc"
+)
+
+type (
+	grpcHandlerSuite struct {
+		*require.Assertions
+		suite.Suite
+		handler    *fakeWorkflowHandler
+		dispatcher *yarpc.Dispatcher
+		client     frontendclient.Client
+	}
+
+	// fakeWorkflowHandler implements the thrift handler methods
+	// exercised by the tests, other methods panic when called
+	fakeWorkflowHandler struct {
+		workflowserviceserver.Interface
+		startRequest    *shared.StartWorkflowExecutionRequest
+		signalRequest   *shared.SignalWorkflowExecutionRequest
+		listOpenRequest *shared.ListOpenWorkflowExecutionsRequest
+		historyRequest  *shared.GetWorkflowExecutionHistoryRequest
+		signalSpan      opentracing.Span
+		err             error
+	}
+)
+
+func TestGRPCHandlerSuite(t *testing.T) {
+	suite.Run(t, new(grpcHandlerSuite))
+}
+
+func (s *grpcHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.handler = &fakeWorkflowHandler{}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	transport := grpc.NewTransport()
+	s.dispatcher = yarpc.NewDispatcher(yarpc.Config{
+		Name:     common.FrontendServiceName,
+		Inbounds: yarpc.Inbounds{transport.NewInbound(listener)},
+		Outbounds: yarpc.Outbounds{
+			common.FrontendServiceName: {Unary: transport.NewSingleOutbound(listener.Addr().String())},
+		},
+	})
+	s.dispatcher.Register(apiv1.BuildWorkflowServiceYARPCProcedures(NewGRPCHandler(s.handler)))
+	s.NoError(s.dispatcher.Start())
+	s.client = frontendclient.NewGRPCClient(apiv1.NewWorkflowServiceYARPCClient(s.dispatcher.ClientConfig(common.FrontendServiceName)))
+}
+
+func (s *grpcHandlerSuite) TearDownTest() {
+	s.dispatcher.Stop()
+}
+
+func (s *grpcHandlerSuite) TestStartWorkflowExecution() {
+	request := &shared.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr("domain"),
+		WorkflowId:                          common.StringPtr("workflow-id"),
+		WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflow-type")},
+		TaskList:                            &shared.TaskList{Name: common.StringPtr("tasklist")},
+		Input:                               []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		WorkflowIdReusePolicy:               shared.WorkflowIdReusePolicyRejectDuplicate.Ptr(),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := s.client.StartWorkflowExecution(ctx, request)
+	s.NoError(err)
+	s.Equal("run-id", response.GetRunId())
+	s.Equal(request, s.handler.startRequest)
+}
+
+func (s *grpcHandlerSuite) TestStartWorkflowExecution_Error() {
+	s.handler.err = &shared.WorkflowExecutionAlreadyStartedError{
+		Message:        common.StringPtr("already started"),
+		StartRequestId: common.StringPtr("request-id"),
+		RunId:          common.StringPtr("run-id"),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	response, err := s.client.StartWorkflowExecution(ctx, &shared.StartWorkflowExecutionRequest{})
+	s.Nil(response)
+	s.Equal(s.handler.err, err)
+}
+
+func (s *grpcHandlerSuite) TestSignalWorkflowExecution_Error() {
+	s.handler.err = &shared.DomainNotActiveError{
+		Message:        "domain is not active",
+		DomainName:     "domain",
+		CurrentCluster: "standby",
+		ActiveCluster:  "active",
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	s.Equal(s.handler.err, s.client.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{}))
+}
+
+func (h *fakeWorkflowHandler) StartWorkflowExecution(
+	ctx context.Context,
+	request *shared.StartWorkflowExecutionRequest,
+) (*shared.StartWorkflowExecutionResponse, error) {
+
+	if h.err != nil {
+		return nil, h.err
+	}
+	h.startRequest = request
+	return &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr("run-id")}, nil
+}
+
+func (h *fakeWorkflowHandler) SignalWorkflowExecution(
+	ctx context.Context,
+	request *shared.SignalWorkflowExecutionRequest,
+) error {
+
+	h.signalRequest = request
+	h.signalSpan = opentracing.SpanFromContext(ctx)
+	return h.err
+}
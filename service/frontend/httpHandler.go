@@ -0,0 +1,500 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/pborman/uuid"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"go.uber.org/yarpc/yarpcerrors"
+)
+
+const (
+	// httpAPIPrefix is the path all the routes of the HTTP gateway live under
+	httpAPIPrefix = "/api/v1/domains/"
+	// httpMaxRequestSize caps the body accepted by the HTTP gateway
+	httpMaxRequestSize = 4 * 1024 * 1024
+	// httpSpanPrefix is the prefix of the name of the request spans
+	httpSpanPrefix = "HTTP "
+)
+
+var _ http.Handler = (*HTTPHandler)(nil)
+
+type (
+	// HTTPHandler exposes the WorkflowService as JSON over REST style
+	// routes. Requests are decoded into the thrift structs and passed
+	// to the handler behind the TChannel endpoint, so they go through
+	// the same rate limiting and DC redirection:
+	//
+	//   GET  /api/v1/domains/{domain}
+	//   POST /api/v1/domains/{domain}/workflows
+	//   GET  /api/v1/domains/{domain}/workflows?status=open|closed
+	//   GET  /api/v1/domains/{domain}/workflows/{workflowID}
+	//   GET  /api/v1/domains/{domain}/workflows/{workflowID}/history
+	//   POST /api/v1/domains/{domain}/workflows/{workflowID}/signal
+	//   POST /api/v1/domains/{domain}/workflows/{workflowID}/query
+	//   POST /api/v1/domains/{domain}/workflows/{workflowID}/cancel
+	//   POST /api/v1/domains/{domain}/workflows/{workflowID}/terminate
+	//
+	// The routes on a workflow accept an optional runId query parameter.
+	//
+	// Each request is traced by a server span, child of the span
+	// propagated in the HTTP headers when the caller is traced.
+	HTTPHandler struct {
+		handler workflowserviceserver.Interface
+		tracer  opentracing.Tracer
+		logger  bark.Logger
+	}
+
+	// httpStatusRecorder records the status written for the request span
+	httpStatusRecorder struct {
+		http.ResponseWriter
+		status int
+	}
+
+	// httpError is the body written for failed requests
+	httpError struct {
+		Type    string      `json:"type"`
+		Message string      `json:"message"`
+		Details interface{} `json:"details,omitempty"`
+	}
+
+	httpRoute struct {
+		domain     string
+		workflowID string
+		action     string
+	}
+)
+
+// NewHTTPHandler creates a HTTP/JSON gateway for the cadence service, frontend
+func NewHTTPHandler(handler workflowserviceserver.Interface, tracer opentracing.Tracer, logger bark.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		handler: handler,
+		tracer:  tracer,
+		logger:  logger,
+	}
+}
+
+// ServeHTTP routes the request to the WorkflowService operation
+func (h *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parent, _ := h.tracer.Extract(opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(r.Header))
+	span := h.tracer.StartSpan(httpSpanPrefix+r.Method, ext.RPCServerOption(parent))
+	defer span.Finish()
+	ext.Component.Set(span, "cadence-http-gateway")
+	ext.HTTPMethod.Set(span, r.Method)
+	ext.HTTPUrl.Set(span, r.URL.Path)
+
+	recorder := &httpStatusRecorder{ResponseWriter: w, status: http.StatusOK}
+	h.serve(recorder, r.WithContext(opentracing.ContextWithSpan(r.Context(), span)))
+	ext.HTTPStatusCode.Set(span, uint16(recorder.status))
+	if recorder.status >= http.StatusInternalServerError {
+		ext.Error.Set(span, true)
+	}
+}
+
+func (h *HTTPHandler) serve(w http.ResponseWriter, r *http.Request) {
+	route, ok := parseHTTPRoute(r.URL.EscapedPath())
+	if !ok {
+		h.writeError(w, http.StatusNotFound, &httpError{Type: "NotFound", Message: "no route for " + r.URL.Path})
+		return
+	}
+	r.Body = http.MaxBytesReader(w, r.Body, httpMaxRequestSize)
+
+	var response interface{}
+	var err error
+	switch {
+	case route.workflowID == "" && route.action == "" && r.Method == http.MethodGet:
+		response, err = h.describeDomain(r, route)
+	case route.workflowID == "" && route.action == "workflows" && r.Method == http.MethodPost:
+		response, err = h.startWorkflow(r, route)
+	case route.workflowID == "" && route.action == "workflows" && r.Method == http.MethodGet:
+		response, err = h.listWorkflows(r, route)
+	case route.workflowID != "" && route.action == "" && r.Method == http.MethodGet:
+		response, err = h.describeWorkflow(r, route)
+	case route.workflowID != "" && route.action == "history" && r.Method == http.MethodGet:
+		response, err = h.getHistory(r, route)
+	case route.workflowID != "" && route.action == "signal" && r.Method == http.MethodPost:
+		response, err = h.signalWorkflow(r, route)
+	case route.workflowID != "" && route.action == "query" && r.Method == http.MethodPost:
+		response, err = h.queryWorkflow(r, route)
+	case route.workflowID != "" && route.action == "cancel" && r.Method == http.MethodPost:
+		response, err = h.cancelWorkflow(r, route)
+	case route.workflowID != "" && route.action == "terminate" && r.Method == http.MethodPost:
+		response, err = h.terminateWorkflow(r, route)
+	default:
+		h.writeError(w, http.StatusMethodNotAllowed, &httpError{
+			Type:    "MethodNotAllowed",
+			Message: fmt.Sprintf("%v is not allowed on %v", r.Method, r.URL.Path),
+		})
+		return
+	}
+	if err != nil {
+		status, body := toHTTPError(err)
+		if status == http.StatusInternalServerError {
+			h.logger.WithField(logging.TagErr, err).Errorf("HTTP gateway request %v %v failed", r.Method, r.URL.Path)
+		}
+		h.writeError(w, status, body)
+		return
+	}
+	if response == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	h.writeJSON(w, http.StatusOK, response)
+}
+
+func (h *HTTPHandler) describeDomain(r *http.Request, route httpRoute) (interface{}, error) {
+	return h.handler.DescribeDomain(r.Context(), &shared.DescribeDomainRequest{
+		Name: common.StringPtr(route.domain),
+	})
+}
+
+func (h *HTTPHandler) startWorkflow(r *http.Request, route httpRoute) (interface{}, error) {
+	request := &shared.StartWorkflowExecutionRequest{}
+	if err := decodeHTTPBody(r, request); err != nil {
+		return nil, err
+	}
+	request.Domain = common.StringPtr(route.domain)
+	if request.GetRequestId() == "" {
+		request.RequestId = common.StringPtr(uuid.New())
+	}
+	return h.handler.StartWorkflowExecution(r.Context(), request)
+}
+
+func (h *HTTPHandler) listWorkflows(r *http.Request, route httpRoute) (interface{}, error) {
+	query := r.URL.Query()
+	filter, err := parseStartTimeFilter(query.Get("earliestTime"), query.Get("latestTime"))
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := parseInt32Param("pageSize", query.Get("pageSize"))
+	if err != nil {
+		return nil, err
+	}
+	nextPageToken, err := parseTokenParam(query.Get("nextPageToken"))
+	if err != nil {
+		return nil, err
+	}
+	var executionFilter *shared.WorkflowExecutionFilter
+	if workflowID := query.Get("workflowId"); workflowID != "" {
+		executionFilter = &shared.WorkflowExecutionFilter{WorkflowId: common.StringPtr(workflowID)}
+	}
+	var typeFilter *shared.WorkflowTypeFilter
+	if workflowType := query.Get("workflowType"); workflowType != "" {
+		typeFilter = &shared.WorkflowTypeFilter{Name: common.StringPtr(workflowType)}
+	}
+
+	switch status := query.Get("status"); status {
+	case "", "open":
+		return h.handler.ListOpenWorkflowExecutions(r.Context(), &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(route.domain),
+			MaximumPageSize: pageSize,
+			NextPageToken:   nextPageToken,
+			StartTimeFilter: filter,
+			ExecutionFilter: executionFilter,
+			TypeFilter:      typeFilter,
+		})
+	case "closed":
+		request := &shared.ListClosedWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(route.domain),
+			MaximumPageSize: pageSize,
+			NextPageToken:   nextPageToken,
+			StartTimeFilter: filter,
+			ExecutionFilter: executionFilter,
+			TypeFilter:      typeFilter,
+		}
+		if closeStatus := query.Get("closeStatus"); closeStatus != "" {
+			var statusFilter shared.WorkflowExecutionCloseStatus
+			if err := statusFilter.UnmarshalText([]byte(strings.ToUpper(closeStatus))); err != nil {
+				return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid closeStatus %q", closeStatus)}
+			}
+			request.StatusFilter = &statusFilter
+		}
+		return h.handler.ListClosedWorkflowExecutions(r.Context(), request)
+	default:
+		return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid status %q, expected open or closed", status)}
+	}
+}
+
+func (h *HTTPHandler) describeWorkflow(r *http.Request, route httpRoute) (interface{}, error) {
+	return h.handler.DescribeWorkflowExecution(r.Context(), &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(route.domain),
+		Execution: workflowExecutionFromRoute(r, route),
+	})
+}
+
+func (h *HTTPHandler) getHistory(r *http.Request, route httpRoute) (interface{}, error) {
+	query := r.URL.Query()
+	pageSize, err := parseInt32Param("pageSize", query.Get("pageSize"))
+	if err != nil {
+		return nil, err
+	}
+	nextPageToken, err := parseTokenParam(query.Get("nextPageToken"))
+	if err != nil {
+		return nil, err
+	}
+	request := &shared.GetWorkflowExecutionHistoryRequest{
+		Domain:          common.StringPtr(route.domain),
+		Execution:       workflowExecutionFromRoute(r, route),
+		MaximumPageSize: pageSize,
+		NextPageToken:   nextPageToken,
+	}
+	if waitForNewEvent := query.Get("waitForNewEvent"); waitForNewEvent != "" {
+		wait, err := strconv.ParseBool(waitForNewEvent)
+		if err != nil {
+			return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid waitForNewEvent %q", waitForNewEvent)}
+		}
+		request.WaitForNewEvent = common.BoolPtr(wait)
+	}
+	if filterType := query.Get("historyEventFilterType"); filterType != "" {
+		var eventFilter shared.HistoryEventFilterType
+		if err := eventFilter.UnmarshalText([]byte(strings.ToUpper(filterType))); err != nil {
+			return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid historyEventFilterType %q", filterType)}
+		}
+		request.HistoryEventFilterType = &eventFilter
+	}
+	return h.handler.GetWorkflowExecutionHistory(r.Context(), request)
+}
+
+func (h *HTTPHandler) signalWorkflow(r *http.Request, route httpRoute) (interface{}, error) {
+	request := &shared.SignalWorkflowExecutionRequest{}
+	if err := decodeHTTPBody(r, request); err != nil {
+		return nil, err
+	}
+	request.Domain = common.StringPtr(route.domain)
+	request.WorkflowExecution = workflowExecutionFromRoute(r, route)
+	return nil, h.handler.SignalWorkflowExecution(r.Context(), request)
+}
+
+func (h *HTTPHandler) queryWorkflow(r *http.Request, route httpRoute) (interface{}, error) {
+	request := &shared.QueryWorkflowRequest{}
+	if err := decodeHTTPBody(r, request); err != nil {
+		return nil, err
+	}
+	request.Domain = common.StringPtr(route.domain)
+	request.Execution = workflowExecutionFromRoute(r, route)
+	return h.handler.QueryWorkflow(r.Context(), request)
+}
+
+func (h *HTTPHandler) cancelWorkflow(r *http.Request, route httpRoute) (interface{}, error) {
+	request := &shared.RequestCancelWorkflowExecutionRequest{}
+	if err := decodeHTTPBody(r, request); err != nil {
+		return nil, err
+	}
+	request.Domain = common.StringPtr(route.domain)
+	request.WorkflowExecution = workflowExecutionFromRoute(r, route)
+	return nil, h.handler.RequestCancelWorkflowExecution(r.Context(), request)
+}
+
+func (h *HTTPHandler) terminateWorkflow(r *http.Request, route httpRoute) (interface{}, error) {
+	request := &shared.TerminateWorkflowExecutionRequest{}
+	if err := decodeHTTPBody(r, request); err != nil {
+		return nil, err
+	}
+	request.Domain = common.StringPtr(route.domain)
+	request.WorkflowExecution = workflowExecutionFromRoute(r, route)
+	return nil, h.handler.TerminateWorkflowExecution(r.Context(), request)
+}
+
+func (h *HTTPHandler) writeError(w http.ResponseWriter, status int, body *httpError) {
+	h.writeJSON(w, status, body)
+}
+
+func (h *HTTPHandler) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		h.logger.WithField(logging.TagErr, err).Error("HTTP gateway failed to encode response")
+		status = http.StatusInternalServerError
+		data, _ = json.Marshal(&httpError{Type: "InternalServiceError", Message: err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// parseHTTPRoute splits /api/v1/domains/{domain}[/workflows[/{workflowID}[/{action}]]]
+// into its parts, the action of the workflows collection is "workflows"
+// parseHTTPRoute splits the escaped path before unescaping its segments,
+// so that domains and workflow IDs may contain an escaped "/"
+func parseHTTPRoute(escapedPath string) (httpRoute, bool) {
+	if !strings.HasPrefix(escapedPath, httpAPIPrefix) {
+		return httpRoute{}, false
+	}
+	parts := strings.Split(strings.TrimSuffix(strings.TrimPrefix(escapedPath, httpAPIPrefix), "/"), "/")
+	for i, part := range parts {
+		unescaped, err := url.PathUnescape(part)
+		if err != nil {
+			return httpRoute{}, false
+		}
+		parts[i] = unescaped
+	}
+	if parts[0] == "" {
+		return httpRoute{}, false
+	}
+	route := httpRoute{domain: parts[0]}
+	switch len(parts) {
+	case 1:
+		return route, true
+	case 2, 3, 4:
+		if parts[1] != "workflows" {
+			return httpRoute{}, false
+		}
+		route.action = "workflows"
+		if len(parts) == 2 {
+			return route, true
+		}
+		if parts[2] == "" {
+			return httpRoute{}, false
+		}
+		route.workflowID = parts[2]
+		route.action = ""
+		if len(parts) == 4 {
+			route.action = parts[3]
+		}
+		return route, true
+	}
+	return httpRoute{}, false
+}
+
+func workflowExecutionFromRoute(r *http.Request, route httpRoute) *shared.WorkflowExecution {
+	execution := &shared.WorkflowExecution{WorkflowId: common.StringPtr(route.workflowID)}
+	if runID := r.URL.Query().Get("runId"); runID != "" {
+		execution.RunId = common.StringPtr(runID)
+	}
+	return execution
+}
+
+func decodeHTTPBody(r *http.Request, request interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(request); err != nil && err != io.EOF {
+		return &shared.BadRequestError{Message: fmt.Sprintf("invalid request body: %v", err)}
+	}
+	return nil
+}
+
+func parseInt32Param(name string, value string) (*int32, error) {
+	if value == "" {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid %v %q", name, value)}
+	}
+	return common.Int32Ptr(int32(v)), nil
+}
+
+// parseTokenParam decodes a page token, tokens are []byte in the thrift
+// responses so they are base64 encoded in the JSON bodies
+func parseTokenParam(value string) ([]byte, error) {
+	if value == "" {
+		return nil, nil
+	}
+	token, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid nextPageToken %q", value)}
+	}
+	return token, nil
+}
+
+// parseStartTimeFilter parses the RFC3339 bounds of a listing, the
+// filter defaults to all the workflows started so far
+func parseStartTimeFilter(earliest string, latest string) (*shared.StartTimeFilter, error) {
+	filter := &shared.StartTimeFilter{
+		EarliestTime: common.Int64Ptr(0),
+		LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+	}
+	if earliest != "" {
+		t, err := time.Parse(time.RFC3339, earliest)
+		if err != nil {
+			return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid earliestTime %q", earliest)}
+		}
+		filter.EarliestTime = common.Int64Ptr(t.UnixNano())
+	}
+	if latest != "" {
+		t, err := time.Parse(time.RFC3339, latest)
+		if err != nil {
+			return nil, &shared.BadRequestError{Message: fmt.Sprintf("invalid latestTime %q", latest)}
+		}
+		filter.LatestTime = common.Int64Ptr(t.UnixNano())
+	}
+	return filter, nil
+}
+
+// toHTTPError maps the exceptions of the handler to a status code
+// and a body carrying the exception fields, in line with the codes
+// the gRPC endpoint uses
+func toHTTPError(err error) (int, *httpError) {
+	status := http.StatusInternalServerError
+	switch err.(type) {
+	case *shared.BadRequestError, *shared.DomainNotActiveError, *shared.QueryFailedError:
+		status = http.StatusBadRequest
+	case *shared.EntityNotExistsError:
+		status = http.StatusNotFound
+	case *shared.WorkflowExecutionAlreadyStartedError, *shared.DomainAlreadyExistsError,
+		*shared.CancellationAlreadyRequestedError:
+		status = http.StatusConflict
+	case *shared.ServiceBusyError, *shared.LimitExceededError:
+		status = http.StatusTooManyRequests
+	case *shared.InternalServiceError:
+		status = http.StatusInternalServerError
+	default:
+		if err == context.DeadlineExceeded || yarpcerrors.FromError(err).Code() == yarpcerrors.CodeDeadlineExceeded {
+			return http.StatusGatewayTimeout, &httpError{Type: "DeadlineExceeded", Message: err.Error()}
+		}
+		return status, &httpError{Type: "InternalServiceError", Message: err.Error()}
+	}
+	return status, &httpError{
+		Type:    reflect.Indirect(reflect.ValueOf(err)).Type().Name(),
+		Message: errorMessage(err),
+		Details: err,
+	}
+}
+
+func errorMessage(err error) string {
+	if e, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
+		return e.GetMessage()
+	}
+	if v := reflect.Indirect(reflect.ValueOf(err)).FieldByName("Message"); v.IsValid() && v.Kind() == reflect.String {
+		return v.String()
+	}
+	return err.Error()
+}
+
+func (r *httpStatusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
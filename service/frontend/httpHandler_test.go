@@ -0,0 +1,293 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+type (
+	httpHandlerSuite struct {
+		*require.Assertions
+		suite.Suite
+		handler *fakeWorkflowHandler
+		tracer  *mocktracer.MockTracer
+		server  *httptest.Server
+	}
+)
+
+func TestHTTPHandlerSuite(t *testing.T) {
+	suite.Run(t, new(httpHandlerSuite))
+}
+
+func (s *httpHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.handler = &fakeWorkflowHandler{}
+	s.tracer = mocktracer.New()
+	s.server = httptest.NewServer(NewHTTPHandler(s.handler, s.tracer, bark.NewLoggerFromLogrus(logrus.New())))
+}
+
+func (s *httpHandlerSuite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *httpHandlerSuite) TestStartWorkflowExecution() {
+	response := s.do(http.MethodPost, "/api/v1/domains/domain/workflows", `{
+		"workflowId": "workflow-id",
+		"workflowType": {"name": "workflow-type"},
+		"taskList": {"name": "tasklist"},
+		"input": "aW5wdXQ=",
+		"executionStartToCloseTimeoutSeconds": 100,
+		"workflowIdReusePolicy": "RejectDuplicate"
+	}`)
+	defer response.Body.Close()
+	s.Equal(http.StatusOK, response.StatusCode)
+	s.Equal("application/json", response.Header.Get("Content-Type"))
+
+	var body shared.StartWorkflowExecutionResponse
+	s.NoError(json.NewDecoder(response.Body).Decode(&body))
+	s.Equal("run-id", body.GetRunId())
+
+	request := s.handler.startRequest
+	s.Equal("domain", request.GetDomain())
+	s.Equal("workflow-id", request.GetWorkflowId())
+	s.Equal("workflow-type", request.GetWorkflowType().GetName())
+	s.Equal([]byte("input"), request.Input)
+	s.Equal(int32(100), request.GetExecutionStartToCloseTimeoutSeconds())
+	s.Equal(shared.WorkflowIdReusePolicyRejectDuplicate, request.GetWorkflowIdReusePolicy())
+	s.NotEmpty(request.GetRequestId())
+}
+
+func (s *httpHandlerSuite) TestStartWorkflowExecution_InvalidBody() {
+	response := s.do(http.MethodPost, "/api/v1/domains/domain/workflows", `{"workflowId": 1}`)
+	defer response.Body.Close()
+	s.Equal(http.StatusBadRequest, response.StatusCode)
+	s.Equal("BadRequestError", s.decodeError(response).Type)
+	s.Nil(s.handler.startRequest)
+}
+
+func (s *httpHandlerSuite) TestStartWorkflowExecution_AlreadyStarted() {
+	s.handler.err = &shared.WorkflowExecutionAlreadyStartedError{
+		Message: common.StringPtr("already started"),
+		RunId:   common.StringPtr("run-id"),
+	}
+	response := s.do(http.MethodPost, "/api/v1/domains/domain/workflows", `{}`)
+	defer response.Body.Close()
+	s.Equal(http.StatusConflict, response.StatusCode)
+	body := s.decodeError(response)
+	s.Equal("WorkflowExecutionAlreadyStartedError", body.Type)
+	s.Equal("already started", body.Message)
+	s.Equal("run-id", body.Details.(map[string]interface{})["runId"])
+}
+
+func (s *httpHandlerSuite) TestSignalWorkflowExecution() {
+	response := s.do(http.MethodPost, "/api/v1/domains/domain/workflows/workflow-id/signal?runId=run-id", `{
+		"signalName": "signal",
+		"input": "aW5wdXQ="
+	}`)
+	defer response.Body.Close()
+	s.Equal(http.StatusNoContent, response.StatusCode)
+
+	request := s.handler.signalRequest
+	s.Equal("domain", request.GetDomain())
+	s.Equal("workflow-id", request.GetWorkflowExecution().GetWorkflowId())
+	s.Equal("run-id", request.GetWorkflowExecution().GetRunId())
+	s.Equal("signal", request.GetSignalName())
+	s.Equal([]byte("input"), request.Input)
+}
+
+func (s *httpHandlerSuite) TestSignalWorkflowExecution_EscapedWorkflowID() {
+	response := s.do(http.MethodPost, "/api/v1/domains/domain/workflows/orders%2F42%20a/signal", `{"signalName": "signal"}`)
+	defer response.Body.Close()
+	s.Equal(http.StatusNoContent, response.StatusCode)
+
+	request := s.handler.signalRequest
+	s.Equal("domain", request.GetDomain())
+	s.Equal("orders/42 a", request.GetWorkflowExecution().GetWorkflowId())
+}
+
+func (s *httpHandlerSuite) TestRequestSpan() {
+	parent := s.tracer.StartSpan("client")
+	request, err := http.NewRequest(http.MethodPost, s.server.URL+"/api/v1/domains/domain/workflows/workflow-id/signal", strings.NewReader(`{}`))
+	s.NoError(err)
+	s.NoError(s.tracer.Inject(parent.Context(), opentracing.HTTPHeaders, opentracing.HTTPHeadersCarrier(request.Header)))
+	response, err := http.DefaultClient.Do(request)
+	s.NoError(err)
+	defer response.Body.Close()
+	s.Equal(http.StatusNoContent, response.StatusCode)
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 1)
+	span := spans[0]
+	s.Equal("HTTP POST", span.OperationName)
+	s.Equal(parent.(*mocktracer.MockSpan).SpanContext.SpanID, span.ParentID)
+	s.Equal(uint16(http.StatusNoContent), span.Tag("http.status_code"))
+	s.Equal(span, s.handler.signalSpan)
+}
+
+func (s *httpHandlerSuite) TestRequestSpan_Error() {
+	s.handler.err = &shared.InternalServiceError{Message: "internal"}
+	response := s.do(http.MethodPost, "/api/v1/domains/domain/workflows/workflow-id/signal", `{}`)
+	defer response.Body.Close()
+	s.Equal(http.StatusInternalServerError, response.StatusCode)
+
+	spans := s.tracer.FinishedSpans()
+	s.Len(spans, 1)
+	s.Equal(0, spans[0].ParentID)
+	s.Equal(uint16(http.StatusInternalServerError), spans[0].Tag("http.status_code"))
+	s.Equal(true, spans[0].Tag("error"))
+}
+
+func (s *httpHandlerSuite) TestSignalWorkflowExecution_Errors() {
+	testCases := []struct {
+		err    error
+		status int
+	}{
+		{&shared.BadRequestError{Message: "bad request"}, http.StatusBadRequest},
+		{&shared.EntityNotExistsError{Message: "not found"}, http.StatusNotFound},
+		{&shared.DomainNotActiveError{Message: "not active"}, http.StatusBadRequest},
+		{&shared.ServiceBusyError{Message: "busy"}, http.StatusTooManyRequests},
+		{&shared.LimitExceededError{Message: "limit"}, http.StatusTooManyRequests},
+		{&shared.InternalServiceError{Message: "internal"}, http.StatusInternalServerError},
+		{context.DeadlineExceeded, http.StatusGatewayTimeout},
+	}
+	for _, tc := range testCases {
+		s.handler.err = tc.err
+		response := s.do(http.MethodPost, "/api/v1/domains/domain/workflows/workflow-id/signal", `{}`)
+		s.Equal(tc.status, response.StatusCode, "%T", tc.err)
+		s.NotEmpty(s.decodeError(response).Message)
+		response.Body.Close()
+	}
+}
+
+func (s *httpHandlerSuite) TestListOpenWorkflowExecutions() {
+	token := base64.StdEncoding.EncodeToString([]byte("token"))
+	response := s.do(http.MethodGet, "/api/v1/domains/domain/workflows?status=open&pageSize=10&workflowType=type"+
+		"&earliestTime=2019-01-01T00:00:00Z&nextPageToken="+token, "")
+	defer response.Body.Close()
+	s.Equal(http.StatusOK, response.StatusCode)
+
+	request := s.handler.listOpenRequest
+	s.Equal("domain", request.GetDomain())
+	s.Equal(int32(10), request.GetMaximumPageSize())
+	s.Equal("type", request.GetTypeFilter().GetName())
+	s.Nil(request.ExecutionFilter)
+	s.Equal([]byte("token"), request.NextPageToken)
+	s.Equal(int64(1546300800000000000), request.GetStartTimeFilter().GetEarliestTime())
+	s.True(request.GetStartTimeFilter().GetLatestTime() > request.GetStartTimeFilter().GetEarliestTime())
+}
+
+func (s *httpHandlerSuite) TestListWorkflowExecutions_InvalidStatus() {
+	response := s.do(http.MethodGet, "/api/v1/domains/domain/workflows?status=running", "")
+	defer response.Body.Close()
+	s.Equal(http.StatusBadRequest, response.StatusCode)
+}
+
+func (s *httpHandlerSuite) TestGetWorkflowExecutionHistory() {
+	response := s.do(http.MethodGet, "/api/v1/domains/domain/workflows/workflow-id/history"+
+		"?waitForNewEvent=true&historyEventFilterType=close_event", "")
+	defer response.Body.Close()
+	s.Equal(http.StatusOK, response.StatusCode)
+
+	var body map[string]interface{}
+	s.NoError(json.NewDecoder(response.Body).Decode(&body))
+	events := body["history"].(map[string]interface{})["events"].([]interface{})
+	s.Equal("WorkflowExecutionStarted", events[0].(map[string]interface{})["eventType"])
+
+	request := s.handler.historyRequest
+	s.Equal("workflow-id", request.GetExecution().GetWorkflowId())
+	s.Nil(request.GetExecution().RunId)
+	s.True(request.GetWaitForNewEvent())
+	s.Equal(shared.HistoryEventFilterTypeCloseEvent, request.GetHistoryEventFilterType())
+}
+
+func (s *httpHandlerSuite) TestRouting() {
+	testCases := []struct {
+		method string
+		path   string
+		status int
+	}{
+		{http.MethodGet, "/api/v1/domains/", http.StatusNotFound},
+		{http.MethodGet, "/api/v2/domains/domain", http.StatusNotFound},
+		{http.MethodGet, "/api/v1/domains/domain/tasklists", http.StatusNotFound},
+		{http.MethodGet, "/api/v1/domains/domain/workflows/id/signal/extra", http.StatusNotFound},
+		{http.MethodDelete, "/api/v1/domains/domain/workflows", http.StatusMethodNotAllowed},
+		{http.MethodGet, "/api/v1/domains/domain/workflows/id/signal", http.StatusMethodNotAllowed},
+		{http.MethodPost, "/api/v1/domains/domain/workflows/id/pause", http.StatusMethodNotAllowed},
+	}
+	for _, tc := range testCases {
+		response := s.do(tc.method, tc.path, "")
+		s.Equal(tc.status, response.StatusCode, "%v %v", tc.method, tc.path)
+		response.Body.Close()
+	}
+}
+
+func (s *httpHandlerSuite) do(method string, path string, body string) *http.Response {
+	request, err := http.NewRequest(method, s.server.URL+path, strings.NewReader(body))
+	s.NoError(err)
+	response, err := http.DefaultClient.Do(request)
+	s.NoError(err)
+	return response
+}
+
+func (s *httpHandlerSuite) decodeError(response *http.Response) *httpError {
+	var body httpError
+	s.NoError(json.NewDecoder(response.Body).Decode(&body))
+	return &body
+}
+
+func (h *fakeWorkflowHandler) ListOpenWorkflowExecutions(
+	ctx context.Context,
+	request *shared.ListOpenWorkflowExecutionsRequest,
+) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+
+	h.listOpenRequest = request
+	return &shared.ListOpenWorkflowExecutionsResponse{}, h.err
+}
+
+func (h *fakeWorkflowHandler) GetWorkflowExecutionHistory(
+	ctx context.Context,
+	request *shared.GetWorkflowExecutionHistoryRequest,
+) (*shared.GetWorkflowExecutionHistoryResponse, error) {
+
+	h.historyRequest = request
+	return &shared.GetWorkflowExecutionHistoryResponse{
+		History: &shared.History{Events: []*shared.HistoryEvent{{
+			EventId:   common.Int64Ptr(1),
+			EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+		}}},
+	}, h.err
+}
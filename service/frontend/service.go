@@ -21,9 +21,13 @@
 package frontend
 
 import (
+	"net/http"
+
 	"github.com/uber/cadence/.gen/go/cadence/workflowserviceserver"
+	apiv1 "github.com/uber/cadence/.gen/proto/uber/cadence/api/v1"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/mocks"
@@ -49,6 +53,10 @@ type Config struct {
 	ESIndexMaxResultWindow          dynamicconfig.IntPropertyFn
 	HistoryMaxPageSize              dynamicconfig.IntPropertyFnWithDomainFilter
 	RPS                             dynamicconfig.IntPropertyFn
+	DomainRPS                       dynamicconfig.IntPropertyFnWithDomainFilter
+	DomainRPSWriteWeight            dynamicconfig.IntPropertyFn
+	DomainRPSPollWeight             dynamicconfig.IntPropertyFn
+	DomainRPSReadWeight             dynamicconfig.IntPropertyFn
 	MaxIDLengthLimit                dynamicconfig.IntPropertyFn
 
 	// Persistence settings
@@ -85,6 +93,10 @@ func NewConfig(dc *dynamicconfig.Collection, enableVisibilityToKafka bool) *Conf
 		ESIndexMaxResultWindow:              dc.GetIntProperty(dynamicconfig.FrontendESIndexMaxResultWindow, 10000),
 		HistoryMaxPageSize:                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendHistoryMaxPageSize, common.GetHistoryMaxPageSize),
 		RPS:                                 dc.GetIntProperty(dynamicconfig.FrontendRPS, 1200),
+		DomainRPS:                           dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendDomainRPS, 0),
+		DomainRPSWriteWeight:                dc.GetIntProperty(dynamicconfig.FrontendDomainRPSWriteWeight, 0),
+		DomainRPSPollWeight:                 dc.GetIntProperty(dynamicconfig.FrontendDomainRPSPollWeight, 0),
+		DomainRPSReadWeight:                 dc.GetIntProperty(dynamicconfig.FrontendDomainRPSReadWeight, 0),
 		MaxIDLengthLimit:                    dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		HistoryMgrNumConns:                  dc.GetIntProperty(dynamicconfig.FrontendHistoryMgrNumConns, 10),
 		MaxDecisionStartToCloseTimeout:      dc.GetIntPropertyFilteredByDomain(dynamicconfig.MaxDecisionStartToCloseTimeout, 600),
@@ -162,6 +174,7 @@ func (s *Service) Start() {
 		visibilityFromES = persistence.NewVisibilitySamplingClient(visibilityFromES, visibilityConfigForES, base.GetMetricsClient(), log)
 		// wrap with metrics
 		visibilityFromES = elasticsearch.NewVisibilityMetricsClient(visibilityFromES, base.GetMetricsClient(), log)
+		base.GetHealthRegistry().Register(healthcheck.ElasticSearchCheckName, healthcheck.ElasticSearchCheck(params.ESClient))
 	}
 	visibility := persistence.NewVisibilityManagerWrapper(visibilityFromDB, visibilityFromES, s.config.EnableReadVisibilityFromES)
 
@@ -181,6 +194,7 @@ func (s *Service) Start() {
 		if err != nil {
 			log.Fatalf("Creating kafka producer failed: %v", err)
 		}
+		base.GetHealthRegistry().Register(healthcheck.KafkaCheckName, healthcheck.KafkaCheck(base.GetMessagingClient()))
 	} else {
 		kafkaProducer = &mocks.KafkaProducer{}
 	}
@@ -190,13 +204,27 @@ func (s *Service) Start() {
 	wfHandler.Start()
 	dcRedirectionHandler := NewDCRedirectionHandler(wfHandler, params.DCRedirectionPolicy)
 	base.GetDispatcher().Register(workflowserviceserver.New(dcRedirectionHandler))
+	base.GetDispatcher().Register(apiv1.BuildWorkflowServiceYARPCProcedures(NewGRPCHandler(dcRedirectionHandler)))
 	adminHandler := NewAdminHandler(base, pConfig.NumHistoryShards, metadata, history, historyV2)
 	adminHandler.Start()
 
+	var httpServer *http.Server
+	if listener := params.RPCFactory.CreateHTTPListener(); listener != nil {
+		httpServer = &http.Server{Handler: NewHTTPHandler(dcRedirectionHandler, base.GetTracer(), log)}
+		go func() {
+			if err := httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+				log.WithField(logging.TagErr, err).Error("HTTP gateway stopped")
+			}
+		}()
+	}
+
 	log.Infof("%v started", common.FrontendServiceName)
 
 	<-s.stopC
 
+	if httpServer != nil {
+		httpServer.Close()
+	}
 	base.Stop()
 }
 
@@ -48,9 +48,11 @@ import (
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cron"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/tokenbucket"
@@ -75,9 +77,11 @@ type (
 		metricsClient     metrics.Client
 		startWG           sync.WaitGroup
 		rateLimiter       tokenbucket.TokenBucket
+		domainRateLimiter *domainRateLimiter
 		config            *Config
 		domainReplicator  DomainReplicator
 		blobstoreClient   blobstore.Client
+		payloadOffloader  payload.Offloader
 		service.Service
 	}
 
@@ -150,17 +154,19 @@ func NewWorkflowHandler(sVice service.Service, config *Config, metadataMgr persi
 	visibilityMgr persistence.VisibilityManager, kafkaProducer messaging.Producer,
 	blobstoreClient blobstore.Client) *WorkflowHandler {
 	handler := &WorkflowHandler{
-		Service:          sVice,
-		config:           config,
-		metadataMgr:      metadataMgr,
-		historyMgr:       historyMgr,
-		historyV2Mgr:     historyV2Mgr,
-		visibilityMgr:    visibilityMgr,
-		tokenSerializer:  common.NewJSONTaskTokenSerializer(),
-		domainCache:      cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
-		rateLimiter:      tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
-		domainReplicator: NewDomainReplicator(kafkaProducer, sVice.GetLogger()),
-		blobstoreClient:  blobstoreClient,
+		Service:           sVice,
+		config:            config,
+		metadataMgr:       metadataMgr,
+		historyMgr:        historyMgr,
+		historyV2Mgr:      historyV2Mgr,
+		visibilityMgr:     visibilityMgr,
+		tokenSerializer:   common.NewJSONTaskTokenSerializer(),
+		domainCache:       cache.NewDomainCache(metadataMgr, sVice.GetClusterMetadata(), sVice.GetMetricsClient(), sVice.GetLogger()),
+		rateLimiter:       tokenbucket.New(config.RPS(), clock.NewRealTimeSource()),
+		domainRateLimiter: newDomainRateLimiter(config),
+		domainReplicator:  NewDomainReplicator(kafkaProducer, sVice.GetLogger()),
+		blobstoreClient:   blobstoreClient,
+		payloadOffloader:  payload.NewOffloader(blobstoreClient, nil),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -176,6 +182,9 @@ func (wh *WorkflowHandler) Start() error {
 	wh.Service.GetDispatcher().Register(metaserver.New(wh))
 	wh.Service.Start()
 	wh.domainCache.Start()
+	wh.GetHealthRegistry().Register(healthcheck.PersistenceCheckName, healthcheck.PersistenceCheck(wh.metadataMgr))
+	wh.GetHealthRegistry().Register(healthcheck.DomainCacheCheckName,
+		healthcheck.DomainCacheCheck(wh.domainCache, healthcheck.DefaultDomainCacheMaxAge))
 
 	wh.history = wh.GetClientBean().GetHistoryClient()
 	wh.matchingRawClient = wh.GetClientBean().GetMatchingClient()
@@ -195,12 +204,11 @@ func (wh *WorkflowHandler) Stop() {
 	wh.Service.Stop()
 }
 
-// Health is for health check
+// Health is for health check, it reports the status of the dependencies of the service
 func (wh *WorkflowHandler) Health(ctx context.Context) (*health.HealthStatus, error) {
 	wh.startWG.Wait()
 	wh.GetLogger().Debug("Frontend health check endpoint reached.")
-	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("frontend good")}
-	return hs, nil
+	return wh.GetHealthRegistry().Check(ctx), nil
 }
 
 func (wh *WorkflowHandler) checkPermission(securityToken *string, scope int) error {
@@ -783,6 +791,10 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(pollRequest.GetDomain(), apiClassPoll, scope); err != nil {
+		return nil, err
+	}
+
 	if len(pollRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errDomainTooLong, scope)
 	}
@@ -828,6 +840,11 @@ func (wh *WorkflowHandler) PollForActivityTask(
 			return nil, wh.error(err, scope)
 		}
 	}
+	if resp != nil {
+		if resp.Input, err = wh.payloadOffloader.Resolve(resp.Input); err != nil {
+			return nil, wh.error(err, scope)
+		}
+	}
 	return resp, nil
 }
 
@@ -859,6 +876,11 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	if pollRequest.Domain == nil || pollRequest.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.checkDomainRateLimit(pollRequest.GetDomain(), apiClassPoll, scope); err != nil {
+		return nil, err
+	}
+
 	if len(pollRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errDomainTooLong, scope)
 	}
@@ -980,6 +1002,10 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 		return nil, wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return nil, err
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
@@ -1067,6 +1093,10 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
 		return nil, wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return nil, err
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
@@ -1139,6 +1169,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	if err != nil {
 		return wh.error(err, scope)
 	}
+
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
 	if len(completeRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errIdentityTooLong, scope)
 	}
@@ -1232,6 +1266,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
 		return wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
@@ -1303,6 +1341,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if err != nil {
 		return wh.error(err, scope)
 	}
+
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
 	if len(failedRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errIdentityTooLong, scope)
 	}
@@ -1383,6 +1425,10 @@ func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
 		return wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
@@ -1444,6 +1490,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 		return wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
+
 	if len(cancelRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errIdentityTooLong, scope)
 	}
@@ -1536,6 +1586,10 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
 		return wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(domainEntry.GetInfo().Name)
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(domainEntry.GetInfo().Name)
 
@@ -1603,6 +1657,15 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	domainEntry, err := wh.domainCache.GetDomainByID(taskToken.DomainID)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return nil, err
+	}
+
 	histResp, err := wh.history.RespondDecisionTaskCompleted(ctx, &h.RespondDecisionTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
 		CompleteRequest: completeRequest},
@@ -1675,6 +1738,10 @@ func (wh *WorkflowHandler) RespondDecisionTaskFailed(
 		return wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
+
 	if len(failedRequest.GetIdentity()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errIdentityTooLong, scope)
 	}
@@ -1727,6 +1794,15 @@ func (wh *WorkflowHandler) RespondQueryTaskCompleted(
 		return wh.error(errInvalidTaskToken, scope)
 	}
 
+	domainEntry, err := wh.domainCache.GetDomainByID(queryTaskToken.DomainID)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.checkDomainRateLimit(domainEntry.GetInfo().Name, apiClassPoll, scope); err != nil {
+		return err
+	}
+
 	matchingRequest := &m.RespondQueryTaskCompletedRequest{
 		DomainUUID:       common.StringPtr(queryTaskToken.DomainID),
 		TaskList:         &gen.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
@@ -1848,6 +1924,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(domainName, apiClassWrite, scope); err != nil {
+		return nil, err
+	}
+
 	sizeLimitError := wh.config.BlobSizeLimitError(startRequest.GetDomain())
 	sizeLimitWarn := wh.config.BlobSizeLimitWarn(startRequest.GetDomain())
 	if err := common.CheckEventBlobSizeLimit(len(startRequest.Input), sizeLimitWarn, sizeLimitError, domainID,
@@ -1887,6 +1967,10 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(getRequest.GetDomain(), apiClassRead, scope); err != nil {
+		return nil, err
+	}
+
 	domainScope := wh.metricsClient.Scope(scope, metrics.DomainTag(getRequest.GetDomain()))
 	if err := wh.validateExecutionAndEmitMetrics(getRequest.Execution, scope); err != nil {
 		return nil, err
@@ -2091,6 +2175,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context,
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(signalRequest.GetDomain(), apiClassWrite, scope); err != nil {
+		return err
+	}
+
 	if len(signalRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
 		return wh.error(errDomainTooLong, scope)
 	}
@@ -2160,6 +2248,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(signalWithStartRequest.GetDomain(), apiClassWrite, scope); err != nil {
+		return nil, err
+	}
+
 	if len(signalWithStartRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errDomainTooLong, scope)
 	}
@@ -2295,6 +2387,10 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx context.Context,
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(terminateRequest.GetDomain(), apiClassWrite, scope); err != nil {
+		return err
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(terminateRequest.WorkflowExecution, scope); err != nil {
 		return err
 	}
@@ -2337,6 +2433,10 @@ func (wh *WorkflowHandler) ResetWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(resetRequest.GetDomain(), apiClassWrite, scope); err != nil {
+		return nil, err
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(resetRequest.WorkflowExecution, scope); err != nil {
 		return nil, err
 	}
@@ -2379,6 +2479,10 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(cancelRequest.GetDomain(), apiClassWrite, scope); err != nil {
+		return err
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(cancelRequest.WorkflowExecution, scope); err != nil {
 		return err
 	}
@@ -2420,6 +2524,10 @@ func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(listRequest.GetDomain(), apiClassRead, scope); err != nil {
+		return nil, err
+	}
+
 	if listRequest.StartTimeFilter == nil {
 		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
 	}
@@ -2513,6 +2621,10 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(listRequest.GetDomain(), apiClassRead, scope); err != nil {
+		return nil, err
+	}
+
 	if listRequest.StartTimeFilter == nil {
 		return nil, wh.error(&gen.BadRequestError{Message: "StartTimeFilter is required"}, scope)
 	}
@@ -2603,6 +2715,89 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx context.Context,
 	return resp, nil
 }
 
+// ListArchivedWorkflowExecutions - retrieves info for closed workflow executions in a domain from archival
+func (wh *WorkflowHandler) ListArchivedWorkflowExecutions(ctx context.Context,
+	listRequest *gen.ListArchivedWorkflowExecutionsRequest) (resp *gen.ListArchivedWorkflowExecutionsResponse, retError error) {
+	defer logging.CapturePanic(wh.GetLogger(), &retError)
+
+	scope := metrics.FrontendListArchivedWorkflowExecutionsScope
+	sw := wh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if listRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok, _ := wh.rateLimiter.TryConsume(1); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if listRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.checkDomainRateLimit(listRequest.GetDomain(), apiClassRead, scope); err != nil {
+		return nil, err
+	}
+
+	if listRequest.CloseTimeFilter == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "CloseTimeFilter is required"}, scope)
+	}
+
+	if listRequest.CloseTimeFilter.EarliestTime == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "EarliestTime in CloseTimeFilter is required"}, scope)
+	}
+
+	if listRequest.CloseTimeFilter.LatestTime == nil {
+		return nil, wh.error(&gen.BadRequestError{Message: "LatestTime in CloseTimeFilter is required"}, scope)
+	}
+
+	if listRequest.CloseTimeFilter.GetEarliestTime() < 0 ||
+		listRequest.CloseTimeFilter.GetEarliestTime() > listRequest.CloseTimeFilter.GetLatestTime() {
+		return nil, wh.error(&gen.BadRequestError{Message: "Invalid CloseTimeFilter"}, scope)
+	}
+
+	if listRequest.GetMaximumPageSize() <= 0 {
+		listRequest.MaximumPageSize = common.Int32Ptr(int32(wh.config.VisibilityMaxPageSize(listRequest.GetDomain())))
+	}
+
+	entry, err := wh.domainCache.GetDomain(listRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	archivalBucket := entry.GetConfig().ArchivalBucket
+	if archivalBucket == "" {
+		return nil, wh.error(errDomainHasNeverBeenEnabledForArchival, scope)
+	}
+
+	query := &archiver.ArchivedVisibilityQuery{
+		DomainID:          entry.GetInfo().ID,
+		EarliestCloseTime: listRequest.CloseTimeFilter.GetEarliestTime(),
+		LatestCloseTime:   listRequest.CloseTimeFilter.GetLatestTime(),
+		PageSize:          int(listRequest.GetMaximumPageSize()),
+		NextPageToken:     listRequest.NextPageToken,
+		CloseStatus:       listRequest.StatusFilter,
+	}
+	if listRequest.TypeFilter != nil {
+		query.WorkflowTypeName = listRequest.TypeFilter.Name
+	}
+	if len(query.NextPageToken) != 0 && !archiver.IsValidVisibilityPageToken(query.NextPageToken) {
+		return nil, wh.error(errInvalidNextArchivalPageToken, scope)
+	}
+
+	result, err := archiver.QueryArchivedVisibility(ctx, wh.blobstoreClient, archivalBucket, query)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp = &gen.ListArchivedWorkflowExecutionsResponse{}
+	for _, record := range result.Records {
+		resp.Executions = append(resp.Executions, record.ToWorkflowExecutionInfo())
+	}
+	resp.NextPageToken = result.NextPageToken
+	return resp, nil
+}
+
 // ResetStickyTaskList reset the volatile information in mutable state of a given workflow.
 func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest *gen.ResetStickyTaskListRequest) (resp *gen.ResetStickyTaskListResponse, retError error) {
 	defer logging.CapturePanic(wh.GetLogger(), &retError)
@@ -2619,6 +2814,10 @@ func (wh *WorkflowHandler) ResetStickyTaskList(ctx context.Context, resetRequest
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(resetRequest.GetDomain(), apiClassWrite, scope); err != nil {
+		return nil, err
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(resetRequest.Execution, scope); err != nil {
 		return nil, err
 	}
@@ -2655,6 +2854,10 @@ func (wh *WorkflowHandler) QueryWorkflow(ctx context.Context,
 		return nil, wh.error(errDomainNotSet, scope)
 	}
 
+	if err := wh.checkDomainRateLimit(queryRequest.GetDomain(), apiClassRead, scope); err != nil {
+		return nil, err
+	}
+
 	if err := wh.validateExecutionAndEmitMetrics(queryRequest.Execution, scope); err != nil {
 		return nil, err
 	}
@@ -2758,6 +2961,10 @@ func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx context.Context, reques
 	if request.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.checkDomainRateLimit(request.GetDomain(), apiClassRead, scope); err != nil {
+		return nil, err
+	}
 	domainID, err := wh.domainCache.GetDomainID(request.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -2800,6 +3007,10 @@ func (wh *WorkflowHandler) DescribeTaskList(ctx context.Context, request *gen.De
 	if request.GetDomain() == "" {
 		return nil, wh.error(errDomainNotSet, scope)
 	}
+
+	if err := wh.checkDomainRateLimit(request.GetDomain(), apiClassRead, scope); err != nil {
+		return nil, err
+	}
 	domainID, err := wh.domainCache.GetDomainID(request.GetDomain())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -2892,6 +3103,10 @@ func (wh *WorkflowHandler) getHistory(
 		}
 	}
 
+	if err := wh.payloadOffloader.ResolveEvents(historyEvents); err != nil {
+		return nil, nil, err
+	}
+
 	if len(nextPageToken) == 0 && transientDecision != nil {
 		// Append the transient decision events once we are done enumerating everything from the events table
 		historyEvents = append(historyEvents, transientDecision.ScheduledEvent, transientDecision.StartedEvent)
@@ -2923,6 +3138,23 @@ func (wh *WorkflowHandler) startRequestProfile(scope int) metrics.Stopwatch {
 	return sw
 }
 
+// checkDomainRateLimit takes a token for a request of the api class from the rps quota of the domain,
+// it returns ServiceBusyError if the domain is over its quota. The domain is validated first, so no
+// quota is tracked for domains which do not exist.
+func (wh *WorkflowHandler) checkDomainRateLimit(domain string, class apiClass, scope int) error {
+	if len(domain) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errDomainTooLong, scope)
+	}
+	if _, err := wh.domainCache.GetDomainID(domain); err != nil {
+		return wh.error(err, scope)
+	}
+	if wh.domainRateLimiter.allow(domain, class) {
+		return nil
+	}
+	wh.metricsClient.Scope(scope, metrics.DomainTag(domain)).IncCounter(metrics.CadenceErrServiceBusyCounter)
+	return createServiceBusyError()
+}
+
 func (wh *WorkflowHandler) error(err error, scope int) error {
 	switch err := err.(type) {
 	case *gen.InternalServiceError:
@@ -182,6 +182,85 @@ func (s *workflowHandlerSuite) getWorkflowHandler(config *Config) *WorkflowHandl
 		s.mockHistoryV2Mgr, s.mockVisibilityMgr, s.mockProducer, s.mockBlobstoreClient)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_DomainThrottled() {
+	config := s.newConfig()
+	config.DomainRPS = dc.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+	mockDomainCache := &cache.DomainCacheMock{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.startWG.Done()
+
+	mockDomainCache.On("GetDomainID", "test-domain").Return(uuid.New(), nil)
+	// use up the single token of the quota
+	assert.True(s.T(), wh.domainRateLimiter.allow("test-domain", apiClassWrite))
+
+	_, err := wh.StartWorkflowExecution(context.Background(), s.newStartWorkflowExecutionRequest("test-domain"))
+	assert.IsType(s.T(), &shared.ServiceBusyError{}, err)
+}
+
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_DomainNotExists() {
+	config := s.newConfig()
+	config.DomainRPS = dc.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+	mockDomainCache := &cache.DomainCacheMock{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.startWG.Done()
+
+	mockDomainCache.On("GetDomainID", "test-domain").Return("", &shared.EntityNotExistsError{})
+
+	_, err := wh.StartWorkflowExecution(context.Background(), s.newStartWorkflowExecutionRequest("test-domain"))
+	assert.IsType(s.T(), &shared.EntityNotExistsError{}, err)
+	// no quota is tracked for domains which do not exist
+	assert.Empty(s.T(), wh.domainRateLimiter.buckets)
+}
+
+func (s *workflowHandlerSuite) TestRespondDecisionTaskCompleted_DomainThrottled() {
+	config := s.newConfig()
+	config.DomainRPS = dc.GetIntPropertyFilteredByDomain(10)
+	wh := s.getWorkflowHandler(config)
+	mockDomainCache := &cache.DomainCacheMock{}
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.domainCache = mockDomainCache
+	wh.startWG.Done()
+
+	domainID := uuid.New()
+	mockDomainCache.On("GetDomainByID", domainID).Return(cache.NewDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: "test-domain"}, &persistence.DomainConfig{}), nil)
+	mockDomainCache.On("GetDomainID", "test-domain").Return(domainID, nil)
+	// use up the single token of the quota
+	assert.True(s.T(), wh.domainRateLimiter.allow("test-domain", apiClassPoll))
+
+	taskToken, err := wh.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:   domainID,
+		WorkflowID: "workflow-id",
+		RunID:      uuid.New(),
+		ScheduleID: 2,
+	})
+	s.NoError(err)
+	_, err = wh.RespondDecisionTaskCompleted(context.Background(), &shared.RespondDecisionTaskCompletedRequest{
+		TaskToken: taskToken,
+	})
+	assert.IsType(s.T(), &shared.ServiceBusyError{}, err)
+}
+
+func (s *workflowHandlerSuite) newStartWorkflowExecutionRequest(domain string) *shared.StartWorkflowExecutionRequest {
+	return &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr(domain),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+}
+
 func (s *workflowHandlerSuite) TestDisableListVisibilityByFilter() {
 	domain := "test-domain"
 	domainID := uuid.New()
@@ -1078,6 +1157,85 @@ func (s *workflowHandlerSuite) TestGetArchivedHistory_Success_GetLastPage() {
 	s.Nil(resp.NextPageToken)
 }
 
+func (s *workflowHandlerSuite) TestListArchivedWorkflowExecutions_Failure_CloseTimeFilterNotSet() {
+	config := s.newConfig()
+	wh := s.getWorkflowHandlerWithParams(s.mockService, config, s.mockMetadataMgr, s.mockBlobstoreClient)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	listRequest := listArchivedWorkflowExecutionsRequest(nil)
+	listRequest.CloseTimeFilter = nil
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listRequest)
+	s.Nil(resp)
+	s.Error(err)
+}
+
+func (s *workflowHandlerSuite) TestListArchivedWorkflowExecutions_Failure_ArchivalBucketEmpty() {
+	config := s.newConfig()
+	mMetadataManager := &mocks.MetadataManager{}
+	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("", shared.ArchivalStatusDisabled), nil)
+	clusterMetadata := &mocks.ClusterMetadata{}
+	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, s.mockBlobstoreClient)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listArchivedWorkflowExecutionsRequest(nil))
+	s.Nil(resp)
+	s.Equal(errDomainHasNeverBeenEnabledForArchival, err)
+}
+
+func (s *workflowHandlerSuite) TestListArchivedWorkflowExecutions_Failure_InvalidPageToken() {
+	config := s.newConfig()
+	mMetadataManager := &mocks.MetadataManager{}
+	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("test-bucket", shared.ArchivalStatusEnabled), nil)
+	clusterMetadata := &mocks.ClusterMetadata{}
+	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, s.mockBlobstoreClient)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listArchivedWorkflowExecutionsRequest([]byte{3, 4, 5, 1}))
+	s.Nil(resp)
+	s.Equal(errInvalidNextArchivalPageToken, err)
+}
+
+func (s *workflowHandlerSuite) TestListArchivedWorkflowExecutions_Success() {
+	config := s.newConfig()
+	mMetadataManager := &mocks.MetadataManager{}
+	mMetadataManager.On("GetDomain", mock.Anything).Return(persistenceGetDomainResponse("test-bucket", shared.ArchivalStatusEnabled), nil)
+	clusterMetadata := &mocks.ClusterMetadata{}
+	clusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	mService := cs.NewTestService(clusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.logger)
+	closeTimestamp := time.Now().UnixNano()
+	key, err := archiver.NewVisibilityBlobKey("test-id", "test-workflow-id", "test-run-id", closeTimestamp)
+	s.NoError(err)
+	record := &archiver.VisibilityRecord{
+		DomainID:         common.StringPtr("test-id"),
+		WorkflowID:       common.StringPtr("test-workflow-id"),
+		RunID:            common.StringPtr("test-run-id"),
+		WorkflowTypeName: common.StringPtr("test-workflow-type"),
+		CloseTimestamp:   common.Int64Ptr(closeTimestamp),
+		CloseStatus:      shared.WorkflowExecutionCloseStatusCompleted.Ptr(),
+	}
+	bytes, err := json.Marshal(record)
+	s.NoError(err)
+	visibilityBlob, err := blob.Wrap(blob.NewBlob(bytes, map[string]string{}), blob.JSONEncoded())
+	s.NoError(err)
+	mBlobstore := &mocks.BlobstoreClient{}
+	mBlobstore.On("ListByPrefixPage", mock.Anything, "test-bucket", mock.Anything, mock.Anything, mock.Anything).Return([]blob.Key{key}, nil, nil)
+	mBlobstore.On("Download", mock.Anything, "test-bucket", key).Return(visibilityBlob, nil)
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager, mBlobstore)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	resp, err := wh.ListArchivedWorkflowExecutions(context.Background(), listArchivedWorkflowExecutionsRequest(nil))
+	s.NoError(err)
+	s.Len(resp.Executions, 1)
+	s.Equal("test-workflow-id", resp.Executions[0].Execution.GetWorkflowId())
+	s.Equal("test-workflow-type", resp.Executions[0].Type.GetName())
+	s.Equal(closeTimestamp, resp.Executions[0].GetCloseTime())
+	s.Nil(resp.NextPageToken)
+}
+
 func (s *workflowHandlerSuite) newConfig() *Config {
 	return NewConfig(dc.NewCollection(dc.NewNopClient(), s.logger), false)
 }
@@ -1101,6 +1259,17 @@ func updateRequest(archivalBucketName *string, archivalStatus *shared.ArchivalSt
 	}
 }
 
+func listArchivedWorkflowExecutionsRequest(nextPageToken []byte) *shared.ListArchivedWorkflowExecutionsRequest {
+	return &shared.ListArchivedWorkflowExecutionsRequest{
+		Domain: common.StringPtr("test-domain"),
+		CloseTimeFilter: &shared.CloseTimeFilter{
+			EarliestTime: common.Int64Ptr(0),
+			LatestTime:   common.Int64Ptr(time.Now().Add(time.Hour).UnixNano()),
+		},
+		NextPageToken: nextPageToken,
+	}
+}
+
 func persistenceGetDomainResponse(archivalBucket string, archivalStatus shared.ArchivalStatus) *persistence.GetDomainResponse {
 	return &persistence.GetDomainResponse{
 		Info: &persistence.DomainInfo{
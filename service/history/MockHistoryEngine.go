@@ -493,4 +493,152 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// ListDLQTasks is mock implementation for ListDLQTasks of HistoryEngine
+func (_m *MockHistoryEngine) ListDLQTasks(ctx context.Context, request *shared.ListDLQTasksRequest) (*shared.ListDLQTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ListDLQTasksResponse
+	if rf, ok := ret.Get(0).(func(*shared.ListDLQTasksRequest) *shared.ListDLQTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListDLQTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ListDLQTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryDLQTask is mock implementation for RetryDLQTask of HistoryEngine
+func (_m *MockHistoryEngine) RetryDLQTask(ctx context.Context, request *shared.RetryDLQTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.RetryDLQTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DropDLQTask is mock implementation for DropDLQTask of HistoryEngine
+func (_m *MockHistoryEngine) DropDLQTask(ctx context.Context, request *shared.DropDLQTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.DropDLQTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeShard is mock implementation for DescribeShard of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShard(ctx context.Context, request *shared.DescribeShardRequest) (*shared.DescribeShardResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.DescribeShardResponse
+	if rf, ok := ret.Get(0).(func(*shared.DescribeShardRequest) *shared.DescribeShardResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.DescribeShardRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShardQueueTasks is mock implementation for ListShardQueueTasks of HistoryEngine
+func (_m *MockHistoryEngine) ListShardQueueTasks(ctx context.Context, request *shared.ListShardQueueTasksRequest) (*shared.ListShardQueueTasksResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ListShardQueueTasksResponse
+	if rf, ok := ret.Get(0).(func(*shared.ListShardQueueTasksRequest) *shared.ListShardQueueTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardQueueTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ListShardQueueTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShardQueueTask is mock implementation for RemoveShardQueueTask of HistoryEngine
+func (_m *MockHistoryEngine) RemoveShardQueueTask(ctx context.Context, request *shared.RemoveShardQueueTaskRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.RemoveShardQueueTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshWorkflowTasks is mock implementation for RefreshWorkflowTasks of HistoryEngine
+func (_m *MockHistoryEngine) RefreshWorkflowTasks(ctx context.Context, request *gohistory.RefreshWorkflowTasksRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RefreshWorkflowTasksRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RebuildMutableState is mock implementation for RebuildMutableState of HistoryEngine
+func (_m *MockHistoryEngine) RebuildMutableState(ctx context.Context, request *gohistory.RebuildMutableStateRequest) (*shared.RebuildMutableStateResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.RebuildMutableStateResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.RebuildMutableStateRequest) *shared.RebuildMutableStateResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.RebuildMutableStateResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.RebuildMutableStateRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
@@ -61,3 +61,17 @@ func (_m *MockTimerQueueProcessor) LockTaskPrrocessing() {
 func (_m *MockTimerQueueProcessor) UnlockTaskPrrocessing() {
 	_m.Called()
 }
+
+// RetryDLQTask is mock implementation for RetryDLQTask of Processor
+func (_m *MockTimerQueueProcessor) RetryDLQTask(task *persistence.TimerTaskInfo) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.TimerTaskInfo) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -59,3 +59,17 @@ func (_m *MockTransferQueueProcessor) LockTaskPrrocessing() {
 func (_m *MockTransferQueueProcessor) UnlockTaskPrrocessing() {
 	_m.Called()
 }
+
+// RetryDLQTask is mock implementation for RetryDLQTask of Processor
+func (_m *MockTransferQueueProcessor) RetryDLQTask(task *persistence.TransferTaskInfo) error {
+	ret := _m.Called(task)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*persistence.TransferTaskInfo) error); ok {
+		r0 = rf(task)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	// domainTaskScheduler hands queue tasks over to the task workers using a weighted round robin across
+	// domains, so a burst of tasks from a single domain does not delay the tasks of the other domains
+	// on the shard. Each domain processes up to its weight of tasks before yielding to the next domain.
+	// The fairness only applies to the tasks buffered by the scheduler, the tasks are still read from
+	// the queue in order, so a large backlog of a single domain delays the reads of the tasks behind it.
+	domainTaskScheduler struct {
+		shard         ShardContext
+		weight        dynamicconfig.IntPropertyFnWithDomainFilter
+		metricsClient metrics.Client
+		metricsScope  int
+		shutdownCh    <-chan struct{}
+		// capacityCh bounds the number of pending tasks, the same way the buffer of a channel does
+		capacityCh chan struct{}
+		// notifyCh wakes up a task worker when there are pending tasks
+		notifyCh chan struct{}
+
+		sync.Mutex
+		queues   map[string]*domainTaskQueue
+		domains  []string // domains with pending tasks in round robin order
+		isClosed bool
+	}
+
+	domainTaskQueue struct {
+		domainName string
+		tasks      []*scheduledTask
+		credit     int
+	}
+
+	scheduledTask struct {
+		task          queueTaskInfo
+		scheduledTime time.Time
+	}
+)
+
+func newDomainTaskScheduler(shard ShardContext, capacity int, weight dynamicconfig.IntPropertyFnWithDomainFilter,
+	metricsClient metrics.Client, metricsScope int, shutdownCh <-chan struct{}) *domainTaskScheduler {
+	return &domainTaskScheduler{
+		shard:         shard,
+		weight:        weight,
+		metricsClient: metricsClient,
+		metricsScope:  metricsScope,
+		shutdownCh:    shutdownCh,
+		capacityCh:    make(chan struct{}, capacity),
+		notifyCh:      make(chan struct{}, 1),
+		queues:        make(map[string]*domainTaskQueue),
+	}
+}
+
+// schedule adds the task to the queue of its domain, it blocks while the scheduler is at capacity
+// and returns false if the processor is shut down before the task is scheduled
+func (s *domainTaskScheduler) schedule(task queueTaskInfo) bool {
+	select {
+	case s.capacityCh <- struct{}{}:
+	case <-s.shutdownCh:
+		return false
+	}
+
+	domainID := task.GetDomainID()
+	s.Lock()
+	queue, ok := s.queues[domainID]
+	if !ok {
+		queue = &domainTaskQueue{domainName: getDomainNameForMetrics(s.shard, domainID)}
+		s.queues[domainID] = queue
+		s.domains = append(s.domains, domainID)
+	}
+	queue.tasks = append(queue.tasks, &scheduledTask{task: task, scheduledTime: time.Now()})
+	backlog := len(queue.tasks)
+	s.Unlock()
+
+	s.getDomainScope(queue.domainName).UpdateGauge(metrics.TaskBacklogGauge, float64(backlog))
+	s.notify()
+	return true
+}
+
+// next returns the next task to process along with the name of its domain, it blocks until a task is
+// available and returns false once the scheduler is closed and all its tasks are handed out, or the
+// processor is shut down
+func (s *domainTaskScheduler) next() (queueTaskInfo, string, bool) {
+	for {
+		s.Lock()
+		if len(s.domains) > 0 {
+			task, domainName, backlog := s.nextLocked()
+			hasMore := len(s.domains) > 0
+			s.Unlock()
+
+			<-s.capacityCh
+			if hasMore {
+				// pass the notification along to the other task workers
+				s.notify()
+			}
+			scope := s.getDomainScope(domainName)
+			scope.UpdateGauge(metrics.TaskBacklogGauge, float64(backlog))
+			scope.RecordTimer(metrics.TaskScheduleLatency, time.Since(task.scheduledTime))
+			return task.task, domainName, true
+		}
+		isClosed := s.isClosed
+		s.Unlock()
+
+		if isClosed {
+			// pass the notification along so the other task workers exit as well
+			s.notify()
+			return nil, "", false
+		}
+
+		select {
+		case <-s.notifyCh:
+		case <-s.shutdownCh:
+			return nil, "", false
+		}
+	}
+}
+
+// close stops the scheduler from handing out tasks once all the pending tasks are handed out, it
+// must only be called by the goroutine scheduling the tasks
+func (s *domainTaskScheduler) close() {
+	s.Lock()
+	s.isClosed = true
+	s.Unlock()
+	s.notify()
+}
+
+func (s *domainTaskScheduler) nextLocked() (*scheduledTask, string, int) {
+	domainID := s.domains[0]
+	queue := s.queues[domainID]
+	if queue.credit <= 0 {
+		// start a new turn for the domain
+		queue.credit = 1
+		if s.weight != nil && s.weight(queue.domainName) > 1 {
+			queue.credit = s.weight(queue.domainName)
+		}
+	}
+
+	task := queue.tasks[0]
+	queue.tasks[0] = nil
+	queue.tasks = queue.tasks[1:]
+	queue.credit--
+
+	if len(queue.tasks) == 0 {
+		// the domain starts with a full turn next time it has tasks
+		delete(s.queues, domainID)
+		s.domains = s.domains[1:]
+	} else if queue.credit == 0 {
+		// the domain used up its turn, move it to the back
+		s.domains = append(s.domains[1:], domainID)
+	}
+	return task, queue.domainName, len(queue.tasks)
+}
+
+func (s *domainTaskScheduler) notify() {
+	select {
+	case s.notifyCh <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
+func (s *domainTaskScheduler) getDomainScope(domainName string) metrics.Scope {
+	return s.metricsClient.Scope(s.metricsScope, metrics.DomainTag(domainName))
+}
+
+// getDomainNameForMetrics returns the name of the domain to tag the metrics with, the domain ID is
+// used if the domain can not be loaded
+func getDomainNameForMetrics(shard ShardContext, domainID string) string {
+	entry, err := shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return domainID
+	}
+	return entry.GetInfo().Name
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"os"
+	"testing"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	domainTaskSchedulerSuite struct {
+		suite.Suite
+		logger          bark.Logger
+		metricsClient   metrics.Client
+		mockMetadataMgr *mocks.MetadataManager
+		mockShard       ShardContext
+		shutdownCh      chan struct{}
+	}
+)
+
+func TestDomainTaskSchedulerSuite(t *testing.T) {
+	s := new(domainTaskSchedulerSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainTaskSchedulerSuite) SetupSuite() {
+	if testing.Verbose() {
+		log.SetOutput(os.Stdout)
+	}
+}
+
+func (s *domainTaskSchedulerSuite) SetupTest() {
+	s.logger = bark.NewLoggerFromLogrus(log.New())
+	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
+	s.mockMetadataMgr = &mocks.MetadataManager{}
+	// the domain ID is used as the domain name when the domain can not be loaded
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(nil, &workflow.EntityNotExistsError{})
+	s.mockShard = &shardContextImpl{
+		shardInfo:     &persistence.ShardInfo{ShardID: 0, RangeID: 1},
+		config:        NewDynamicConfigForTest(),
+		logger:        s.logger,
+		domainCache:   cache.NewDomainCache(s.mockMetadataMgr, nil, s.metricsClient, s.logger),
+		metricsClient: s.metricsClient,
+	}
+	s.shutdownCh = make(chan struct{})
+}
+
+func (s *domainTaskSchedulerSuite) TestWeightedRoundRobin() {
+	weights := map[string]int{"domain1": 2}
+	scheduler := newDomainTaskScheduler(s.mockShard, 100, func(domain string) int {
+		return weights[domain]
+	}, s.metricsClient, metrics.TransferActiveQueueProcessorScope, s.shutdownCh)
+
+	taskID := int64(0)
+	for _, domainID := range []string{"domain1", "domain1", "domain1", "domain1", "domain2", "domain2", "domain3"} {
+		taskID++
+		s.True(scheduler.schedule(&persistence.TransferTaskInfo{DomainID: domainID, TaskID: taskID}))
+	}
+	scheduler.close()
+
+	var domains []string
+	for {
+		task, _, ok := scheduler.next()
+		if !ok {
+			break
+		}
+		domains = append(domains, task.GetDomainID())
+	}
+	s.Equal([]string{"domain1", "domain1", "domain2", "domain3", "domain1", "domain1", "domain2"}, domains)
+}
+
+func (s *domainTaskSchedulerSuite) TestOrderWithinDomain() {
+	scheduler := newDomainTaskScheduler(s.mockShard, 10, nil, s.metricsClient,
+		metrics.TimerActiveQueueProcessorScope, s.shutdownCh)
+
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		s.True(scheduler.schedule(&persistence.TimerTaskInfo{DomainID: "domain1", TaskID: taskID}))
+	}
+	for taskID := int64(1); taskID <= 3; taskID++ {
+		task, domainName, ok := scheduler.next()
+		s.True(ok)
+		s.Equal(taskID, task.GetTaskID())
+		s.Equal("domain1", domainName)
+	}
+}
+
+func (s *domainTaskSchedulerSuite) TestScheduleBlockedUntilShutdown() {
+	scheduler := newDomainTaskScheduler(s.mockShard, 1, nil, s.metricsClient,
+		metrics.TransferActiveQueueProcessorScope, s.shutdownCh)
+	s.True(scheduler.schedule(&persistence.TransferTaskInfo{DomainID: "domain1", TaskID: 1}))
+
+	scheduledCh := make(chan bool)
+	go func() {
+		scheduledCh <- scheduler.schedule(&persistence.TransferTaskInfo{DomainID: "domain2", TaskID: 2})
+	}()
+	close(s.shutdownCh)
+	s.False(<-scheduledCh)
+
+	_, _, ok := scheduler.next()
+	s.True(ok)
+}
+
+func (s *domainTaskSchedulerSuite) TestNextBlockedUntilScheduled() {
+	scheduler := newDomainTaskScheduler(s.mockShard, 10, nil, s.metricsClient,
+		metrics.TransferActiveQueueProcessorScope, s.shutdownCh)
+
+	taskCh := make(chan queueTaskInfo)
+	go func() {
+		task, _, _ := scheduler.next()
+		taskCh <- task
+	}()
+	task := &persistence.TransferTaskInfo{DomainID: "domain1", TaskID: 1}
+	s.True(scheduler.schedule(task))
+	s.Equal(task, <-taskCh)
+}
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
@@ -88,6 +89,7 @@ var (
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
+	errTaskIDNotSet            = &gen.BadRequestError{Message: "Task ID not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
 )
 
@@ -170,6 +172,7 @@ func (h *Handler) Start() error {
 	// events notifier must starts before controller
 	h.historyEventNotifier.Start()
 	h.controller.Start()
+	h.registerHealthChecks()
 	h.startWG.Done()
 	return nil
 }
@@ -196,12 +199,22 @@ func (h *Handler) CreateEngine(context ShardContext) Engine {
 		h.publicClient, h.historyEventNotifier, h.publisher, h.visibilityProducer, h.config)
 }
 
-// Health is for health check
+// Health is for health check, it reports the status of the dependencies of the service
 func (h *Handler) Health(ctx context.Context) (*health.HealthStatus, error) {
 	h.startWG.Wait()
 	h.GetLogger().Debug("History health check endpoint reached.")
-	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("history good")}
-	return hs, nil
+	return h.GetHealthRegistry().Check(ctx), nil
+}
+
+func (h *Handler) registerHealthChecks() {
+	registry := h.GetHealthRegistry()
+	registry.Register(healthcheck.PersistenceCheckName, healthcheck.PersistenceCheck(h.metadataMgr))
+	registry.Register(healthcheck.DomainCacheCheckName,
+		healthcheck.DomainCacheCheck(h.domainCache, healthcheck.DefaultDomainCacheMaxAge))
+	registry.Register(healthcheck.ShardsCheckName, healthcheck.ShardsCheck(h.controller.numShards, h.config.NumberOfShards))
+	if h.publisher != nil || h.visibilityProducer != nil {
+		registry.Register(healthcheck.KafkaCheckName, healthcheck.KafkaCheck(h.GetMessagingClient()))
+	}
 }
 
 // RecordActivityTaskHeartbeat - Record Activity Task Heart beat.
@@ -1237,6 +1250,287 @@ func (h *Handler) SyncActivity(ctx context.Context, syncActivityRequest *hist.Sy
 	return nil
 }
 
+// ListDLQTasks returns the transfer or timer tasks moved into the task DLQ of a shard
+func (h *Handler) ListDLQTasks(ctx context.Context,
+	request *gen.ListDLQTasksRequest) (resp *gen.ListDLQTasksResponse, retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryListDLQTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ListDLQTasks(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// RetryDLQTask processes a task from the task DLQ of a shard once, the task is removed from the DLQ on success
+func (h *Handler) RetryDLQTask(ctx context.Context, request *gen.RetryDLQTaskRequest) (retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRetryDLQTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.TaskId == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.RetryDLQTask(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// DropDLQTask removes a task from the task DLQ of a shard without processing it
+func (h *Handler) DropDLQTask(ctx context.Context, request *gen.DropDLQTaskRequest) (retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDropDLQTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.TaskId == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.DropDLQTask(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// DescribeShard returns the ack levels, read levels, failover levels and outstanding task counts of the queues of a shard
+func (h *Handler) DescribeShard(ctx context.Context,
+	request *gen.DescribeShardRequest) (resp *gen.DescribeShardResponse, retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.DescribeShard(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// ListShardQueueTasks returns the pending tasks of a queue of a shard within a range
+func (h *Handler) ListShardQueueTasks(ctx context.Context,
+	request *gen.ListShardQueueTasksRequest) (resp *gen.ListShardQueueTasksResponse, retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryListShardQueueTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ListShardQueueTasks(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	return resp, nil
+}
+
+// RemoveShardQueueTask deletes a task from a queue of a shard
+func (h *Handler) RemoveShardQueueTask(ctx context.Context, request *gen.RemoveShardQueueTaskRequest) (retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRemoveShardQueueTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.TaskId == nil {
+		return h.error(errTaskIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardId()))
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	err = engine.RemoveShardQueueTask(ctx, request)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// CloseShard unloads a shard from this host, the shard is acquired again on the next request routed to it
+func (h *Handler) CloseShard(ctx context.Context, request *gen.CloseShardRequest) (retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryCloseShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if err := h.controller.closeShard(int(request.GetShardId())); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// ReloadShard unloads a shard from this host and acquires it again
+func (h *Handler) ReloadShard(ctx context.Context, request *gen.ReloadShardRequest) (retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryReloadShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardId == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if err := h.controller.reloadShard(int(request.GetShardId())); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// RefreshWorkflowTasks regenerates all outstanding transfer and timer tasks of a workflow execution
+func (h *Handler) RefreshWorkflowTasks(ctx context.Context,
+	wrappedRequest *hist.RefreshWorkflowTasksRequest) (retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRefreshWorkflowTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.GetRequest().GetExecution()
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.RefreshWorkflowTasks(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// RebuildMutableState replays the history of a workflow execution and compares the result with its mutable state
+func (h *Handler) RebuildMutableState(ctx context.Context,
+	wrappedRequest *hist.RebuildMutableStateRequest) (resp *gen.RebuildMutableStateResponse, retError error) {
+	defer logging.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRebuildMutableStateScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok, _ := h.rateLimiter.TryConsume(1); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.GetRequest().GetExecution()
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.RebuildMutableState(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
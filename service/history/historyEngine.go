@@ -27,6 +27,9 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"github.com/opentracing/opentracing-go/log"
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
@@ -44,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/service/worker/archiver"
 	"go.uber.org/cadence/.gen/go/shared"
@@ -55,6 +59,9 @@ const (
 	activityCancellationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancellationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancellationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	historyEngineSpanPrefix                   = "HistoryEngine::"
+	persistenceSpanPrefix                     = "persistence::"
+	persistenceSpanComponent                  = "cadence-persistence"
 )
 
 type (
@@ -361,7 +368,8 @@ func (e *historyEngineImpl) generateFirstDecisionTask(domainID string, msBuilder
 	return transferTasks, di, nil
 }
 
-func (e *historyEngineImpl) appendFirstBatchHistoryEvents(msBuilder mutableState, domainID string, execution workflow.WorkflowExecution) (historySize int, err error) {
+func (e *historyEngineImpl) appendFirstBatchHistoryEvents(ctx context.Context, msBuilder mutableState, domainID string,
+	execution workflow.WorkflowExecution) (historySize int, err error) {
 	// call FlushBufferedEvents to assign task id to event
 	// as well as update last event task id in new state builder
 	err = msBuilder.FlushBufferedEvents()
@@ -370,6 +378,8 @@ func (e *historyEngineImpl) appendFirstBatchHistoryEvents(msBuilder mutableState
 	}
 	events := msBuilder.GetHistoryBuilder().GetHistory().Events
 	startedEvent := events[0]
+	span := startPersistenceSpan(ctx, "AppendHistoryEvents")
+	defer func() { finishPersistenceSpan(span, err) }()
 	if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
 		branchToken := msBuilder.GetCurrentBranch()
 		historySize, err = e.shard.AppendHistoryV2Events(&persistence.AppendHistoryNodesRequest{
@@ -430,7 +440,7 @@ func generateFirstReplicationTask(msBuilder mutableState, clusterMetadata cluste
 	return replicationTasks
 }
 
-func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutionRequest, msBuilder mutableState, createMode int, prevRunID string, prevLastWriteVersion int64,
+func (e *historyEngineImpl) createWorkflow(ctx context.Context, startRequest *h.StartWorkflowExecutionRequest, msBuilder mutableState, createMode int, prevRunID string, prevLastWriteVersion int64,
 	firstDecisionTask *decisionInfo, transferTasks, timerTasks, replicationTasks []persistence.Task, clusterMetadata cluster.Metadata) (err error) {
 
 	request := startRequest.StartRequest
@@ -497,13 +507,18 @@ func (e *historyEngineImpl) createWorkflow(startRequest *h.StartWorkflowExecutio
 		createRequest.ExpirationSeconds = request.RetryPolicy.GetExpirationIntervalInSeconds()
 	}
 
+	span := startPersistenceSpan(ctx, "CreateWorkflowExecution")
 	_, err = e.shard.CreateWorkflowExecution(createRequest)
+	finishPersistenceSpan(span, err)
 	return err
 }
 
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startRequest *h.StartWorkflowExecutionRequest) (
 	resp *workflow.StartWorkflowExecutionResponse, retError error) {
+	span, ctx := startSpan(ctx, "StartWorkflowExecution")
+	defer span.Finish()
+
 	domainEntry, retError := e.getActiveDomainEntry(startRequest.DomainUUID)
 	if retError != nil {
 		return
@@ -568,7 +583,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	// set versions and timestamp for timer and transfer tasks
 	setTaskInfo(msBuilder.GetCurrentVersion(), time.Now(), transferTasks, timerTasks)
 
-	historySize, retError := e.appendFirstBatchHistoryEvents(msBuilder, domainID, execution)
+	historySize, retError := e.appendFirstBatchHistoryEvents(ctx, msBuilder, domainID, execution)
 	if retError != nil {
 		return
 	}
@@ -588,7 +603,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 	createMode := persistence.CreateWorkflowModeBrandNew
 	prevRunID := ""
 	prevLastWriteVersion := int64(0)
-	retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+	retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 	if retError != nil {
 		t, ok := retError.(*persistence.WorkflowExecutionAlreadyStartedError)
 		if ok {
@@ -616,7 +631,7 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 			if retError != nil {
 				return
 			}
-			retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+			retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, prevRunID, prevLastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 		}
 	}
 
@@ -633,6 +648,8 @@ func (e *historyEngineImpl) StartWorkflowExecution(ctx context.Context, startReq
 // GetMutableState retrieves the mutable state of the workflow execution
 func (e *historyEngineImpl) GetMutableState(ctx context.Context,
 	request *h.GetMutableStateRequest) (*h.GetMutableStateResponse, error) {
+	span, ctx := startSpan(ctx, "GetMutableState")
+	defer span.Finish()
 
 	domainID, err := validateDomainUUID(request.DomainUUID)
 	if err != nil {
@@ -712,7 +729,9 @@ func (e *historyEngineImpl) getMutableState(ctx context.Context,
 	}
 	defer func() { release(retError) }()
 
+	loadSpan := startPersistenceSpan(ctx, "GetWorkflowExecution")
 	msBuilder, retError := context.loadWorkflowExecution()
+	finishPersistenceSpan(loadSpan, retError)
 	if retError != nil {
 		return
 	}
@@ -752,6 +771,8 @@ func (e *historyEngineImpl) getMutableState(ctx context.Context,
 
 func (e *historyEngineImpl) DescribeMutableState(ctx context.Context,
 	request *h.DescribeMutableStateRequest) (retResp *h.DescribeMutableStateResponse, retError error) {
+	span, ctx := startSpan(ctx, "DescribeMutableState")
+	defer span.Finish()
 
 	domainID, err := validateDomainUUID(request.DomainUUID)
 	if err != nil {
@@ -799,6 +820,9 @@ func (e *historyEngineImpl) toMutableStateJSON(msb mutableState) (*string, error
 // 4. ClientFeatureVersion
 // 5. ClientImpl
 func (e *historyEngineImpl) ResetStickyTaskList(ctx context.Context, resetRequest *h.ResetStickyTaskListRequest) (*h.ResetStickyTaskListResponse, error) {
+	span, ctx := startSpan(ctx, "ResetStickyTaskList")
+	defer span.Finish()
+
 	domainID, err := validateDomainUUID(resetRequest.DomainUUID)
 	if err != nil {
 		return nil, err
@@ -823,6 +847,9 @@ func (e *historyEngineImpl) ResetStickyTaskList(ctx context.Context, resetReques
 // DescribeWorkflowExecution returns information about the specified workflow execution.
 func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 	request *h.DescribeWorkflowExecutionRequest) (retResp *workflow.DescribeWorkflowExecutionResponse, retError error) {
+	span, ctx := startSpan(ctx, "DescribeWorkflowExecution")
+	defer span.Finish()
+
 	domainID, err := validateDomainUUID(request.DomainUUID)
 	if err != nil {
 		return nil, err
@@ -836,7 +863,9 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 	}
 	defer func() { release(retError) }()
 
+	loadSpan := startPersistenceSpan(ctx, "GetWorkflowExecution")
 	msBuilder, err1 := context.loadWorkflowExecution()
+	finishPersistenceSpan(loadSpan, err1)
 	if err1 != nil {
 		return nil, err1
 	}
@@ -921,6 +950,8 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(ctx context.Context,
 
 func (e *historyEngineImpl) RecordDecisionTaskStarted(ctx context.Context,
 	request *h.RecordDecisionTaskStartedRequest) (retResp *h.RecordDecisionTaskStartedResponse, retError error) {
+	span, ctx := startSpan(ctx, "RecordDecisionTaskStarted")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
 	if err != nil {
@@ -939,7 +970,9 @@ func (e *historyEngineImpl) RecordDecisionTaskStarted(ctx context.Context,
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		loadSpan := startPersistenceSpan(ctx, "GetWorkflowExecution")
 		msBuilder, err0 := context.loadWorkflowExecution()
+		finishPersistenceSpan(loadSpan, err0)
 		if err0 != nil {
 			return nil, err0
 		}
@@ -1004,7 +1037,10 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		if err3 := context.updateWorkflowExecution(nil, timerTasks, transactionID); err3 != nil {
+		updateSpan := startPersistenceSpan(ctx, "UpdateWorkflowExecution")
+		err3 := context.updateWorkflowExecution(nil, timerTasks, transactionID)
+		finishPersistenceSpan(updateSpan, err3)
+		if err3 != nil {
 			if err3 == ErrConflict {
 				e.metricsClient.IncCounter(metrics.HistoryRecordDecisionTaskStartedScope,
 					metrics.ConcurrencyUpdateFailureCounter)
@@ -1021,6 +1057,8 @@ Update_History_Loop:
 
 func (e *historyEngineImpl) RecordActivityTaskStarted(ctx context.Context,
 	request *h.RecordActivityTaskStartedRequest) (*h.RecordActivityTaskStartedResponse, error) {
+	span, ctx := startSpan(ctx, "RecordActivityTaskStarted")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
 	if err != nil {
@@ -1172,6 +1210,8 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	req *h.RespondDecisionTaskCompletedRequest,
 ) (response *h.RespondDecisionTaskCompletedResponse, retError error) {
+	span, ctx := startSpan(ctx, "RespondDecisionTaskCompleted")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -1221,7 +1261,9 @@ func (e *historyEngineImpl) RespondDecisionTaskCompleted(
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		loadSpan := startPersistenceSpan(ctx, "GetWorkflowExecution")
 		msBuilder, err1 := context.loadWorkflowExecution()
+		finishPersistenceSpan(loadSpan, err1)
 		if err1 != nil {
 			return nil, err1
 		}
@@ -1366,7 +1408,7 @@ Update_History_Loop:
 					}
 				} else {
 					// this is a cron workflow
-					startEvent, err := getWorkflowStartedEvent(e.historyMgr, e.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId())
+					startEvent, err := getWorkflowStartedEvent(e.historyMgr, e.historyV2Mgr, e.shard.GetPayloadOffloader(), msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId())
 					if err != nil {
 						return nil, err
 					}
@@ -1439,7 +1481,7 @@ Update_History_Loop:
 					}
 				} else {
 					// retry or cron with backoff
-					startEvent, err := getWorkflowStartedEvent(e.historyMgr, e.historyV2Mgr, msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId())
+					startEvent, err := getWorkflowStartedEvent(e.historyMgr, e.historyV2Mgr, e.shard.GetPayloadOffloader(), msBuilder.GetEventStoreVersion(), msBuilder.GetCurrentBranch(), e.logger, domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId())
 					if err != nil {
 						return nil, err
 					}
@@ -1839,6 +1881,7 @@ Update_History_Loop:
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
 		var updateErr error
+		updateSpan := startPersistenceSpan(ctx, "UpdateWorkflowExecution")
 		if continueAsNewBuilder != nil {
 			continueAsNewTimerTasks = msBuilder.GetContinueAsNew().TimerTasks
 			updateErr = context.continueAsNewWorkflowExecution(request.ExecutionContext, continueAsNewBuilder,
@@ -1847,6 +1890,7 @@ Update_History_Loop:
 			updateErr = context.updateWorkflowExecutionWithContext(request.ExecutionContext, transferTasks, timerTasks,
 				transactionID)
 		}
+		finishPersistenceSpan(updateSpan, updateErr)
 
 		if updateErr != nil {
 			if updateErr == ErrConflict {
@@ -1878,6 +1922,8 @@ Update_History_Loop:
 }
 
 func (e *historyEngineImpl) RespondDecisionTaskFailed(ctx context.Context, req *h.RespondDecisionTaskFailedRequest) error {
+	span, ctx := startSpan(ctx, "RespondDecisionTaskFailed")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -1917,6 +1963,8 @@ func (e *historyEngineImpl) RespondDecisionTaskFailed(ctx context.Context, req *
 
 // RespondActivityTaskCompleted completes an activity task.
 func (e *historyEngineImpl) RespondActivityTaskCompleted(ctx context.Context, req *h.RespondActivityTaskCompletedRequest) error {
+	span, ctx := startSpan(ctx, "RespondActivityTaskCompleted")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -1972,6 +2020,8 @@ func (e *historyEngineImpl) RespondActivityTaskCompleted(ctx context.Context, re
 
 // RespondActivityTaskFailed completes an activity task failure.
 func (e *historyEngineImpl) RespondActivityTaskFailed(ctx context.Context, req *h.RespondActivityTaskFailedRequest) error {
+	span, ctx := startSpan(ctx, "RespondActivityTaskFailed")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2037,6 +2087,8 @@ func (e *historyEngineImpl) RespondActivityTaskFailed(ctx context.Context, req *
 
 // RespondActivityTaskCanceled completes an activity task failure.
 func (e *historyEngineImpl) RespondActivityTaskCanceled(ctx context.Context, req *h.RespondActivityTaskCanceledRequest) error {
+	span, ctx := startSpan(ctx, "RespondActivityTaskCanceled")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2099,6 +2151,8 @@ func (e *historyEngineImpl) RespondActivityTaskCanceled(ctx context.Context, req
 // - For reporting progress of the activity, this can be done even if the liveness is not configured.
 func (e *historyEngineImpl) RecordActivityTaskHeartbeat(ctx context.Context,
 	req *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error) {
+	span, ctx := startSpan(ctx, "RecordActivityTaskHeartbeat")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2169,6 +2223,8 @@ func (e *historyEngineImpl) RecordActivityTaskHeartbeat(ctx context.Context,
 // RequestCancelWorkflowExecution records request cancellation event for workflow execution
 func (e *historyEngineImpl) RequestCancelWorkflowExecution(ctx context.Context,
 	req *h.RequestCancelWorkflowExecutionRequest) error {
+	span, ctx := startSpan(ctx, "RequestCancelWorkflowExecution")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(req.DomainUUID)
 	if err != nil {
@@ -2223,6 +2279,8 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(ctx context.Context,
 }
 
 func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context, signalRequest *h.SignalWorkflowExecutionRequest) error {
+	span, ctx := startSpan(ctx, "SignalWorkflowExecution")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(signalRequest.DomainUUID)
 	if err != nil {
@@ -2283,6 +2341,8 @@ func (e *historyEngineImpl) SignalWorkflowExecution(ctx context.Context, signalR
 
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context, signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
 	retResp *workflow.StartWorkflowExecutionResponse, retError error) {
+	span, ctx := startSpan(ctx, "SignalWithStartWorkflowExecution")
+	defer span.Finish()
 
 	domainEntry, retError := e.getActiveDomainEntry(signalWithStartRequest.DomainUUID)
 	if retError != nil {
@@ -2305,7 +2365,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	Just_Signal_Loop:
 		for ; attempt < conditionalRetryCount; attempt++ {
 			// workflow not exist, will create workflow then signal
+			loadSpan := startPersistenceSpan(ctx, "GetWorkflowExecution")
 			msBuilder, err1 := context.loadWorkflowExecution()
+			finishPersistenceSpan(loadSpan, err1)
 			if err1 != nil {
 				if _, ok := err1.(*workflow.EntityNotExistsError); ok {
 					break
@@ -2363,7 +2425,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 
 			// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 			// the history and try the operation again.
-			if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+			updateSpan := startPersistenceSpan(ctx, "UpdateWorkflowExecution")
+			err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID)
+			finishPersistenceSpan(updateSpan, err)
+			if err != nil {
 				if err == ErrConflict {
 					continue Just_Signal_Loop
 				}
@@ -2458,7 +2523,7 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 	// set versions and timestamp for timer and transfer tasks
 	setTaskInfo(msBuilder.GetCurrentVersion(), time.Now(), transferTasks, timerTasks)
 
-	historySize, retError := e.appendFirstBatchHistoryEvents(msBuilder, domainID, execution)
+	historySize, retError := e.appendFirstBatchHistoryEvents(ctx, msBuilder, domainID, execution)
 	if retError != nil {
 		return
 	}
@@ -2477,10 +2542,10 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 		createMode := persistence.CreateWorkflowModeWorkflowIDReuse
 		prevRunID := prevMutableState.GetExecutionInfo().RunID
 		lastWriteVersion := prevMutableState.GetLastWriteVersion()
-		retError = e.createWorkflow(startRequest, msBuilder, createMode, prevRunID, lastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+		retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, prevRunID, lastWriteVersion, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 	} else {
 		createMode := persistence.CreateWorkflowModeBrandNew
-		retError = e.createWorkflow(startRequest, msBuilder, createMode, "", 0, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
+		retError = e.createWorkflow(ctx, startRequest, msBuilder, createMode, "", 0, firstDecisionTask, transferTasks, timerTasks, replicationTasks, clusterMetadata)
 	}
 
 	t, ok := retError.(*persistence.WorkflowExecutionAlreadyStartedError)
@@ -2505,6 +2570,8 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(ctx context.Context
 
 // RemoveSignalMutableState remove the signal request id in signal_requested for deduplicate
 func (e *historyEngineImpl) RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error {
+	span, ctx := startSpan(ctx, "RemoveSignalMutableState")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
 	if err != nil {
@@ -2530,6 +2597,8 @@ func (e *historyEngineImpl) RemoveSignalMutableState(ctx context.Context, reques
 }
 
 func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, terminateRequest *h.TerminateWorkflowExecutionRequest) error {
+	span, ctx := startSpan(ctx, "TerminateWorkflowExecution")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(terminateRequest.DomainUUID)
 	if err != nil {
@@ -2564,6 +2633,8 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(ctx context.Context, term
 
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(ctx context.Context, scheduleRequest *h.ScheduleDecisionTaskRequest) error {
+	span, ctx := startSpan(ctx, "ScheduleDecisionTask")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(scheduleRequest.DomainUUID)
 	if err != nil {
@@ -2602,6 +2673,8 @@ func (e *historyEngineImpl) ScheduleDecisionTask(ctx context.Context, scheduleRe
 
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(ctx context.Context, completionRequest *h.RecordChildExecutionCompletedRequest) error {
+	span, ctx := startSpan(ctx, "RecordChildExecutionCompleted")
+	defer span.Finish()
 
 	domainEntry, err := e.getActiveDomainEntry(completionRequest.DomainUUID)
 	if err != nil {
@@ -2614,6 +2687,11 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(ctx context.Context, c
 		RunId:      completionRequest.WorkflowExecution.RunId,
 	}
 
+	// payloads offloaded for the child are deleted with the child, so the parent history must hold the payload itself
+	if err := e.shard.GetPayloadOffloader().ResolveEvents([]*workflow.HistoryEvent{completionRequest.CompletionEvent}); err != nil {
+		return err
+	}
+
 	return e.updateWorkflowExecution(ctx, domainID, execution, false, true,
 		func(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, error) {
 			if !msBuilder.IsWorkflowExecutionRunning() {
@@ -2653,14 +2731,23 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(ctx context.Context, c
 }
 
 func (e *historyEngineImpl) ReplicateEvents(ctx context.Context, replicateRequest *h.ReplicateEventsRequest) error {
+	span, ctx := startSpan(ctx, "ReplicateEvents")
+	defer span.Finish()
+
 	return e.replicator.ApplyEvents(ctx, replicateRequest)
 }
 
 func (e *historyEngineImpl) ReplicateRawEvents(ctx context.Context, replicateRequest *h.ReplicateRawEventsRequest) error {
+	span, ctx := startSpan(ctx, "ReplicateRawEvents")
+	defer span.Finish()
+
 	return e.replicator.ApplyRawEvents(ctx, replicateRequest)
 }
 
 func (e *historyEngineImpl) SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error {
+	span, ctx := startSpan(ctx, "SyncShardStatus")
+	defer span.Finish()
+
 	clusterName := request.GetSourceCluster()
 	now := time.Unix(0, request.GetTimestamp())
 
@@ -2675,10 +2762,16 @@ func (e *historyEngineImpl) SyncShardStatus(ctx context.Context, request *h.Sync
 }
 
 func (e *historyEngineImpl) SyncActivity(ctx context.Context, request *h.SyncActivityRequest) (retError error) {
+	span, ctx := startSpan(ctx, "SyncActivity")
+	defer span.Finish()
+
 	return e.replicator.SyncActivity(ctx, request)
 }
 
 func (e *historyEngineImpl) ResetWorkflowExecution(ctx context.Context, resetRequest *h.ResetWorkflowExecutionRequest) (response *workflow.ResetWorkflowExecutionResponse, retError error) {
+	span, ctx := startSpan(ctx, "ResetWorkflowExecution")
+	defer span.Finish()
+
 	return e.resetor.ResetWorkflowExecution(ctx, resetRequest)
 }
 
@@ -2699,7 +2792,9 @@ func (e *historyEngineImpl) updateWorkflowExecutionWithAction(ctx context.Contex
 
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		loadSpan := startPersistenceSpan(ctx, "GetWorkflowExecution")
 		msBuilder, err1 := context.loadWorkflowExecution()
+		finishPersistenceSpan(loadSpan, err1)
 		if err1 != nil {
 			return err1
 		}
@@ -2761,7 +2856,10 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
+		updateSpan := startPersistenceSpan(ctx, "UpdateWorkflowExecution")
+		err = context.updateWorkflowExecution(transferTasks, timerTasks, transactionID)
+		finishPersistenceSpan(updateSpan, err)
+		if err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
 			}
@@ -3341,7 +3439,7 @@ func getStartRequest(domainID string,
 	return startRequest
 }
 
-func getWorkflowStartedEvent(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, eventStoreVersion int32, branchToken []byte, logger bark.Logger, domainID, workflowID, runID string) (*workflow.HistoryEvent, error) {
+func getWorkflowStartedEvent(historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, payloadOffloader payload.Offloader, eventStoreVersion int32, branchToken []byte, logger bark.Logger, domainID, workflowID, runID string) (*workflow.HistoryEvent, error) {
 	var events []*workflow.HistoryEvent
 	if eventStoreVersion == persistence.EventStoreVersionV2 {
 		response, err := historyV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
@@ -3388,6 +3486,10 @@ func getWorkflowStartedEvent(historyMgr persistence.HistoryManager, historyV2Mgr
 		return nil, errNoHistoryFound
 	}
 
+	// the started event is used to start a new run, which must not refer to payloads offloaded for this run
+	if err := payloadOffloader.ResolveEvents(events[:1]); err != nil {
+		return nil, err
+	}
 	return events[0], nil
 }
 
@@ -3449,3 +3551,41 @@ func getWorkflowAlreadyStartedError(errMsg string, createRequestID string, workf
 		RunId:          common.StringPtr(fmt.Sprintf("%v", runID)),
 	}
 }
+
+// startSpan starts a child of the span of the request, the engine
+// does not trace requests whose context carries no span
+func startSpan(ctx context.Context, operation string) (opentracing.Span, context.Context) {
+	var parent opentracing.Span
+	if ctx != nil {
+		parent = opentracing.SpanFromContext(ctx)
+	}
+	if parent == nil {
+		return opentracing.NoopTracer{}.StartSpan(operation), ctx
+	}
+	return opentracing.StartSpanFromContextWithTracer(ctx, parent.Tracer(), historyEngineSpanPrefix+operation)
+}
+
+// startPersistenceSpan starts a child of the span of the engine operation for a persistence operation,
+// the persistence managers are not given the context of the request so the engine traces their calls
+func startPersistenceSpan(ctx context.Context, operation string) opentracing.Span {
+	var parent opentracing.Span
+	if ctx != nil {
+		parent = opentracing.SpanFromContext(ctx)
+	}
+	if parent == nil {
+		return opentracing.NoopTracer{}.StartSpan(operation)
+	}
+	span := parent.Tracer().StartSpan(persistenceSpanPrefix+operation, opentracing.ChildOf(parent.Context()))
+	ext.SpanKindRPCClient.Set(span)
+	ext.Component.Set(span, persistenceSpanComponent)
+	return span
+}
+
+// finishPersistenceSpan marks the span as failed if the persistence operation failed and finishes it
+func finishPersistenceSpan(span opentracing.Span, err error) {
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(log.Error(err))
+	}
+	span.Finish()
+}
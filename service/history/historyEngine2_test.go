@@ -24,9 +24,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -42,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/worker/archiver"
@@ -138,6 +142,7 @@ func (s *engine2Suite) SetupTest() {
 		maxTransferSequenceNumber: 100000,
 		closeCh:                   s.shardClosedCh,
 		config:                    s.config,
+		payloadOffloader:          payload.NewOffloader(nil, nil),
 		logger:                    s.logger,
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
 		eventsCache:               s.mockEventsCache,
@@ -1637,6 +1642,412 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_Start_WorkflowAlread
 	s.NotNil(err)
 }
 
+func (s *engine2Suite) TestDescribeShard() {
+	shard := s.historyEngine.shard.(*shardContextImpl)
+	shard.shardInfo.TransferAckLevel = 10
+	shard.shardInfo.ReplicationAckLevel = 5
+	shard.transferMaxReadLevel = 20
+
+	s.mockExecutionMgr.On("GetTransferTasks", &p.GetTransferTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 20,
+		BatchSize:    describeShardTaskCountPageSize,
+	}).Return(&p.GetTransferTasksResponse{Tasks: []*p.TransferTaskInfo{{TaskID: 11}, {TaskID: 12}}}, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(&p.GetTimerIndexTasksResponse{}, nil).Once()
+	s.mockExecutionMgr.On("GetReplicationTasks", &p.GetReplicationTasksRequest{
+		ReadLevel:    5,
+		MaxReadLevel: 20,
+		BatchSize:    describeShardTaskCountPageSize,
+	}).Return(&p.GetReplicationTasksResponse{Tasks: []*p.ReplicationTaskInfo{{TaskID: 6}}}, nil).Once()
+
+	resp, err := s.historyEngine.DescribeShard(context.Background(), &workflow.DescribeShardRequest{ShardId: common.Int32Ptr(0)})
+	s.Nil(err)
+	s.Equal(int64(1), resp.GetRangeId())
+	s.Equal(int64(10), resp.TransferQueue.GetAckLevel())
+	s.Equal(int64(20), resp.TransferQueue.GetMaxReadLevel())
+	s.Equal(int32(2), resp.TransferQueue.GetOutstandingTaskCount())
+	s.False(resp.TransferQueue.GetOutstandingTaskCountTruncated())
+	s.Equal(int32(0), resp.TimerQueue.GetOutstandingTaskCount())
+	s.Equal(int64(5), resp.ReplicationQueue.GetAckLevel())
+	s.Equal(int32(1), resp.ReplicationQueue.GetOutstandingTaskCount())
+}
+
+func (s *engine2Suite) TestListShardQueueTasks_DefaultRange() {
+	shard := s.historyEngine.shard.(*shardContextImpl)
+	shard.shardInfo.TransferAckLevel = 10
+	shard.transferMaxReadLevel = 20
+
+	visibilityTimestamp := time.Now()
+	s.mockExecutionMgr.On("GetTransferTasks", &p.GetTransferTasksRequest{
+		ReadLevel:    10,
+		MaxReadLevel: 20,
+		BatchSize:    defaultShardQueueTasksPageSize,
+	}).Return(&p.GetTransferTasksResponse{
+		Tasks: []*p.TransferTaskInfo{{
+			DomainID:            validDomainID,
+			WorkflowID:          "wId",
+			RunID:               validRunID,
+			TaskID:              11,
+			TaskType:            p.TransferTaskTypeDecisionTask,
+			ScheduleID:          2,
+			VisibilityTimestamp: visibilityTimestamp,
+		}},
+		NextPageToken: []byte{1},
+	}, nil).Once()
+
+	resp, err := s.historyEngine.ListShardQueueTasks(context.Background(), &workflow.ListShardQueueTasksRequest{
+		ShardId:   common.Int32Ptr(0),
+		QueueType: workflow.ShardQueueTypeTransfer.Ptr(),
+	})
+	s.Nil(err)
+	s.Equal([]byte{1}, resp.NextPageToken)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(int64(11), resp.Tasks[0].GetTaskId())
+	s.Equal(int32(p.TransferTaskTypeDecisionTask), resp.Tasks[0].GetTaskType())
+	s.Equal("wId", resp.Tasks[0].GetWorkflowId())
+	s.Equal(int64(2), resp.Tasks[0].GetEventId())
+	s.Equal(visibilityTimestamp.UnixNano(), resp.Tasks[0].GetVisibilityTimestamp())
+}
+
+func (s *engine2Suite) TestListShardQueueTasks_TimerRange() {
+	minTimestamp := time.Unix(0, 100)
+	maxTimestamp := time.Unix(0, 200)
+	s.mockExecutionMgr.On("GetTimerIndexTasks", &p.GetTimerIndexTasksRequest{
+		MinTimestamp: minTimestamp,
+		MaxTimestamp: maxTimestamp,
+		BatchSize:    10,
+	}).Return(&p.GetTimerIndexTasksResponse{Timers: []*p.TimerTaskInfo{{TaskID: 1, EventID: 5}}}, nil).Once()
+
+	resp, err := s.historyEngine.ListShardQueueTasks(context.Background(), &workflow.ListShardQueueTasksRequest{
+		ShardId:         common.Int32Ptr(0),
+		QueueType:       workflow.ShardQueueTypeTimer.Ptr(),
+		MinLevel:        common.Int64Ptr(minTimestamp.UnixNano()),
+		MaxLevel:        common.Int64Ptr(maxTimestamp.UnixNano()),
+		MaximumPageSize: common.Int32Ptr(10),
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(int64(5), resp.Tasks[0].GetEventId())
+
+	_, err = s.historyEngine.ListShardQueueTasks(context.Background(), &workflow.ListShardQueueTasksRequest{
+		ShardId: common.Int32Ptr(0),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engine2Suite) TestRemoveShardQueueTask() {
+	s.mockExecutionMgr.On("CompleteTransferTask", &p.CompleteTransferTaskRequest{TaskID: 11}).Return(nil).Once()
+	err := s.historyEngine.RemoveShardQueueTask(context.Background(), &workflow.RemoveShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(0),
+		QueueType: workflow.ShardQueueTypeTransfer.Ptr(),
+		TaskId:    common.Int64Ptr(11),
+	})
+	s.Nil(err)
+
+	err = s.historyEngine.RemoveShardQueueTask(context.Background(), &workflow.RemoveShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(0),
+		QueueType: workflow.ShardQueueTypeTimer.Ptr(),
+		TaskId:    common.Int64Ptr(12),
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+
+	visibilityTimestamp := time.Unix(0, 100)
+	s.mockExecutionMgr.On("CompleteTimerTask", &p.CompleteTimerTaskRequest{
+		VisibilityTimestamp: visibilityTimestamp,
+		TaskID:              12,
+	}).Return(nil).Once()
+	err = s.historyEngine.RemoveShardQueueTask(context.Background(), &workflow.RemoveShardQueueTaskRequest{
+		ShardId:             common.Int32Ptr(0),
+		QueueType:           workflow.ShardQueueTypeTimer.Ptr(),
+		TaskId:              common.Int64Ptr(12),
+		VisibilityTimestamp: common.Int64Ptr(visibilityTimestamp.UnixNano()),
+	})
+	s.Nil(err)
+}
+
+func (s *engine2Suite) TestRefreshWorkflowTasks() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	activityEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addTimerStartedEvent(msBuilder, decisionCompletedEvent.GetEventId(), "timer1", 10)
+	childEvent, _ := addStartChildWorkflowExecutionInitiatedEvent(msBuilder, decisionCompletedEvent.GetEventId(),
+		uuid.New(), "", "child-wId", "child-wType", tl, nil, 100, 10)
+	cancelEvent, _ := addRequestCancelInitiatedEvent(msBuilder, decisionCompletedEvent.GetEventId(), uuid.New(), "",
+		"cancel-wId", "cancel-rId")
+	signalEvent, _ := addRequestSignalInitiatedEvent(msBuilder, decisionCompletedEvent.GetEventId(), uuid.New(), "",
+		"signal-wId", "signal-rId", "signal", nil, nil)
+	// mark all the timer tasks as created, as if they were created and then lost
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		ai.TimerTaskStatus = TimerTaskStatusCreatedScheduleToClose | TimerTaskStatusCreatedScheduleToStart
+	}
+	for _, ti := range msBuilder.GetPendingTimerInfos() {
+		ti.TaskID = TimerTaskStatusCreated
+	}
+	history := msBuilder.GetHistoryBuilder().GetHistory().Events
+	ms := createMutableState(msBuilder)
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{HistoryEvents: history}, nil).Once()
+	s.mockEventsCache.On("getEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		common.FirstEventID, common.FirstEventID, mock.Anything, mock.Anything).Return(history[0], nil)
+	s.mockEventsCache.On("getEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		decisionCompletedEvent.GetEventId(), childEvent.GetEventId(), mock.Anything, mock.Anything).Return(childEvent, nil)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		updateRequest = request
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.historyEngine.RefreshWorkflowTasks(context.Background(), &h.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.RefreshWorkflowTasksRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.Nil(err)
+	s.NotNil(updateRequest)
+
+	transferTasks := make(map[int]p.Task)
+	for _, task := range updateRequest.TransferTasks {
+		transferTasks[task.GetType()] = task
+	}
+	s.Len(transferTasks, 4)
+	s.Equal(activityEvent.GetEventId(), transferTasks[p.TransferTaskTypeActivityTask].(*p.ActivityTask).ScheduleID)
+	s.Equal(childEvent.GetEventId(), transferTasks[p.TransferTaskTypeStartChildExecution].(*p.StartChildExecutionTask).InitiatedID)
+	cancelTask := transferTasks[p.TransferTaskTypeCancelExecution].(*p.CancelExecutionTask)
+	s.Equal(domainID, cancelTask.TargetDomainID)
+	s.Equal("cancel-wId", cancelTask.TargetWorkflowID)
+	s.Equal("cancel-rId", cancelTask.TargetRunID)
+	s.Equal(cancelEvent.GetEventId(), cancelTask.InitiatedID)
+	s.Equal("signal-wId", transferTasks[p.TransferTaskTypeSignalExecution].(*p.SignalExecutionTask).TargetWorkflowID)
+	s.Equal(signalEvent.GetEventId(), transferTasks[p.TransferTaskTypeSignalExecution].(*p.SignalExecutionTask).InitiatedID)
+
+	timerTasks := make(map[int]p.Task)
+	for _, task := range updateRequest.TimerTasks {
+		timerTasks[task.GetType()] = task
+	}
+	s.Len(timerTasks, 3)
+	s.Contains(timerTasks, p.TaskTypeWorkflowTimeout)
+	s.Contains(timerTasks, p.TaskTypeActivityTimeout)
+	s.Contains(timerTasks, p.TaskTypeUserTimer)
+}
+
+func (s *engine2Suite) TestRefreshWorkflowTasks_ClosedNotCurrentRun() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskList", "testIdentity", false)
+	msBuilder.GetExecutionInfo().State = p.WorkflowStateCompleted
+	ms := createMutableState(msBuilder)
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", &p.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+	}).Return(&p.GetCurrentExecutionResponse{RunID: uuid.New()}, nil).Once()
+
+	err := s.historyEngine.RefreshWorkflowTasks(context.Background(), &h.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.RefreshWorkflowTasksRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engine2Suite) TestRefreshWorkflowTasks_ClosedCurrentRun() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	retentionDays := int32(2)
+	s.mockDomainCache.ExpectedCalls = nil
+	s.mockDomainCache.On("GetDomainByID", domainID).Return(cache.NewDomainCacheEntryForTest(
+		&p.DomainInfo{ID: domainID}, &p.DomainConfig{Retention: retentionDays}), nil)
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskList", "testIdentity", false)
+	closeTime := time.Now().Add(-time.Hour)
+	msBuilder.GetExecutionInfo().State = p.WorkflowStateCompleted
+	msBuilder.GetExecutionInfo().CompletionEvent = &workflow.HistoryEvent{Timestamp: common.Int64Ptr(closeTime.UnixNano())}
+	ms := createMutableState(msBuilder)
+
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", &p.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+	}).Return(&p.GetCurrentExecutionResponse{RunID: validRunID}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		updateRequest = request
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.historyEngine.RefreshWorkflowTasks(context.Background(), &h.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.RefreshWorkflowTasksRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.Nil(err)
+	s.NotNil(updateRequest)
+	s.Empty(updateRequest.TransferTasks)
+	s.Len(updateRequest.TimerTasks, 1)
+	deleteTask, ok := updateRequest.TimerTasks[0].(*p.DeleteHistoryEventTask)
+	s.True(ok)
+	s.Equal(closeTime.Add(time.Duration(retentionDays)*24*time.Hour).UnixNano(), deleteTask.VisibilityTimestamp.UnixNano())
+}
+
+func (s *engine2Suite) TestRebuildMutableState_NoDiff() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1_id", "activity_type1", tl,
+		[]byte("input1"), 100, 10, 5)
+	addTimerStartedEvent(msBuilder, decisionCompletedEvent.GetEventId(), "timer1", 10)
+	history := msBuilder.GetHistoryBuilder().GetHistory().Events
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.LastFirstEventID = decisionCompletedEvent.GetEventId()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything).Return(&p.ReadHistoryBranchByBatchResponse{
+		History: []*workflow.History{{Events: history[0:2]}, {Events: history[2:3]}, {Events: history[3:]}},
+	}, nil).Once()
+
+	resp, err := s.historyEngine.RebuildMutableState(context.Background(), &h.RebuildMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.RebuildMutableStateRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.Nil(err)
+	s.Empty(resp.Diff)
+	s.False(resp.GetApplied())
+}
+
+func (s *engine2Suite) TestRebuildMutableState_DryRun() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	activityEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	history := msBuilder.GetHistoryBuilder().GetHistory().Events
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.LastFirstEventID = decisionCompletedEvent.GetEventId()
+	// corrupt the stored mutable state
+	delete(ms.ActivityInfos, activityEvent.GetEventId())
+	ms.ExecutionInfo.LastProcessedEvent = common.EmptyEventID
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything).Return(&p.ReadHistoryBranchByBatchResponse{
+		History: []*workflow.History{{Events: history[0:2]}, {Events: history[2:3]}, {Events: history[3:]}},
+	}, nil).Once()
+
+	resp, err := s.historyEngine.RebuildMutableState(context.Background(), &h.RebuildMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.RebuildMutableStateRequest{
+			Execution: &workflowExecution,
+			DryRun:    common.BoolPtr(true),
+		},
+	})
+	s.Nil(err)
+	s.Equal([]string{
+		fmt.Sprintf("ExecutionInfo.LastProcessedEvent: %v -> %v", common.EmptyEventID, decisionCompletedEvent.GetEventId()-1),
+		fmt.Sprintf("ActivityInfos[%v]: missing in stored mutable state", activityEvent.GetEventId()),
+	}, resp.Diff)
+	s.False(resp.GetApplied())
+}
+
+func (s *engine2Suite) TestRebuildMutableState_Apply() {
+	domainID := validDomainID
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	activityEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1_id",
+		"activity_type1", tl, []byte("input1"), 100, 10, 5)
+	history := msBuilder.GetHistoryBuilder().GetHistory().Events
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.LastFirstEventID = decisionCompletedEvent.GetEventId()
+	rebuiltMS := createMutableState(msBuilder)
+	delete(ms.ActivityInfos, activityEvent.GetEventId())
+
+	var resetRequest *p.ResetMutableStateRequest
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: ms}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.Anything).Return(&p.ReadHistoryBranchByBatchResponse{
+		History: []*workflow.History{{Events: history[0:2]}, {Events: history[2:3]}, {Events: history[3:]}},
+	}, nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", &p.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+	}).Return(&p.GetCurrentExecutionResponse{RunID: workflowExecution.GetRunId()}, nil).Once()
+	s.mockExecutionMgr.On("ResetMutableState", mock.MatchedBy(func(request *p.ResetMutableStateRequest) bool {
+		resetRequest = request
+		return true
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{State: rebuiltMS}, nil).Once()
+	s.mockEventsCache.On("getEvent", domainID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		common.FirstEventID, common.FirstEventID, mock.Anything, mock.Anything).Return(history[0], nil)
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		updateRequest = request
+		return true
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	resp, err := s.historyEngine.RebuildMutableState(context.Background(), &h.RebuildMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Request: &workflow.RebuildMutableStateRequest{
+			Execution: &workflowExecution,
+		},
+	})
+	s.Nil(err)
+	s.Equal([]string{
+		fmt.Sprintf("ActivityInfos[%v]: missing in stored mutable state", activityEvent.GetEventId()),
+	}, resp.Diff)
+	s.True(resp.GetApplied())
+
+	s.NotNil(resetRequest)
+	s.Equal(workflowExecution.GetRunId(), resetRequest.PrevRunID)
+	s.Equal(ms.ExecutionInfo.NextEventID, resetRequest.Condition)
+	s.Len(resetRequest.InsertActivityInfos, 1)
+	s.Equal(activityEvent.GetEventId(), resetRequest.InsertActivityInfos[0].ScheduleID)
+
+	s.NotNil(updateRequest)
+	s.Len(updateRequest.TransferTasks, 1)
+	s.Equal(activityEvent.GetEventId(), updateRequest.TransferTasks[0].(*p.ActivityTask).ScheduleID)
+}
+
 func (s *engine2Suite) getBuilder(domainID string, we workflow.WorkflowExecution) mutableState {
 	context, release, err := s.historyEngine.historyCache.getOrCreateWorkflowExecution(domainID, we)
 	if err != nil {
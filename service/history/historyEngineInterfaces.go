@@ -73,6 +73,14 @@ type (
 		ReplicateRawEvents(ctx context.Context, request *h.ReplicateRawEventsRequest) error
 		SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
+		ListDLQTasks(ctx context.Context, request *workflow.ListDLQTasksRequest) (*workflow.ListDLQTasksResponse, error)
+		RetryDLQTask(ctx context.Context, request *workflow.RetryDLQTaskRequest) error
+		DropDLQTask(ctx context.Context, request *workflow.DropDLQTaskRequest) error
+		DescribeShard(ctx context.Context, request *workflow.DescribeShardRequest) (*workflow.DescribeShardResponse, error)
+		ListShardQueueTasks(ctx context.Context, request *workflow.ListShardQueueTasksRequest) (*workflow.ListShardQueueTasksResponse, error)
+		RemoveShardQueueTask(ctx context.Context, request *workflow.RemoveShardQueueTaskRequest) error
+		RefreshWorkflowTasks(ctx context.Context, request *h.RefreshWorkflowTasksRequest) error
+		RebuildMutableState(ctx context.Context, request *h.RebuildMutableStateRequest) (*workflow.RebuildMutableStateResponse, error)
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -95,6 +103,7 @@ type (
 	}
 
 	queueTaskInfo interface {
+		GetDomainID() string
 		GetVersion() int64
 		GetTaskID() int64
 		GetTaskType() int
@@ -115,6 +124,7 @@ type (
 		NotifyNewTask(clusterName string, transferTasks []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		RetryDLQTask(task *persistence.TransferTaskInfo) error
 	}
 
 	// TODO the timer queue processor and the one below, timer processor
@@ -126,6 +136,7 @@ type (
 		NotifyNewTimers(clusterName string, currentTime time.Time, timerTask []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		RetryDLQTask(task *persistence.TimerTaskInfo) error
 	}
 
 	timerProcessor interface {
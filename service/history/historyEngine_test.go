@@ -28,6 +28,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/mocktracer"
 	"github.com/pborman/uuid"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
@@ -44,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -145,6 +148,7 @@ func (s *engineSuite) SetupTest() {
 		maxTransferSequenceNumber: 100000,
 		closeCh:                   s.shardClosedCh,
 		config:                    s.config,
+		payloadOffloader:          payload.NewOffloader(nil, nil),
 		logger:                    s.logger,
 		metricsClient:             metrics.NewClient(tally.NoopScope, metrics.History),
 	}
@@ -225,6 +229,40 @@ func (s *engineSuite) TestGetMutableStateSync() {
 	s.Equal(int64(4), response.GetNextEventId())
 }
 
+func (s *engineSuite) TestGetMutableStateTraced() {
+	tracer := mocktracer.New()
+	requestSpan := tracer.StartSpan("request")
+	ctx := opentracing.ContextWithSpan(context.Background(), requestSpan)
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-get-mutable-state-traced"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockClusterMetadata.GetCurrentClusterName(), s.mockHistoryEngine.shard, s.eventsCache,
+		bark.NewLoggerFromLogrus(log.New()), execution.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskList", []byte("input"), 100, 200, "testIdentity")
+	gweResponse := &persistence.GetWorkflowExecutionResponse{State: createMutableState(msBuilder)}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gweResponse, nil).Once()
+
+	_, err := s.mockHistoryEngine.GetMutableState(ctx, &history.GetMutableStateRequest{
+		DomainUUID: common.StringPtr(validDomainID),
+		Execution:  &execution,
+	})
+	s.Nil(err)
+
+	spans := make(map[string]*mocktracer.MockSpan)
+	for _, span := range tracer.FinishedSpans() {
+		spans[span.OperationName] = span
+	}
+	engineSpan := spans[historyEngineSpanPrefix+"GetMutableState"]
+	persistenceSpan := spans[persistenceSpanPrefix+"GetWorkflowExecution"]
+	s.NotNil(engineSpan)
+	s.NotNil(persistenceSpan)
+	s.Equal(requestSpan.Context().(mocktracer.MockSpanContext).SpanID, engineSpan.ParentID)
+	s.Equal(engineSpan.SpanContext.SpanID, persistenceSpan.ParentID)
+	s.Equal(persistenceSpanComponent, persistenceSpan.Tag("component"))
+}
+
 func (s *engineSuite) TestGetMutableState_InvalidRunID() {
 	ctx := context.Background()
 	domainID := validDomainID
@@ -36,6 +36,7 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	persistencetests "github.com/uber/cadence/common/persistence/persistence-tests"
 	"github.com/uber/cadence/common/service"
@@ -72,6 +73,7 @@ type (
 		executionMgr           persistence.ExecutionManager
 		domainCache            cache.DomainCache
 		eventsCache            eventsCache
+		payloadOffloader       payload.Offloader
 
 		config                    *Config
 		logger                    bark.Logger
@@ -130,6 +132,7 @@ func newTestShardContext(shardInfo *persistence.ShardInfo, transferSequenceNumbe
 	}
 
 	shardCtx.eventsCache = newEventsCache(shardCtx)
+	shardCtx.payloadOffloader = newPayloadOffloader(shardCtx.service, config, metricsClient)
 	return shardCtx
 }
 
@@ -168,6 +171,19 @@ func (s *TestShardContext) GetEventsCache() eventsCache {
 	return s.eventsCache
 }
 
+// GetPayloadOffloader test implementation
+func (s *TestShardContext) GetPayloadOffloader() payload.Offloader {
+	return s.payloadOffloader
+}
+
+// GetShardInfo test implementation
+func (s *TestShardContext) GetShardInfo() *persistence.ShardInfo {
+	s.RLock()
+	defer s.RUnlock()
+
+	return copyShardInfo(s.shardInfo)
+}
+
 // GetNextTransferTaskID test implementation
 func (s *TestShardContext) GetNextTransferTaskID() (int64, error) {
 	return atomic.AddInt64(&s.transferSequenceNumber, 1), nil
@@ -415,6 +431,16 @@ func (s *TestShardContext) GetTimerMaxReadLevel(cluster string) time.Time {
 	return s.timerMaxReadLevelMap[cluster]
 }
 
+// PutTaskToDLQ test implementation
+func (s *TestShardContext) PutTaskToDLQ(request *persistence.PutTaskToDLQRequest) error {
+	return s.executionMgr.PutTaskToDLQ(request)
+}
+
+// DeleteDLQTask test implementation
+func (s *TestShardContext) DeleteDLQTask(request *persistence.DeleteDLQTaskRequest) error {
+	return s.executionMgr.DeleteDLQTask(request)
+}
+
 // ResetMutableState test implementation
 func (s *TestShardContext) ResetMutableState(request *persistence.ResetMutableStateRequest) error {
 	return s.executionMgr.ResetMutableState(request)
@@ -45,12 +45,15 @@ type (
 		StartDelay                         dynamicconfig.DurationPropertyFn
 		BatchSize                          dynamicconfig.IntPropertyFn
 		WorkerCount                        dynamicconfig.IntPropertyFn
+		DomainWeight                       dynamicconfig.IntPropertyFnWithDomainFilter
+		SchedulerBufferSize                dynamicconfig.IntPropertyFn
 		MaxPollRPS                         dynamicconfig.IntPropertyFn
 		MaxPollInterval                    dynamicconfig.DurationPropertyFn
 		MaxPollIntervalJitterCoefficient   dynamicconfig.FloatPropertyFn
 		UpdateAckInterval                  dynamicconfig.DurationPropertyFn
 		UpdateAckIntervalJitterCoefficient dynamicconfig.FloatPropertyFn
 		MaxRetryCount                      dynamicconfig.IntPropertyFn
+		EnableTaskDLQ                      dynamicconfig.BoolPropertyFn
 		MetricScope                        int
 	}
 
@@ -151,13 +154,13 @@ func (p *queueProcessorBase) processorPump() {
 	<-time.NewTimer(backoff.NewJitter().JitDuration(p.options.StartDelay(), 0.99)).C
 
 	defer p.shutdownWG.Done()
-	tasksCh := make(chan queueTaskInfo, p.options.BatchSize())
+	scheduler := p.newDomainTaskScheduler()
 
 	var workerWG sync.WaitGroup
 	for i := 0; i < p.options.WorkerCount(); i++ {
 		workerWG.Add(1)
 		notificationChan := p.workerNotificationChans[i]
-		go p.taskWorker(tasksCh, notificationChan, &workerWG)
+		go p.taskWorker(scheduler, notificationChan, &workerWG)
 	}
 
 	jitter := backoff.NewJitter()
@@ -182,14 +185,14 @@ processorPumpLoop:
 			// use a separate gorouting since the caller hold the shutdownWG
 			go p.Stop()
 		case <-p.notifyCh:
-			p.processBatch(tasksCh)
+			p.processBatch(scheduler)
 		case <-pollTimer.C:
 			pollTimer.Reset(jitter.JitDuration(
 				p.options.MaxPollInterval(),
 				p.options.MaxPollIntervalJitterCoefficient(),
 			))
 			if p.lastPollTime.Add(p.options.MaxPollInterval()).Before(time.Now()) {
-				p.processBatch(tasksCh)
+				p.processBatch(scheduler)
 			}
 		case <-updateAckTimer.C:
 			updateAckTimer.Reset(jitter.JitDuration(
@@ -201,15 +204,27 @@ processorPumpLoop:
 	}
 
 	p.logger.Info("Queue processor pump shutting down.")
-	// This is the only pump which writes to tasksCh, so it is safe to close channel here
-	close(tasksCh)
+	// This is the only pump which schedules tasks, so it is safe to close the scheduler here
+	scheduler.close()
 	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
 		p.logger.Warn("Queue processor timedout on worker shutdown.")
 	}
 
 }
 
-func (p *queueProcessorBase) processBatch(tasksCh chan<- queueTaskInfo) {
+// newDomainTaskScheduler creates the scheduler handing the tasks read by the pump over to the task workers,
+// its buffer spans several batches so the tasks of a domain are not stuck behind a backlog of another
+// domain which is larger than a single batch
+func (p *queueProcessorBase) newDomainTaskScheduler() *domainTaskScheduler {
+	bufferSize := p.options.BatchSize()
+	if p.options.SchedulerBufferSize != nil && p.options.SchedulerBufferSize() > bufferSize {
+		bufferSize = p.options.SchedulerBufferSize()
+	}
+	return newDomainTaskScheduler(p.shard, bufferSize, p.options.DomainWeight, p.metricsClient,
+		p.options.MetricScope, p.shutdownCh)
+}
+
+func (p *queueProcessorBase) processBatch(scheduler *domainTaskScheduler) {
 
 	if !p.rateLimiter.Consume(1, loadQueueTaskThrottleRetryDelay) {
 		p.notifyNewTask() // re-enqueue the event
@@ -230,9 +245,7 @@ func (p *queueProcessorBase) processBatch(tasksCh chan<- queueTaskInfo) {
 	}
 
 	for _, task := range tasks {
-		select {
-		case tasksCh <- task:
-		case <-p.shutdownCh:
+		if !scheduler.schedule(task) {
 			return
 		}
 	}
@@ -246,19 +259,15 @@ func (p *queueProcessorBase) processBatch(tasksCh chan<- queueTaskInfo) {
 	return
 }
 
-func (p *queueProcessorBase) taskWorker(tasksCh <-chan queueTaskInfo, notificationChan <-chan struct{}, workerWG *sync.WaitGroup) {
+func (p *queueProcessorBase) taskWorker(scheduler *domainTaskScheduler, notificationChan <-chan struct{}, workerWG *sync.WaitGroup) {
 	defer workerWG.Done()
 
 	for {
-		select {
-		case <-p.shutdownCh:
+		task, domainName, ok := scheduler.next()
+		if !ok {
 			return
-		case task, ok := <-tasksCh:
-			if !ok {
-				return
-			}
-			p.processTaskAndAck(notificationChan, task)
 		}
+		p.processTaskAndAck(notificationChan, task, domainName)
 	}
 }
 
@@ -271,7 +280,7 @@ func (p *queueProcessorBase) retryTasks() {
 	}
 }
 
-func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{}, task queueTaskInfo) {
+func (p *queueProcessorBase) processTaskAndAck(notificationChan <-chan struct{}, task queueTaskInfo, domainName string) {
 
 	var scope int
 	var shouldProcessTask bool
@@ -329,14 +338,44 @@ FilterLoop:
 		default:
 			err = backoff.Retry(op, p.retryPolicy, retryCondition)
 			if err == nil {
-				p.ackTaskOnce(task, scope, shouldProcessTask, startTime, attempt)
+				p.ackTaskOnce(task, domainName, scope, shouldProcessTask, startTime, attempt)
 				return
 			}
 			incAttempt()
+			if p.shouldMoveTaskToDLQ(task, attempt, err) && p.moveTaskToDLQ(task, scope, logger) {
+				// the task is kept in the DLQ, ack it so the ack level is not blocked by it
+				p.ackTaskOnce(task, domainName, scope, shouldProcessTask, startTime, attempt)
+				return
+			}
 		}
 	}
 }
 
+func (p *queueProcessorBase) shouldMoveTaskToDLQ(task queueTaskInfo, attempt int, err error) bool {
+	if p.options.EnableTaskDLQ == nil || !p.options.EnableTaskDLQ() {
+		return false
+	}
+	// only transfer tasks can be moved into the task DLQ, replication tasks are retried until they succeed
+	if _, ok := task.(*persistence.TransferTaskInfo); !ok {
+		return false
+	}
+	return attempt >= p.options.MaxRetryCount() && isTaskDLQEligibleError(err)
+}
+
+func (p *queueProcessorBase) moveTaskToDLQ(task queueTaskInfo, scope int, logger bark.Logger) bool {
+	err := p.shard.PutTaskToDLQ(&persistence.PutTaskToDLQRequest{
+		TransferTask: task.(*persistence.TransferTaskInfo),
+	})
+	if err != nil {
+		logger.WithField(logging.TagErr, err).Error("Fail to move transfer task to DLQ.")
+		return false
+	}
+
+	p.metricsClient.IncCounter(scope, metrics.TaskMovedToDLQCounter)
+	logger.Warn("Transfer task moved to DLQ.")
+	return true
+}
+
 func (p *queueProcessorBase) processTaskOnce(notificationChan <-chan struct{}, task queueTaskInfo, shouldProcessTask bool, logger bark.Logger) (int, error) {
 	select {
 	case <-notificationChan:
@@ -402,11 +441,12 @@ func (p *queueProcessorBase) handleTaskError(scope int, startTime time.Time,
 	return err
 }
 
-func (p *queueProcessorBase) ackTaskOnce(task queueTaskInfo, scope int, reportMetrics bool, startTime time.Time, attempt int) {
+func (p *queueProcessorBase) ackTaskOnce(task queueTaskInfo, domainName string, scope int, reportMetrics bool, startTime time.Time, attempt int) {
 	p.ackMgr.completeQueueTask(task.GetTaskID())
 	if reportMetrics {
 		p.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
-		p.metricsClient.RecordTimer(scope, metrics.TaskLatency, time.Since(startTime))
+		p.metricsClient.Scope(scope, metrics.DomainTag(domainName)).
+			RecordTimer(metrics.TaskLatency, time.Since(startTime))
 		p.metricsClient.RecordTimer(
 			scope,
 			metrics.TaskQueueLatency,
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/client"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
@@ -132,7 +133,7 @@ func (s *queueProcessorSuite) TearDownTest() {
 
 func (s *queueProcessorSuite) TestProcessTaskAndAck_ShutDown() {
 	close(s.queueProcessor.shutdownCh)
-	s.queueProcessor.processTaskAndAck(s.notificationChan, &persistence.TransferTaskInfo{})
+	s.queueProcessor.processTaskAndAck(s.notificationChan, &persistence.TransferTaskInfo{}, "")
 }
 
 func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainErrRetry_ProcessNoErr() {
@@ -147,7 +148,7 @@ func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainErrRetry_ProcessNoErr(
 	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
 	s.mockProcessor.On("process", task, true).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainFalse_ProcessNoErr() {
@@ -158,7 +159,7 @@ func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainFalse_ProcessNoErr() {
 	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
 	s.mockProcessor.On("process", task, false).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessNoErr() {
@@ -169,7 +170,7 @@ func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessNoErr() {
 	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
 	s.mockProcessor.On("process", task, true).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessErrNoErr() {
@@ -182,7 +183,7 @@ func (s *queueProcessorSuite) TestProcessTaskAndAck_DomainTrue_ProcessErrNoErr()
 	s.mockProcessor.On("process", task, true).Return(s.scope, err).Once()
 	s.mockProcessor.On("process", task, true).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.queueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.queueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *queueProcessorSuite) TestHandleTaskError_EntiryNotExists() {
@@ -235,3 +236,41 @@ func (s *queueProcessorSuite) TestHandleTaskError_RandomErr() {
 	err := errors.New("random error")
 	s.Equal(err, s.queueProcessor.handleTaskError(s.scope, time.Now(), s.notificationChan, err, s.logger))
 }
+
+func (s *queueProcessorSuite) TestProcessBatch_BacklogLargerThanBatch() {
+	s.queueProcessor.options.BatchSize = dynamicconfig.GetIntPropertyFn(2)
+	s.queueProcessor.options.SchedulerBufferSize = dynamicconfig.GetIntPropertyFn(6)
+	// the domain ID is used as the domain name when the domain can not be loaded
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(nil, &workflow.EntityNotExistsError{})
+
+	batches := [][]queueTaskInfo{
+		{
+			&persistence.TransferTaskInfo{DomainID: "domain1", TaskID: 1},
+			&persistence.TransferTaskInfo{DomainID: "domain1", TaskID: 2},
+		},
+		{
+			&persistence.TransferTaskInfo{DomainID: "domain1", TaskID: 3},
+			&persistence.TransferTaskInfo{DomainID: "domain1", TaskID: 4},
+		},
+		{
+			&persistence.TransferTaskInfo{DomainID: "domain2", TaskID: 5},
+		},
+	}
+	for i, batch := range batches {
+		s.mockQueueAckMgr.On("readQueueTasks").Return(batch, i < len(batches)-1, nil).Once()
+	}
+
+	scheduler := s.queueProcessor.newDomainTaskScheduler()
+	for range batches {
+		// the scheduler buffers more than one batch, so reading the backlog does not block
+		s.queueProcessor.processBatch(scheduler)
+	}
+
+	// the task of domain2 is read in the last batch but handed out right after the first task of domain1
+	expectedTaskIDs := []int64{1, 5, 2, 3, 4}
+	for _, taskID := range expectedTaskIDs {
+		task, _, ok := scheduler.next()
+		s.True(ok)
+		s.Equal(taskID, task.GetTaskID())
+	}
+}
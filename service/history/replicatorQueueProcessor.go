@@ -222,6 +222,17 @@ func (p *replicatorQueueProcessorImpl) processHistoryReplicationTask(task *persi
 		return err
 	}
 
+	// remote clusters do not necessarily share the blobstore, so offloaded payloads are replicated in place
+	attributes := replicationTask.HistoryTaskAttributes
+	if err := p.shard.GetPayloadOffloader().ResolveEvents(attributes.History.Events); err != nil {
+		return err
+	}
+	if attributes.NewRunHistory != nil {
+		if err := p.shard.GetPayloadOffloader().ResolveEvents(attributes.NewRunHistory.Events); err != nil {
+			return err
+		}
+	}
+
 	return p.replicator.Publish(replicationTask)
 }
 
@@ -59,8 +59,10 @@ type Config struct {
 	EventsCacheTTL         dynamicconfig.DurationPropertyFn
 
 	// ShardController settings
-	RangeSizeBits        uint
-	AcquireShardInterval dynamicconfig.DurationPropertyFn
+	RangeSizeBits              uint
+	AcquireShardInterval       dynamicconfig.DurationPropertyFn
+	EnableGracefulShardHandoff dynamicconfig.BoolPropertyFn
+	ShardHandoffTimeout        dynamicconfig.DurationPropertyFn
 
 	// the artificial delay added to standby cluster's view of active cluster's time
 	StandbyClusterDelay dynamicconfig.DurationPropertyFn
@@ -68,7 +70,10 @@ type Config struct {
 	// TimerQueueProcessor settings
 	TimerTaskBatchSize                               dynamicconfig.IntPropertyFn
 	TimerTaskWorkerCount                             dynamicconfig.IntPropertyFn
+	TimerProcessorDomainWeight                       dynamicconfig.IntPropertyFnWithDomainFilter
+	TimerProcessorSchedulerBufferSize                dynamicconfig.IntPropertyFn
 	TimerTaskMaxRetryCount                           dynamicconfig.IntPropertyFn
+	TimerTaskDLQEnabled                              dynamicconfig.BoolPropertyFn
 	TimerProcessorStartDelay                         dynamicconfig.DurationPropertyFn
 	TimerProcessorFailoverStartDelay                 dynamicconfig.DurationPropertyFn
 	TimerProcessorGetFailureRetryCount               dynamicconfig.IntPropertyFn
@@ -85,7 +90,10 @@ type Config struct {
 	// TransferQueueProcessor settings
 	TransferTaskBatchSize                               dynamicconfig.IntPropertyFn
 	TransferTaskWorkerCount                             dynamicconfig.IntPropertyFn
+	TransferProcessorDomainWeight                       dynamicconfig.IntPropertyFnWithDomainFilter
+	TransferProcessorSchedulerBufferSize                dynamicconfig.IntPropertyFn
 	TransferTaskMaxRetryCount                           dynamicconfig.IntPropertyFn
+	TransferTaskDLQEnabled                              dynamicconfig.BoolPropertyFn
 	TransferProcessorStartDelay                         dynamicconfig.DurationPropertyFn
 	TransferProcessorFailoverStartDelay                 dynamicconfig.DurationPropertyFn
 	TransferProcessorCompleteTransferFailureRetryCount  dynamicconfig.IntPropertyFn
@@ -139,6 +147,11 @@ type Config struct {
 	HistoryCountLimitError dynamicconfig.IntPropertyFnWithDomainFilter
 	HistoryCountLimitWarn  dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// PayloadOffloadBucket is the blobstore bucket large event payloads are offloaded to
+	PayloadOffloadBucket dynamicconfig.StringPropertyFn
+	// PayloadOffloadThreshold is the payload size above which event payloads of a domain are offloaded
+	PayloadOffloadThreshold dynamicconfig.IntPropertyFnWithDomainFilter
+
 	ThrottledLogRPS dynamicconfig.IntPropertyFn
 }
 
@@ -163,10 +176,15 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		EventsCacheTTL:                                        dc.GetDurationProperty(dynamicconfig.EventsCacheTTL, time.Hour),
 		RangeSizeBits:                                         20, // 20 bits for sequencer, 2^20 sequence number for any range
 		AcquireShardInterval:                                  dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, time.Minute),
+		EnableGracefulShardHandoff:                            dc.GetBoolProperty(dynamicconfig.EnableGracefulShardHandoff, true),
+		ShardHandoffTimeout:                                   dc.GetDurationProperty(dynamicconfig.ShardHandoffTimeout, 10*time.Second),
 		StandbyClusterDelay:                                   dc.GetDurationProperty(dynamicconfig.AcquireShardInterval, 5*time.Minute),
 		TimerTaskBatchSize:                                    dc.GetIntProperty(dynamicconfig.TimerTaskBatchSize, 100),
 		TimerTaskWorkerCount:                                  dc.GetIntProperty(dynamicconfig.TimerTaskWorkerCount, 10),
+		TimerProcessorDomainWeight:                            dc.GetIntPropertyFilteredByDomain(dynamicconfig.TimerProcessorDomainWeight, 1),
+		TimerProcessorSchedulerBufferSize:                     dc.GetIntProperty(dynamicconfig.TimerProcessorSchedulerBufferSize, 1000),
 		TimerTaskMaxRetryCount:                                dc.GetIntProperty(dynamicconfig.TimerTaskMaxRetryCount, 100),
+		TimerTaskDLQEnabled:                                   dc.GetBoolProperty(dynamicconfig.TimerTaskDLQEnabled, true),
 		TimerProcessorStartDelay:                              dc.GetDurationProperty(dynamicconfig.TimerProcessorStartDelay, 1*time.Microsecond),
 		TimerProcessorFailoverStartDelay:                      dc.GetDurationProperty(dynamicconfig.TimerProcessorFailoverStartDelay, 5*time.Second),
 		TimerProcessorGetFailureRetryCount:                    dc.GetIntProperty(dynamicconfig.TimerProcessorGetFailureRetryCount, 5),
@@ -183,7 +201,10 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		TransferProcessorFailoverMaxPollRPS:                   dc.GetIntProperty(dynamicconfig.TransferProcessorFailoverMaxPollRPS, 1),
 		TransferProcessorMaxPollRPS:                           dc.GetIntProperty(dynamicconfig.TransferProcessorMaxPollRPS, 20),
 		TransferTaskWorkerCount:                               dc.GetIntProperty(dynamicconfig.TransferTaskWorkerCount, 10),
+		TransferProcessorDomainWeight:                         dc.GetIntPropertyFilteredByDomain(dynamicconfig.TransferProcessorDomainWeight, 1),
+		TransferProcessorSchedulerBufferSize:                  dc.GetIntProperty(dynamicconfig.TransferProcessorSchedulerBufferSize, 1000),
 		TransferTaskMaxRetryCount:                             dc.GetIntProperty(dynamicconfig.TransferTaskMaxRetryCount, 100),
+		TransferTaskDLQEnabled:                                dc.GetBoolProperty(dynamicconfig.TransferTaskDLQEnabled, true),
 		TransferProcessorStartDelay:                           dc.GetDurationProperty(dynamicconfig.TransferProcessorStartDelay, 1*time.Microsecond),
 		TransferProcessorFailoverStartDelay:                   dc.GetDurationProperty(dynamicconfig.TransferProcessorFailoverStartDelay, 5*time.Second),
 		TransferProcessorCompleteTransferFailureRetryCount:    dc.GetIntProperty(dynamicconfig.TransferProcessorCompleteTransferFailureRetryCount, 10),
@@ -222,6 +243,9 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		HistoryCountLimitError: dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitError, 200*1024),
 		HistoryCountLimitWarn:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryCountLimitWarn, 50*1024),
 
+		PayloadOffloadBucket:    dc.GetStringProperty(dynamicconfig.PayloadOffloadBucket, ""),
+		PayloadOffloadThreshold: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 0),
+
 		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.HistoryThrottledLogRPS, 20),
 	}
 
@@ -319,7 +343,7 @@ func (s *Service) Start() {
 	log.Infof("%v started", common.HistoryServiceName)
 
 	<-s.stopC
-	base.Stop()
+	handler.Stop()
 }
 
 // Stop stops the service
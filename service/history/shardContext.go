@@ -30,10 +30,12 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 )
@@ -47,6 +49,7 @@ type (
 		GetHistoryManager() persistence.HistoryManager
 		GetHistoryV2Manager() persistence.HistoryV2Manager
 		GetDomainCache() cache.DomainCache
+		GetShardInfo() *persistence.ShardInfo
 		GetNextTransferTaskID() (int64, error)
 		GetTransferTaskIDs(number int) ([]int64, error)
 		GetTransferMaxReadLevel() int64
@@ -78,6 +81,7 @@ type (
 		NotifyNewHistoryEvent(event *historyEventNotification) error
 		GetConfig() *Config
 		GetEventsCache() eventsCache
+		GetPayloadOffloader() payload.Offloader
 		GetLogger() bark.Logger
 		GetThrottledLogger() bark.Logger
 		GetMetricsClient() metrics.Client
@@ -86,6 +90,8 @@ type (
 		GetCurrentTime(cluster string) time.Time
 		GetTimerMaxReadLevel(cluster string) time.Time
 		UpdateTimerMaxReadLevel(cluster string) time.Time
+		PutTaskToDLQ(request *persistence.PutTaskToDLQRequest) error
+		DeleteDLQTask(request *persistence.DeleteDLQTaskRequest) error
 	}
 
 	shardContextImpl struct {
@@ -100,6 +106,7 @@ type (
 		executionManager persistence.ExecutionManager
 		domainCache      cache.DomainCache
 		eventsCache      eventsCache
+		payloadOffloader payload.Offloader
 		closeCh          chan<- int
 		isClosed         bool
 		config           *Config
@@ -114,6 +121,10 @@ type (
 		maxTransferSequenceNumber int64
 		transferMaxReadLevel      int64
 		timerMaxReadLevelMap      map[string]time.Time // cluster -> timerMaxReadLevel
+		transferDLQDepth          int64
+		timerDLQDepth             int64
+		transferDLQDepthCapped    bool
+		timerDLQDepthCapped       bool
 
 		// exist only in memory
 		standbyClusterCurrentTime map[string]time.Time
@@ -126,6 +137,8 @@ const (
 	logWarnTransferLevelDiff = 3000000 // 3 million
 	logWarnTimerLevelDiff    = time.Duration(30 * time.Minute)
 	historySizeLogThreshold  = 10 * 1024 * 1024
+	dlqDepthLoadPageSize     = 1000
+	dlqDepthLoadMaxPages     = 10
 )
 
 func (s *shardContextImpl) GetShardID() int {
@@ -152,6 +165,14 @@ func (s *shardContextImpl) GetDomainCache() cache.DomainCache {
 	return s.domainCache
 }
 
+// GetShardInfo returns a copy of the shard info, which is safe to read without holding the shard lock
+func (s *shardContextImpl) GetShardInfo() *persistence.ShardInfo {
+	s.RLock()
+	defer s.RUnlock()
+
+	return copyShardInfo(s.shardInfo)
+}
+
 func (s *shardContextImpl) GetNextTransferTaskID() (int64, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -738,6 +759,16 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 		return 0, err
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
+	request.DomainID = domainID
+	if s.payloadOffloader.IsEnabled(domainEntry.GetInfo().Name) {
+		treeID, err := persistence.GetHistoryTreeID(request.BranchToken)
+		if err != nil {
+			return 0, err
+		}
+		if err := s.payloadOffloader.OffloadEvents(domainID, domainEntry.GetInfo().Name, treeID, request.Events); err != nil {
+			return 0, err
+		}
+	}
 	size := 0
 	defer func() {
 		// N.B. - Dual emit here makes sense so that we can see aggregate timer stats across all
@@ -769,6 +800,12 @@ func (s *shardContextImpl) AppendHistoryEvents(request *persistence.AppendHistor
 		return 0, err
 	}
 	request.Encoding = s.getDefaultEncoding(domainEntry)
+	// a history of events v1 is never shared with another run, so the run ID identifies its payloads
+	// the same way the tree ID does for events v2
+	if err := s.payloadOffloader.OffloadEvents(request.DomainID, domainEntry.GetInfo().Name,
+		request.Execution.GetRunId(), request.Events); err != nil {
+		return 0, err
+	}
 
 	size := 0
 	defer func() {
@@ -826,6 +863,10 @@ func (s *shardContextImpl) GetEventsCache() eventsCache {
 	return s.eventsCache
 }
 
+func (s *shardContextImpl) GetPayloadOffloader() payload.Offloader {
+	return s.payloadOffloader
+}
+
 func (s *shardContextImpl) GetLogger() bark.Logger {
 	return s.logger
 }
@@ -862,6 +903,37 @@ func (s *shardContextImpl) closeShard() {
 	}
 }
 
+// releaseShard persists the latest shard info with the owner cleared and closes the shard without
+// notifying the shard controller, so the next owner of the shard starts from the latest ack levels
+func (s *shardContextImpl) releaseShard() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isClosed {
+		return &persistence.ShardOwnershipLostError{
+			ShardID: s.shardID,
+			Msg:     fmt.Sprintf("Shard %v is already closed.", s.shardID),
+		}
+	}
+
+	updatedShardInfo := copyShardInfo(s.shardInfo)
+	updatedShardInfo.Owner = ""
+	s.emitShardInfoMetricsLogsLocked()
+
+	err := s.shardManager.UpdateShard(&persistence.UpdateShardRequest{
+		ShardInfo:       updatedShardInfo,
+		PreviousRangeID: s.shardInfo.RangeID,
+	})
+
+	s.isClosed = true
+
+	// fails any writes that may start after this point.
+	s.shardInfo.RangeID = -1
+	atomic.StoreInt64(&s.rangeID, s.shardInfo.RangeID)
+
+	return err
+}
+
 func (s *shardContextImpl) getNextTransferTaskIDLocked() (int64, error) {
 	if err := s.updateRangeIfNeededLocked(); err != nil {
 		return -1, err
@@ -1006,6 +1078,93 @@ func (s *shardContextImpl) emitShardInfoMetricsLogsLocked() {
 
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTransferFailoverInProgressTimer, time.Duration(transferFailoverInProgress))
 	s.metricsClient.RecordTimer(metrics.ShardInfoScope, metrics.ShardInfoTimerFailoverInProgressTimer, time.Duration(timerFailoverInProgress))
+
+	s.metricsClient.UpdateGauge(metrics.ShardInfoScope, metrics.ShardInfoTransferDLQDepthGauge, float64(s.transferDLQDepth))
+	s.metricsClient.UpdateGauge(metrics.ShardInfoScope, metrics.ShardInfoTimerDLQDepthGauge, float64(s.timerDLQDepth))
+	s.metricsClient.UpdateGauge(metrics.ShardInfoScope, metrics.ShardInfoTransferDLQDepthCappedGauge, boolToGauge(s.transferDLQDepthCapped))
+	s.metricsClient.UpdateGauge(metrics.ShardInfoScope, metrics.ShardInfoTimerDLQDepthCappedGauge, boolToGauge(s.timerDLQDepthCapped))
+}
+
+func (s *shardContextImpl) PutTaskToDLQ(request *persistence.PutTaskToDLQRequest) error {
+	if err := s.executionManager.PutTaskToDLQ(request); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if request.TransferTask != nil {
+		s.transferDLQDepth++
+	} else {
+		s.timerDLQDepth++
+	}
+	return nil
+}
+
+func (s *shardContextImpl) DeleteDLQTask(request *persistence.DeleteDLQTaskRequest) error {
+	if err := s.executionManager.DeleteDLQTask(request); err != nil {
+		return err
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	if request.Category == persistence.DLQTaskCategoryTransfer && s.transferDLQDepth > 0 {
+		s.transferDLQDepth--
+	} else if request.Category == persistence.DLQTaskCategoryTimer && s.timerDLQDepth > 0 {
+		s.timerDLQDepth--
+	}
+	return nil
+}
+
+// loadDLQDepth counts the tasks in the task DLQ of the shard, the counts are only used for reporting
+// so a failure to load them is logged and does not fail the shard acquisition.
+// At most dlqDepthLoadMaxPages pages are read to keep the shard acquisition cheap. The depth of
+// a larger DLQ is a lower bound, which is reported by the capped gauges until the shard is reloaded.
+func (s *shardContextImpl) loadDLQDepth() {
+	transferDLQDepth, transferDLQDepthCapped, err := s.countDLQTasks(persistence.DLQTaskCategoryTransfer)
+	if err != nil {
+		s.logger.WithField(logging.TagErr, err).Warn("Failed to load transfer task DLQ depth.")
+	}
+	timerDLQDepth, timerDLQDepthCapped, err := s.countDLQTasks(persistence.DLQTaskCategoryTimer)
+	if err != nil {
+		s.logger.WithField(logging.TagErr, err).Warn("Failed to load timer task DLQ depth.")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+	s.transferDLQDepth = transferDLQDepth
+	s.timerDLQDepth = timerDLQDepth
+	s.transferDLQDepthCapped = transferDLQDepthCapped
+	s.timerDLQDepthCapped = timerDLQDepthCapped
+}
+
+// countDLQTasks returns the number of tasks in the DLQ of the category, and whether
+// counting stopped at dlqDepthLoadMaxPages before the end of the DLQ was reached
+func (s *shardContextImpl) countDLQTasks(category int) (int64, bool, error) {
+	var count int64
+	var nextPageToken []byte
+	for page := 0; page < dlqDepthLoadMaxPages; page++ {
+		resp, err := s.executionManager.GetDLQTasks(&persistence.GetDLQTasksRequest{
+			Category:      category,
+			PageSize:      dlqDepthLoadPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return count, false, err
+		}
+		count += int64(len(resp.TransferTasks) + len(resp.TimerTasks))
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			return count, false, nil
+		}
+	}
+	return count, true, nil
+}
+
+func boolToGauge(value bool) float64 {
+	if value {
+		return 1
+	}
+	return 0
 }
 
 // NOTE: allocateTimerIDsLocked should always been called after assigning taskID for transferTasks when assigning taskID together,
@@ -1075,7 +1234,7 @@ func (s *shardContextImpl) GetCurrentTime(cluster string) time.Time {
 }
 
 // TODO: This method has too many parameters.  Clean it up.  Maybe create a struct to pass in as parameter.
-func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContext,
+func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (*shardContextImpl,
 	error) {
 
 	var shardInfo *persistence.ShardInfo
@@ -1163,11 +1322,13 @@ func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContex
 	context.logger = shardItem.logger
 	context.throttledLogger = shardItem.throttledLogger
 	context.eventsCache = newEventsCache(context)
+	context.payloadOffloader = newPayloadOffloader(shardItem.service, shardItem.config, shardItem.metricsClient)
 
 	err1 := context.renewRangeLocked(true)
 	if err1 != nil {
 		return nil, err1
 	}
+	context.loadDLQDepth()
 
 	return context, nil
 }
@@ -1206,3 +1367,17 @@ func copyShardInfo(shardInfo *persistence.ShardInfo) *persistence.ShardInfo {
 
 	return shardInfoCopy
 }
+
+func newPayloadOffloader(svc service.Service, config *Config, metricsClient metrics.Client) payload.Offloader {
+	blobstoreClient := svc.GetBlobstoreClient()
+	if blobstoreClient != nil {
+		blobstoreClient = blobstore.NewRetryableClient(
+			blobstore.NewMetricClient(blobstoreClient, metricsClient),
+			blobstoreClient.GetRetryPolicy(),
+			blobstoreClient.IsRetryableError)
+	}
+	return payload.NewOffloader(blobstoreClient, &payload.Config{
+		Bucket:    config.PayloadOffloadBucket,
+		Threshold: config.PayloadOffloadThreshold,
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func Test_CountDLQTasks(t *testing.T) {
+	a := assert.New(t)
+	executionMgr := &mocks.ExecutionManager{}
+	shard := &shardContextImpl{executionManager: executionMgr}
+
+	page := &persistence.GetDLQTasksResponse{
+		TransferTasks: make([]*persistence.TransferTaskInfo, dlqDepthLoadPageSize),
+		NextPageToken: []byte("next page"),
+	}
+	executionMgr.On("GetDLQTasks", mock.MatchedBy(func(request *persistence.GetDLQTasksRequest) bool {
+		return request.Category == persistence.DLQTaskCategoryTransfer
+	})).Return(page, nil)
+	executionMgr.On("GetDLQTasks", mock.MatchedBy(func(request *persistence.GetDLQTasksRequest) bool {
+		return request.Category == persistence.DLQTaskCategoryTimer
+	})).Return(&persistence.GetDLQTasksResponse{
+		TimerTasks: make([]*persistence.TimerTaskInfo, 3),
+	}, nil)
+
+	count, capped, err := shard.countDLQTasks(persistence.DLQTaskCategoryTransfer)
+	a.NoError(err)
+	a.True(capped)
+	a.Equal(int64(dlqDepthLoadMaxPages*dlqDepthLoadPageSize), count)
+
+	count, capped, err = shard.countDLQTasks(persistence.DLQTaskCategoryTimer)
+	a.NoError(err)
+	a.False(capped)
+	a.Equal(int64(3), count)
+}
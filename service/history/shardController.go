@@ -84,6 +84,7 @@ type (
 		engineFactory   EngineFactory
 		host            *membership.HostInfo
 		engine          Engine
+		shard           *shardContextImpl
 		config          *Config
 		logger          bark.Logger
 		throttledLogger bark.Logger
@@ -176,6 +177,13 @@ func (c *shardController) Stop() {
 		return
 	}
 
+	if atomic.LoadInt32(&c.isStarted) == 1 && c.config.EnableGracefulShardHandoff() {
+		// leave the ring first so that the shards get reassigned and requests are routed to the new owners
+		if err := c.service.GetMembershipMonitor().EvictSelf(); err != nil {
+			logging.LogOperationFailedEvent(c.logger, "Error evicting host from membership ring", err)
+		}
+	}
+
 	c.Lock()
 	c.isStopping = true
 	c.Unlock()
@@ -218,6 +226,31 @@ func (c *shardController) removeEngineForShard(shardID int) {
 	}
 }
 
+// closeShard unloads the shard and stops its engine, the shard is acquired again on the next request
+// routed to it, it fails with ShardOwnershipLostError if the shard is not owned by this host
+func (c *shardController) closeShard(shardID int) error {
+	info, err := c.hServiceResolver.Lookup(string(rune(shardID)))
+	if err != nil {
+		return err
+	}
+	if info.Identity() != c.host.Identity() {
+		return createShardOwnershipLostError(c.host.Identity(), info.GetAddress())
+	}
+
+	c.removeEngineForShard(shardID)
+	return nil
+}
+
+// reloadShard unloads the shard and acquires it again, reloading the shard info from persistence
+func (c *shardController) reloadShard(shardID int) error {
+	if err := c.closeShard(shardID); err != nil {
+		return err
+	}
+
+	_, err := c.getEngineForShard(shardID)
+	return err
+}
+
 func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShardsItem, error) {
 	c.RLock()
 	if item, ok := c.historyShards[shardID]; ok {
@@ -240,6 +273,10 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 	}
 
 	if c.isStopping {
+		// redirect the request to the new owner if the shard has already been reassigned
+		if info, err := c.hServiceResolver.Lookup(string(rune(shardID))); err == nil && info.Identity() != c.host.Identity() {
+			return nil, createShardOwnershipLostError(c.host.Identity(), info.GetAddress())
+		}
 		return nil, fmt.Errorf("shardController for host '%v' shutting down", c.host.Identity())
 	}
 	info, err := c.hServiceResolver.Lookup(string(shardID))
@@ -354,6 +391,9 @@ AcquireLoop:
 
 func (c *shardController) doShutdown() {
 	logging.LogShardControllerShuttingDownEvent(c.logger, c.host.Identity())
+	if c.config.EnableGracefulShardHandoff() {
+		c.handoffShards()
+	}
 	c.Lock()
 	defer c.Unlock()
 	for _, item := range c.historyShards {
@@ -362,6 +402,35 @@ func (c *shardController) doShutdown() {
 	c.historyShards = nil
 }
 
+// handoffShards drains and releases all the shards owned by this host in parallel, shards which are not
+// released within the handoff timeout are stopped without flushing their shard info
+func (c *shardController) handoffShards() {
+	c.RLock()
+	items := make([]*historyShardsItem, 0, len(c.historyShards))
+	for _, item := range c.historyShards {
+		items = append(items, item)
+	}
+	c.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, item := range items {
+		wg.Add(1)
+		go func(item *historyShardsItem) {
+			defer wg.Done()
+			sw := c.metricsClient.StartTimer(metrics.HistoryShardControllerScope, metrics.ShardHandoffLatency)
+			defer sw.Stop()
+			if err := item.handoff(); err != nil {
+				c.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.ShardHandoffFailedCounter)
+				logging.LogOperationFailedEvent(item.logger, fmt.Sprintf("Error handing off shard: %v", item.shardID), err)
+			}
+		}(item)
+	}
+
+	if success := common.AwaitWaitGroup(&wg, c.config.ShardHandoffTimeout()); !success {
+		c.logger.Warnf("Timed out handing off shards for host '%v'.", c.host.Identity())
+	}
+}
+
 func (c *shardController) processShardClosedEvents() {
 	for {
 		select {
@@ -411,6 +480,7 @@ func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine,
 		if err != nil {
 			return nil, err
 		}
+		i.shard = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		logging.LogShardEngineCreatedEvent(i.logger, i.host.Identity(), i.shardID)
@@ -436,6 +506,7 @@ func (i *historyShardsItem) stopEngine() {
 		logging.LogShardEngineStoppingEvent(i.logger, i.host.Identity(), i.shardID)
 		i.engine.Stop()
 		i.engine = nil
+		i.shard = nil
 		logging.LogShardEngineStoppedEvent(i.logger, i.host.Identity(), i.shardID)
 		i.status = historyShardsItemStatusStopped
 	case historyShardsItemStatusStopped:
@@ -445,6 +516,29 @@ func (i *historyShardsItem) stopEngine() {
 	}
 }
 
+// handoff stops accepting requests for the shard, stops its engine and releases the shard after
+// persisting the latest shard info, so the new owner can acquire it right away
+func (i *historyShardsItem) handoff() error {
+	i.Lock()
+	if i.status != historyShardsItemStatusStarted {
+		i.status = historyShardsItemStatusStopped
+		i.Unlock()
+		return nil
+	}
+	engine := i.engine
+	shard := i.shard
+	i.engine = nil
+	i.shard = nil
+	i.status = historyShardsItemStatusStopped
+	i.Unlock()
+
+	logging.LogShardEngineStoppingEvent(i.logger, i.host.Identity(), i.shardID)
+	engine.Stop()
+	logging.LogShardEngineStoppedEvent(i.logger, i.host.Identity(), i.shardID)
+
+	return shard.releaseShard()
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
@@ -35,6 +35,7 @@ import (
 	"time"
 
 	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/client"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
@@ -44,9 +45,20 @@ import (
 	mmocks "github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
+	handoffTestService struct {
+		service.Service
+		monitor *handoffTestMonitor
+	}
+
+	handoffTestMonitor struct {
+		membership.Monitor
+		evicted bool
+	}
+
 	shardControllerSuite struct {
 		suite.Suite
 		hostInfo                *membership.HostInfo
@@ -78,6 +90,8 @@ func TestShardControllerSuite(t *testing.T) {
 func (s *shardControllerSuite) SetupTest() {
 	s.logger = bark.NewLoggerFromLogrus(log.New())
 	s.config = NewDynamicConfigForTest()
+	// the handoff of the shards on stop is covered by TestShardControllerHandoff
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(false)
 	s.metricsClient = metrics.NewClient(tally.NoopScope, metrics.History)
 	s.hostInfo = membership.NewHostInfo("shardController-host-test", nil)
 	s.mockShardManager = &mmocks.ShardManager{}
@@ -122,6 +136,7 @@ func (s *shardControllerSuite) TestAcquireShardSuccess() {
 		if hostID == 0 {
 			myShards = append(myShards, shardID)
 			mockExecutionMgr := &mmocks.ExecutionManager{}
+			mockExecutionMgr.On("GetDLQTasks", mock.Anything).Return(&persistence.GetDLQTasksResponse{}, nil)
 			s.mockExecutionMgrFactory.On("NewExecutionManager", mock.Anything).Return(mockExecutionMgr, nil).Once()
 			mockEngine := &MockHistoryEngine{}
 			mockEngine.On("Start").Return().Once()
@@ -212,6 +227,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewSuccess() {
 
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockExecutionMgr := &mmocks.ExecutionManager{}
+		mockExecutionMgr.On("GetDLQTasks", mock.Anything).Return(&persistence.GetDLQTasksResponse{}, nil)
 		s.mockExecutionMgrFactory.On("NewExecutionManager", mock.Anything).Return(mockExecutionMgr, nil).Once()
 		mockEngine := &MockHistoryEngine{}
 		mockEngine.On("Start").Return().Once()
@@ -287,6 +303,7 @@ func (s *shardControllerSuite) TestAcquireShardRenewLookupFailed() {
 
 	for shardID := 0; shardID < numShards; shardID++ {
 		mockExecutionMgr := &mmocks.ExecutionManager{}
+		mockExecutionMgr.On("GetDLQTasks", mock.Anything).Return(&persistence.GetDLQTasksResponse{}, nil)
 		s.mockExecutionMgrFactory.On("NewExecutionManager", mock.Anything).Return(mockExecutionMgr, nil).Once()
 		mockEngine := &MockHistoryEngine{}
 		mockEngine.On("Start").Return().Once()
@@ -573,6 +590,49 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 	workerWG.Wait()
 }
 
+func (s *shardControllerSuite) TestShardControllerHandoff() {
+	numShards := 4
+	s.config.NumberOfShards = numShards
+	s.config.EnableGracefulShardHandoff = dynamicconfig.GetBoolPropertyFn(true)
+	monitor := &handoffTestMonitor{}
+	s.controller = newShardController(&handoffTestService{Service: s.mockService, monitor: monitor}, s.hostInfo,
+		s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr, s.domainCache,
+		s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+	historyEngines := make(map[int]*MockHistoryEngine)
+	for shardID := 0; shardID < numShards; shardID++ {
+		mockEngine := &MockHistoryEngine{}
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+
+	s.mockServiceResolver.On("AddListener", shardControllerMembershipUpdateListenerName,
+		mock.Anything).Return(nil)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterFailoverVersions").Return(cluster.TestSingleDCAllClusterFailoverVersions)
+	s.controller.Start()
+	s.Equal(numShards, s.controller.numShards())
+
+	newOwner := membership.NewHostInfo("newOwner", nil)
+	s.mockServiceResolver.On("RemoveListener", shardControllerMembershipUpdateListenerName).Return(nil)
+	for shardID := 0; shardID < numShards; shardID++ {
+		historyEngines[shardID].On("Stop").Return().Once()
+		s.mockServiceResolver.On("Lookup", string(rune(shardID))).Return(newOwner, nil)
+		// the shard is released with the latest shard info and no owner
+		s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+			return request.ShardInfo.ShardID == shardID && request.ShardInfo.Owner == "" &&
+				request.ShardInfo.RangeID == 6 && request.PreviousRangeID == 6
+		})).Return(nil).Once()
+	}
+	s.controller.Stop()
+
+	s.True(monitor.evicted)
+	for shardID := 0; shardID < numShards; shardID++ {
+		historyEngines[shardID].AssertExpectations(s.T())
+		_, err := s.controller.getEngineForShard(shardID)
+		s.IsType(&h.ShardOwnershipLostError{}, err)
+	}
+}
+
 func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *MockHistoryEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -583,6 +643,7 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 	alternativeClusterTimerAck := time.Now().Add(-200 * time.Second)
 
 	mockExecutionMgr := &mmocks.ExecutionManager{}
+	mockExecutionMgr.On("GetDLQTasks", mock.Anything).Return(&persistence.GetDLQTasksResponse{}, nil)
 	mockExecutionMgr.On("Close").Return()
 	s.mockExecutionMgrFactory.On("NewExecutionManager", shardID).Return(mockExecutionMgr, nil).Once()
 	mockEngine.On("Start").Return().Once()
@@ -630,3 +691,12 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 		PreviousRangeID: currentRangeID,
 	}).Return(nil).Once()
 }
+
+func (s *handoffTestService) GetMembershipMonitor() membership.Monitor {
+	return s.monitor
+}
+
+func (m *handoffTestMonitor) EvictSelf() error {
+	m.evicted = true
+	return nil
+}
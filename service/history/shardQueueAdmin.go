@@ -0,0 +1,304 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	defaultShardQueueTasksPageSize = 100
+	// describeShardTaskCountLimit caps the number of persisted tasks read when counting the outstanding
+	// tasks of a queue, so describing a shard with a large backlog stays cheap
+	describeShardTaskCountLimit    = 10000
+	describeShardTaskCountPageSize = 1000
+)
+
+// DescribeShard returns the ack levels, read levels, failover levels and outstanding task counts of
+// the transfer, timer and replication queues of the shard
+func (e *historyEngineImpl) DescribeShard(ctx context.Context,
+	request *workflow.DescribeShardRequest) (*workflow.DescribeShardResponse, error) {
+
+	shardInfo := e.shard.GetShardInfo()
+	transferMaxReadLevel := e.shard.GetTransferMaxReadLevel()
+	timerMaxReadLevel := e.shard.GetTimerMaxReadLevel(e.currentClusterName)
+
+	transferFailoverLevels := make(map[string]*workflow.ShardQueueFailoverLevel, len(shardInfo.TransferFailoverLevels))
+	for failoverID, level := range shardInfo.TransferFailoverLevels {
+		transferFailoverLevels[failoverID] = &workflow.ShardQueueFailoverLevel{
+			StartTime:    common.Int64Ptr(level.StartTime.UnixNano()),
+			MinLevel:     common.Int64Ptr(level.MinLevel),
+			CurrentLevel: common.Int64Ptr(level.CurrentLevel),
+			MaxLevel:     common.Int64Ptr(level.MaxLevel),
+			DomainIds:    domainIDSetToList(level.DomainIDs),
+		}
+	}
+	transferQueue := &workflow.ShardQueueState{
+		AckLevel:         common.Int64Ptr(shardInfo.TransferAckLevel),
+		MaxReadLevel:     common.Int64Ptr(transferMaxReadLevel),
+		ClusterAckLevels: shardInfo.ClusterTransferAckLevel,
+		FailoverLevels:   transferFailoverLevels,
+	}
+
+	timerClusterAckLevels := make(map[string]int64, len(shardInfo.ClusterTimerAckLevel))
+	for cluster, level := range shardInfo.ClusterTimerAckLevel {
+		timerClusterAckLevels[cluster] = level.UnixNano()
+	}
+	timerFailoverLevels := make(map[string]*workflow.ShardQueueFailoverLevel, len(shardInfo.TimerFailoverLevels))
+	for failoverID, level := range shardInfo.TimerFailoverLevels {
+		timerFailoverLevels[failoverID] = &workflow.ShardQueueFailoverLevel{
+			StartTime:    common.Int64Ptr(level.StartTime.UnixNano()),
+			MinLevel:     common.Int64Ptr(level.MinLevel.UnixNano()),
+			CurrentLevel: common.Int64Ptr(level.CurrentLevel.UnixNano()),
+			MaxLevel:     common.Int64Ptr(level.MaxLevel.UnixNano()),
+			DomainIds:    domainIDSetToList(level.DomainIDs),
+		}
+	}
+	timerQueue := &workflow.ShardQueueState{
+		AckLevel:         common.Int64Ptr(shardInfo.TimerAckLevel.UnixNano()),
+		MaxReadLevel:     common.Int64Ptr(timerMaxReadLevel.UnixNano()),
+		ClusterAckLevels: timerClusterAckLevels,
+		FailoverLevels:   timerFailoverLevels,
+	}
+
+	// replication tasks share the task ID sequence with transfer tasks
+	replicationQueue := &workflow.ShardQueueState{
+		AckLevel:     common.Int64Ptr(shardInfo.ReplicationAckLevel),
+		MaxReadLevel: common.Int64Ptr(transferMaxReadLevel),
+	}
+
+	for queueType, state := range map[workflow.ShardQueueType]*workflow.ShardQueueState{
+		workflow.ShardQueueTypeTransfer:    transferQueue,
+		workflow.ShardQueueTypeTimer:       timerQueue,
+		workflow.ShardQueueTypeReplication: replicationQueue,
+	} {
+		count, truncated, err := e.countShardQueueTasks(queueType, state.GetAckLevel(), state.GetMaxReadLevel())
+		if err != nil {
+			return nil, err
+		}
+		state.OutstandingTaskCount = common.Int32Ptr(int32(count))
+		state.OutstandingTaskCountTruncated = common.BoolPtr(truncated)
+	}
+
+	return &workflow.DescribeShardResponse{
+		ShardId:          common.Int32Ptr(int32(shardInfo.ShardID)),
+		Owner:            common.StringPtr(shardInfo.Owner),
+		RangeId:          common.Int64Ptr(shardInfo.RangeID),
+		TransferQueue:    transferQueue,
+		TimerQueue:       timerQueue,
+		ReplicationQueue: replicationQueue,
+	}, nil
+}
+
+// ListShardQueueTasks returns a page of the persisted tasks of a queue of the shard within the requested
+// range, the range defaults to the tasks between the ack level and the max read level of the queue
+func (e *historyEngineImpl) ListShardQueueTasks(ctx context.Context,
+	request *workflow.ListShardQueueTasksRequest) (*workflow.ListShardQueueTasksResponse, error) {
+
+	if request.QueueType == nil {
+		return nil, &workflow.BadRequestError{Message: "Queue type is not set on request."}
+	}
+	queueType := request.GetQueueType()
+
+	minLevel, maxLevel, err := e.getShardQueueLevels(queueType)
+	if err != nil {
+		return nil, err
+	}
+	if request.MinLevel != nil {
+		minLevel = request.GetMinLevel()
+	}
+	if request.MaxLevel != nil {
+		maxLevel = request.GetMaxLevel()
+	}
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultShardQueueTasksPageSize
+	}
+
+	tasks, nextPageToken, err := e.getShardQueueTasks(queueType, minLevel, maxLevel, pageSize, request.NextPageToken)
+	if err != nil {
+		return nil, err
+	}
+	return &workflow.ListShardQueueTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// RemoveShardQueueTask deletes a task from a queue of the shard, a task which is already loaded by the
+// queue processor is still processed
+func (e *historyEngineImpl) RemoveShardQueueTask(ctx context.Context, request *workflow.RemoveShardQueueTaskRequest) error {
+	if request.QueueType == nil {
+		return &workflow.BadRequestError{Message: "Queue type is not set on request."}
+	}
+
+	switch request.GetQueueType() {
+	case workflow.ShardQueueTypeTransfer:
+		return e.executionManager.CompleteTransferTask(&persistence.CompleteTransferTaskRequest{
+			TaskID: request.GetTaskId(),
+		})
+	case workflow.ShardQueueTypeTimer:
+		if request.VisibilityTimestamp == nil {
+			return &workflow.BadRequestError{Message: "Visibility timestamp is required to remove a timer task."}
+		}
+		return e.executionManager.CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
+			VisibilityTimestamp: time.Unix(0, request.GetVisibilityTimestamp()),
+			TaskID:              request.GetTaskId(),
+		})
+	case workflow.ShardQueueTypeReplication:
+		return e.executionManager.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{
+			TaskID: request.GetTaskId(),
+		})
+	default:
+		return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown queue type: %v", request.GetQueueType())}
+	}
+}
+
+func (e *historyEngineImpl) getShardQueueLevels(queueType workflow.ShardQueueType) (int64, int64, error) {
+	switch queueType {
+	case workflow.ShardQueueTypeTransfer:
+		return e.shard.GetTransferAckLevel(), e.shard.GetTransferMaxReadLevel(), nil
+	case workflow.ShardQueueTypeTimer:
+		return e.shard.GetTimerAckLevel().UnixNano(), e.shard.GetTimerMaxReadLevel(e.currentClusterName).UnixNano(), nil
+	case workflow.ShardQueueTypeReplication:
+		return e.shard.GetReplicatorAckLevel(), e.shard.GetTransferMaxReadLevel(), nil
+	default:
+		return 0, 0, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown queue type: %v", queueType)}
+	}
+}
+
+func (e *historyEngineImpl) countShardQueueTasks(queueType workflow.ShardQueueType, minLevel int64,
+	maxLevel int64) (int, bool, error) {
+
+	count := 0
+	var nextPageToken []byte
+	for {
+		tasks, token, err := e.getShardQueueTasks(queueType, minLevel, maxLevel, describeShardTaskCountPageSize, nextPageToken)
+		if err != nil {
+			return 0, false, err
+		}
+		count += len(tasks)
+		if len(token) == 0 {
+			return count, false, nil
+		}
+		if count >= describeShardTaskCountLimit {
+			return count, true, nil
+		}
+		nextPageToken = token
+	}
+}
+
+func (e *historyEngineImpl) getShardQueueTasks(queueType workflow.ShardQueueType, minLevel int64, maxLevel int64,
+	pageSize int, pageToken []byte) ([]*workflow.ShardQueueTaskInfo, []byte, error) {
+
+	switch queueType {
+	case workflow.ShardQueueTypeTransfer:
+		resp, err := e.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+			ReadLevel:     minLevel,
+			MaxReadLevel:  maxLevel,
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks := make([]*workflow.ShardQueueTaskInfo, 0, len(resp.Tasks))
+		for _, task := range resp.Tasks {
+			tasks = append(tasks, &workflow.ShardQueueTaskInfo{
+				TaskId:              common.Int64Ptr(task.TaskID),
+				TaskType:            common.Int32Ptr(int32(task.TaskType)),
+				DomainId:            common.StringPtr(task.DomainID),
+				WorkflowId:          common.StringPtr(task.WorkflowID),
+				RunId:               common.StringPtr(task.RunID),
+				VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+				Version:             common.Int64Ptr(task.Version),
+				EventId:             common.Int64Ptr(task.ScheduleID),
+			})
+		}
+		return tasks, resp.NextPageToken, nil
+
+	case workflow.ShardQueueTypeTimer:
+		resp, err := e.executionManager.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			MinTimestamp:  time.Unix(0, minLevel),
+			MaxTimestamp:  time.Unix(0, maxLevel),
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks := make([]*workflow.ShardQueueTaskInfo, 0, len(resp.Timers))
+		for _, task := range resp.Timers {
+			tasks = append(tasks, &workflow.ShardQueueTaskInfo{
+				TaskId:              common.Int64Ptr(task.TaskID),
+				TaskType:            common.Int32Ptr(int32(task.TaskType)),
+				DomainId:            common.StringPtr(task.DomainID),
+				WorkflowId:          common.StringPtr(task.WorkflowID),
+				RunId:               common.StringPtr(task.RunID),
+				VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+				Version:             common.Int64Ptr(task.Version),
+				EventId:             common.Int64Ptr(task.EventID),
+			})
+		}
+		return tasks, resp.NextPageToken, nil
+
+	case workflow.ShardQueueTypeReplication:
+		resp, err := e.executionManager.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:     minLevel,
+			MaxReadLevel:  maxLevel,
+			BatchSize:     pageSize,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		tasks := make([]*workflow.ShardQueueTaskInfo, 0, len(resp.Tasks))
+		for _, task := range resp.Tasks {
+			tasks = append(tasks, &workflow.ShardQueueTaskInfo{
+				TaskId:     common.Int64Ptr(task.TaskID),
+				TaskType:   common.Int32Ptr(int32(task.TaskType)),
+				DomainId:   common.StringPtr(task.DomainID),
+				WorkflowId: common.StringPtr(task.WorkflowID),
+				RunId:      common.StringPtr(task.RunID),
+				Version:    common.Int64Ptr(task.Version),
+				EventId:    common.Int64Ptr(task.FirstEventID),
+			})
+		}
+		return tasks, resp.NextPageToken, nil
+
+	default:
+		return nil, nil, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown queue type: %v", queueType)}
+	}
+}
+
+func domainIDSetToList(domainIDs map[string]struct{}) []string {
+	result := make([]string, 0, len(domainIDs))
+	for domainID := range domainIDs {
+		result = append(result, domainID)
+	}
+	return result
+}
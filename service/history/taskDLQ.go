@@ -0,0 +1,211 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"fmt"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	defaultDLQTasksPageSize = 100
+)
+
+// isTaskDLQEligibleError returns true if a task which keeps failing with the given error can be moved into the
+// task DLQ, errors which are expected to go away by waiting, like a standby task waiting for replication or
+// throttling, are not eligible
+func isTaskDLQEligibleError(err error) bool {
+	if err == nil || err == ErrTaskRetry {
+		return false
+	}
+
+	switch err.(type) {
+	case *workflow.DomainNotActiveError,
+		*workflow.ServiceBusyError,
+		*workflow.LimitExceededError,
+		*persistence.ShardOwnershipLostError:
+		return false
+	}
+	return true
+}
+
+// ListDLQTasks returns a page of the transfer or timer tasks moved into the task DLQ of the shard
+func (e *historyEngineImpl) ListDLQTasks(ctx context.Context,
+	request *workflow.ListDLQTasksRequest) (*workflow.ListDLQTasksResponse, error) {
+
+	category, err := toPersistenceDLQTaskCategory(request.Category)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultDLQTasksPageSize
+	}
+
+	resp, err := e.executionManager.GetDLQTasks(&persistence.GetDLQTasksRequest{
+		Category:      category,
+		PageSize:      pageSize,
+		NextPageToken: request.NextPageToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*workflow.DLQTaskInfo, 0, len(resp.TransferTasks)+len(resp.TimerTasks))
+	for _, task := range resp.TransferTasks {
+		tasks = append(tasks, &workflow.DLQTaskInfo{
+			TaskId:              common.Int64Ptr(task.TaskID),
+			TaskType:            common.Int32Ptr(int32(task.TaskType)),
+			DomainId:            common.StringPtr(task.DomainID),
+			WorkflowId:          common.StringPtr(task.WorkflowID),
+			RunId:               common.StringPtr(task.RunID),
+			VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+			Version:             common.Int64Ptr(task.Version),
+			EventId:             common.Int64Ptr(task.ScheduleID),
+		})
+	}
+	for _, task := range resp.TimerTasks {
+		tasks = append(tasks, &workflow.DLQTaskInfo{
+			TaskId:              common.Int64Ptr(task.TaskID),
+			TaskType:            common.Int32Ptr(int32(task.TaskType)),
+			DomainId:            common.StringPtr(task.DomainID),
+			WorkflowId:          common.StringPtr(task.WorkflowID),
+			RunId:               common.StringPtr(task.RunID),
+			VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+			Version:             common.Int64Ptr(task.Version),
+			EventId:             common.Int64Ptr(task.EventID),
+		})
+	}
+
+	return &workflow.ListDLQTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: resp.NextPageToken,
+	}, nil
+}
+
+// RetryDLQTask processes a task from the task DLQ of the shard once, the task is removed from the DLQ
+// if it is processed successfully or turns out to be no longer applicable
+func (e *historyEngineImpl) RetryDLQTask(ctx context.Context, request *workflow.RetryDLQTaskRequest) error {
+	category, err := toPersistenceDLQTaskCategory(request.Category)
+	if err != nil {
+		return err
+	}
+
+	resp, err := e.executionManager.GetDLQTask(&persistence.GetDLQTaskRequest{
+		Category: category,
+		TaskID:   request.GetTaskId(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if category == persistence.DLQTaskCategoryTransfer {
+		err = e.txProcessor.RetryDLQTask(resp.TransferTask)
+	} else {
+		err = e.timerProcessor.RetryDLQTask(resp.TimerTask)
+	}
+	if err != nil && err != ErrTaskDiscarded {
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			return err
+		}
+	}
+
+	return e.shard.DeleteDLQTask(&persistence.DeleteDLQTaskRequest{
+		Category: category,
+		TaskID:   request.GetTaskId(),
+	})
+}
+
+// DropDLQTask removes a task from the task DLQ of the shard without processing it
+func (e *historyEngineImpl) DropDLQTask(ctx context.Context, request *workflow.DropDLQTaskRequest) error {
+	category, err := toPersistenceDLQTaskCategory(request.Category)
+	if err != nil {
+		return err
+	}
+
+	return e.shard.DeleteDLQTask(&persistence.DeleteDLQTaskRequest{
+		Category: category,
+		TaskID:   request.GetTaskId(),
+	})
+}
+
+// RetryDLQTask processes a transfer task from the task DLQ once, using the active or standby processor
+// the task belongs to
+func (t *transferQueueProcessorImpl) RetryDLQTask(task *persistence.TransferTaskInfo) error {
+	processors := []processor{t.activeTaskProcessor}
+	for _, standbyTaskProcessor := range t.standbyTaskProcessors {
+		processors = append(processors, standbyTaskProcessor)
+	}
+
+	for _, p := range processors {
+		shouldProcessTask, err := p.getTaskFilter()(task)
+		if err != nil {
+			return err
+		}
+		if shouldProcessTask {
+			_, err = p.process(task, true)
+			return err
+		}
+	}
+	// no processor owns the task anymore, nothing to retry
+	return nil
+}
+
+// RetryDLQTask processes a timer task from the task DLQ once, using the active or standby processor
+// the task belongs to
+func (t *timerQueueProcessorImpl) RetryDLQTask(task *persistence.TimerTaskInfo) error {
+	processors := []timerProcessor{t.activeTimerProcessor}
+	for _, standbyTimerProcessor := range t.standbyTimerProcessors {
+		processors = append(processors, standbyTimerProcessor)
+	}
+
+	for _, p := range processors {
+		shouldProcessTask, err := p.getTaskFilter()(task)
+		if err != nil {
+			return err
+		}
+		if shouldProcessTask {
+			_, err = p.process(task, true)
+			return err
+		}
+	}
+	// no processor owns the task anymore, nothing to retry
+	return nil
+}
+
+func toPersistenceDLQTaskCategory(category *workflow.DLQTaskCategory) (int, error) {
+	if category == nil {
+		return 0, &workflow.BadRequestError{Message: "DLQ task category is not set on request."}
+	}
+
+	switch *category {
+	case workflow.DLQTaskCategoryTransfer:
+		return persistence.DLQTaskCategoryTransfer, nil
+	case workflow.DLQTaskCategoryTimer:
+		return persistence.DLQTaskCategoryTimer, nil
+	default:
+		return 0, &workflow.BadRequestError{Message: fmt.Sprintf("Unknown DLQ task category: %v", *category)}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func Test_IsTaskDLQEligibleError(t *testing.T) {
+	a := assert.New(t)
+
+	a.False(isTaskDLQEligibleError(nil))
+	a.False(isTaskDLQEligibleError(ErrTaskRetry))
+	a.False(isTaskDLQEligibleError(&workflow.DomainNotActiveError{}))
+	a.False(isTaskDLQEligibleError(&workflow.ServiceBusyError{}))
+	a.False(isTaskDLQEligibleError(&workflow.LimitExceededError{}))
+	a.False(isTaskDLQEligibleError(&persistence.ShardOwnershipLostError{}))
+
+	a.True(isTaskDLQEligibleError(errors.New("some random error")))
+	a.True(isTaskDLQEligibleError(&workflow.InternalServiceError{}))
+	a.True(isTaskDLQEligibleError(&workflow.BadRequestError{}))
+}
+
+func Test_ToPersistenceDLQTaskCategory(t *testing.T) {
+	a := assert.New(t)
+
+	category, err := toPersistenceDLQTaskCategory(workflow.DLQTaskCategoryTransfer.Ptr())
+	a.Nil(err)
+	a.Equal(persistence.DLQTaskCategoryTransfer, category)
+
+	category, err = toPersistenceDLQTaskCategory(workflow.DLQTaskCategoryTimer.Ptr())
+	a.Nil(err)
+	a.Equal(persistence.DLQTaskCategoryTimer, category)
+
+	_, err = toPersistenceDLQTaskCategory(nil)
+	a.IsType(&workflow.BadRequestError{}, err)
+}
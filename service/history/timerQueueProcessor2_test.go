@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
@@ -133,6 +134,7 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 		maxTransferSequenceNumber: 100000,
 		closeCh:                   s.shardClosedCh,
 		config:                    s.config,
+		payloadOffloader:          payload.NewOffloader(nil, nil),
 		logger:                    s.logger,
 		domainCache:               domainCache,
 		eventsCache:               s.mockEventsCache,
@@ -38,6 +38,7 @@ import (
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/common/tokenbucket"
@@ -64,7 +65,7 @@ type (
 		status             int32
 		shutdownWG         sync.WaitGroup
 		shutdownCh         chan struct{}
-		tasksCh            chan *persistence.TimerTaskInfo
+		scheduler          *domainTaskScheduler
 		config             *Config
 		logger             bark.Logger
 		metricsClient      metrics.Client
@@ -106,6 +107,10 @@ func newTimerQueueProcessorBase(scope int, shard ShardContext, historyService *h
 		workerNotificationChans = append(workerNotificationChans, make(chan struct{}, 1))
 	}
 
+	shutdownCh := make(chan struct{})
+	scheduler := newDomainTaskScheduler(shard, shard.GetConfig().TimerProcessorSchedulerBufferSize(),
+		shard.GetConfig().TimerProcessorDomainWeight, historyService.metricsClient, scope, shutdownCh)
+
 	base := &timerQueueProcessorBase{
 		scope:                   scope,
 		shard:                   shard,
@@ -113,8 +118,8 @@ func newTimerQueueProcessorBase(scope int, shard ShardContext, historyService *h
 		cache:                   historyService.historyCache,
 		executionManager:        shard.GetExecutionManager(),
 		status:                  common.DaemonStatusInitialized,
-		shutdownCh:              make(chan struct{}),
-		tasksCh:                 make(chan *persistence.TimerTaskInfo, 10*shard.GetConfig().TimerTaskBatchSize()),
+		shutdownCh:              shutdownCh,
+		scheduler:               scheduler,
 		config:                  shard.GetConfig(),
 		logger:                  log,
 		metricsClient:           historyService.metricsClient,
@@ -188,8 +193,8 @@ RetryProcessor:
 	}
 
 	t.logger.Info("Timer queue processor pump shutting down.")
-	// This is the only pump which writes to tasksCh, so it is safe to close channel here
-	close(t.tasksCh)
+	// This is the only pump which schedules tasks, so it is safe to close the scheduler here
+	t.scheduler.close()
 	if success := common.AwaitWaitGroup(&workerWG, 10*time.Second); !success {
 		t.logger.Warn("Timer queue processor timedout on worker shutdown.")
 	}
@@ -200,15 +205,11 @@ func (t *timerQueueProcessorBase) taskWorker(workerWG *sync.WaitGroup, notificat
 	defer workerWG.Done()
 
 	for {
-		select {
-		case <-t.shutdownCh:
+		task, domainName, ok := t.scheduler.next()
+		if !ok {
 			return
-		case task, ok := <-t.tasksCh:
-			if !ok {
-				return
-			}
-			t.processTaskAndAck(notificationChan, task)
 		}
+		t.processTaskAndAck(notificationChan, task.(*persistence.TimerTaskInfo), domainName)
 	}
 }
 
@@ -378,9 +379,7 @@ func (t *timerQueueProcessorBase) readAndFanoutTimerTasks() (*persistence.TimerT
 
 	for _, task := range timerTasks {
 		// We have a timer to fire.
-		select {
-		case t.tasksCh <- task:
-		case <-t.shutdownCh:
+		if !t.scheduler.schedule(task) {
 			return nil, nil
 		}
 	}
@@ -402,7 +401,7 @@ func (t *timerQueueProcessorBase) retryTasks() {
 	}
 }
 
-func (t *timerQueueProcessorBase) processTaskAndAck(notificationChan <-chan struct{}, task *persistence.TimerTaskInfo) {
+func (t *timerQueueProcessorBase) processTaskAndAck(notificationChan <-chan struct{}, task *persistence.TimerTaskInfo, domainName string) {
 
 	var scope int
 	var shouldProcessTask bool
@@ -455,14 +454,40 @@ FilterLoop:
 		default:
 			err = backoff.Retry(op, t.retryPolicy, retryCondition)
 			if err == nil {
-				t.ackTaskOnce(task, scope, shouldProcessTask, startTime, attempt)
+				t.ackTaskOnce(task, domainName, scope, shouldProcessTask, startTime, attempt)
 				return
 			}
 			incAttempt()
+			if t.shouldMoveTaskToDLQ(attempt, err) && t.moveTaskToDLQ(task, scope, logger) {
+				// the task is kept in the DLQ, ack it so the ack level is not blocked by it
+				t.ackTaskOnce(task, domainName, scope, shouldProcessTask, startTime, attempt)
+				return
+			}
 		}
 	}
 }
 
+func (t *timerQueueProcessorBase) shouldMoveTaskToDLQ(attempt int, err error) bool {
+	if !t.config.TimerTaskDLQEnabled() {
+		return false
+	}
+	return attempt >= t.config.TimerTaskMaxRetryCount() && isTaskDLQEligibleError(err)
+}
+
+func (t *timerQueueProcessorBase) moveTaskToDLQ(task *persistence.TimerTaskInfo, scope int, logger bark.Logger) bool {
+	err := t.shard.PutTaskToDLQ(&persistence.PutTaskToDLQRequest{
+		TimerTask: task,
+	})
+	if err != nil {
+		logger.WithField(logging.TagErr, err).Error("Fail to move timer task to DLQ.")
+		return false
+	}
+
+	t.metricsClient.IncCounter(scope, metrics.TaskMovedToDLQCounter)
+	logger.Warn("Timer task moved to DLQ.")
+	return true
+}
+
 func (t *timerQueueProcessorBase) processTaskOnce(notificationChan <-chan struct{}, task *persistence.TimerTaskInfo, shouldProcessTask bool, logger bark.Logger) (int, error) {
 	select {
 	case <-notificationChan:
@@ -529,11 +554,12 @@ func (t *timerQueueProcessorBase) handleTaskError(scope int, startTime time.Time
 	return err
 }
 
-func (t *timerQueueProcessorBase) ackTaskOnce(task *persistence.TimerTaskInfo, scope int, reportMetrics bool, startTime time.Time, attempt int) {
+func (t *timerQueueProcessorBase) ackTaskOnce(task *persistence.TimerTaskInfo, domainName string, scope int, reportMetrics bool, startTime time.Time, attempt int) {
 	t.timerQueueAckMgr.completeTimerTask(task)
 	if reportMetrics {
 		t.metricsClient.RecordTimer(scope, metrics.TaskAttemptTimer, time.Duration(attempt))
-		t.metricsClient.RecordTimer(scope, metrics.TaskLatency, time.Since(startTime))
+		t.metricsClient.Scope(scope, metrics.DomainTag(domainName)).
+			RecordTimer(metrics.TaskLatency, time.Since(startTime))
 		t.metricsClient.RecordTimer(
 			scope,
 			metrics.TaskQueueLatency,
@@ -617,12 +643,18 @@ func (t *timerQueueProcessorBase) processDeleteHistoryEvent(task *persistence.Ti
 }
 
 func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
-	err := t.deleteWorkflowExecution(task)
+	// history and offloaded payloads go first, so that a failure is retried while the mutable state still exists
+	err := t.deleteWorkflowHistory(task, msBuilder)
+	if err != nil {
+		return err
+	}
+
+	err = t.deleteWorkflowPayloads(task, msBuilder)
 	if err != nil {
 		return err
 	}
 
-	err = t.deleteWorkflowHistory(task, msBuilder)
+	err = t.deleteWorkflowExecution(task)
 	if err != nil {
 		return err
 	}
@@ -638,6 +670,13 @@ func (t *timerQueueProcessorBase) deleteWorkflow(task *persistence.TimerTaskInfo
 }
 
 func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInfo, msBuilder mutableState, context workflowExecutionContext) error {
+	executionInfo := msBuilder.GetExecutionInfo()
+	var closeTimestamp int64
+	if completionEvent, ok := msBuilder.GetCompletionEvent(); ok {
+		closeTimestamp = completionEvent.GetTimestamp()
+	} else {
+		closeTimestamp = getLastUpdatedTimestamp(msBuilder)
+	}
 	req := &archiver.ArchiveRequest{
 		ShardID:              t.shard.GetShardID(),
 		DomainID:             task.DomainID,
@@ -647,6 +686,16 @@ func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInf
 		BranchToken:          msBuilder.GetCurrentBranch(),
 		NextEventID:          msBuilder.GetNextEventID(),
 		CloseFailoverVersion: msBuilder.GetLastWriteVersion(),
+
+		// fields used by visibility archival
+		WorkflowTypeName:   executionInfo.WorkflowTypeName,
+		StartTimestamp:     executionInfo.StartTimestamp.UnixNano(),
+		ExecutionTimestamp: getWorkflowExecutionTimestamp(msBuilder).UnixNano(),
+		CloseTimestamp:     closeTimestamp,
+		CloseStatus:        getWorkflowExecutionCloseStatus(executionInfo.CloseStatus),
+		HistoryLength:      msBuilder.GetNextEventID() - 1,
+		Memo:               getArchivedMemo(executionInfo),
+		SearchAttributes:   getArchivedSearchAttributes(executionInfo),
 	}
 
 	// send signal before deleting mutable state to make sure archival is idempotent
@@ -676,6 +725,33 @@ func (t *timerQueueProcessorBase) archiveWorkflow(task *persistence.TimerTaskInf
 	return nil
 }
 
+// getArchivedMemo returns the memo archived with the visibility record of the workflow
+func getArchivedMemo(executionInfo *persistence.WorkflowExecutionInfo) map[string][]byte {
+	if len(executionInfo.ExecutionContext) == 0 {
+		return nil
+	}
+	return map[string][]byte{
+		archiver.MemoKeyExecutionContext: executionInfo.ExecutionContext,
+	}
+}
+
+// getArchivedSearchAttributes returns the attributes of the workflow which are archived
+// with its visibility record in addition to the fields of the closed record
+func getArchivedSearchAttributes(executionInfo *persistence.WorkflowExecutionInfo) map[string][]byte {
+	attributes := map[string][]byte{
+		archiver.SearchAttributeTaskList: []byte(executionInfo.TaskList),
+	}
+	if executionInfo.CronSchedule != "" {
+		attributes[archiver.SearchAttributeCronSchedule] = []byte(executionInfo.CronSchedule)
+	}
+	if executionInfo.ParentWorkflowID != "" {
+		attributes[archiver.SearchAttributeParentDomainID] = []byte(executionInfo.ParentDomainID)
+		attributes[archiver.SearchAttributeParentWorkflowID] = []byte(executionInfo.ParentWorkflowID)
+		attributes[archiver.SearchAttributeParentRunID] = []byte(executionInfo.ParentRunID)
+	}
+	return attributes
+}
+
 func (t *timerQueueProcessorBase) deleteWorkflowExecution(task *persistence.TimerTaskInfo) error {
 	op := func() error {
 		return t.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
@@ -710,6 +786,20 @@ func (t *timerQueueProcessorBase) deleteWorkflowHistory(task *persistence.TimerT
 	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 }
 
+// deleteWorkflowPayloads deletes the payloads offloaded for the history of the workflow, the payloads of a
+// history of events v2 are kept until the last run sharing its history tree is deleted
+func (t *timerQueueProcessorBase) deleteWorkflowPayloads(task *persistence.TimerTaskInfo, msBuilder mutableState) error {
+	payloadOffloader := t.shard.GetPayloadOffloader()
+	op := func() error {
+		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
+			return payload.DeleteHistoryTreePayloads(payloadOffloader, t.historyService.historyV2Mgr, task.DomainID,
+				msBuilder.GetCurrentBranch())
+		}
+		return payloadOffloader.DeletePayloads(task.DomainID, task.RunID)
+	}
+	return backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+}
+
 func (t *timerQueueProcessorBase) deleteWorkflowVisibility(task *persistence.TimerTaskInfo) error {
 	if t.visibilityProducer == nil {
 		return nil
@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/client"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
@@ -41,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/archiver"
 )
 
 type (
@@ -70,6 +72,32 @@ func TestTimerQueueProcessorBaseSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func Test_GetArchivedVisibilityAttributes(t *testing.T) {
+	a := assert.New(t)
+
+	executionInfo := &persistence.WorkflowExecutionInfo{TaskList: "some random tasklist"}
+	a.Nil(getArchivedMemo(executionInfo))
+	a.Equal(map[string][]byte{
+		archiver.SearchAttributeTaskList: []byte("some random tasklist"),
+	}, getArchivedSearchAttributes(executionInfo))
+
+	executionInfo.ExecutionContext = []byte("some random context")
+	executionInfo.CronSchedule = "@every 1m"
+	executionInfo.ParentDomainID = "some random parent domain ID"
+	executionInfo.ParentWorkflowID = "some random parent workflow ID"
+	executionInfo.ParentRunID = "some random parent run ID"
+	a.Equal(map[string][]byte{
+		archiver.MemoKeyExecutionContext: []byte("some random context"),
+	}, getArchivedMemo(executionInfo))
+	a.Equal(map[string][]byte{
+		archiver.SearchAttributeTaskList:         []byte("some random tasklist"),
+		archiver.SearchAttributeCronSchedule:     []byte("@every 1m"),
+		archiver.SearchAttributeParentDomainID:   []byte("some random parent domain ID"),
+		archiver.SearchAttributeParentWorkflowID: []byte("some random parent workflow ID"),
+		archiver.SearchAttributeParentRunID:      []byte("some random parent run ID"),
+	}, getArchivedSearchAttributes(executionInfo))
+}
+
 func (s *timerQueueProcessorBaseSuite) SetupSuite() {
 	if testing.Verbose() {
 		log.SetOutput(os.Stdout)
@@ -138,7 +166,7 @@ func (s *timerQueueProcessorBaseSuite) TearDownTest() {
 
 func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_ShutDown() {
 	close(s.timerQueueProcessor.shutdownCh)
-	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, &persistence.TimerTaskInfo{})
+	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, &persistence.TimerTaskInfo{}, "")
 }
 
 func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainErrRetry_ProcessNoErr() {
@@ -153,7 +181,7 @@ func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainErrRetry_Proc
 	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
 	s.mockProcessor.On("process", task, true).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainFalse_ProcessNoErr() {
@@ -164,7 +192,7 @@ func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainFalse_Process
 	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
 	s.mockProcessor.On("process", task, false).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainTrue_ProcessNoErr() {
@@ -175,7 +203,7 @@ func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainTrue_ProcessN
 	s.mockProcessor.On("getTaskFilter").Return(taskFilter).Once()
 	s.mockProcessor.On("process", task, false).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainTrue_ProcessErrNoErr() {
@@ -188,7 +216,7 @@ func (s *timerQueueProcessorBaseSuite) TestProcessTaskAndAck_DomainTrue_ProcessE
 	s.mockProcessor.On("process", task).Return(s.scope, err).Once()
 	s.mockProcessor.On("process", task).Return(s.scope, nil).Once()
 	s.mockQueueAckMgr.On("completeQueueTask", task.GetTaskID()).Once()
-	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task)
+	s.timerQueueProcessor.processTaskAndAck(s.notificationChan, task, "")
 }
 
 func (s *timerQueueProcessorBaseSuite) TestHandleTaskError_EntiryNotExists() {
@@ -65,12 +65,15 @@ func newTransferQueueActiveProcessor(shard ShardContext, historyService *history
 		StartDelay:                         config.TransferProcessorStartDelay,
 		BatchSize:                          config.TransferTaskBatchSize,
 		WorkerCount:                        config.TransferTaskWorkerCount,
+		DomainWeight:                       config.TransferProcessorDomainWeight,
+		SchedulerBufferSize:                config.TransferProcessorSchedulerBufferSize,
 		MaxPollRPS:                         config.TransferProcessorMaxPollRPS,
 		MaxPollInterval:                    config.TransferProcessorMaxPollInterval,
 		MaxPollIntervalJitterCoefficient:   config.TransferProcessorMaxPollIntervalJitterCoefficient,
 		UpdateAckInterval:                  config.TransferProcessorUpdateAckInterval,
 		UpdateAckIntervalJitterCoefficient: config.TransferProcessorUpdateAckIntervalJitterCoefficient,
 		MaxRetryCount:                      config.TransferTaskMaxRetryCount,
+		EnableTaskDLQ:                      config.TransferTaskDLQEnabled,
 		MetricScope:                        metrics.TransferActiveQueueProcessorScope,
 	}
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
@@ -127,12 +130,15 @@ func newTransferQueueFailoverProcessor(shard ShardContext, historyService *histo
 		StartDelay:                         config.TransferProcessorFailoverStartDelay,
 		BatchSize:                          config.TransferTaskBatchSize,
 		WorkerCount:                        config.TransferTaskWorkerCount,
+		DomainWeight:                       config.TransferProcessorDomainWeight,
+		SchedulerBufferSize:                config.TransferProcessorSchedulerBufferSize,
 		MaxPollRPS:                         config.TransferProcessorFailoverMaxPollRPS,
 		MaxPollInterval:                    config.TransferProcessorMaxPollInterval,
 		MaxPollIntervalJitterCoefficient:   config.TransferProcessorMaxPollIntervalJitterCoefficient,
 		UpdateAckInterval:                  config.TransferProcessorUpdateAckInterval,
 		UpdateAckIntervalJitterCoefficient: config.TransferProcessorUpdateAckIntervalJitterCoefficient,
 		MaxRetryCount:                      config.TransferTaskMaxRetryCount,
+		EnableTaskDLQ:                      config.TransferTaskDLQEnabled,
 		MetricScope:                        metrics.TransferActiveQueueProcessorScope,
 	}
 	currentClusterName := shard.GetService().GetClusterMetadata().GetCurrentClusterName()
@@ -761,6 +767,12 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 	initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(initiatedEventID)
 	if ok && ci.StartedID == common.EmptyEventID {
 		attributes := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes
+		// the child must not refer to payloads offloaded for the parent
+		var input []byte
+		input, err = t.shard.GetPayloadOffloader().Resolve(attributes.Input)
+		if err != nil {
+			return err
+		}
 		// Found pending child execution and it is not marked as started
 		// Let's try and start the child execution
 		startRequest := &h.StartWorkflowExecutionRequest{
@@ -770,7 +782,7 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(task *pers
 				WorkflowId:                          attributes.WorkflowId,
 				WorkflowType:                        attributes.WorkflowType,
 				TaskList:                            attributes.TaskList,
-				Input:                               attributes.Input,
+				Input:                               input,
 				ExecutionStartToCloseTimeoutSeconds: attributes.ExecutionStartToCloseTimeoutSeconds,
 				TaskStartToCloseTimeoutSeconds:      attributes.TaskStartToCloseTimeoutSeconds,
 				// Use the same request ID to dedupe StartWorkflowExecution calls
@@ -59,12 +59,15 @@ func newTransferQueueStandbyProcessor(clusterName string, shard ShardContext, hi
 		StartDelay:                         config.TransferProcessorStartDelay,
 		BatchSize:                          config.TransferTaskBatchSize,
 		WorkerCount:                        config.TransferTaskWorkerCount,
+		DomainWeight:                       config.TransferProcessorDomainWeight,
+		SchedulerBufferSize:                config.TransferProcessorSchedulerBufferSize,
 		MaxPollRPS:                         config.TransferProcessorMaxPollRPS,
 		MaxPollInterval:                    config.TransferProcessorMaxPollInterval,
 		MaxPollIntervalJitterCoefficient:   config.TransferProcessorMaxPollIntervalJitterCoefficient,
 		UpdateAckInterval:                  config.TransferProcessorUpdateAckInterval,
 		UpdateAckIntervalJitterCoefficient: config.TransferProcessorUpdateAckIntervalJitterCoefficient,
 		MaxRetryCount:                      config.TransferTaskMaxRetryCount,
+		EnableTaskDLQ:                      config.TransferTaskDLQEnabled,
 		MetricScope:                        metrics.TransferStandbyQueueProcessorScope,
 	}
 	logger = logger.WithFields(bark.Fields{
@@ -0,0 +1,393 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package history
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+var (
+	// mutable state fields which are not derived from history events are never reported as differences
+	rebuildIgnoredExecutionInfoFields = map[string]struct{}{
+		"LastUpdatedTimestamp":         {},
+		"StickyTaskList":               {},
+		"StickyScheduleToStartTimeout": {},
+		"ClientLibraryVersion":         {},
+		"ClientFeatureVersion":         {},
+		"ClientImpl":                   {},
+	}
+	rebuildIgnoredReplicationStateFields = map[string]struct{}{
+		"LastReplicationInfo": {},
+	}
+	rebuildIgnoredActivityInfoFields = map[string]struct{}{
+		"Details":                        {},
+		"LastHeartBeatUpdatedTime":       {},
+		"TimerTaskStatus":                {},
+		"LastHeartbeatTimeoutVisibility": {},
+	}
+	rebuildIgnoredTimerInfoFields = map[string]struct{}{
+		"TaskID": {},
+	}
+)
+
+// RebuildMutableState replays the complete history of a workflow execution into a fresh mutable state and
+// compares the result with the stored mutable state. Unless dry run is requested the stored mutable state is
+// overwritten with the rebuilt one and the tasks of the execution are generated again. For a closed execution
+// only the retention timer is generated again, its close side effects are not repeated.
+func (e *historyEngineImpl) RebuildMutableState(ctx context.Context,
+	request *h.RebuildMutableStateRequest) (*workflow.RebuildMutableStateResponse, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	rebuildRequest := request.Request
+	if rebuildRequest == nil || rebuildRequest.Execution == nil || rebuildRequest.Execution.GetWorkflowId() == "" {
+		return nil, &workflow.BadRequestError{Message: "Execution is not set on request."}
+	}
+	execution := workflow.WorkflowExecution{
+		WorkflowId: rebuildRequest.Execution.WorkflowId,
+		RunId:      rebuildRequest.Execution.RunId,
+	}
+
+	diff, applied, err := e.rebuildAndResetMutableState(ctx, domainID, &execution, rebuildRequest.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	if applied {
+		// ResetMutableState does not carry any tasks, so they are generated from the new mutable state
+		// the same way RefreshWorkflowTasks does it, which for a closed run is only the retention timer
+		err = e.RefreshWorkflowTasks(ctx, &h.RefreshWorkflowTasksRequest{
+			DomainUUID: common.StringPtr(domainID),
+			Request: &workflow.RefreshWorkflowTasksRequest{
+				Domain:    rebuildRequest.Domain,
+				Execution: &execution,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &workflow.RebuildMutableStateResponse{
+		Diff:    diff,
+		Applied: common.BoolPtr(applied),
+	}, nil
+}
+
+func (e *historyEngineImpl) rebuildAndResetMutableState(ctx context.Context, domainID string,
+	execution *workflow.WorkflowExecution, dryRun bool) (diff []string, applied bool, retError error) {
+
+	context, release, retError := e.historyCache.getOrCreateWorkflowExecutionWithTimeout(ctx, domainID, *execution)
+	if retError != nil {
+		return
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, retError := context.loadWorkflowExecution()
+	if retError != nil {
+		return
+	}
+	executionInfo := msBuilder.GetExecutionInfo()
+	execution.RunId = common.StringPtr(executionInfo.RunID)
+	if executionInfo.CloseStatus == persistence.WorkflowCloseStatusContinuedAsNew {
+		// replaying the continue as new event requires the history of the new run
+		retError = &workflow.BadRequestError{
+			Message: "Mutable state of a workflow execution closed by continue as new cannot be rebuilt.",
+		}
+		return
+	}
+
+	rebuiltBuilder, retError := e.rebuildMutableStateFromHistory(context, msBuilder)
+	if retError != nil {
+		return
+	}
+
+	diff = diffMutableState(msBuilder.CopyToPersistence(), rebuiltBuilder.CopyToPersistence())
+	if dryRun || len(diff) == 0 {
+		return
+	}
+
+	if msBuilder.HasBufferedEvents() || msBuilder.HasBufferedReplicationTasks() {
+		// resetting mutable state drops buffered events which are not part of the history yet
+		retError = &workflow.BadRequestError{
+			Message: "Workflow execution has buffered events, retry after the next decision completes.",
+		}
+		return
+	}
+	resp, retError := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: executionInfo.WorkflowID,
+	})
+	if retError != nil {
+		return
+	}
+	if resp.RunID != executionInfo.RunID {
+		retError = &workflow.BadRequestError{
+			Message: "Mutable state can only be overwritten for the current run of a workflow execution.",
+		}
+		return
+	}
+
+	if _, retError = context.resetMutableState(executionInfo.RunID, rebuiltBuilder); retError != nil {
+		return
+	}
+	applied = true
+	return
+}
+
+// rebuildMutableStateFromHistory applies all events of the workflow execution to a new mutable state, the
+// stored mutable state is only used to locate the history and for fields which are not part of the history
+func (e *historyEngineImpl) rebuildMutableStateFromHistory(context workflowExecutionContext,
+	msBuilder mutableState) (*mutableStateBuilder, error) {
+
+	domainID := context.getDomainID()
+	execution := *context.getExecution()
+	executionInfo := msBuilder.GetExecutionInfo()
+	eventStoreVersion := msBuilder.GetEventStoreVersion()
+	branchToken := msBuilder.GetCurrentBranch()
+
+	var rebuiltBuilder *mutableStateBuilder
+	var sBuilder stateBuilder
+	var lastEvent *workflow.HistoryEvent
+	var nextPageToken []byte
+	for hasMore := true; hasMore; hasMore = len(nextPageToken) > 0 {
+		batches, size, token, err := e.getWorkflowHistoryBatches(domainID, execution, eventStoreVersion, branchToken,
+			nextPageToken)
+		if err != nil {
+			return nil, err
+		}
+		nextPageToken = token
+
+		// events are applied batch by batch, so the batch IDs recorded in mutable state are correct
+		for _, batch := range batches {
+			if len(batch.Events) == 0 {
+				continue
+			}
+			if rebuiltBuilder == nil {
+				if msBuilder.GetReplicationState() != nil {
+					rebuiltBuilder = newMutableStateBuilderWithReplicationState(e.currentClusterName, e.shard,
+						e.shard.GetEventsCache(), e.logger, batch.Events[0].GetVersion())
+				} else {
+					rebuiltBuilder = newMutableStateBuilder(e.currentClusterName, e.shard, e.shard.GetEventsCache(), e.logger)
+				}
+				rebuiltBuilder.executionInfo.EventStoreVersion = eventStoreVersion
+				sBuilder = newStateBuilder(e.shard, rebuiltBuilder, e.logger)
+			}
+
+			if _, _, _, err := sBuilder.applyEvents(domainID, executionInfo.CreateRequestID, execution, batch.Events, nil,
+				eventStoreVersion, 0); err != nil {
+				return nil, err
+			}
+			rebuiltBuilder.executionInfo.SetLastFirstEventID(batch.Events[0].GetEventId())
+			lastEvent = batch.Events[len(batch.Events)-1]
+		}
+		if rebuiltBuilder != nil {
+			rebuiltBuilder.IncrementHistorySize(size)
+		}
+	}
+	if rebuiltBuilder == nil {
+		return nil, &workflow.InternalServiceError{Message: "History of workflow execution is empty."}
+	}
+
+	rebuiltInfo := rebuiltBuilder.executionInfo
+	// the start event set a new branch for V2 history, keep the one the history was read from
+	rebuiltInfo.BranchToken = branchToken
+	rebuiltInfo.RunID = executionInfo.RunID
+	rebuiltInfo.SetNextEventID(lastEvent.GetEventId() + 1)
+	rebuiltInfo.StartTimestamp = executionInfo.StartTimestamp
+	rebuiltInfo.LastUpdatedTimestamp = executionInfo.LastUpdatedTimestamp
+	rebuiltInfo.StickyTaskList = executionInfo.StickyTaskList
+	rebuiltInfo.StickyScheduleToStartTimeout = executionInfo.StickyScheduleToStartTimeout
+	rebuiltInfo.ClientLibraryVersion = executionInfo.ClientLibraryVersion
+	rebuiltInfo.ClientFeatureVersion = executionInfo.ClientFeatureVersion
+	rebuiltInfo.ClientImpl = executionInfo.ClientImpl
+
+	if replicationState := msBuilder.GetReplicationState(); replicationState != nil {
+		sourceCluster := e.shard.GetService().GetClusterMetadata().ClusterNameForFailoverVersion(lastEvent.GetVersion())
+		rebuiltBuilder.UpdateReplicationStateLastEventID(sourceCluster, lastEvent.GetVersion(), lastEvent.GetEventId())
+		rebuiltBuilder.replicationState.LastReplicationInfo = replicationState.LastReplicationInfo
+	}
+
+	// heartbeat progress is only recorded in mutable state
+	for _, ai := range rebuiltBuilder.GetPendingActivityInfos() {
+		if storedInfo, ok := msBuilder.GetActivityInfo(ai.ScheduleID); ok {
+			ai.Details = storedInfo.Details
+			ai.LastHeartBeatUpdatedTime = storedInfo.LastHeartBeatUpdatedTime
+		}
+	}
+
+	return rebuiltBuilder, nil
+}
+
+func (e *historyEngineImpl) getWorkflowHistoryBatches(domainID string, execution workflow.WorkflowExecution,
+	eventStoreVersion int32, branchToken []byte, nextPageToken []byte) ([]*workflow.History, int, []byte, error) {
+
+	if eventStoreVersion == persistence.EventStoreVersionV2 {
+		response, err := e.historyV2Mgr.ReadHistoryBranchByBatch(&persistence.ReadHistoryBranchRequest{
+			BranchToken:   branchToken,
+			MinEventID:    common.FirstEventID,
+			MaxEventID:    common.EndEventID,
+			PageSize:      defaultHistoryPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		return response.History, response.Size, response.NextPageToken, nil
+	}
+	response, err := e.historyMgr.GetWorkflowExecutionHistoryByBatch(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:      domainID,
+		Execution:     execution,
+		FirstEventID:  common.FirstEventID,
+		NextEventID:   common.EndEventID,
+		PageSize:      defaultHistoryPageSize,
+		NextPageToken: nextPageToken,
+	})
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return response.History, response.Size, response.NextPageToken, nil
+}
+
+// diffMutableState returns one human readable line for every field which differs between the stored and the
+// rebuilt mutable state
+func diffMutableState(stored, rebuilt *persistence.WorkflowMutableState) []string {
+	var diff []string
+	diff = append(diff, diffStructFields("ExecutionInfo", stored.ExecutionInfo, rebuilt.ExecutionInfo,
+		rebuildIgnoredExecutionInfoFields)...)
+	diff = append(diff, diffStructFields("ReplicationState", stored.ReplicationState, rebuilt.ReplicationState,
+		rebuildIgnoredReplicationStateFields)...)
+	diff = append(diff, diffStructMaps("ActivityInfos", stored.ActivityInfos, rebuilt.ActivityInfos,
+		rebuildIgnoredActivityInfoFields)...)
+	diff = append(diff, diffStructMaps("TimerInfos", stored.TimerInfos, rebuilt.TimerInfos,
+		rebuildIgnoredTimerInfoFields)...)
+	diff = append(diff, diffStructMaps("ChildExecutionInfos", stored.ChildExecutionInfos, rebuilt.ChildExecutionInfos,
+		nil)...)
+	diff = append(diff, diffStructMaps("RequestCancelInfos", stored.RequestCancelInfos, rebuilt.RequestCancelInfos,
+		nil)...)
+	diff = append(diff, diffStructMaps("SignalInfos", stored.SignalInfos, rebuilt.SignalInfos, nil)...)
+	diff = append(diff, diffStructMaps("SignalRequestedIDs", stored.SignalRequestedIDs, rebuilt.SignalRequestedIDs,
+		nil)...)
+	return diff
+}
+
+func diffStructFields(name string, stored, rebuilt interface{}, ignoredFields map[string]struct{}) []string {
+	storedValue := reflect.Indirect(reflect.ValueOf(stored))
+	rebuiltValue := reflect.Indirect(reflect.ValueOf(rebuilt))
+	if !storedValue.IsValid() || !rebuiltValue.IsValid() {
+		if storedValue.IsValid() != rebuiltValue.IsValid() {
+			return []string{fmt.Sprintf("%v: %v -> %v", name, formatDiffValue(stored), formatDiffValue(rebuilt))}
+		}
+		return nil
+	}
+
+	var diff []string
+	for i := 0; i < storedValue.NumField(); i++ {
+		field := storedValue.Type().Field(i).Name
+		if _, ok := ignoredFields[field]; ok {
+			continue
+		}
+		storedField := storedValue.Field(i).Interface()
+		rebuiltField := rebuiltValue.Field(i).Interface()
+		if !equalDiffValues(storedField, rebuiltField) {
+			diff = append(diff, fmt.Sprintf("%v.%v: %v -> %v", name, field, formatDiffValue(storedField),
+				formatDiffValue(rebuiltField)))
+		}
+	}
+	return diff
+}
+
+func diffStructMaps(name string, stored, rebuilt interface{}, ignoredFields map[string]struct{}) []string {
+	storedValue := reflect.ValueOf(stored)
+	rebuiltValue := reflect.ValueOf(rebuilt)
+
+	keys := storedValue.MapKeys()
+	for _, key := range rebuiltValue.MapKeys() {
+		if !storedValue.MapIndex(key).IsValid() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Kind() == reflect.String {
+			return keys[i].String() < keys[j].String()
+		}
+		return keys[i].Int() < keys[j].Int()
+	})
+
+	var diff []string
+	for _, key := range keys {
+		entry := fmt.Sprintf("%v[%v]", name, key.Interface())
+		storedEntry := storedValue.MapIndex(key)
+		rebuiltEntry := rebuiltValue.MapIndex(key)
+		switch {
+		case !rebuiltEntry.IsValid():
+			diff = append(diff, fmt.Sprintf("%v: missing in rebuilt mutable state", entry))
+		case !storedEntry.IsValid():
+			diff = append(diff, fmt.Sprintf("%v: missing in stored mutable state", entry))
+		default:
+			diff = append(diff, diffStructFields(entry, storedEntry.Interface(), rebuiltEntry.Interface(),
+				ignoredFields)...)
+		}
+	}
+	return diff
+}
+
+func equalDiffValues(stored, rebuilt interface{}) bool {
+	if storedTime, ok := stored.(time.Time); ok {
+		// timestamps read from the database lose monotonic clock and location
+		return storedTime.Equal(rebuilt.(time.Time))
+	}
+	storedValue := reflect.ValueOf(stored)
+	rebuiltValue := reflect.ValueOf(rebuilt)
+	if storedValue.Kind() == reflect.Slice && storedValue.Len() == 0 && rebuiltValue.Len() == 0 {
+		// nil and empty slices are persisted the same way
+		return true
+	}
+	return reflect.DeepEqual(stored, rebuilt)
+}
+
+func formatDiffValue(value interface{}) string {
+	switch v := value.(type) {
+	case []byte:
+		return fmt.Sprintf("%q", v)
+	case string:
+		return fmt.Sprintf("%q", v)
+	case *workflow.HistoryEvent:
+		if v == nil {
+			return "nil"
+		}
+		return fmt.Sprintf("%v(%v)", v.GetEventType(), v.GetEventId())
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
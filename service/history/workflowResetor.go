@@ -418,7 +418,7 @@ func (w *workflowResetorImpl) terminateIfCurrIsRunning(currMutableState mutableS
 }
 
 func historyGarbageCleanupInfo(domainID, workflowID, runID string) string {
-	return fmt.Sprintf("%v:%v:%v", domainID, workflowID, runID)
+	return persistence.BuildHistoryGarbageCleanupInfo(domainID, workflowID, runID)
 }
 
 func (w *workflowResetorImpl) setEventIDsWithHistory(msBuilder mutableState) int64 {
@@ -468,6 +468,11 @@ func (w *workflowResetorImpl) generateReplicationTasksForReset(terminateCurr boo
 
 // replay signals in the base run, and also signals in all the runs along the chain of contineAsNew
 func (w *workflowResetorImpl) replayReceivedSignals(ctx context.Context, receivedSignals []*workflow.HistoryEvent, continueRunID string, newMutableState, currMutableState mutableState) error {
+	// signal payloads offloaded for the base run are deleted with it, so the new run must hold the payloads itself
+	payloadOffloader := w.eng.shard.GetPayloadOffloader()
+	if err := payloadOffloader.ResolveEvents(receivedSignals); err != nil {
+		return err
+	}
 	for _, se := range receivedSignals {
 		sigReq := &workflow.SignalWorkflowExecutionRequest{
 			SignalName: se.GetWorkflowExecutionSignaledEventAttributes().SignalName,
@@ -518,10 +523,14 @@ func (w *workflowResetorImpl) replayReceivedSignals(ctx context.Context, receive
 			for _, batch := range readResp.History {
 				for _, e := range batch.Events {
 					if e.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
+						input, err := payloadOffloader.Resolve(e.GetWorkflowExecutionSignaledEventAttributes().Input)
+						if err != nil {
+							return err
+						}
 						sigReq := &workflow.SignalWorkflowExecutionRequest{
 							SignalName: e.GetWorkflowExecutionSignaledEventAttributes().SignalName,
 							Identity:   e.GetWorkflowExecutionSignaledEventAttributes().Identity,
-							Input:      e.GetWorkflowExecutionSignaledEventAttributes().Input,
+							Input:      input,
 						}
 						newMutableState.AddWorkflowExecutionSignaled(sigReq.GetSignalName(), sigReq.GetInput(), sigReq.GetIdentity())
 					} else if e.GetEventType() == workflow.EventTypeWorkflowExecutionContinuedAsNew {
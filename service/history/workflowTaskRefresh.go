@@ -0,0 +1,355 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// RefreshWorkflowTasks regenerates all outstanding transfer and timer tasks implied by the mutable state of a
+// workflow execution. Queue processors verify every task against mutable state before acting on it, so
+// duplicates of tasks which still exist are discarded and refreshing a workflow any number of times is safe.
+func (e *historyEngineImpl) RefreshWorkflowTasks(ctx context.Context, request *h.RefreshWorkflowTasksRequest) error {
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	refreshRequest := request.Request
+	if refreshRequest == nil || refreshRequest.Execution == nil || refreshRequest.Execution.GetWorkflowId() == "" {
+		return &workflow.BadRequestError{Message: "Execution is not set on request."}
+	}
+	execution := workflow.WorkflowExecution{
+		WorkflowId: refreshRequest.Execution.WorkflowId,
+		RunId:      refreshRequest.Execution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				// persisting the refreshed run also writes its state into the current execution record,
+				// so a closed run that has since been succeeded by a newer run is rejected up front
+				// instead of overwriting, or failing on, the record of the newer run
+				executionInfo := msBuilder.GetExecutionInfo()
+				resp, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+					DomainID:   domainID,
+					WorkflowID: executionInfo.WorkflowID,
+				})
+				if err != nil {
+					return nil, err
+				}
+				if resp.RunID != executionInfo.RunID {
+					return nil, &workflow.BadRequestError{
+						Message: "Tasks of a closed workflow execution can only be refreshed while it is the current run.",
+					}
+				}
+				// the close side effects (parent notification, visibility and archival) already happened when
+				// the run was closed, so only the retention timer is regenerated
+				deleteTask, err := getClosedWorkflowDeleteHistoryTask(e.shard, domainID, msBuilder)
+				if err != nil {
+					return nil, err
+				}
+				return &updateWorkflowAction{
+					timerTasks: []persistence.Task{deleteTask},
+				}, nil
+			}
+
+			transferTasks, timerTasks, err := e.getWorkflowRefreshTasks(domainID, msBuilder, tBuilder)
+			if err != nil {
+				return nil, err
+			}
+			return &updateWorkflowAction{
+				transferTasks: transferTasks,
+				timerTasks:    timerTasks,
+			}, nil
+		})
+}
+
+// getClosedWorkflowDeleteHistoryTask returns the task deleting the history of a closed workflow execution
+// once the retention period of its domain has passed since the execution was closed
+func getClosedWorkflowDeleteHistoryTask(shard ShardContext, domainID string, msBuilder mutableState) (persistence.Task, error) {
+	var retentionInDays int32
+	domainEntry, err := shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			return nil, err
+		}
+	} else {
+		retentionInDays = domainEntry.GetRetentionDays(msBuilder.GetExecutionInfo().WorkflowID)
+	}
+	closeTime := time.Unix(0, getLastUpdatedTimestamp(msBuilder))
+	if completionEvent, ok := msBuilder.GetCompletionEvent(); ok {
+		closeTime = time.Unix(0, completionEvent.GetTimestamp())
+	}
+	return &persistence.DeleteHistoryEventTask{
+		VisibilityTimestamp: closeTime.Add(time.Duration(retentionInDays) * time.Hour * 24),
+	}, nil
+}
+
+func (e *historyEngineImpl) getWorkflowRefreshTasks(domainID string, msBuilder mutableState,
+	tBuilder *timerBuilder) ([]persistence.Task, []persistence.Task, error) {
+
+	var transferTasks, timerTasks []persistence.Task
+	executionInfo := msBuilder.GetExecutionInfo()
+	now := e.shard.GetTimeSource().Now()
+
+	// workflow timeout and first decision backoff
+	executionTimestamp := getWorkflowExecutionTimestamp(msBuilder)
+	workflowTimeout := time.Duration(executionInfo.WorkflowTimeout) * time.Second
+	if executionTimestamp.After(executionInfo.StartTimestamp) {
+		workflowTimeout += executionTimestamp.Sub(executionInfo.StartTimestamp)
+	}
+	timerTasks = append(timerTasks, &persistence.WorkflowTimeoutTask{
+		VisibilityTimestamp: executionInfo.StartTimestamp.Add(workflowTimeout),
+	})
+	if msBuilder.GetPreviousStartedEventID() == common.EmptyEventID && !msBuilder.HasPendingDecisionTask() {
+		backoffTimer := &persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: executionTimestamp,
+			TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCron,
+		}
+		if startEvent, ok := msBuilder.GetStartEvent(); ok &&
+			startEvent.WorkflowExecutionStartedEventAttributes.GetInitiator() == workflow.ContinueAsNewInitiatorRetryPolicy {
+			backoffTimer.TimeoutType = persistence.WorkflowBackoffTimeoutTypeRetry
+		}
+		timerTasks = append(timerTasks, backoffTimer)
+	}
+
+	// decision
+	if msBuilder.HasPendingDecisionTask() {
+		if di, ok := msBuilder.GetInFlightDecisionTask(); ok {
+			timerTasks = append(timerTasks, tBuilder.AddStartToCloseDecisionTimoutTask(di.ScheduleID, di.Attempt,
+				di.DecisionTimeout))
+		} else if di, ok := msBuilder.GetPendingDecision(executionInfo.DecisionScheduleID); ok {
+			transferTasks = append(transferTasks, &persistence.DecisionTask{
+				DomainID:   domainID,
+				TaskList:   di.TaskList,
+				ScheduleID: di.ScheduleID,
+			})
+			if msBuilder.IsStickyTaskListEnabled() {
+				timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(di.ScheduleID, di.Attempt,
+					executionInfo.StickyScheduleToStartTimeout))
+			}
+		}
+	}
+
+	// activities, the timer status bits are cleared so the timer builder creates the next activity timeout again
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		if ai.StartedID == common.EmptyEventID {
+			if ai.Attempt > 0 && ai.ScheduledTime.After(now) {
+				timerTasks = append(timerTasks, &persistence.ActivityRetryTimerTask{
+					VisibilityTimestamp: ai.ScheduledTime,
+					EventID:             ai.ScheduleID,
+					Attempt:             ai.Attempt,
+				})
+			} else {
+				transferTasks = append(transferTasks, &persistence.ActivityTask{
+					DomainID:   ai.DomainID,
+					TaskList:   ai.TaskList,
+					ScheduleID: ai.ScheduleID,
+				})
+			}
+		}
+		ai.TimerTaskStatus = TimerTaskStatusNone
+		if err := msBuilder.UpdateActivity(ai); err != nil {
+			return nil, nil, err
+		}
+	}
+	if t := tBuilder.GetActivityTimerTaskIfNeeded(msBuilder); t != nil {
+		timerTasks = append(timerTasks, t)
+	}
+
+	// user timers, same as activities the task creation flag is cleared before loading them
+	for _, ti := range msBuilder.GetPendingTimerInfos() {
+		ti.TaskID = TimerTaskStatusNone
+		msBuilder.UpdateUserTimer(ti.TimerID, ti)
+	}
+	tBuilder.loadUserTimers(msBuilder)
+	if t := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); t != nil {
+		timerTasks = append(timerTasks, t)
+	}
+
+	// child workflows which are not started yet
+	for _, ci := range msBuilder.GetPendingChildExecutionInfos() {
+		if ci.StartedID != common.EmptyEventID {
+			continue
+		}
+		initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(ci.InitiatedID)
+		if !ok {
+			return nil, nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Initiated event of child execution %v is missing.", ci.InitiatedID),
+			}
+		}
+		attributes := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes
+		targetDomainID, err := e.getRefreshTargetDomainID(domainID, attributes.GetDomain())
+		if err != nil {
+			return nil, nil, err
+		}
+		transferTasks = append(transferTasks, &persistence.StartChildExecutionTask{
+			TargetDomainID:   targetDomainID,
+			TargetWorkflowID: attributes.GetWorkflowId(),
+			InitiatedID:      ci.InitiatedID,
+		})
+	}
+
+	// external cancellations and signals, their targets are only recorded on the initiated events
+	requestCancels := msBuilder.GetAllRequestCancels()
+	signalsToSend := msBuilder.GetAllSignalsToSend()
+	if len(requestCancels) == 0 && len(signalsToSend) == 0 {
+		return transferTasks, timerTasks, nil
+	}
+	initiatedIDs := make(map[int64]struct{}, len(requestCancels)+len(signalsToSend))
+	for initiatedID := range requestCancels {
+		initiatedIDs[initiatedID] = struct{}{}
+	}
+	for initiatedID := range signalsToSend {
+		initiatedIDs[initiatedID] = struct{}{}
+	}
+	initiatedEvents, err := e.getWorkflowHistoryEvents(domainID, msBuilder, initiatedIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for initiatedID := range requestCancels {
+		event, ok := initiatedEvents[initiatedID]
+		if !ok || event.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes == nil {
+			return nil, nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Initiated event of external cancellation %v is missing.", initiatedID),
+			}
+		}
+		attributes := event.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+		targetDomainID, err := e.getRefreshTargetDomainID(domainID, attributes.GetDomain())
+		if err != nil {
+			return nil, nil, err
+		}
+		transferTasks = append(transferTasks, &persistence.CancelExecutionTask{
+			TargetDomainID:          targetDomainID,
+			TargetWorkflowID:        attributes.WorkflowExecution.GetWorkflowId(),
+			TargetRunID:             attributes.WorkflowExecution.GetRunId(),
+			TargetChildWorkflowOnly: attributes.GetChildWorkflowOnly(),
+			InitiatedID:             initiatedID,
+		})
+	}
+
+	for initiatedID := range signalsToSend {
+		event, ok := initiatedEvents[initiatedID]
+		if !ok || event.SignalExternalWorkflowExecutionInitiatedEventAttributes == nil {
+			return nil, nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Initiated event of external signal %v is missing.", initiatedID),
+			}
+		}
+		attributes := event.SignalExternalWorkflowExecutionInitiatedEventAttributes
+		targetDomainID, err := e.getRefreshTargetDomainID(domainID, attributes.GetDomain())
+		if err != nil {
+			return nil, nil, err
+		}
+		transferTasks = append(transferTasks, &persistence.SignalExecutionTask{
+			TargetDomainID:          targetDomainID,
+			TargetWorkflowID:        attributes.WorkflowExecution.GetWorkflowId(),
+			TargetRunID:             attributes.WorkflowExecution.GetRunId(),
+			TargetChildWorkflowOnly: attributes.GetChildWorkflowOnly(),
+			InitiatedID:             initiatedID,
+		})
+	}
+
+	return transferTasks, timerTasks, nil
+}
+
+func (e *historyEngineImpl) getRefreshTargetDomainID(domainID string, targetDomain string) (string, error) {
+	if targetDomain == "" {
+		return domainID, nil
+	}
+	domainEntry, err := e.shard.GetDomainCache().GetDomain(targetDomain)
+	if err != nil {
+		return "", err
+	}
+	return domainEntry.GetInfo().ID, nil
+}
+
+// getWorkflowHistoryEvents reads the events with the given IDs from the history of the workflow execution
+func (e *historyEngineImpl) getWorkflowHistoryEvents(domainID string, msBuilder mutableState,
+	eventIDs map[int64]struct{}) (map[int64]*workflow.HistoryEvent, error) {
+
+	minEventID := msBuilder.GetNextEventID()
+	maxEventID := common.FirstEventID
+	for eventID := range eventIDs {
+		if eventID < minEventID {
+			minEventID = eventID
+		}
+		if eventID > maxEventID {
+			maxEventID = eventID
+		}
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	events := make(map[int64]*workflow.HistoryEvent, len(eventIDs))
+	var nextPageToken []byte
+	for {
+		var historyEvents []*workflow.HistoryEvent
+		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
+			response, err := e.historyV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+				BranchToken:   msBuilder.GetCurrentBranch(),
+				MinEventID:    minEventID,
+				MaxEventID:    maxEventID + 1,
+				PageSize:      defaultHistoryPageSize,
+				NextPageToken: nextPageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			historyEvents = response.HistoryEvents
+			nextPageToken = response.NextPageToken
+		} else {
+			response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+				DomainID: domainID,
+				Execution: workflow.WorkflowExecution{
+					WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+					RunId:      common.StringPtr(executionInfo.RunID),
+				},
+				FirstEventID:  minEventID,
+				NextEventID:   maxEventID + 1,
+				PageSize:      defaultHistoryPageSize,
+				NextPageToken: nextPageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			historyEvents = response.History.Events
+			nextPageToken = response.NextPageToken
+		}
+
+		for _, event := range historyEvents {
+			if _, ok := eventIDs[event.GetEventId()]; ok {
+				events[event.GetEventId()] = event
+			}
+		}
+		if len(nextPageToken) == 0 {
+			return events, nil
+		}
+	}
+}
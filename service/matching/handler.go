@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"strconv"
 	"sync"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
@@ -83,6 +85,7 @@ func (h *Handler) Start() error {
 	h.engine = NewEngine(
 		h.taskPersistence, h.GetClientBean().GetHistoryClient(), h.config, h.Service.GetLogger(), h.Service.GetMetricsClient(), h.domainCache,
 	)
+	h.registerHealthChecks()
 	h.startWG.Done()
 	return nil
 }
@@ -96,12 +99,23 @@ func (h *Handler) Stop() {
 	h.Service.Stop()
 }
 
-// Health is for health check
+// Health is for health check, it reports the status of the dependencies of the service
 func (h *Handler) Health(ctx context.Context) (*health.HealthStatus, error) {
 	h.startWG.Wait()
 	h.GetLogger().Debug("Matching service health check endpoint reached.")
-	hs := &health.HealthStatus{Ok: true, Msg: common.StringPtr("matching good")}
-	return hs, nil
+	return h.GetHealthRegistry().Check(ctx), nil
+}
+
+func (h *Handler) registerHealthChecks() {
+	registry := h.GetHealthRegistry()
+	registry.Register(healthcheck.PersistenceCheckName, healthcheck.PersistenceCheck(h.metadataMgr))
+	registry.Register(healthcheck.DomainCacheCheckName,
+		healthcheck.DomainCacheCheck(h.domainCache, healthcheck.DefaultDomainCacheMaxAge))
+	registry.Register("taskLists", func(ctx context.Context) (map[string]string, error) {
+		return map[string]string{
+			"loaded": strconv.Itoa(h.engine.NumTaskLists()),
+		}, nil
+	})
 }
 
 // startRequestProfile initiates recording of request metrics
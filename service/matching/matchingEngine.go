@@ -140,6 +140,12 @@ func (e *matchingEngineImpl) Stop() {
 	}
 }
 
+func (e *matchingEngineImpl) NumTaskLists() int {
+	e.taskListsLock.RLock()
+	defer e.taskListsLock.RUnlock()
+	return len(e.taskLists)
+}
+
 func (e *matchingEngineImpl) getTaskLists(maxCount int) (lists []taskListManager) {
 	e.taskListsLock.RLock()
 	defer e.taskListsLock.RUnlock()
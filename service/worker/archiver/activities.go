@@ -27,6 +27,7 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/uber-common/bark"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
@@ -36,15 +37,19 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"go.uber.org/cadence"
 	"go.uber.org/cadence/activity"
 )
 
 const (
-	uploadHistoryActivityFnName = "uploadHistoryActivity"
-	deleteHistoryActivityFnName = "deleteHistoryActivity"
-	blobstoreTimeout            = 30 * time.Second
+	uploadHistoryActivityFnName    = "uploadHistoryActivity"
+	deleteHistoryActivityFnName    = "deleteHistoryActivity"
+	uploadVisibilityActivityFnName = "uploadVisibilityActivity"
+	verifyArchivalActivityFnName   = "verifyArchivalActivity"
+	blobstoreTimeout               = 30 * time.Second
+	verificationPageSize           = 100
 
 	errGetDomainByID = "could not get domain cache entry"
 	errGetTags       = "could not get blob tags"
@@ -56,12 +61,14 @@ const (
 
 	errDeleteHistoryV1 = "failed to delete history from events_v1"
 	errDeleteHistoryV2 = "failed to delete history from events_v2"
+	errDeletePayloads  = "failed to delete offloaded payloads"
 )
 
 var (
-	uploadHistoryActivityNonRetryableErrors = []string{errGetDomainByID, errGetTags, errUploadBlob, errReadBlob, errEmptyBucket, errConstructBlob}
-	deleteHistoryActivityNonRetryableErrors = []string{errDeleteHistoryV1, errDeleteHistoryV2}
-	errContextTimeout                       = errors.New("activity aborted because context timed out")
+	uploadHistoryActivityNonRetryableErrors    = []string{errGetDomainByID, errGetTags, errUploadBlob, errReadBlob, errEmptyBucket, errConstructBlob}
+	deleteHistoryActivityNonRetryableErrors    = []string{errDeleteHistoryV1, errDeleteHistoryV2, errDeletePayloads}
+	uploadVisibilityActivityNonRetryableErrors = []string{errGetDomainByID, errUploadBlob, errEmptyBucket, errConstructBlob}
+	errContextTimeout                          = errors.New("activity aborted because context timed out")
 )
 
 // uploadHistoryActivity is used to upload a workflow execution history to blobstore.
@@ -173,6 +180,72 @@ func uploadHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 	return nil
 }
 
+// uploadVisibilityActivity is used to upload the visibility record of a closed workflow execution to blobstore.
+// method will retry all retryable operations until context expires.
+// archival will be skipped and no error will be returned if cluster or domain is not figured for archival.
+// method will always return either: nil, errContextTimeout or an error from uploadVisibilityActivityNonRetryableErrors.
+func uploadVisibilityActivity(ctx context.Context, request ArchiveRequest) (err error) {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	metricsClient := container.MetricsClient
+	sw := metricsClient.StartTimer(metrics.ArchiverUploadVisibilityActivityScope, metrics.CadenceLatency)
+	defer func() {
+		sw.Stop()
+		if err != nil {
+			if err == errContextTimeout {
+				metricsClient.IncCounter(metrics.ArchiverUploadVisibilityActivityScope, metrics.CadenceErrContextTimeoutCounter)
+			} else {
+				metricsClient.IncCounter(metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverNonRetryableErrorCount)
+			}
+		}
+	}()
+
+	logger := tagLoggerWithRequest(container.Logger, request).WithField(logging.TagAttempt, activity.GetInfo(ctx).Attempt)
+	if request.CloseTimestamp == 0 {
+		// requests sent before visibility archival was introduced do not carry visibility information
+		logging.LogSkipArchivalUpload(logger, "archive request does not contain visibility information")
+		metricsClient.IncCounter(metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverSkipUploadCount)
+		return nil
+	}
+	domainCacheEntry, err := getDomainByID(ctx, container.DomainCache, request.DomainID)
+	if err != nil {
+		logging.LogFailArchivalUploadAttempt(logger, err, "could not get domain cache entry", "", "")
+		return err
+	}
+	if container.ClusterMetadata.ArchivalConfig().GetArchivalStatus() != cluster.ArchivalEnabled {
+		logging.LogSkipArchivalUpload(logger, "cluster is not enabled for archival")
+		metricsClient.IncCounter(metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverSkipUploadCount)
+		return nil
+	}
+	if domainCacheEntry.GetConfig().ArchivalStatus != shared.ArchivalStatusEnabled {
+		logging.LogSkipArchivalUpload(logger, "domain is not enabled for archival")
+		metricsClient.IncCounter(metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverSkipUploadCount)
+		return nil
+	}
+	bucket := domainCacheEntry.GetConfig().ArchivalBucket
+	if len(bucket) == 0 {
+		// this should not be able to occur, if domain enables archival bucket should always be set
+		logging.LogFailArchivalUploadAttempt(logger, err, "domain enables archival but does not have a bucket set", "", "")
+		return cadence.NewCustomError(errEmptyBucket)
+	}
+	key, err := NewVisibilityBlobKey(request.DomainID, request.WorkflowID, request.RunID, request.CloseTimestamp)
+	if err != nil {
+		logging.LogFailArchivalUploadAttempt(logger, err, "could not construct blob key", bucket, "")
+		return cadence.NewCustomError(errConstructBlob)
+	}
+	domainName := domainCacheEntry.GetInfo().Name
+	record := NewVisibilityRecord(request, domainName, container.ClusterMetadata.GetCurrentClusterName(), time.Now().String())
+	blob, reason, err := constructVisibilityBlob(record, container.Config.EnableArchivalCompression(domainName))
+	if err != nil {
+		logging.LogFailArchivalUploadAttempt(logger, err, reason, bucket, key.String())
+		return cadence.NewCustomError(errConstructBlob)
+	}
+	if err := uploadBlob(ctx, container.Blobstore, bucket, key, blob); err != nil {
+		logging.LogFailArchivalUploadAttempt(logger, err, "could not upload blob", bucket, key.String())
+		return err
+	}
+	return nil
+}
+
 // deleteHistoryActivity deletes workflow execution history from persistence.
 // method will retry all retryable operations until context expires.
 // method will always return either: nil, contextTimeoutErr or an error from deleteHistoryActivityNonRetryableErrors.
@@ -196,8 +269,17 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 			logger.WithError(err).Error("failed to delete history from events v2")
 			return err
 		}
+		// the payloads go after the branch, they are kept while other branches of the tree refer to them
+		if err := deletePayloads(ctx, container, request); err != nil {
+			logger.WithError(err).Error("failed to delete offloaded payloads")
+			return err
+		}
 		return nil
 	}
+	if err := deletePayloads(ctx, container, request); err != nil {
+		logger.WithError(err).Error("failed to delete offloaded payloads")
+		return err
+	}
 	if err := deleteHistoryV1(ctx, container, request); err != nil {
 		logger.WithError(err).Error("failed to delete history from events v1")
 		return err
@@ -205,6 +287,157 @@ func deleteHistoryActivity(ctx context.Context, request ArchiveRequest) (err err
 	return nil
 }
 
+// verifyArchivalActivity verifies the archived history of every workflow run closed within the verification lookback period
+// in domains which are enabled for archival. Workflow runs are found through their archived visibility records.
+// Runs whose archived history is missing or corrupted are re-archived if their source history still exists.
+// Problems are reported through logs and metrics, method will always return either: nil or errContextTimeout.
+func verifyArchivalActivity(ctx context.Context) (err error) {
+	container := ctx.Value(bootstrapContainerKey).(*BootstrapContainer)
+	metricsClient := container.MetricsClient
+	sw := metricsClient.StartTimer(metrics.ArchiverVerifyArchivalActivityScope, metrics.CadenceLatency)
+	defer func() {
+		sw.Stop()
+		if err == errContextTimeout {
+			metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.CadenceErrContextTimeoutCounter)
+		}
+	}()
+
+	logger := container.Logger.WithField(logging.TagAttempt, activity.GetInfo(ctx).Attempt)
+	if !container.Config.EnableArchivalVerification() {
+		logger.Info("archival verification is disabled")
+		return nil
+	}
+	if container.ClusterMetadata.ArchivalConfig().GetArchivalStatus() != cluster.ArchivalEnabled {
+		logger.Info("archival verification skipped because cluster is not enabled for archival")
+		return nil
+	}
+	archiverClient := container.ArchiverClient
+	if archiverClient == nil { // only will be set by testing code
+		archiverClient = NewClient(metricsClient, container.Logger, container.PublicClient, container.Config.NumArchiveSystemWorkflows)
+	}
+	latestCloseTime := time.Now()
+	earliestCloseTime := latestCloseTime.Add(-container.Config.ArchivalVerificationLookback())
+	for _, domainCacheEntry := range container.DomainCache.GetAllDomain() {
+		if domainCacheEntry.GetConfig().ArchivalStatus != shared.ArchivalStatusEnabled || len(domainCacheEntry.GetConfig().ArchivalBucket) == 0 {
+			continue
+		}
+		if err := verifyDomainArchival(ctx, container, archiverClient, domainCacheEntry, earliestCloseTime.UnixNano(), latestCloseTime.UnixNano()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func verifyDomainArchival(
+	ctx context.Context,
+	container *BootstrapContainer,
+	archiverClient Client,
+	domainCacheEntry *cache.DomainCacheEntry,
+	earliestCloseTime int64,
+	latestCloseTime int64,
+) error {
+
+	metricsClient := container.MetricsClient
+	domainID := domainCacheEntry.GetInfo().ID
+	domainName := domainCacheEntry.GetInfo().Name
+	bucket := domainCacheEntry.GetConfig().ArchivalBucket
+	logger := container.Logger.WithFields(bark.Fields{
+		logging.TagDomainID: domainID,
+		logging.TagDomain:   domainName,
+		logging.TagBucket:   bucket,
+	})
+	query := &ArchivedVisibilityQuery{
+		DomainID:          domainID,
+		EarliestCloseTime: earliestCloseTime,
+		LatestCloseTime:   latestCloseTime,
+		PageSize:          verificationPageSize,
+	}
+	for {
+		if contextExpired(ctx) {
+			return errContextTimeout
+		}
+		result, err := QueryArchivedVisibility(ctx, container.Blobstore, bucket, query)
+		if err != nil {
+			logger.WithError(err).Error("failed to query archived visibility records")
+			metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverVerificationFailedCount)
+			return nil
+		}
+		for _, record := range result.Records {
+			activity.RecordHeartbeat(ctx)
+			if contextExpired(ctx) {
+				return errContextTimeout
+			}
+			runLogger := logger.WithFields(bark.Fields{
+				logging.TagWorkflowExecutionID: *record.WorkflowID,
+				logging.TagWorkflowRunID:       *record.RunID,
+			})
+			verifyRunArchival(ctx, container, archiverClient, runLogger, bucket, domainID, domainName, *record.WorkflowID, *record.RunID)
+		}
+		if len(result.NextPageToken) == 0 {
+			return nil
+		}
+		query.NextPageToken = result.NextPageToken
+	}
+}
+
+func verifyRunArchival(
+	ctx context.Context,
+	container *BootstrapContainer,
+	archiverClient Client,
+	logger bark.Logger,
+	bucket string,
+	domainID string,
+	domainName string,
+	workflowID string,
+	runID string,
+) {
+
+	metricsClient := container.MetricsClient
+	result, err := VerifyArchivedHistory(ctx, container.Blobstore, bucket, domainID, workflowID, runID)
+	if err != nil {
+		logger.WithError(err).Error("failed to verify archived history")
+		metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverVerificationFailedCount)
+		return
+	}
+	switch result.Status {
+	case HistoryVerificationStatusValid:
+		metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverHistoryVerifiedCount)
+		return
+	case HistoryVerificationStatusMissing:
+		metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverHistoryMissingCount)
+	default:
+		metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverHistoryCorruptedCount)
+	}
+	logger = logger.WithFields(bark.Fields{
+		logging.TagArchivalVerifyStatus: result.Status.String(),
+		logging.TagArchivalVerifyReason: result.Reason,
+	})
+	logger.Warn("archived history is not valid, attempting to re-archive workflow run")
+	err = ReArchiveHistory(
+		ctx,
+		archiverClient,
+		container.Blobstore,
+		bucket,
+		container.HistoryManager,
+		container.HistoryV2Manager,
+		domainID,
+		workflowID,
+		runID,
+		container.Config.HistoryPageSize(domainName),
+	)
+	if err == ErrSourceHistoryNotExists {
+		logger.Error("archived history cannot be recovered because source history no longer exists")
+		metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverHistoryUnrecoverableCount)
+		return
+	}
+	if err != nil {
+		logger.WithError(err).Error("failed to re-archive workflow run")
+		metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverVerificationFailedCount)
+		return
+	}
+	metricsClient.IncCounter(metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverHistoryReArchivedCount)
+}
+
 func getBlob(ctx context.Context, historyBlobReader HistoryBlobReader, blobPage int) (*HistoryBlob, error) {
 	blob, err := historyBlobReader.GetBlob(blobPage)
 	op := func() error {
@@ -318,6 +551,22 @@ func constructBlob(historyBlob *HistoryBlob, enableCompression bool) (*blob.Blob
 	return blob, "", nil
 }
 
+func constructVisibilityBlob(record *VisibilityRecord, enableCompression bool) (*blob.Blob, string, error) {
+	body, err := json.Marshal(record)
+	if err != nil {
+		return nil, "failed to serialize blob", err
+	}
+	wrapFunctions := []blob.WrapFn{blob.JSONEncoded()}
+	if enableCompression {
+		wrapFunctions = append(wrapFunctions, blob.GzipCompressed())
+	}
+	blob, err := blob.Wrap(blob.NewBlob(body, ConvertVisibilityRecordToTags(record)), wrapFunctions...)
+	if err != nil {
+		return nil, "failed to wrap blob", err
+	}
+	return blob, "", nil
+}
+
 func deleteHistoryV1(ctx context.Context, container *BootstrapContainer, request ArchiveRequest) error {
 	deleteHistoryReq := &persistence.DeleteWorkflowExecutionHistoryRequest{
 		DomainID: request.DomainID,
@@ -365,6 +614,30 @@ func deleteHistoryV2(ctx context.Context, container *BootstrapContainer, request
 	return nil
 }
 
+func deletePayloads(ctx context.Context, container *BootstrapContainer, request ArchiveRequest) error {
+	if container.Blobstore == nil || container.PayloadOffloadConfig == nil {
+		return nil
+	}
+	payloadOffloader := payload.NewOffloader(container.Blobstore, container.PayloadOffloadConfig)
+	op := func() error {
+		if request.EventStoreVersion == persistence.EventStoreVersionV2 {
+			return payload.DeleteHistoryTreePayloads(payloadOffloader, container.HistoryV2Manager, request.DomainID, request.BranchToken)
+		}
+		return payloadOffloader.DeletePayloads(request.DomainID, request.RunID)
+	}
+	err := op()
+	for err != nil {
+		if !container.Blobstore.IsRetryableError(err) && !common.IsPersistenceTransientError(err) {
+			return cadence.NewCustomError(errDeletePayloads)
+		}
+		if contextExpired(ctx) {
+			return errContextTimeout
+		}
+		err = op()
+	}
+	return nil
+}
+
 func contextExpired(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
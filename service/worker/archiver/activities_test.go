@@ -22,8 +22,12 @@ package archiver
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -32,6 +36,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/blobstore/blob"
+	"github.com/uber/cadence/common/blobstore/filestore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/metrics"
@@ -629,6 +634,139 @@ func (s *activitiesSuite) TestUploadHistoryActivity_Success_ConcurrentUploads()
 	s.NoError(err)
 }
 
+func (s *activitiesSuite) TestUploadVisibilityActivity_Skip_MissingVisibilityInfo() {
+	s.metricsClient.On("IncCounter", metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverSkipUploadCount).Once()
+	container := &BootstrapContainer{
+		Logger:        s.logger,
+		MetricsClient: s.metricsClient,
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	request := ArchiveRequest{
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+	}
+	_, err := env.ExecuteActivity(uploadVisibilityActivity, request)
+	s.NoError(err)
+}
+
+func (s *activitiesSuite) TestUploadVisibilityActivity_Skip_ClusterArchivalNotEnabled() {
+	s.metricsClient.On("IncCounter", metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverSkipUploadCount).Once()
+	domainCache, mockClusterMetadata := s.archivalConfig(true, testArchivalBucket, false)
+	container := &BootstrapContainer{
+		Logger:          s.logger,
+		MetricsClient:   s.metricsClient,
+		DomainCache:     domainCache,
+		ClusterMetadata: mockClusterMetadata,
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	_, err := env.ExecuteActivity(uploadVisibilityActivity, s.visibilityArchiveRequest())
+	s.NoError(err)
+}
+
+func (s *activitiesSuite) TestUploadVisibilityActivity_Fail_DomainConfigMissingBucket() {
+	s.metricsClient.On("IncCounter", metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverNonRetryableErrorCount).Once()
+	domainCache, mockClusterMetadata := s.archivalConfig(true, "", true)
+	container := &BootstrapContainer{
+		Logger:          s.logger,
+		MetricsClient:   s.metricsClient,
+		DomainCache:     domainCache,
+		ClusterMetadata: mockClusterMetadata,
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	_, err := env.ExecuteActivity(uploadVisibilityActivity, s.visibilityArchiveRequest())
+	s.Equal(errEmptyBucket, err.Error())
+}
+
+func (s *activitiesSuite) TestUploadVisibilityActivity_Fail_UploadBlobNonRetryableError() {
+	s.metricsClient.On("IncCounter", metrics.ArchiverUploadVisibilityActivityScope, metrics.ArchiverNonRetryableErrorCount).Once()
+	domainCache, mockClusterMetadata := s.archivalConfig(true, testArchivalBucket, true)
+	mockBlobstore := &mocks.BlobstoreClient{}
+	mockBlobstore.On("Upload", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("some error"))
+	mockBlobstore.On("IsRetryableError", mock.Anything).Return(false)
+	container := &BootstrapContainer{
+		Logger:          s.logger,
+		MetricsClient:   s.metricsClient,
+		DomainCache:     domainCache,
+		ClusterMetadata: mockClusterMetadata,
+		Blobstore:       mockBlobstore,
+		Config:          getConfig(false),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	_, err := env.ExecuteActivity(uploadVisibilityActivity, s.visibilityArchiveRequest())
+	s.Equal(errUploadBlob, err.Error())
+}
+
+func (s *activitiesSuite) TestUploadVisibilityActivity_Success() {
+	request := s.visibilityArchiveRequest()
+	expectedKey, err := NewVisibilityBlobKey(request.DomainID, request.WorkflowID, request.RunID, request.CloseTimestamp)
+	s.NoError(err)
+	domainCache, mockClusterMetadata := s.archivalConfig(true, testArchivalBucket, true)
+	mockBlobstore := &mocks.BlobstoreClient{}
+	mockBlobstore.On("Upload", mock.Anything, testArchivalBucket, expectedKey, mock.Anything).Return(nil).Once()
+	container := &BootstrapContainer{
+		Logger:          s.logger,
+		MetricsClient:   s.metricsClient,
+		DomainCache:     domainCache,
+		ClusterMetadata: mockClusterMetadata,
+		Blobstore:       mockBlobstore,
+		Config:          getConfig(false),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	_, err = env.ExecuteActivity(uploadVisibilityActivity, request)
+	s.NoError(err)
+	mockBlobstore.AssertExpectations(s.T())
+}
+
+func (s *activitiesSuite) TestUploadVisibilityActivity_Success_MemoAndSearchAttributes() {
+	request := s.visibilityArchiveRequest()
+	request.Memo = map[string][]byte{MemoKeyExecutionContext: []byte("some random context")}
+	request.SearchAttributes = map[string][]byte{SearchAttributeTaskList: []byte("some random tasklist")}
+	domainCache, mockClusterMetadata := s.archivalConfig(true, testArchivalBucket, true)
+	var uploaded *blob.Blob
+	mockBlobstore := &mocks.BlobstoreClient{}
+	mockBlobstore.On("Upload", mock.Anything, testArchivalBucket, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		uploaded = args.Get(3).(*blob.Blob)
+	}).Return(nil).Once()
+	container := &BootstrapContainer{
+		Logger:          s.logger,
+		MetricsClient:   s.metricsClient,
+		DomainCache:     domainCache,
+		ClusterMetadata: mockClusterMetadata,
+		Blobstore:       mockBlobstore,
+		Config:          getConfig(false),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	_, err := env.ExecuteActivity(uploadVisibilityActivity, request)
+	s.NoError(err)
+	mockBlobstore.AssertExpectations(s.T())
+
+	unwrapped, _, err := blob.Unwrap(uploaded)
+	s.NoError(err)
+	record := &VisibilityRecord{}
+	s.NoError(json.Unmarshal(unwrapped.Body, record))
+	s.Equal(request.Memo, record.Memo)
+	s.Equal(request.SearchAttributes, record.SearchAttributes)
+}
+
 func (s *activitiesSuite) TestDeleteHistoryActivity_Fail_DeleteFromV2NonRetryableError() {
 	s.metricsClient.On("IncCounter", metrics.ArchiverDeleteHistoryActivityScope, metrics.ArchiverNonRetryableErrorCount).Once()
 	mockHistoryV2Manager := &mocks.HistoryV2Manager{}
@@ -755,6 +893,96 @@ func (s *activitiesSuite) TestDeleteHistoryActivity_Success() {
 	s.NoError(err)
 }
 
+func (s *activitiesSuite) TestVerifyArchivalActivity_Skip_VerificationDisabled() {
+	container := &BootstrapContainer{
+		Logger:        s.logger,
+		MetricsClient: s.metricsClient,
+		Config: &Config{
+			EnableArchivalVerification: dynamicconfig.GetBoolPropertyFn(false),
+		},
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	_, err := env.ExecuteActivity(verifyArchivalActivity)
+	s.NoError(err)
+}
+
+func (s *activitiesSuite) TestVerifyArchivalActivity_Success_ReArchiveMissingHistory() {
+	s.metricsClient.On("IncCounter", metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverHistoryMissingCount).Once()
+	s.metricsClient.On("IncCounter", metrics.ArchiverVerifyArchivalActivityScope, metrics.ArchiverHistoryReArchivedCount).Once()
+	dir, err := ioutil.TempDir("", "TestVerifyArchivalActivity")
+	s.NoError(err)
+	defer os.RemoveAll(dir)
+	blobstoreClient, err := filestore.NewClient(&filestore.Config{
+		StoreDirectory: dir,
+		DefaultBucket: filestore.BucketConfig{
+			Name:          testArchivalBucket,
+			Owner:         "test-owner",
+			RetentionDays: 10,
+		},
+	})
+	s.NoError(err)
+	request := s.visibilityArchiveRequest()
+	key, err := NewVisibilityBlobKey(request.DomainID, request.WorkflowID, request.RunID, request.CloseTimestamp)
+	s.NoError(err)
+	visibilityBlob, _, err := constructVisibilityBlob(NewVisibilityRecord(request, testDomain, testCurrentClusterName, "upload-time"), true)
+	s.NoError(err)
+	s.NoError(blobstoreClient.Upload(context.Background(), testArchivalBucket, key, visibilityBlob))
+
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetAllDomain").Return(map[string]*cache.DomainCacheEntry{
+		testDomainID: cache.NewDomainCacheEntryForTest(
+			&persistence.DomainInfo{ID: testDomainID, Name: testDomain},
+			&persistence.DomainConfig{ArchivalBucket: testArchivalBucket, ArchivalStatus: shared.ArchivalStatusEnabled},
+		),
+		"disabled-domain-id": cache.NewDomainCacheEntryForTest(
+			&persistence.DomainInfo{ID: "disabled-domain-id", Name: "disabled-domain"},
+			&persistence.DomainConfig{ArchivalBucket: testArchivalBucket, ArchivalStatus: shared.ArchivalStatusDisabled},
+		),
+	}).Once()
+	mockClusterMetadata := &mocks.ClusterMetadata{}
+	mockClusterMetadata.On("ArchivalConfig").Return(cluster.NewArchivalConfig(cluster.ArchivalEnabled, testArchivalBucket, true))
+	historyManager := &mocks.HistoryManager{}
+	historyManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		History: &shared.History{Events: []*shared.HistoryEvent{
+			{EventId: common.Int64Ptr(common.FirstEventID), Version: common.Int64Ptr(testCloseFailoverVersion)},
+		}},
+	}, nil).Once()
+	archiverClient := &ClientMock{}
+	archiverClient.On("Archive", &ArchiveRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		NextEventID:          common.FirstEventID + 1,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}).Return(nil).Once()
+	container := &BootstrapContainer{
+		Logger:          s.logger,
+		MetricsClient:   s.metricsClient,
+		ClusterMetadata: mockClusterMetadata,
+		HistoryManager:  historyManager,
+		Blobstore:       blobstoreClient,
+		DomainCache:     domainCache,
+		ArchiverClient:  archiverClient,
+		Config: &Config{
+			HistoryPageSize:              dynamicconfig.GetIntPropertyFilteredByDomain(testDefaultPersistencePageSize),
+			EnableArchivalVerification:   dynamicconfig.GetBoolPropertyFn(true),
+			ArchivalVerificationLookback: dynamicconfig.GetDurationPropertyFn(24 * time.Hour),
+		},
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), bootstrapContainerKey, container),
+	})
+	_, err = env.ExecuteActivity(verifyArchivalActivity)
+	s.NoError(err)
+	domainCache.AssertExpectations(s.T())
+	historyManager.AssertExpectations(s.T())
+	archiverClient.AssertExpectations(s.T())
+}
+
 func (s *activitiesSuite) archivalConfig(
 	domainEnablesArchival bool,
 	domainArchivalBucket string,
@@ -796,6 +1024,22 @@ func (s *activitiesSuite) archivalConfig(
 	return cache.NewDomainCache(mockMetadataMgr, mockClusterMetadata, s.metricsClient, s.logger), mockClusterMetadata
 }
 
+func (s *activitiesSuite) visibilityArchiveRequest() ArchiveRequest {
+	return ArchiveRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		BranchToken:          testBranchToken,
+		NextEventID:          testNextEventID,
+		CloseFailoverVersion: testCloseFailoverVersion,
+		WorkflowTypeName:     "test-workflow-type",
+		StartTimestamp:       time.Now().Add(-time.Hour).UnixNano(),
+		CloseTimestamp:       time.Now().UnixNano(),
+		CloseStatus:          shared.WorkflowExecutionCloseStatusCompleted,
+		HistoryLength:        testNextEventID - 1,
+	}
+}
+
 func getConfig(constCheck bool) *Config {
 	probability := 0.0
 	if constCheck {
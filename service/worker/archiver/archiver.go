@@ -30,6 +30,11 @@ import (
 	"go.uber.org/cadence/workflow"
 )
 
+const (
+	uploadVisibilityChangeID = "uploadVisibility"
+	uploadVisibilityVersion  = workflow.Version(1)
+)
+
 type (
 	// Archiver is used to process archival requests
 	Archiver interface {
@@ -125,6 +130,27 @@ func handleRequest(ctx workflow.Context, logger bark.Logger, metricsClient metri
 	}
 	uploadSW.Stop()
 
+	// archival workflows started before visibility archival was introduced must replay without the upload step
+	if workflow.GetVersion(ctx, uploadVisibilityChangeID, workflow.DefaultVersion, uploadVisibilityVersion) >= uploadVisibilityVersion {
+		ao = workflow.ActivityOptions{
+			ScheduleToStartTimeout: 10 * time.Minute,
+			StartToCloseTimeout:    5 * time.Minute,
+			RetryPolicy: &cadence.RetryPolicy{
+				InitialInterval:          time.Second,
+				BackoffCoefficient:       2.0,
+				ExpirationInterval:       10 * time.Minute,
+				NonRetriableErrorReasons: uploadVisibilityActivityNonRetryableErrors,
+			},
+		}
+		actCtx = workflow.WithActivityOptions(ctx, ao)
+		if err := workflow.ExecuteActivity(actCtx, uploadVisibilityActivityFnName, request).Get(actCtx, nil); err != nil {
+			logger.WithField(logging.TagErr, err).Error("failed to upload visibility record, moving on to deleting history without archiving visibility")
+			metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverUploadVisibilityFailedAllRetriesCount)
+		} else {
+			metricsClient.IncCounter(metrics.ArchiverScope, metrics.ArchiverUploadVisibilitySuccessCount)
+		}
+	}
+
 	lao := workflow.LocalActivityOptions{
 		ScheduleToCloseTimeout: 1 * time.Minute,
 		RetryPolicy: &cadence.RetryPolicy{
@@ -26,6 +26,7 @@ import (
 	"math/rand"
 
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/public"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/logging"
@@ -45,6 +46,16 @@ type (
 		BranchToken          []byte
 		NextEventID          int64
 		CloseFailoverVersion int64
+
+		// visibility information of the closed workflow execution
+		WorkflowTypeName   string
+		StartTimestamp     int64
+		ExecutionTimestamp int64
+		CloseTimestamp     int64
+		CloseStatus        shared.WorkflowExecutionCloseStatus
+		HistoryLength      int64
+		Memo               map[string][]byte
+		SearchAttributes   map[string][]byte
 	}
 
 	// Client is used to archive workflow histories
@@ -27,14 +27,18 @@ import (
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/client/public"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/cadence/.gen/go/shared"
 	"go.uber.org/cadence/activity"
+	cclient "go.uber.org/cadence/client"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/cadence/workflow"
 )
@@ -47,22 +51,27 @@ type (
 	}
 
 	clientWorker struct {
-		worker      worker.Worker
-		domainCache cache.DomainCache
+		worker       worker.Worker
+		domainCache  cache.DomainCache
+		publicClient public.Client
+		logger       bark.Logger
+		stopC        chan struct{}
 	}
 
 	// BootstrapContainer contains everything need for bootstrapping
 	BootstrapContainer struct {
-		PublicClient      public.Client
-		MetricsClient     metrics.Client
-		Logger            bark.Logger
-		ClusterMetadata   cluster.Metadata
-		HistoryManager    persistence.HistoryManager
-		HistoryV2Manager  persistence.HistoryV2Manager
-		Blobstore         blobstore.Client
-		DomainCache       cache.DomainCache
-		Config            *Config
-		HistoryBlobReader HistoryBlobReader // this is only set in testing code
+		PublicClient         public.Client
+		MetricsClient        metrics.Client
+		Logger               bark.Logger
+		ClusterMetadata      cluster.Metadata
+		HistoryManager       persistence.HistoryManager
+		HistoryV2Manager     persistence.HistoryV2Manager
+		Blobstore            blobstore.Client
+		DomainCache          cache.DomainCache
+		Config               *Config
+		PayloadOffloadConfig *payload.Config   // payloads offloaded to blobstore are not deleted if this is not set
+		HistoryBlobReader    HistoryBlobReader // this is only set in testing code
+		ArchiverClient       Client            // this is only set in testing code
 	}
 
 	// Config for ClientWorker
@@ -73,6 +82,9 @@ type (
 		ArchiverConcurrency                       dynamicconfig.IntPropertyFn
 		ArchivalsPerIteration                     dynamicconfig.IntPropertyFn
 		DeterministicConstructionCheckProbability dynamicconfig.FloatPropertyFn
+		NumArchiveSystemWorkflows                 dynamicconfig.IntPropertyFn
+		EnableArchivalVerification                dynamicconfig.BoolPropertyFn
+		ArchivalVerificationLookback              dynamicconfig.DurationPropertyFn
 	}
 
 	contextKey int
@@ -85,6 +97,9 @@ const (
 	archivalWorkflowFnName          = "archivalWorkflow"
 	workflowStartToCloseTimeout     = time.Hour * 24 * 30
 	workflowTaskStartToCloseTimeout = time.Minute
+	verifierWorkflowID              = "cadence-archival-verifier"
+	verifierWorkflowFnName          = "archivalVerifierWorkflow"
+	verifierWorkflowCronSchedule    = "0 0 * * *"
 
 	bootstrapContainerKey contextKey = iota
 )
@@ -98,8 +113,11 @@ var (
 
 func init() {
 	workflow.RegisterWithOptions(archivalWorkflow, workflow.RegisterOptions{Name: archivalWorkflowFnName})
+	workflow.RegisterWithOptions(archivalVerifierWorkflow, workflow.RegisterOptions{Name: verifierWorkflowFnName})
 	activity.RegisterWithOptions(uploadHistoryActivity, activity.RegisterOptions{Name: uploadHistoryActivityFnName})
 	activity.RegisterWithOptions(deleteHistoryActivity, activity.RegisterOptions{Name: deleteHistoryActivityFnName})
+	activity.RegisterWithOptions(uploadVisibilityActivity, activity.RegisterOptions{Name: uploadVisibilityActivityFnName})
+	activity.RegisterWithOptions(verifyArchivalActivity, activity.RegisterOptions{Name: verifyArchivalActivityFnName})
 }
 
 // NewClientWorker returns a new ClientWorker
@@ -115,8 +133,11 @@ func NewClientWorker(container *BootstrapContainer) ClientWorker {
 		BackgroundActivityContext: actCtx,
 	}
 	return &clientWorker{
-		worker:      worker.New(container.PublicClient, common.SystemDomainName, decisionTaskList, wo),
-		domainCache: container.DomainCache,
+		worker:       worker.New(container.PublicClient, common.SystemDomainName, decisionTaskList, wo),
+		domainCache:  container.DomainCache,
+		publicClient: container.PublicClient,
+		logger:       container.Logger,
+		stopC:        make(chan struct{}),
 	}
 }
 
@@ -126,11 +147,52 @@ func (w *clientWorker) Start() error {
 		w.worker.Stop()
 		return err
 	}
+	go w.startVerifierWorkflowWithRetry()
 	return nil
 }
 
 // Stop the ClientWorker
 func (w *clientWorker) Stop() {
+	close(w.stopC)
 	w.worker.Stop()
 	w.domainCache.Stop()
 }
+
+// startVerifierWorkflowWithRetry starts the cron workflow which periodically verifies archived histories,
+// it keeps retrying until the workflow is started or the ClientWorker is stopped
+func (w *clientWorker) startVerifierWorkflowWithRetry() {
+	client := cclient.NewClient(w.publicClient, common.SystemDomainName, &cclient.Options{})
+	policy := backoff.NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(time.Minute)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	for attempt := 0; ; attempt++ {
+		err := w.startVerifierWorkflow(client)
+		if err == nil {
+			return
+		}
+		w.logger.WithError(err).Error("failed to start archival verifier workflow")
+		select {
+		case <-time.After(policy.ComputeNextDelay(0, attempt)):
+		case <-w.stopC:
+			return
+		}
+	}
+}
+
+func (w *clientWorker) startVerifierWorkflow(client cclient.Client) error {
+	workflowOptions := cclient.StartWorkflowOptions{
+		ID:                              verifierWorkflowID,
+		TaskList:                        decisionTaskList,
+		ExecutionStartToCloseTimeout:    verifierWorkflowStartToCloseTimeout,
+		DecisionTaskStartToCloseTimeout: workflowTaskStartToCloseTimeout,
+		WorkflowIDReusePolicy:           cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                    verifierWorkflowCronSchedule,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err := client.StartWorkflow(ctx, workflowOptions, verifierWorkflowFnName)
+	if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
+		return nil
+	}
+	return err
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/payload"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -51,6 +52,7 @@ type (
 		// the following are only used to read history and dynamic config
 		historyManager       persistence.HistoryManager
 		historyV2Manager     persistence.HistoryV2Manager
+		payloadOffloader     payload.Offloader
 		domainID             string
 		workflowID           string
 		runID                string
@@ -82,6 +84,7 @@ func NewHistoryBlobIterator(
 
 		historyManager:       container.HistoryManager,
 		historyV2Manager:     container.HistoryV2Manager,
+		payloadOffloader:     payload.NewOffloader(container.Blobstore, container.PayloadOffloadConfig),
 		domainID:             request.DomainID,
 		workflowID:           request.WorkflowID,
 		runID:                request.RunID,
@@ -106,6 +109,10 @@ func (i *historyBlobIterator) Next() (*HistoryBlob, error) {
 	if err != nil {
 		return nil, err
 	}
+	// archived history outlives offloaded payloads, so it holds the payloads themselves
+	if err := i.payloadOffloader.ResolveEvents(events); err != nil {
+		return nil, err
+	}
 
 	// only if no error was encountered reading history does the state of the iterator get advanced
 	i.finishedIteration = historyEndReached
@@ -64,6 +64,11 @@ type (
 	}
 )
 
+const (
+	// historyBlobExtension is the extension used by all history blob keys
+	historyBlobExtension = "history"
+)
+
 var (
 	errInvalidKeyInput = errors.New("invalid input to construct history blob key")
 )
@@ -76,11 +81,15 @@ func NewHistoryBlobKey(domainID, workflowID, runID string, pageToken int) (blob.
 	if pageToken < common.FirstBlobPageToken {
 		return nil, errInvalidKeyInput
 	}
+	return blob.NewKey(historyBlobExtension, historyBlobKeyName(domainID, workflowID, runID), StringPageToken(pageToken))
+}
+
+// historyBlobKeyName returns the key name shared by all history blobs of a workflow run
+func historyBlobKeyName(domainID, workflowID, runID string) string {
 	domainIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(domainID)))
 	workflowIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(workflowID)))
 	runIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(runID)))
-	combinedHash := strings.Join([]string{domainIDHash, workflowIDHash, runIDHash}, "")
-	return blob.NewKey("history", combinedHash, StringPageToken(pageToken))
+	return strings.Join([]string{domainIDHash, workflowIDHash, runIDHash}, "")
 }
 
 // StringPageToken converts input blob page token to string form
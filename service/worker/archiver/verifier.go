@@ -0,0 +1,382 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// HistoryVerificationStatus is the outcome of verifying the archived history of a workflow run
+	HistoryVerificationStatus int
+
+	// HistoryVerificationResult describes the archived history of a workflow run as found by VerifyArchivedHistory
+	HistoryVerificationResult struct {
+		Status HistoryVerificationStatus
+		// Reason describes why history is missing or corrupted, it is empty for valid history
+		Reason string
+		// PageToken is the page token of the first blob found to be missing or corrupted
+		PageToken int
+		// NumPages is the number of blobs which were found to be valid
+		NumPages int
+		// LastEventID is the ID of the last event found in valid blobs
+		LastEventID int64
+	}
+)
+
+const (
+	// HistoryVerificationStatusValid indicates that all blobs of archived history exist and are consistent
+	HistoryVerificationStatusValid HistoryVerificationStatus = iota
+	// HistoryVerificationStatusMissing indicates that a blob of archived history does not exist
+	HistoryVerificationStatusMissing
+	// HistoryVerificationStatusCorrupted indicates that a blob of archived history cannot be decoded or is inconsistent
+	HistoryVerificationStatusCorrupted
+)
+
+var (
+	// ErrSourceHistoryNotExists indicates that history of the workflow run no longer exists in persistence
+	ErrSourceHistoryNotExists = errors.New("source history of workflow run no longer exists")
+
+	errAmbiguousHistoryTree = errors.New("history tree of workflow run has more than one branch")
+	errEmptySourceHistory   = errors.New("source history of workflow run has no events")
+)
+
+// String returns the string representation of status
+func (s HistoryVerificationStatus) String() string {
+	switch s {
+	case HistoryVerificationStatusValid:
+		return "Valid"
+	case HistoryVerificationStatusMissing:
+		return "Missing"
+	case HistoryVerificationStatusCorrupted:
+		return "Corrupted"
+	default:
+		return fmt.Sprintf("HistoryVerificationStatus(%d)", int(s))
+	}
+}
+
+// VerifyArchivedHistory walks the chain of history blobs archived in bucket for the given workflow run.
+// It verifies that every blob exists and decodes, that blob headers match the workflow run and page chain,
+// that event IDs are contiguous across blobs and that the chain ends in a blob marked as last.
+// An error is only returned if blobstore could not be read, problems found in archived history are described by the result.
+func VerifyArchivedHistory(
+	ctx context.Context,
+	blobstoreClient blobstore.Client,
+	bucket string,
+	domainID string,
+	workflowID string,
+	runID string,
+) (*HistoryVerificationResult, error) {
+
+	result := &HistoryVerificationResult{}
+	expectedEventID := common.FirstEventID
+	for pageToken := common.FirstBlobPageToken; ; pageToken++ {
+		result.PageToken = pageToken
+		key, err := NewHistoryBlobKey(domainID, workflowID, runID, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		b, err := blobstoreClient.Download(ctx, bucket, key)
+		if err == blobstore.ErrBlobNotExists {
+			result.Status = HistoryVerificationStatusMissing
+			result.Reason = fmt.Sprintf("blob %v does not exist", key.String())
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		historyBlob, reason := decodeHistoryBlob(b)
+		if historyBlob == nil {
+			return corruptedResult(result, key, reason), nil
+		}
+		if reason := verifyHistoryBlob(historyBlob, domainID, workflowID, runID, pageToken, expectedEventID); reason != "" {
+			return corruptedResult(result, key, reason), nil
+		}
+		tags, err := ConvertHeaderToTags(historyBlob.Header)
+		if err != nil {
+			return corruptedResult(result, key, "failed to convert header to tags"), nil
+		}
+		for k, v := range tags {
+			if b.Tags[k] != v {
+				return corruptedResult(result, key, fmt.Sprintf("tag %v does not match header", k)), nil
+			}
+		}
+		result.NumPages++
+		result.LastEventID = *historyBlob.Header.LastEventID
+		expectedEventID = result.LastEventID + 1
+		if *historyBlob.Header.IsLast {
+			result.Status = HistoryVerificationStatusValid
+			return result, nil
+		}
+	}
+}
+
+// NewReArchiveRequest constructs the ArchiveRequest which archives the workflow run again from its source history.
+// ErrSourceHistoryNotExists is returned if history of the workflow run has already been deleted from persistence.
+// The returned request does not carry visibility information, so the archived visibility record is left untouched.
+func NewReArchiveRequest(
+	historyManager persistence.HistoryManager,
+	historyV2Manager persistence.HistoryV2Manager,
+	domainID string,
+	workflowID string,
+	runID string,
+	pageSize int,
+) (*ArchiveRequest, error) {
+
+	request := &ArchiveRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}
+	lastEvent, err := readLastEventV1(historyManager, domainID, workflowID, runID, pageSize)
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			return nil, err
+		}
+		branchToken, err := getHistoryBranchToken(historyV2Manager, runID)
+		if err != nil {
+			return nil, err
+		}
+		lastEvent, err = readLastEventV2(historyV2Manager, branchToken, pageSize)
+		if err != nil {
+			return nil, err
+		}
+		request.EventStoreVersion = persistence.EventStoreVersionV2
+		request.BranchToken = branchToken
+	}
+	request.NextEventID = lastEvent.GetEventId() + 1
+	request.CloseFailoverVersion = lastEvent.GetVersion()
+	return request, nil
+}
+
+// ReArchiveHistory archives the workflow run again if its source history still exists in persistence.
+// History blobs previously archived for the workflow run are deleted before the archive request is sent.
+// ErrSourceHistoryNotExists is returned if history of the workflow run has already been deleted from persistence.
+func ReArchiveHistory(
+	ctx context.Context,
+	archiverClient Client,
+	blobstoreClient blobstore.Client,
+	bucket string,
+	historyManager persistence.HistoryManager,
+	historyV2Manager persistence.HistoryV2Manager,
+	domainID string,
+	workflowID string,
+	runID string,
+	pageSize int,
+) error {
+
+	request, err := NewReArchiveRequest(historyManager, historyV2Manager, domainID, workflowID, runID, pageSize)
+	if err != nil {
+		return err
+	}
+	if err := DeleteArchivedHistory(ctx, blobstoreClient, bucket, domainID, workflowID, runID); err != nil {
+		return err
+	}
+	return archiverClient.Archive(request)
+}
+
+// DeleteArchivedHistory deletes all history blobs archived in bucket for the given workflow run.
+// This is used before re-archiving a workflow run, because archival skips uploading blobs which already exist.
+func DeleteArchivedHistory(
+	ctx context.Context,
+	blobstoreClient blobstore.Client,
+	bucket string,
+	domainID string,
+	workflowID string,
+	runID string,
+) error {
+
+	prefix := historyBlobKeyName(domainID, workflowID, runID) + "_"
+	keys, err := blobstoreClient.ListByPrefix(ctx, bucket, prefix)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Extension() != historyBlobExtension {
+			continue
+		}
+		if _, err := blobstoreClient.Delete(ctx, bucket, key); err != nil && err != blobstore.ErrBlobNotExists {
+			return err
+		}
+	}
+	return nil
+}
+
+func corruptedResult(result *HistoryVerificationResult, key blob.Key, reason string) *HistoryVerificationResult {
+	result.Status = HistoryVerificationStatusCorrupted
+	result.Reason = fmt.Sprintf("blob %v is corrupted: %v", key.String(), reason)
+	return result
+}
+
+func decodeHistoryBlob(b *blob.Blob) (*HistoryBlob, string) {
+	unwrappedBlob, wrappingLayers, err := blob.Unwrap(b)
+	if err != nil {
+		return nil, fmt.Sprintf("failed to unwrap blob: %v", err)
+	}
+	if wrappingLayers.EncodingFormat == nil || *wrappingLayers.EncodingFormat != blob.JSONEncoding {
+		return nil, "blob has unsupported encoding"
+	}
+	historyBlob := &HistoryBlob{}
+	if err := json.Unmarshal(unwrappedBlob.Body, historyBlob); err != nil {
+		return nil, fmt.Sprintf("failed to deserialize blob: %v", err)
+	}
+	return historyBlob, ""
+}
+
+func verifyHistoryBlob(
+	historyBlob *HistoryBlob,
+	domainID string,
+	workflowID string,
+	runID string,
+	pageToken int,
+	expectedEventID int64,
+) string {
+
+	header := historyBlob.Header
+	if header == nil || header.DomainID == nil || header.WorkflowID == nil || header.RunID == nil ||
+		header.CurrentPageToken == nil || header.NextPageToken == nil || header.IsLast == nil ||
+		header.FirstEventID == nil || header.LastEventID == nil || header.EventCount == nil {
+		return "header is incomplete"
+	}
+	if *header.DomainID != domainID || *header.WorkflowID != workflowID || *header.RunID != runID {
+		return "header does not match workflow run"
+	}
+	if *header.CurrentPageToken != pageToken {
+		return fmt.Sprintf("header has page token %v", *header.CurrentPageToken)
+	}
+	if *header.IsLast && *header.NextPageToken != common.LastBlobNextPageToken {
+		return fmt.Sprintf("last blob has next page token %v", *header.NextPageToken)
+	}
+	if !*header.IsLast && *header.NextPageToken != pageToken+1 {
+		return fmt.Sprintf("header has next page token %v", *header.NextPageToken)
+	}
+	if historyBlob.Body == nil || len(historyBlob.Body.Events) == 0 {
+		return "blob has no events"
+	}
+	events := historyBlob.Body.Events
+	if *header.EventCount != int64(len(events)) {
+		return fmt.Sprintf("header has event count %v but blob has %v events", *header.EventCount, len(events))
+	}
+	if *header.FirstEventID != events[0].GetEventId() || *header.LastEventID != events[len(events)-1].GetEventId() {
+		return "header event IDs do not match events"
+	}
+	for _, event := range events {
+		if event.GetEventId() != expectedEventID {
+			return fmt.Sprintf("expected event ID %v but found %v", expectedEventID, event.GetEventId())
+		}
+		expectedEventID++
+	}
+	return ""
+}
+
+func readLastEventV1(
+	historyManager persistence.HistoryManager,
+	domainID string,
+	workflowID string,
+	runID string,
+	pageSize int,
+) (*shared.HistoryEvent, error) {
+
+	request := &persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID: domainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(workflowID),
+			RunId:      common.StringPtr(runID),
+		},
+		FirstEventID: common.FirstEventID,
+		NextEventID:  common.EndEventID,
+		PageSize:     pageSize,
+	}
+	var lastEvent *shared.HistoryEvent
+	for {
+		response, err := historyManager.GetWorkflowExecutionHistory(request)
+		if err != nil {
+			return nil, err
+		}
+		if response.History != nil && len(response.History.Events) > 0 {
+			lastEvent = response.History.Events[len(response.History.Events)-1]
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	if lastEvent == nil {
+		return nil, errEmptySourceHistory
+	}
+	return lastEvent, nil
+}
+
+func readLastEventV2(historyV2Manager persistence.HistoryV2Manager, branchToken []byte, pageSize int) (*shared.HistoryEvent, error) {
+	request := &persistence.ReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.EndEventID,
+		PageSize:    pageSize,
+	}
+	var lastEvent *shared.HistoryEvent
+	for {
+		events, _, nextPageToken, err := persistence.ReadFullPageV2Events(historyV2Manager, request)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) > 0 {
+			lastEvent = events[len(events)-1]
+		}
+		if len(nextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = nextPageToken
+	}
+	if lastEvent == nil {
+		return nil, errEmptySourceHistory
+	}
+	return lastEvent, nil
+}
+
+// getHistoryBranchToken returns the branch token of the history tree rooted at the given run,
+// history trees of workflow runs forked from other runs by reset are not supported.
+func getHistoryBranchToken(historyV2Manager persistence.HistoryV2Manager, runID string) ([]byte, error) {
+	response, err := historyV2Manager.GetHistoryTree(&persistence.GetHistoryTreeRequest{
+		TreeID: runID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch len(response.Branches) {
+	case 0:
+		return nil, ErrSourceHistoryNotExists
+	case 1:
+		return codec.NewThriftRWEncoder().Encode(response.Branches[0])
+	default:
+		return nil, errAmbiguousHistoryTree
+	}
+}
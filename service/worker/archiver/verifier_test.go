@@ -0,0 +1,353 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/mocks"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type verifierSuite struct {
+	suite.Suite
+	storeDir        string
+	blobstoreClient blobstore.Client
+}
+
+func TestVerifierSuite(t *testing.T) {
+	suite.Run(t, new(verifierSuite))
+}
+
+func (s *verifierSuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "TestVerifierSuite")
+	s.NoError(err)
+	s.storeDir = dir
+	s.blobstoreClient, err = filestore.NewClient(&filestore.Config{
+		StoreDirectory: dir,
+		DefaultBucket: filestore.BucketConfig{
+			Name:          testArchivalBucket,
+			Owner:         "test-owner",
+			RetentionDays: 10,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *verifierSuite) TearDownTest() {
+	os.RemoveAll(s.storeDir)
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_Valid() {
+	s.uploadHistoryBlobs(s.historyBlobs(3, 4))
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusValid, result.Status)
+	s.Empty(result.Reason)
+	s.Equal(3, result.NumPages)
+	s.Equal(int64(12), result.LastEventID)
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_MissingFirstBlob() {
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusMissing, result.Status)
+	s.Equal(common.FirstBlobPageToken, result.PageToken)
+	s.Equal(0, result.NumPages)
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_MissingLastBlob() {
+	historyBlobs := s.historyBlobs(3, 4)
+	s.uploadHistoryBlobs(historyBlobs[:2])
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusMissing, result.Status)
+	s.Equal(common.FirstBlobPageToken+2, result.PageToken)
+	s.Equal(2, result.NumPages)
+	s.Equal(int64(8), result.LastEventID)
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_NonContiguousEvents() {
+	historyBlobs := s.historyBlobs(3, 4)
+	historyBlobs[1].Body.Events = historyBlobs[1].Body.Events[1:]
+	historyBlobs[1].Header.FirstEventID = historyBlobs[1].Body.Events[0].EventId
+	historyBlobs[1].Header.EventCount = common.Int64Ptr(3)
+	s.uploadHistoryBlobs(historyBlobs)
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusCorrupted, result.Status)
+	s.Equal(common.FirstBlobPageToken+1, result.PageToken)
+	s.Contains(result.Reason, "expected event ID 5")
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_HeaderMismatch() {
+	historyBlobs := s.historyBlobs(2, 4)
+	historyBlobs[0].Header.RunID = common.StringPtr("other-run-id")
+	s.uploadHistoryBlobs(historyBlobs)
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusCorrupted, result.Status)
+	s.Equal(common.FirstBlobPageToken, result.PageToken)
+	s.Contains(result.Reason, "header does not match workflow run")
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_ChainNotEndingInLastBlob() {
+	historyBlobs := s.historyBlobs(2, 4)
+	historyBlobs[1].Header.IsLast = common.BoolPtr(false)
+	s.uploadHistoryBlobs(historyBlobs)
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusCorrupted, result.Status)
+	s.Equal(common.FirstBlobPageToken+1, result.PageToken)
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_UndecodableBlob() {
+	key, err := NewHistoryBlobKey(testDomainID, testWorkflowID, testRunID, common.FirstBlobPageToken)
+	s.NoError(err)
+	b, err := blob.Wrap(blob.NewBlob([]byte("not json"), map[string]string{}), blob.JSONEncoded())
+	s.NoError(err)
+	s.NoError(s.blobstoreClient.Upload(context.Background(), testArchivalBucket, key, b))
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusCorrupted, result.Status)
+	s.Contains(result.Reason, "failed to deserialize blob")
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_TagsMismatch() {
+	historyBlobs := s.historyBlobs(1, 4)
+	s.uploadHistoryBlobs(historyBlobs)
+	key, err := NewHistoryBlobKey(testDomainID, testWorkflowID, testRunID, common.FirstBlobPageToken)
+	s.NoError(err)
+	historyBlobs[0].Header.IsLast = common.BoolPtr(false)
+	tags, err := ConvertHeaderToTags(historyBlobs[0].Header)
+	s.NoError(err)
+	historyBlobs[0].Header.IsLast = common.BoolPtr(true)
+	b, _, err := constructBlob(historyBlobs[0], false)
+	s.NoError(err)
+	b.Tags["is_last"] = tags["is_last"]
+	s.NoError(s.blobstoreClient.Upload(context.Background(), testArchivalBucket, key, b))
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusCorrupted, result.Status)
+	s.Contains(result.Reason, "tag is_last does not match header")
+}
+
+func (s *verifierSuite) TestVerifyArchivedHistory_BucketNotExists() {
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, "other-bucket", testDomainID, testWorkflowID, testRunID)
+	s.Equal(blobstore.ErrBucketNotExists, err)
+	s.Nil(result)
+}
+
+func (s *verifierSuite) TestDeleteArchivedHistory() {
+	s.uploadHistoryBlobs(s.historyBlobs(3, 4))
+	otherBlob := s.historyBlobs(1, 4)[0]
+	otherBlob.Header.RunID = common.StringPtr("other-run-id")
+	key, err := NewHistoryBlobKey(testDomainID, testWorkflowID, "other-run-id", common.FirstBlobPageToken)
+	s.NoError(err)
+	b, _, err := constructBlob(otherBlob, true)
+	s.NoError(err)
+	s.NoError(s.blobstoreClient.Upload(context.Background(), testArchivalBucket, key, b))
+
+	s.NoError(DeleteArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID))
+	for pageToken := common.FirstBlobPageToken; pageToken < common.FirstBlobPageToken+3; pageToken++ {
+		key, err := NewHistoryBlobKey(testDomainID, testWorkflowID, testRunID, pageToken)
+		s.NoError(err)
+		exists, err := s.blobstoreClient.Exists(context.Background(), testArchivalBucket, key)
+		s.NoError(err)
+		s.False(exists)
+	}
+	exists, err := s.blobstoreClient.Exists(context.Background(), testArchivalBucket, key)
+	s.NoError(err)
+	s.True(exists)
+}
+
+func (s *verifierSuite) TestNewReArchiveRequest_V1() {
+	historyManager := &mocks.HistoryManager{}
+	historyManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		History:       &shared.History{Events: s.historyEvents(1, 10)},
+		NextPageToken: []byte{1},
+	}, nil).Once()
+	historyManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		History: &shared.History{Events: s.historyEvents(11, 5)},
+	}, nil).Once()
+	historyV2Manager := &mocks.HistoryV2Manager{}
+
+	request, err := NewReArchiveRequest(historyManager, historyV2Manager, testDomainID, testWorkflowID, testRunID, testDefaultPersistencePageSize)
+	s.NoError(err)
+	s.Equal(&ArchiveRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		NextEventID:          16,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}, request)
+	historyManager.AssertExpectations(s.T())
+	historyV2Manager.AssertExpectations(s.T())
+}
+
+func (s *verifierSuite) TestNewReArchiveRequest_V2() {
+	historyManager := &mocks.HistoryManager{}
+	historyManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(nil, &shared.EntityNotExistsError{}).Once()
+	branch := &shared.HistoryBranch{
+		TreeID:    common.StringPtr(testRunID),
+		BranchID:  common.StringPtr("test-branch-id"),
+		Ancestors: []*shared.HistoryBranchRange{},
+	}
+	historyV2Manager := &mocks.HistoryV2Manager{}
+	historyV2Manager.On("GetHistoryTree", &persistence.GetHistoryTreeRequest{TreeID: testRunID}).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*shared.HistoryBranch{branch},
+	}, nil).Once()
+	historyV2Manager.On("ReadHistoryBranch", mock.Anything).Return(&persistence.ReadHistoryBranchResponse{
+		HistoryEvents: s.historyEvents(1, 7),
+	}, nil).Once()
+
+	request, err := NewReArchiveRequest(historyManager, historyV2Manager, testDomainID, testWorkflowID, testRunID, testDefaultPersistencePageSize)
+	s.NoError(err)
+	branchToken, err := codec.NewThriftRWEncoder().Encode(branch)
+	s.NoError(err)
+	s.Equal(&ArchiveRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		EventStoreVersion:    persistence.EventStoreVersionV2,
+		BranchToken:          branchToken,
+		NextEventID:          8,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}, request)
+	historyManager.AssertExpectations(s.T())
+	historyV2Manager.AssertExpectations(s.T())
+}
+
+func (s *verifierSuite) TestNewReArchiveRequest_SourceHistoryNotExists() {
+	historyManager := &mocks.HistoryManager{}
+	historyManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(nil, &shared.EntityNotExistsError{}).Once()
+	historyV2Manager := &mocks.HistoryV2Manager{}
+	historyV2Manager.On("GetHistoryTree", mock.Anything).Return(&persistence.GetHistoryTreeResponse{}, nil).Once()
+
+	request, err := NewReArchiveRequest(historyManager, historyV2Manager, testDomainID, testWorkflowID, testRunID, testDefaultPersistencePageSize)
+	s.Equal(ErrSourceHistoryNotExists, err)
+	s.Nil(request)
+}
+
+func (s *verifierSuite) TestNewReArchiveRequest_AmbiguousHistoryTree() {
+	historyManager := &mocks.HistoryManager{}
+	historyManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(nil, &shared.EntityNotExistsError{}).Once()
+	historyV2Manager := &mocks.HistoryV2Manager{}
+	historyV2Manager.On("GetHistoryTree", mock.Anything).Return(&persistence.GetHistoryTreeResponse{
+		Branches: []*shared.HistoryBranch{{}, {}},
+	}, nil).Once()
+
+	request, err := NewReArchiveRequest(historyManager, historyV2Manager, testDomainID, testWorkflowID, testRunID, testDefaultPersistencePageSize)
+	s.Equal(errAmbiguousHistoryTree, err)
+	s.Nil(request)
+}
+
+func (s *verifierSuite) TestReArchiveHistory() {
+	s.uploadHistoryBlobs(s.historyBlobs(2, 4))
+	historyManager := &mocks.HistoryManager{}
+	historyManager.On("GetWorkflowExecutionHistory", mock.Anything).Return(&persistence.GetWorkflowExecutionHistoryResponse{
+		History: &shared.History{Events: s.historyEvents(1, 8)},
+	}, nil).Once()
+	archiverClient := &ClientMock{}
+	archiverClient.On("Archive", &ArchiveRequest{
+		DomainID:             testDomainID,
+		WorkflowID:           testWorkflowID,
+		RunID:                testRunID,
+		NextEventID:          9,
+		CloseFailoverVersion: testCloseFailoverVersion,
+	}).Return(nil).Once()
+
+	err := ReArchiveHistory(context.Background(), archiverClient, s.blobstoreClient, testArchivalBucket, historyManager, &mocks.HistoryV2Manager{},
+		testDomainID, testWorkflowID, testRunID, testDefaultPersistencePageSize)
+	s.NoError(err)
+	result, err := VerifyArchivedHistory(context.Background(), s.blobstoreClient, testArchivalBucket, testDomainID, testWorkflowID, testRunID)
+	s.NoError(err)
+	s.Equal(HistoryVerificationStatusMissing, result.Status)
+	s.Equal(common.FirstBlobPageToken, result.PageToken)
+	archiverClient.AssertExpectations(s.T())
+}
+
+// historyBlobs returns a valid chain of numBlobs history blobs each containing eventsPerBlob events
+func (s *verifierSuite) historyBlobs(numBlobs int, eventsPerBlob int) []*HistoryBlob {
+	var historyBlobs []*HistoryBlob
+	for i := 0; i < numBlobs; i++ {
+		pageToken := common.FirstBlobPageToken + i
+		events := s.historyEvents(common.FirstEventID+int64(i*eventsPerBlob), eventsPerBlob)
+		isLast := i == numBlobs-1
+		nextPageToken := pageToken + 1
+		if isLast {
+			nextPageToken = common.LastBlobNextPageToken
+		}
+		historyBlobs = append(historyBlobs, &HistoryBlob{
+			Header: &HistoryBlobHeader{
+				DomainName:           common.StringPtr(testDomain),
+				DomainID:             common.StringPtr(testDomainID),
+				WorkflowID:           common.StringPtr(testWorkflowID),
+				RunID:                common.StringPtr(testRunID),
+				CurrentPageToken:     common.IntPtr(pageToken),
+				NextPageToken:        common.IntPtr(nextPageToken),
+				IsLast:               common.BoolPtr(isLast),
+				FirstFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
+				LastFailoverVersion:  common.Int64Ptr(testCloseFailoverVersion),
+				FirstEventID:         events[0].EventId,
+				LastEventID:          events[len(events)-1].EventId,
+				UploadDateTime:       common.StringPtr("upload-time"),
+				UploadCluster:        common.StringPtr(testCurrentClusterName),
+				EventCount:           common.Int64Ptr(int64(len(events))),
+				CloseFailoverVersion: common.Int64Ptr(testCloseFailoverVersion),
+			},
+			Body: &shared.History{Events: events},
+		})
+	}
+	return historyBlobs
+}
+
+func (s *verifierSuite) historyEvents(firstEventID int64, numEvents int) []*shared.HistoryEvent {
+	var events []*shared.HistoryEvent
+	for i := 0; i < numEvents; i++ {
+		events = append(events, &shared.HistoryEvent{
+			EventId: common.Int64Ptr(firstEventID + int64(i)),
+			Version: common.Int64Ptr(testCloseFailoverVersion),
+		})
+	}
+	return events
+}
+
+func (s *verifierSuite) uploadHistoryBlobs(historyBlobs []*HistoryBlob) {
+	for _, historyBlob := range historyBlobs {
+		key, err := NewHistoryBlobKey(testDomainID, testWorkflowID, testRunID, *historyBlob.Header.CurrentPageToken)
+		s.NoError(err)
+		b, _, err := constructBlob(historyBlob, true)
+		s.NoError(err)
+		s.NoError(s.blobstoreClient.Upload(context.Background(), testArchivalBucket, key, b))
+	}
+}
@@ -0,0 +1,307 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/dgryski/go-farm"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+)
+
+const (
+	// visibilityBlobExtension is the extension used by all visibility blob keys
+	visibilityBlobExtension = "visibility"
+	// closeTimestampWidth is the number of digits inverted close timestamps are padded to in visibility blob keys,
+	// this makes the lexical order of keys match the descending order of close timestamps
+	closeTimestampWidth = 19
+
+	// MemoKeyExecutionContext is the memo key of the execution context last set by the workflow
+	MemoKeyExecutionContext = "ExecutionContext"
+	// SearchAttributeTaskList is the search attribute of the task list of the workflow
+	SearchAttributeTaskList = "TaskList"
+	// SearchAttributeCronSchedule is the search attribute of the cron schedule of the workflow
+	SearchAttributeCronSchedule = "CronSchedule"
+	// SearchAttributeParentDomainID is the search attribute of the domain ID of the parent workflow
+	SearchAttributeParentDomainID = "ParentDomainID"
+	// SearchAttributeParentWorkflowID is the search attribute of the workflow ID of the parent workflow
+	SearchAttributeParentWorkflowID = "ParentWorkflowID"
+	// SearchAttributeParentRunID is the search attribute of the run ID of the parent workflow
+	SearchAttributeParentRunID = "ParentRunID"
+)
+
+type (
+	// VisibilityRecord is the serializable data that forms the body of a visibility blob
+	VisibilityRecord struct {
+		DomainName         *string                              `json:"domain_name,omitempty"`
+		DomainID           *string                              `json:"domain_id,omitempty"`
+		WorkflowID         *string                              `json:"workflow_id,omitempty"`
+		RunID              *string                              `json:"run_id,omitempty"`
+		WorkflowTypeName   *string                              `json:"workflow_type_name,omitempty"`
+		StartTimestamp     *int64                               `json:"start_timestamp,omitempty"`
+		ExecutionTimestamp *int64                               `json:"execution_timestamp,omitempty"`
+		CloseTimestamp     *int64                               `json:"close_timestamp,omitempty"`
+		CloseStatus        *shared.WorkflowExecutionCloseStatus `json:"close_status,omitempty"`
+		HistoryLength      *int64                               `json:"history_length,omitempty"`
+		Memo               map[string][]byte                    `json:"memo,omitempty"`
+		SearchAttributes   map[string][]byte                    `json:"search_attributes,omitempty"`
+		UploadDateTime     *string                              `json:"upload_date_time,omitempty"`
+		UploadCluster      *string                              `json:"upload_cluster,omitempty"`
+	}
+
+	// ArchivedVisibilityQuery describes a query over the visibility records archived for a domain
+	ArchivedVisibilityQuery struct {
+		DomainID          string
+		EarliestCloseTime int64
+		LatestCloseTime   int64
+		WorkflowTypeName  *string
+		CloseStatus       *shared.WorkflowExecutionCloseStatus
+		PageSize          int
+		NextPageToken     []byte
+	}
+
+	// ArchivedVisibilityQueryResult is the result of an ArchivedVisibilityQuery
+	ArchivedVisibilityQueryResult struct {
+		Records       []*VisibilityRecord
+		NextPageToken []byte
+	}
+
+	archivedVisibilityPageToken struct {
+		BlobstorePageToken []byte
+	}
+)
+
+var (
+	errInvalidVisibilityQuery = errors.New("invalid archived visibility query")
+)
+
+// NewVisibilityBlobKey returns a key for visibility blob.
+// Keys of the same domain share a common prefix followed by the zero padded inverted close timestamp,
+// this allows the visibility blobs of a domain within a close time range to be listed by prefix
+// from the most recently closed execution.
+func NewVisibilityBlobKey(domainID, workflowID, runID string, closeTimestamp int64) (blob.Key, error) {
+	if len(domainID) == 0 || len(workflowID) == 0 || len(runID) == 0 {
+		return nil, errInvalidKeyInput
+	}
+	if closeTimestamp < 0 {
+		return nil, errInvalidKeyInput
+	}
+	domainIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(domainID)))
+	workflowIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(workflowID)))
+	runIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(runID)))
+	executionHash := strings.Join([]string{workflowIDHash, runIDHash}, "")
+	return blob.NewKey(visibilityBlobExtension, domainIDHash, paddedCloseTimestamp(closeTimestamp), executionHash)
+}
+
+// NewVisibilityRecord constructs the visibility record of the closed workflow execution described by request
+func NewVisibilityRecord(request ArchiveRequest, domainName, clusterName, uploadDateTime string) *VisibilityRecord {
+	return &VisibilityRecord{
+		DomainName:         common.StringPtr(domainName),
+		DomainID:           common.StringPtr(request.DomainID),
+		WorkflowID:         common.StringPtr(request.WorkflowID),
+		RunID:              common.StringPtr(request.RunID),
+		WorkflowTypeName:   common.StringPtr(request.WorkflowTypeName),
+		StartTimestamp:     common.Int64Ptr(request.StartTimestamp),
+		ExecutionTimestamp: common.Int64Ptr(request.ExecutionTimestamp),
+		CloseTimestamp:     common.Int64Ptr(request.CloseTimestamp),
+		CloseStatus:        request.CloseStatus.Ptr(),
+		HistoryLength:      common.Int64Ptr(request.HistoryLength),
+		Memo:               request.Memo,
+		SearchAttributes:   request.SearchAttributes,
+		UploadDateTime:     common.StringPtr(uploadDateTime),
+		UploadCluster:      common.StringPtr(clusterName),
+	}
+}
+
+// ConvertVisibilityRecordToTags converts the searchable fields of visibility record into metadata tags for blob
+func ConvertVisibilityRecordToTags(record *VisibilityRecord) map[string]string {
+	tags := make(map[string]string)
+	if record.WorkflowID != nil {
+		tags["workflow_id"] = *record.WorkflowID
+	}
+	if record.RunID != nil {
+		tags["run_id"] = *record.RunID
+	}
+	if record.WorkflowTypeName != nil {
+		tags["workflow_type_name"] = *record.WorkflowTypeName
+	}
+	if record.CloseTimestamp != nil {
+		tags["close_timestamp"] = strconv.FormatInt(*record.CloseTimestamp, 10)
+	}
+	if record.CloseStatus != nil {
+		tags["close_status"] = record.CloseStatus.String()
+	}
+	return tags
+}
+
+// ToWorkflowExecutionInfo converts visibility record to the WorkflowExecutionInfo returned by visibility APIs
+func (r *VisibilityRecord) ToWorkflowExecutionInfo() *shared.WorkflowExecutionInfo {
+	info := &shared.WorkflowExecutionInfo{
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: r.WorkflowID,
+			RunId:      r.RunID,
+		},
+		StartTime:     r.StartTimestamp,
+		CloseTime:     r.CloseTimestamp,
+		CloseStatus:   r.CloseStatus,
+		HistoryLength: r.HistoryLength,
+		ExecutionTime: r.ExecutionTimestamp,
+	}
+	if r.WorkflowTypeName != nil {
+		info.Type = &shared.WorkflowType{Name: r.WorkflowTypeName}
+	}
+	return info
+}
+
+// QueryArchivedVisibility returns the visibility records archived in bucket which match query.
+// Records are returned in descending order of close time, each page lists and downloads
+// at most PageSize blobs for every page of matching records it returns.
+func QueryArchivedVisibility(
+	ctx context.Context,
+	blobstoreClient blobstore.Client,
+	bucket string,
+	query *ArchivedVisibilityQuery,
+) (*ArchivedVisibilityQueryResult, error) {
+
+	if len(query.DomainID) == 0 || query.PageSize <= 0 ||
+		query.EarliestCloseTime < 0 || query.EarliestCloseTime > query.LatestCloseTime {
+		return nil, errInvalidVisibilityQuery
+	}
+	token := &archivedVisibilityPageToken{}
+	if len(query.NextPageToken) != 0 {
+		if err := json.Unmarshal(query.NextPageToken, token); err != nil {
+			return nil, err
+		}
+	}
+
+	prefix := visibilityBlobKeyPrefix(query.DomainID, query.EarliestCloseTime, query.LatestCloseTime)
+	result := &ArchivedVisibilityQueryResult{}
+	for {
+		keys, nextBlobstorePageToken, err := blobstoreClient.ListByPrefixPage(
+			ctx, bucket, prefix, query.PageSize-len(result.Records), token.BlobstorePageToken)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			closeTimestamp, ok := parseVisibilityBlobKey(k)
+			if !ok || closeTimestamp > query.LatestCloseTime {
+				continue
+			}
+			if closeTimestamp < query.EarliestCloseTime {
+				// keys are listed in descending order of close time so no later key can match
+				return result, nil
+			}
+			record, err := downloadVisibilityRecord(ctx, blobstoreClient, bucket, k)
+			if err != nil {
+				return nil, err
+			}
+			if record.matches(query) {
+				result.Records = append(result.Records, record)
+			}
+		}
+		if len(nextBlobstorePageToken) == 0 {
+			return result, nil
+		}
+		token.BlobstorePageToken = nextBlobstorePageToken
+		if len(result.Records) == query.PageSize {
+			nextToken, err := json.Marshal(token)
+			if err != nil {
+				return nil, err
+			}
+			result.NextPageToken = nextToken
+			return result, nil
+		}
+	}
+}
+
+// IsValidVisibilityPageToken returns true if token can be used as the NextPageToken of an ArchivedVisibilityQuery
+func IsValidVisibilityPageToken(token []byte) bool {
+	return json.Unmarshal(token, &archivedVisibilityPageToken{}) == nil
+}
+
+func downloadVisibilityRecord(ctx context.Context, blobstoreClient blobstore.Client, bucket string, key blob.Key) (*VisibilityRecord, error) {
+	b, err := blobstoreClient.Download(ctx, bucket, key)
+	if err != nil {
+		return nil, err
+	}
+	unwrappedBlob, wrappingLayers, err := blob.Unwrap(b)
+	if err != nil {
+		return nil, err
+	}
+	record := &VisibilityRecord{}
+	if wrappingLayers.EncodingFormat == nil || *wrappingLayers.EncodingFormat != blob.JSONEncoding {
+		return nil, fmt.Errorf("visibility blob %v has unsupported encoding", key.String())
+	}
+	if err := json.Unmarshal(unwrappedBlob.Body, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (r *VisibilityRecord) matches(query *ArchivedVisibilityQuery) bool {
+	if query.WorkflowTypeName != nil && (r.WorkflowTypeName == nil || *r.WorkflowTypeName != *query.WorkflowTypeName) {
+		return false
+	}
+	if query.CloseStatus != nil && (r.CloseStatus == nil || *r.CloseStatus != *query.CloseStatus) {
+		return false
+	}
+	return true
+}
+
+// visibilityBlobKeyPrefix returns the longest key prefix shared by all visibility blobs of domain
+// closed within the given close time range
+func visibilityBlobKeyPrefix(domainID string, earliestCloseTime int64, latestCloseTime int64) string {
+	domainIDHash := fmt.Sprintf("%v", farm.Fingerprint64([]byte(domainID)))
+	earliest := paddedCloseTimestamp(earliestCloseTime)
+	latest := paddedCloseTimestamp(latestCloseTime)
+	i := 0
+	for i < len(earliest) && earliest[i] == latest[i] {
+		i++
+	}
+	return strings.Join([]string{domainIDHash, latest[:i]}, "_")
+}
+
+func parseVisibilityBlobKey(key blob.Key) (int64, bool) {
+	pieces := key.Pieces()
+	if key.Extension() != visibilityBlobExtension || len(pieces) != 3 {
+		return 0, false
+	}
+	invertedCloseTimestamp, err := strconv.ParseInt(pieces[1], 10, 64)
+	if err != nil || invertedCloseTimestamp < 0 {
+		return 0, false
+	}
+	return math.MaxInt64 - invertedCloseTimestamp, true
+}
+
+// paddedCloseTimestamp returns the key piece of closeTimestamp,
+// later close timestamps sort first
+func paddedCloseTimestamp(closeTimestamp int64) string {
+	return fmt.Sprintf("%0*d", closeTimestampWidth, math.MaxInt64-closeTimestamp)
+}
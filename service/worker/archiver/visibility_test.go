@@ -0,0 +1,269 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package archiver
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/blob"
+	"github.com/uber/cadence/common/blobstore/filestore"
+)
+
+type visibilitySuite struct {
+	suite.Suite
+	storeDir        string
+	blobstoreClient blobstore.Client
+}
+
+func TestVisibilitySuite(t *testing.T) {
+	suite.Run(t, new(visibilitySuite))
+}
+
+func (s *visibilitySuite) SetupTest() {
+	dir, err := ioutil.TempDir("", "TestVisibilitySuite")
+	s.NoError(err)
+	s.storeDir = dir
+	s.blobstoreClient, err = filestore.NewClient(&filestore.Config{
+		StoreDirectory: dir,
+		DefaultBucket: filestore.BucketConfig{
+			Name:          testArchivalBucket,
+			Owner:         "test-owner",
+			RetentionDays: 10,
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *visibilitySuite) TearDownTest() {
+	os.RemoveAll(s.storeDir)
+}
+
+func (s *visibilitySuite) TestNewVisibilityBlobKey_Fail() {
+	_, err := NewVisibilityBlobKey("", testWorkflowID, testRunID, 100)
+	s.Error(err)
+	_, err = NewVisibilityBlobKey(testDomainID, testWorkflowID, testRunID, -1)
+	s.Error(err)
+}
+
+func (s *visibilitySuite) TestNewVisibilityBlobKey_Success() {
+	key, err := NewVisibilityBlobKey(testDomainID, testWorkflowID, testRunID, 100)
+	s.NoError(err)
+	closeTimestamp, ok := parseVisibilityBlobKey(key)
+	s.True(ok)
+	s.Equal(int64(100), closeTimestamp)
+
+	historyKey, err := NewHistoryBlobKey(testDomainID, testWorkflowID, testRunID, common.FirstBlobPageToken)
+	s.NoError(err)
+	_, ok = parseVisibilityBlobKey(historyKey)
+	s.False(ok)
+}
+
+func (s *visibilitySuite) TestVisibilityBlobKeyPrefix() {
+	prefix := visibilityBlobKeyPrefix(testDomainID, 1000, 1999)
+	for _, closeTimestamp := range []int64{1000, 1500, 1999} {
+		key, err := NewVisibilityBlobKey(testDomainID, testWorkflowID, testRunID, closeTimestamp)
+		s.NoError(err)
+		s.True(strings.HasPrefix(key.String(), prefix))
+	}
+	key, err := NewVisibilityBlobKey(testDomainID, testWorkflowID, testRunID, 10000)
+	s.NoError(err)
+	s.False(strings.HasPrefix(key.String(), prefix))
+}
+
+func (s *visibilitySuite) TestQueryArchivedVisibility_InvalidQuery() {
+	_, err := QueryArchivedVisibility(context.Background(), s.blobstoreClient, testArchivalBucket, &ArchivedVisibilityQuery{
+		DomainID:          testDomainID,
+		EarliestCloseTime: 200,
+		LatestCloseTime:   100,
+		PageSize:          10,
+	})
+	s.Error(err)
+}
+
+func (s *visibilitySuite) TestQueryArchivedVisibility_FilterByCloseTime() {
+	for i := 1; i <= 10; i++ {
+		s.uploadVisibilityRecord(testDomainID, fmt.Sprintf("run-%v", i), "type", int64(i*100), shared.WorkflowExecutionCloseStatusCompleted)
+	}
+	s.uploadVisibilityRecord("other-domain-id", "run-other", "type", 500, shared.WorkflowExecutionCloseStatusCompleted)
+
+	result, err := QueryArchivedVisibility(context.Background(), s.blobstoreClient, testArchivalBucket, &ArchivedVisibilityQuery{
+		DomainID:          testDomainID,
+		EarliestCloseTime: 300,
+		LatestCloseTime:   700,
+		PageSize:          10,
+	})
+	s.NoError(err)
+	s.Nil(result.NextPageToken)
+	s.Len(result.Records, 5)
+	for i, r := range result.Records {
+		s.Equal(testDomainID, *r.DomainID)
+		s.Equal(int64(700-i*100), *r.CloseTimestamp)
+	}
+}
+
+func (s *visibilitySuite) TestQueryArchivedVisibility_FilterByTypeAndStatus() {
+	s.uploadVisibilityRecord(testDomainID, "run-1", "type-a", 100, shared.WorkflowExecutionCloseStatusCompleted)
+	s.uploadVisibilityRecord(testDomainID, "run-2", "type-b", 200, shared.WorkflowExecutionCloseStatusCompleted)
+	s.uploadVisibilityRecord(testDomainID, "run-3", "type-a", 300, shared.WorkflowExecutionCloseStatusFailed)
+	s.uploadVisibilityRecord(testDomainID, "run-4", "type-a", 400, shared.WorkflowExecutionCloseStatusCompleted)
+
+	result, err := QueryArchivedVisibility(context.Background(), s.blobstoreClient, testArchivalBucket, &ArchivedVisibilityQuery{
+		DomainID:          testDomainID,
+		EarliestCloseTime: 0,
+		LatestCloseTime:   1000,
+		WorkflowTypeName:  common.StringPtr("type-a"),
+		PageSize:          10,
+	})
+	s.NoError(err)
+	s.Len(result.Records, 3)
+
+	result, err = QueryArchivedVisibility(context.Background(), s.blobstoreClient, testArchivalBucket, &ArchivedVisibilityQuery{
+		DomainID:          testDomainID,
+		EarliestCloseTime: 0,
+		LatestCloseTime:   1000,
+		WorkflowTypeName:  common.StringPtr("type-a"),
+		CloseStatus:       shared.WorkflowExecutionCloseStatusCompleted.Ptr(),
+		PageSize:          10,
+	})
+	s.NoError(err)
+	s.Len(result.Records, 2)
+	s.Equal("run-4", *result.Records[0].RunID)
+	s.Equal("run-1", *result.Records[1].RunID)
+}
+
+func (s *visibilitySuite) TestQueryArchivedVisibility_Pagination() {
+	numRecords := 7
+	for i := 1; i <= numRecords; i++ {
+		s.uploadVisibilityRecord(testDomainID, fmt.Sprintf("run-%v", i), "type", int64(i*100), shared.WorkflowExecutionCloseStatusCompleted)
+	}
+
+	query := &ArchivedVisibilityQuery{
+		DomainID:          testDomainID,
+		EarliestCloseTime: 0,
+		LatestCloseTime:   int64(numRecords * 100),
+		PageSize:          3,
+	}
+	var closeTimestamps []int64
+	for {
+		result, err := QueryArchivedVisibility(context.Background(), s.blobstoreClient, testArchivalBucket, query)
+		s.NoError(err)
+		s.True(len(result.Records) <= query.PageSize)
+		for _, r := range result.Records {
+			closeTimestamps = append(closeTimestamps, *r.CloseTimestamp)
+		}
+		if result.NextPageToken == nil {
+			break
+		}
+		query.NextPageToken = result.NextPageToken
+	}
+	s.Len(closeTimestamps, numRecords)
+	for i, closeTimestamp := range closeTimestamps {
+		s.Equal(int64((numRecords-i)*100), closeTimestamp)
+	}
+}
+
+func (s *visibilitySuite) TestQueryArchivedVisibility_DownloadsOnlyOnePage() {
+	for i := 1; i <= 20; i++ {
+		s.uploadVisibilityRecord(testDomainID, fmt.Sprintf("run-%v", i), "type", int64(i*100), shared.WorkflowExecutionCloseStatusCompleted)
+	}
+
+	client := &downloadCountingClient{Client: s.blobstoreClient}
+	result, err := QueryArchivedVisibility(context.Background(), client, testArchivalBucket, &ArchivedVisibilityQuery{
+		DomainID:          testDomainID,
+		EarliestCloseTime: 0,
+		LatestCloseTime:   2000,
+		PageSize:          3,
+	})
+	s.NoError(err)
+	s.Len(result.Records, 3)
+	s.NotNil(result.NextPageToken)
+	s.Equal(3, client.downloads)
+}
+
+func (s *visibilitySuite) TestQueryArchivedVisibility_MemoAndSearchAttributes() {
+	request := ArchiveRequest{
+		DomainID:         testDomainID,
+		WorkflowID:       testWorkflowID,
+		RunID:            testRunID,
+		CloseTimestamp:   100,
+		Memo:             map[string][]byte{"memo-key": []byte("memo-value")},
+		SearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"keyword"`)},
+	}
+	key, err := NewVisibilityBlobKey(testDomainID, testWorkflowID, testRunID, 100)
+	s.NoError(err)
+	blob, _, err := constructVisibilityBlob(NewVisibilityRecord(request, testDomain, testCurrentClusterName, "upload-time"), false)
+	s.NoError(err)
+	s.NoError(s.blobstoreClient.Upload(context.Background(), testArchivalBucket, key, blob))
+
+	result, err := QueryArchivedVisibility(context.Background(), s.blobstoreClient, testArchivalBucket, &ArchivedVisibilityQuery{
+		DomainID:          testDomainID,
+		EarliestCloseTime: 0,
+		LatestCloseTime:   1000,
+		PageSize:          10,
+	})
+	s.NoError(err)
+	s.Len(result.Records, 1)
+	s.Equal(request.Memo, result.Records[0].Memo)
+	s.Equal(request.SearchAttributes, result.Records[0].SearchAttributes)
+}
+
+func (s *visibilitySuite) uploadVisibilityRecord(
+	domainID string,
+	runID string,
+	workflowTypeName string,
+	closeTimestamp int64,
+	closeStatus shared.WorkflowExecutionCloseStatus,
+) {
+	request := ArchiveRequest{
+		DomainID:         domainID,
+		WorkflowID:       testWorkflowID,
+		RunID:            runID,
+		WorkflowTypeName: workflowTypeName,
+		StartTimestamp:   closeTimestamp - 1,
+		CloseTimestamp:   closeTimestamp,
+		CloseStatus:      closeStatus,
+		HistoryLength:    testNextEventID - 1,
+	}
+	key, err := NewVisibilityBlobKey(domainID, testWorkflowID, runID, closeTimestamp)
+	s.NoError(err)
+	blob, _, err := constructVisibilityBlob(NewVisibilityRecord(request, testDomain, testCurrentClusterName, "upload-time"), true)
+	s.NoError(err)
+	s.NoError(s.blobstoreClient.Upload(context.Background(), testArchivalBucket, key, blob))
+}
+
+type downloadCountingClient struct {
+	blobstore.Client
+	downloads int
+}
+
+func (c *downloadCountingClient) Download(ctx context.Context, bucket string, key blob.Key) (*blob.Blob, error) {
+	c.downloads++
+	return c.Client.Download(ctx, bucket, key)
+}
@@ -21,12 +21,29 @@
 package archiver
 
 import (
+	"time"
+
 	"github.com/uber-common/bark"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/cadence"
 	"go.uber.org/cadence/workflow"
 )
 
+const (
+	verifierWorkflowStartToCloseTimeout    = 24 * time.Hour
+	verifierActivityScheduleToStartTimeout = 5 * time.Minute
+	verifierActivityStartToCloseTimeout    = 12 * time.Hour
+	verifierActivityHeartbeatTimeout       = 5 * time.Minute
+)
+
+var verifierActivityRetryPolicy = cadence.RetryPolicy{
+	InitialInterval:    10 * time.Second,
+	BackoffCoefficient: 2,
+	MaximumInterval:    5 * time.Minute,
+	ExpirationInterval: verifierActivityStartToCloseTimeout,
+}
+
 type dynamicConfigResult struct {
 	ArchiverConcurrency   int
 	ArchivalsPerIteration int
@@ -102,3 +119,14 @@ func archivalWorkflowHelper(
 	ctx = workflow.WithWorkflowTaskStartToCloseTimeout(ctx, workflowTaskStartToCloseTimeout)
 	return workflow.NewContinueAsNewError(ctx, archivalWorkflowFnName, pumpResult.UnhandledCarryover)
 }
+
+// archivalVerifierWorkflow is the cron workflow which periodically verifies archived histories
+func archivalVerifierWorkflow(ctx workflow.Context) error {
+	opts := workflow.ActivityOptions{
+		ScheduleToStartTimeout: verifierActivityScheduleToStartTimeout,
+		StartToCloseTimeout:    verifierActivityStartToCloseTimeout,
+		HeartbeatTimeout:       verifierActivityHeartbeatTimeout,
+		RetryPolicy:            &verifierActivityRetryPolicy,
+	}
+	return workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), verifyArchivalActivityFnName).Get(ctx, nil)
+}
@@ -0,0 +1,293 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	h "github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	p "github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// domainRetentionCache caches the domain info needed by the scavenger
+	// for the duration of a single run
+	domainRetentionCache struct {
+		sync.RWMutex
+		db      p.MetadataManager
+		domains map[string]*domainEntry
+	}
+
+	domainEntry struct {
+		name      string
+		retention time.Duration
+	}
+)
+
+var retryForeverPolicy = newRetryForeverPolicy()
+
+func (s *Scavenger) listConcreteExecutions(db p.ExecutionManager, pageToken []byte) (*p.ListConcreteExecutionsResponse, error) {
+	var err error
+	var resp *p.ListConcreteExecutionsResponse
+	s.retryForever(func() error {
+		resp, err = db.ListConcreteExecutions(&p.ListConcreteExecutionsRequest{
+			PageSize:  executionBatchSize,
+			PageToken: pageToken,
+		})
+		return err
+	})
+	return resp, err
+}
+
+func (s *Scavenger) listCurrentExecutions(db p.ExecutionManager, pageToken []byte) (*p.ListCurrentExecutionsResponse, error) {
+	var err error
+	var resp *p.ListCurrentExecutionsResponse
+	s.retryForever(func() error {
+		resp, err = db.ListCurrentExecutions(&p.ListCurrentExecutionsRequest{
+			PageSize:  executionBatchSize,
+			PageToken: pageToken,
+		})
+		return err
+	})
+	return resp, err
+}
+
+// executionExists returns true if the concrete execution for the given run exists
+func (s *Scavenger) executionExists(db p.ExecutionManager, domainID string, workflowID string, runID string) (bool, error) {
+	err := s.retryForever(func() error {
+		_, err := db.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+			DomainID: domainID,
+			Execution: shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(runID),
+			},
+		})
+		return err
+	})
+	return s.existsOrError(err)
+}
+
+// historyExists returns true if the first event of the history of the given execution can be read
+func (s *Scavenger) historyExists(execution *p.ConcreteExecutionInfo) (bool, error) {
+	if execution.EventStoreVersion == p.EventStoreVersionV2 {
+		var resp *p.ReadHistoryBranchResponse
+		err := s.retryForever(func() error {
+			var err error
+			resp, err = s.HistoryV2DB.ReadHistoryBranch(&p.ReadHistoryBranchRequest{
+				BranchToken: execution.BranchToken,
+				MinEventID:  common.FirstEventID,
+				MaxEventID:  common.FirstEventID + 1,
+				PageSize:    1,
+			})
+			return err
+		})
+		if err == nil && len(resp.HistoryEvents) == 0 {
+			return false, nil
+		}
+		return s.existsOrError(err)
+	}
+
+	var resp *p.GetWorkflowExecutionHistoryResponse
+	err := s.retryForever(func() error {
+		var err error
+		resp, err = s.HistoryDB.GetWorkflowExecutionHistory(&p.GetWorkflowExecutionHistoryRequest{
+			DomainID: execution.DomainID,
+			Execution: shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(execution.WorkflowID),
+				RunId:      common.StringPtr(execution.RunID),
+			},
+			FirstEventID: common.FirstEventID,
+			NextEventID:  common.FirstEventID + 1,
+			PageSize:     1,
+		})
+		return err
+	})
+	if err == nil && (resp.History == nil || len(resp.History.Events) == 0) {
+		return false, nil
+	}
+	return s.existsOrError(err)
+}
+
+// openVisibilityExists returns true if the given execution shows up in the open visibility records
+func (s *Scavenger) openVisibilityExists(domainName string, execution *p.ConcreteExecutionInfo) (bool, error) {
+	var pageToken []byte
+	for {
+		var resp *p.ListWorkflowExecutionsResponse
+		err := s.retryForever(func() error {
+			var err error
+			resp, err = s.VisibilityDB.ListOpenWorkflowExecutionsByWorkflowID(&p.ListWorkflowExecutionsByWorkflowIDRequest{
+				ListWorkflowExecutionsRequest: p.ListWorkflowExecutionsRequest{
+					DomainUUID:        execution.DomainID,
+					Domain:            domainName,
+					EarliestStartTime: execution.StartTimestamp.Add(-visibilityStartTimeDelta).UnixNano(),
+					LatestStartTime:   execution.StartTimestamp.Add(visibilityStartTimeDelta).UnixNano(),
+					PageSize:          executionBatchSize,
+					NextPageToken:     pageToken,
+				},
+				WorkflowID: execution.WorkflowID,
+			})
+			return err
+		})
+		if err != nil {
+			return false, err
+		}
+		for _, info := range resp.Executions {
+			if info.Execution.GetRunId() == execution.RunID {
+				return true, nil
+			}
+		}
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return false, nil
+		}
+	}
+}
+
+// deleteExecution deletes the mutable state of the given execution along with the current
+// execution row, if that row still points to this run. When deleteHistory is set, the history
+// of the execution is deleted as well
+func (s *Scavenger) deleteExecution(db p.ExecutionManager, execution *p.ConcreteExecutionInfo, deleteHistory bool) error {
+	if deleteHistory {
+		var err error
+		if execution.EventStoreVersion == p.EventStoreVersionV2 {
+			err = s.retryForever(func() error {
+				return s.HistoryV2DB.DeleteHistoryBranch(&p.DeleteHistoryBranchRequest{
+					BranchToken: execution.BranchToken,
+				})
+			})
+		} else {
+			err = s.retryForever(func() error {
+				return s.HistoryDB.DeleteWorkflowExecutionHistory(&p.DeleteWorkflowExecutionHistoryRequest{
+					DomainID: execution.DomainID,
+					Execution: shared.WorkflowExecution{
+						WorkflowId: common.StringPtr(execution.WorkflowID),
+						RunId:      common.StringPtr(execution.RunID),
+					},
+				})
+			})
+		}
+		if err != nil {
+			return err
+		}
+	}
+	err := s.retryForever(func() error {
+		return db.DeleteWorkflowExecution(&p.DeleteWorkflowExecutionRequest{
+			DomainID:   execution.DomainID,
+			WorkflowID: execution.WorkflowID,
+			RunID:      execution.RunID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return s.deleteCurrentExecution(db, execution.DomainID, execution.WorkflowID, execution.RunID)
+}
+
+// refreshWorkflowTasks asks the history service to regenerate the transfer and timer tasks of the given execution
+func (s *Scavenger) refreshWorkflowTasks(domainName string, execution *p.ConcreteExecutionInfo) error {
+	ctx, cancel := context.WithTimeout(context.Background(), refreshTasksTimeout)
+	defer cancel()
+	return s.HistoryClient.RefreshWorkflowTasks(ctx, &h.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(execution.DomainID),
+		Request: &shared.RefreshWorkflowTasksRequest{
+			Domain: common.StringPtr(domainName),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(execution.WorkflowID),
+				RunId:      common.StringPtr(execution.RunID),
+			},
+		},
+	})
+}
+
+func (s *Scavenger) deleteCurrentExecution(db p.ExecutionManager, domainID string, workflowID string, runID string) error {
+	return s.retryForever(func() error {
+		return db.DeleteCurrentWorkflowExecution(&p.DeleteCurrentWorkflowExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: workflowID,
+			RunID:      runID,
+		})
+	})
+}
+
+func (s *Scavenger) existsOrError(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+	if _, ok := err.(*shared.EntityNotExistsError); ok {
+		return false, nil
+	}
+	return false, err
+}
+
+func (s *Scavenger) retryForever(op func() error) error {
+	return backoff.Retry(op, retryForeverPolicy, s.isRetryable)
+}
+
+func newRetryForeverPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(250 * time.Millisecond)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	policy.SetMaximumInterval(30 * time.Second)
+	return policy
+}
+
+func (s *Scavenger) isRetryable(err error) bool {
+	return s.Alive() && common.IsPersistenceTransientError(err)
+}
+
+func newDomainRetentionCache(db p.MetadataManager) *domainRetentionCache {
+	return &domainRetentionCache{
+		db:      db,
+		domains: make(map[string]*domainEntry),
+	}
+}
+
+// get returns the cached entry for the given domain, a nil entry
+// is returned when the domain does not exist
+func (c *domainRetentionCache) get(domainID string) (*domainEntry, error) {
+	c.RLock()
+	entry, ok := c.domains[domainID]
+	c.RUnlock()
+	if ok {
+		return entry, nil
+	}
+
+	resp, err := c.db.GetDomain(&p.GetDomainRequest{ID: domainID})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); !ok {
+			return nil, err
+		}
+	} else {
+		entry = &domainEntry{
+			name:      resp.Info.Name,
+			retention: time.Duration(resp.Config.Retention) * 24 * time.Hour,
+		}
+	}
+
+	c.Lock()
+	c.domains[domainID] = entry
+	c.Unlock()
+	return entry, nil
+}
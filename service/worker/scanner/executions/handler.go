@@ -0,0 +1,284 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/scanner/executor"
+)
+
+type (
+	handlerStatus = executor.TaskStatus
+
+	// shardState is the progress of the scan of a single shard, a shard is
+	// scanned in two phases, first the concrete executions and then the
+	// current executions
+	shardState struct {
+		shardID         int
+		db              p.ExecutionManager
+		scanningCurrent bool
+		pageToken       []byte
+	}
+)
+
+const (
+	handlerStatusDone  = executor.TaskStatusDone
+	handlerStatusErr   = executor.TaskStatusErr
+	handlerStatusDefer = executor.TaskStatusDefer
+)
+
+var corruptionMetrics = map[CorruptionType]int{
+	CorruptionTypeMissingHistory:           metrics.ExecutionMissingHistoryCount,
+	CorruptionTypeDanglingCurrentExecution: metrics.ExecutionDanglingCurrentCount,
+	CorruptionTypeRetentionExpired:         metrics.ExecutionRetentionExpiredCount,
+	CorruptionTypeMissingVisibility:        metrics.ExecutionMissingVisibilityCount,
+}
+
+// scanHandler scans a single shard
+// this handler limits the amount of pages read to maxPagesPerJob
+// for fairness among all the shards in the system - when there
+// is more work to do subsequently, this handler will return StatusDefer
+// with the assumption that the executor will schedule this task later
+func (s *Scavenger) scanHandler(state *shardState) handlerStatus {
+	if state.db == nil {
+		db, err := s.ExecutionDBs.NewExecutionManager(state.shardID)
+		if err != nil {
+			s.onShardFailed(state, err)
+			return handlerStatusErr
+		}
+		state.db = db
+	}
+
+	for nPages := 0; nPages < maxPagesPerJob; nPages++ {
+		if !s.Alive() {
+			state.db.Close()
+			return handlerStatusDone
+		}
+
+		var done bool
+		var err error
+		if state.scanningCurrent {
+			done, err = s.scanCurrentExecutions(state)
+		} else {
+			done, err = s.scanConcreteExecutions(state)
+		}
+		if err != nil {
+			s.onShardFailed(state, err)
+			return handlerStatusErr
+		}
+		if !done {
+			continue
+		}
+		if state.scanningCurrent {
+			state.db.Close()
+			return handlerStatusDone
+		}
+		state.scanningCurrent = true
+		state.pageToken = nil
+	}
+
+	return handlerStatusDefer
+}
+
+// scanConcreteExecutions validates the next page of concrete executions of the shard,
+// returns true when there are no more pages left
+func (s *Scavenger) scanConcreteExecutions(state *shardState) (bool, error) {
+	resp, err := s.listConcreteExecutions(state.db, state.pageToken)
+	if err != nil {
+		return false, err
+	}
+	for _, execution := range resp.Executions {
+		s.checkConcreteExecution(state, execution)
+	}
+	s.report.addScanned(len(resp.Executions), 0)
+	state.pageToken = resp.NextPageToken
+	return len(state.pageToken) == 0, nil
+}
+
+// scanCurrentExecutions validates the next page of current executions of the shard,
+// returns true when there are no more pages left
+func (s *Scavenger) scanCurrentExecutions(state *shardState) (bool, error) {
+	resp, err := s.listCurrentExecutions(state.db, state.pageToken)
+	if err != nil {
+		return false, err
+	}
+	for _, execution := range resp.Executions {
+		s.checkCurrentExecution(state, execution)
+	}
+	s.report.addScanned(0, len(resp.Executions))
+	state.pageToken = resp.NextPageToken
+	return len(state.pageToken) == 0, nil
+}
+
+// checkConcreteExecution validates a single concrete execution
+//   - A closed execution is corrupted if it outlived its domain retention
+//   - An open execution is corrupted if its history is gone
+//   - An open execution, started long enough ago, is corrupted if it is missing from visibility
+func (s *Scavenger) checkConcreteExecution(state *shardState, execution *p.ConcreteExecutionInfo) {
+	domain, err := s.domains.get(execution.DomainID)
+	if err != nil {
+		s.logCheckError(state, execution.DomainID, execution.WorkflowID, execution.RunID, err)
+		return
+	}
+
+	if execution.State == p.WorkflowStateCompleted {
+		if domain == nil {
+			return
+		}
+		if time.Now().After(execution.LastUpdatedTime.Add(domain.retention + retentionGracePeriod)) {
+			s.onCorrupted(state, execution.DomainID, execution.WorkflowID, execution.RunID, CorruptionTypeRetentionExpired, func() error {
+				return s.deleteExecution(state.db, execution, true)
+			})
+		}
+		return
+	}
+
+	exists, err := s.historyExists(execution)
+	if err != nil {
+		s.logCheckError(state, execution.DomainID, execution.WorkflowID, execution.RunID, err)
+		return
+	}
+	if !exists {
+		s.onCorrupted(state, execution.DomainID, execution.WorkflowID, execution.RunID, CorruptionTypeMissingHistory, func() error {
+			return s.deleteExecution(state.db, execution, false)
+		})
+		return
+	}
+
+	if domain == nil {
+		return
+	}
+	if s.RefreshTasksEnabled != nil && s.RefreshTasksEnabled() {
+		s.refreshTasks(state, domain.name, execution)
+	}
+
+	if time.Now().Before(execution.StartTimestamp.Add(visibilityGracePeriod)) {
+		return
+	}
+	exists, err = s.openVisibilityExists(domain.name, execution)
+	if err != nil {
+		s.logCheckError(state, execution.DomainID, execution.WorkflowID, execution.RunID, err)
+		return
+	}
+	if !exists {
+		// visibility records are owned by the history service, so this is only reported
+		s.onCorrupted(state, execution.DomainID, execution.WorkflowID, execution.RunID, CorruptionTypeMissingVisibility, nil)
+	}
+}
+
+// checkCurrentExecution validates a single current execution row, the row
+// is corrupted if it points to a run that does not exist
+func (s *Scavenger) checkCurrentExecution(state *shardState, execution *p.CurrentExecutionInfo) {
+	exists, err := s.executionExists(state.db, execution.DomainID, execution.WorkflowID, execution.CurrentRunID)
+	if err != nil {
+		s.logCheckError(state, execution.DomainID, execution.WorkflowID, execution.CurrentRunID, err)
+		return
+	}
+	if !exists {
+		s.onCorrupted(state, execution.DomainID, execution.WorkflowID, execution.CurrentRunID, CorruptionTypeDanglingCurrentExecution, func() error {
+			return s.deleteCurrentExecution(state.db, execution.DomainID, execution.WorkflowID, execution.CurrentRunID)
+		})
+	}
+}
+
+// onCorrupted records a corrupted execution, and fixes it using the given
+// fix function when fix mode is enabled
+func (s *Scavenger) onCorrupted(state *shardState, domainID string, workflowID string, runID string,
+	corruptionType CorruptionType, fix func() error) {
+	corrupted := CorruptedExecution{
+		ShardID:    state.shardID,
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+		Type:       corruptionType.String(),
+	}
+	logger := s.Logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID:      state.shardID,
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: workflowID,
+		logging.TagWorkflowRunID:       runID,
+		logging.TagCorruptionType:      corrupted.Type,
+	})
+	s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, corruptionMetrics[corruptionType])
+
+	if fix != nil && s.FixEnabled() {
+		if err := fix(); err != nil {
+			s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionFixFailedCount)
+			logger.WithField(logging.TagErr, err).Error("failed to fix corrupted execution")
+		} else {
+			corrupted.Fixed = true
+			s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionFixedCount)
+		}
+	}
+
+	logger.Warnf("corrupted execution found, fixed:%v", corrupted.Fixed)
+	s.report.addCorrupted(corrupted)
+}
+
+// refreshTasks regenerates the transfer and timer tasks of an open execution, executions
+// of domains which are not active in this cluster are skipped
+func (s *Scavenger) refreshTasks(state *shardState, domainName string, execution *p.ConcreteExecutionInfo) {
+	err := s.refreshWorkflowTasks(domainName, execution)
+	if err == nil {
+		s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionTasksRefreshedCount)
+		s.report.addTasksRefreshed()
+		return
+	}
+	if _, ok := err.(*shared.DomainNotActiveError); ok {
+		return
+	}
+	s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, metrics.ExecutionRefreshTasksFailedCount)
+	s.Logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID:      state.shardID,
+		logging.TagDomainID:            execution.DomainID,
+		logging.TagWorkflowExecutionID: execution.WorkflowID,
+		logging.TagWorkflowRunID:       execution.RunID,
+		logging.TagErr:                 err,
+	}).Error("failed to refresh execution tasks")
+}
+
+func (s *Scavenger) onShardFailed(state *shardState, err error) {
+	if state.db != nil {
+		state.db.Close()
+	}
+	s.report.addShardFailed()
+	s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, metrics.ShardScanFailedCount)
+	s.Logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID: state.shardID,
+		logging.TagErr:            err,
+	}).Error("failed to scan shard")
+}
+
+func (s *Scavenger) logCheckError(state *shardState, domainID string, workflowID string, runID string, err error) {
+	s.Logger.WithFields(bark.Fields{
+		logging.TagHistoryShardID:      state.shardID,
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: workflowID,
+		logging.TagWorkflowRunID:       runID,
+		logging.TagErr:                 err,
+	}).Error("failed to validate execution")
+}
@@ -0,0 +1,350 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/scanner/executor"
+)
+
+type (
+	// ScavengerParams contains the set of dependencies needed by the executions scavenger
+	ScavengerParams struct {
+		// NumShards is the total number of history shards in the cluster
+		NumShards int
+		// ExecutionDBs creates the execution manager for each of the shards
+		ExecutionDBs p.ExecutionManagerFactory
+		// HistoryDB is the history manager for events v1
+		HistoryDB p.HistoryManager
+		// HistoryV2DB is the history manager for events v2
+		HistoryV2DB p.HistoryV2Manager
+		// VisibilityDB is the visibility manager
+		VisibilityDB p.VisibilityManager
+		// DomainDB is the metadata manager used to read domain retention
+		DomainDB p.MetadataManager
+		// FixEnabled indicates if the corruptions found should be fixed
+		FixEnabled dynamicconfig.BoolPropertyFn
+		// HistoryClient is the client used to refresh the tasks of open executions
+		HistoryClient history.Client
+		// RefreshTasksEnabled indicates if the transfer and timer tasks of the open executions
+		// scanned should be regenerated by the history service
+		RefreshTasksEnabled dynamicconfig.BoolPropertyFn
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		// Logger is an instance of bark logger
+		Logger bark.Logger
+	}
+
+	// Scavenger is the type that holds the state for executions scavenger daemon
+	Scavenger struct {
+		ScavengerParams
+		executor executor.Executor
+		report   *reportBuilder
+		domains  *domainRetentionCache
+		status   int32
+		stopC    chan struct{}
+		stopWG   sync.WaitGroup
+	}
+
+	// CorruptionType is the type of a corruption found by the executions scavenger
+	CorruptionType int
+
+	// CorruptedExecution is a single corrupted execution found by the scavenger
+	CorruptedExecution struct {
+		ShardID    int
+		DomainID   string
+		WorkflowID string
+		RunID      string
+		Type       string
+		Fixed      bool
+	}
+
+	// Report is the summary of a single run of the executions scavenger
+	Report struct {
+		StartTime                time.Time
+		EndTime                  time.Time
+		FixEnabled               bool
+		NumShards                int
+		ShardsFailed             int
+		ExecutionsScanned        int64
+		CurrentExecutionsScanned int64
+		// TasksRefreshed is the number of open executions whose tasks were regenerated
+		TasksRefreshed int64
+		// Corrupted is the number of corrupted executions found, keyed by corruption type
+		Corrupted map[string]int64
+		// Fixed is the number of corrupted executions fixed, keyed by corruption type
+		Fixed map[string]int64
+		// Samples contains up to maxReportSamples of the corrupted executions found
+		Samples []CorruptedExecution
+	}
+
+	reportBuilder struct {
+		sync.Mutex
+		report Report
+	}
+
+	// executorTask is a runnable task that adheres to the executor.Task interface
+	// for the scavenger, each of this task scans a single shard
+	executorTask struct {
+		shardState
+		scvg *Scavenger
+	}
+)
+
+const (
+	// CorruptionTypeMissingHistory is an open execution whose history is gone
+	CorruptionTypeMissingHistory CorruptionType = iota
+	// CorruptionTypeDanglingCurrentExecution is a current execution row pointing to a run that does not exist
+	CorruptionTypeDanglingCurrentExecution
+	// CorruptionTypeRetentionExpired is a closed execution that is still around long after its domain retention
+	CorruptionTypeRetentionExpired
+	// CorruptionTypeMissingVisibility is an open execution without an open visibility record
+	CorruptionTypeMissingVisibility
+)
+
+var (
+	executionBatchSize       = 100             // number of executions we read from persistence in one call
+	maxPagesPerJob           = 10              // maximum number of pages we process for a shard as part of a single job
+	nWorkers                 = 8               // number of go routines scanning shards
+	maxReportSamples         = 100             // maximum number of corrupted executions recorded in the report
+	retentionGracePeriod     = 24 * time.Hour  // amount of time past retention before a closed execution is considered corrupted
+	visibilityGracePeriod    = time.Hour       // amount of time an open execution has to show up in visibility
+	visibilityStartTimeDelta = 5 * time.Minute // slack used when looking up the visibility record by start time
+	executorPollInterval     = time.Minute
+	executorMaxDeferredTasks = 10000
+	refreshTasksTimeout      = 10 * time.Second
+)
+
+// String returns the string representation of the corruption type
+func (c CorruptionType) String() string {
+	switch c {
+	case CorruptionTypeMissingHistory:
+		return "MissingHistory"
+	case CorruptionTypeDanglingCurrentExecution:
+		return "DanglingCurrentExecution"
+	case CorruptionTypeRetentionExpired:
+		return "RetentionExpired"
+	case CorruptionTypeMissingVisibility:
+		return "MissingVisibility"
+	default:
+		return "Unknown"
+	}
+}
+
+// NewScavenger returns an instance of executions scavenger daemon
+// The Scavenger can be started by calling the Start() method on the
+// returned object. Calling the Start() method will result in one
+// complete iteration over all of the executions of all shards in the
+// system. Each concrete execution is validated against history and
+// visibility, and each current execution row is validated against the
+// run it points to. The corruptions detected are
+//   - open executions whose history is gone
+//   - current executions pointing to a run that does not exist
+//   - closed executions that outlived their retention
+//   - open executions missing from visibility
+//
+// When FixEnabled is true, the scavenger deletes the corrupted executions
+// (with the exception of missing visibility records, which are only reported)
+//
+// When RefreshTasksEnabled is true, the scavenger also asks the history service
+// to regenerate the transfer and timer tasks of every healthy open execution,
+// which recovers executions stuck because of lost tasks
+//
+// The scavenger stops under two conditions
+//   - either all shards are processed (or)
+//   - Stop() method is called to stop the scavenger
+func NewScavenger(params *ScavengerParams) *Scavenger {
+	taskExecutor := executor.NewFixedSizePoolExecutor(
+		nWorkers, executorMaxDeferredTasks, params.MetricsClient, metrics.ExecutionsScavengerScope)
+	return &Scavenger{
+		ScavengerParams: *params,
+		executor:        taskExecutor,
+		report: &reportBuilder{
+			report: Report{
+				NumShards: params.NumShards,
+				Corrupted: make(map[string]int64),
+				Fixed:     make(map[string]int64),
+			},
+		},
+		domains: newDomainRetentionCache(params.DomainDB),
+		stopC:   make(chan struct{}),
+	}
+}
+
+// Start starts the scavenger
+func (s *Scavenger) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	s.Logger.Info("Executions scavenger starting")
+	s.report.start(s.FixEnabled())
+	s.stopWG.Add(1)
+	s.executor.Start()
+	go s.run()
+	s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, metrics.StartedCount)
+	s.Logger.Info("Executions scavenger started")
+}
+
+// Stop stops the scavenger
+func (s *Scavenger) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	s.MetricsClient.IncCounter(metrics.ExecutionsScavengerScope, metrics.StoppedCount)
+	s.Logger.Info("Executions scavenger stopping")
+	close(s.stopC)
+	s.executor.Stop()
+	s.stopWG.Wait()
+	s.Logger.Info("Executions scavenger stopped")
+}
+
+// Alive returns true if the scavenger is still running
+func (s *Scavenger) Alive() bool {
+	return atomic.LoadInt32(&s.status) == common.DaemonStatusStarted
+}
+
+// Report returns a snapshot of the report of the current run
+func (s *Scavenger) Report() Report {
+	return s.report.snapshot()
+}
+
+// run does a single run over all shards
+func (s *Scavenger) run() {
+	defer func() {
+		s.report.finish()
+		s.emitStats()
+		go s.Stop()
+		s.stopWG.Done()
+	}()
+
+	for shardID := 0; shardID < s.NumShards; shardID++ {
+		if !s.executor.Submit(s.newTask(shardID)) {
+			return
+		}
+	}
+
+	s.awaitExecutor()
+}
+
+// process is a callback function that gets invoked from within the executor.Run() method
+func (s *Scavenger) process(state *shardState) executor.TaskStatus {
+	return s.scanHandler(state)
+}
+
+func (s *Scavenger) awaitExecutor() {
+	outstanding := s.executor.TaskCount()
+	for outstanding > 0 {
+		select {
+		case <-time.After(executorPollInterval):
+			outstanding = s.executor.TaskCount()
+		case <-s.stopC:
+			return
+		}
+	}
+}
+
+func (s *Scavenger) emitStats() {
+	report := s.report.snapshot()
+	s.MetricsClient.UpdateGauge(metrics.ExecutionsScavengerScope, metrics.ExecutionProcessedCount, float64(report.ExecutionsScanned))
+	s.MetricsClient.UpdateGauge(metrics.ExecutionsScavengerScope, metrics.CurrentExecutionProcessedCount, float64(report.CurrentExecutionsScanned))
+}
+
+// newTask returns a new instance of an executable task which will scan a single shard
+func (s *Scavenger) newTask(shardID int) executor.Task {
+	return &executorTask{
+		shardState: shardState{shardID: shardID},
+		scvg:       s,
+	}
+}
+
+// Run runs the task
+func (t *executorTask) Run() executor.TaskStatus {
+	return t.scvg.process(&t.shardState)
+}
+
+func (r *reportBuilder) start(fixEnabled bool) {
+	r.Lock()
+	defer r.Unlock()
+	r.report.StartTime = time.Now()
+	r.report.FixEnabled = fixEnabled
+}
+
+func (r *reportBuilder) finish() {
+	r.Lock()
+	defer r.Unlock()
+	r.report.EndTime = time.Now()
+}
+
+func (r *reportBuilder) addScanned(nExecutions int, nCurrentExecutions int) {
+	r.Lock()
+	defer r.Unlock()
+	r.report.ExecutionsScanned += int64(nExecutions)
+	r.report.CurrentExecutionsScanned += int64(nCurrentExecutions)
+}
+
+func (r *reportBuilder) addTasksRefreshed() {
+	r.Lock()
+	defer r.Unlock()
+	r.report.TasksRefreshed++
+}
+
+func (r *reportBuilder) addShardFailed() {
+	r.Lock()
+	defer r.Unlock()
+	r.report.ShardsFailed++
+}
+
+func (r *reportBuilder) addCorrupted(execution CorruptedExecution) {
+	r.Lock()
+	defer r.Unlock()
+	r.report.Corrupted[execution.Type]++
+	if execution.Fixed {
+		r.report.Fixed[execution.Type]++
+	}
+	if len(r.report.Samples) < maxReportSamples {
+		r.report.Samples = append(r.report.Samples, execution)
+	}
+}
+
+func (r *reportBuilder) snapshot() Report {
+	r.Lock()
+	defer r.Unlock()
+	report := r.report
+	report.Corrupted = make(map[string]int64, len(r.report.Corrupted))
+	for k, v := range r.report.Corrupted {
+		report.Corrupted[k] = v
+	}
+	report.Fixed = make(map[string]int64, len(r.report.Fixed))
+	for k, v := range r.report.Fixed {
+		report.Fixed[k] = v
+	}
+	report.Samples = append([]CorruptedExecution(nil), r.report.Samples...)
+	return report
+}
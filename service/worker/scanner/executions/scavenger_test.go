@@ -0,0 +1,342 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package executions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/history"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		executionDBs  *mocks.ExecutionManagerFactory
+		shardDBs      []*mocks.ExecutionManager
+		historyDB     *mocks.HistoryManager
+		historyV2DB   *mocks.HistoryV2Manager
+		visibilityDB  *mocks.VisibilityManager
+		domainDB      *mocks.MetadataManager
+		historyClient *mocks.HistoryClient
+		fixEnabled    bool
+		refreshTasks  bool
+	}
+)
+
+const (
+	testNumShards  = 2
+	testDomainID   = "test-domain-id"
+	testDomainName = "test-domain"
+	testWorkflowID = "test-workflow-id"
+	testRunID      = "test-run-id"
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.executionDBs = &mocks.ExecutionManagerFactory{}
+	s.historyDB = &mocks.HistoryManager{}
+	s.historyV2DB = &mocks.HistoryV2Manager{}
+	s.visibilityDB = &mocks.VisibilityManager{}
+	s.domainDB = &mocks.MetadataManager{}
+	s.historyClient = &mocks.HistoryClient{}
+	s.fixEnabled = false
+	s.refreshTasks = false
+	s.shardDBs = nil
+	for i := 0; i < testNumShards; i++ {
+		db := &mocks.ExecutionManager{}
+		db.On("Close").Return()
+		s.executionDBs.On("NewExecutionManager", i).Return(db, nil)
+		s.shardDBs = append(s.shardDBs, db)
+	}
+	s.domainDB.On("GetDomain", &p.GetDomainRequest{ID: testDomainID}).Return(&p.GetDomainResponse{
+		Info:   &p.DomainInfo{ID: testDomainID, Name: testDomainName},
+		Config: &p.DomainConfig{Retention: 1},
+	}, nil)
+	executorPollInterval = time.Millisecond * 10
+}
+
+func (s *ScavengerTestSuite) TestNoCorruptions() {
+	s.setupConcreteExecutions(0, s.openExecution(time.Now().Add(-2*visibilityGracePeriod)))
+	s.setupCurrentExecutions(0, s.currentExecution())
+	s.shardDBs[0].On("GetWorkflowExecution", mock.Anything).Return(&p.GetWorkflowExecutionResponse{}, nil)
+	s.historyV2DB.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{{EventId: common.Int64Ptr(common.FirstEventID)}},
+	}, nil)
+	s.setupOpenVisibility(true)
+
+	report := s.runScavenger()
+	s.Equal(testNumShards, report.NumShards)
+	s.Equal(0, report.ShardsFailed)
+	s.Equal(int64(1), report.ExecutionsScanned)
+	s.Equal(int64(1), report.CurrentExecutionsScanned)
+	s.Empty(report.Corrupted)
+	s.Empty(report.Samples)
+}
+
+func (s *ScavengerTestSuite) TestMissingHistory_Fixed() {
+	s.fixEnabled = true
+	s.setupConcreteExecutions(1, s.openExecution(time.Now()))
+	s.historyV2DB.On("ReadHistoryBranch", mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+	s.shardDBs[1].On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		DomainID: testDomainID, WorkflowID: testWorkflowID, RunID: testRunID,
+	}).Return(nil).Once()
+	s.shardDBs[1].On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID: testDomainID, WorkflowID: testWorkflowID, RunID: testRunID,
+	}).Return(nil).Once()
+
+	report := s.runScavenger()
+	s.True(report.FixEnabled)
+	s.Equal(int64(1), report.Corrupted[CorruptionTypeMissingHistory.String()])
+	s.Equal(int64(1), report.Fixed[CorruptionTypeMissingHistory.String()])
+	s.Equal([]CorruptedExecution{{
+		ShardID:    1,
+		DomainID:   testDomainID,
+		WorkflowID: testWorkflowID,
+		RunID:      testRunID,
+		Type:       CorruptionTypeMissingHistory.String(),
+		Fixed:      true,
+	}}, report.Samples)
+	s.shardDBs[1].AssertExpectations(s.T())
+	s.historyV2DB.AssertNotCalled(s.T(), "DeleteHistoryBranch", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestDanglingCurrentExecution_NotFixed() {
+	s.setupCurrentExecutions(0, s.currentExecution())
+	s.shardDBs[0].On("GetWorkflowExecution", mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+
+	report := s.runScavenger()
+	s.False(report.FixEnabled)
+	s.Equal(int64(1), report.Corrupted[CorruptionTypeDanglingCurrentExecution.String()])
+	s.Empty(report.Fixed)
+	s.Len(report.Samples, 1)
+	s.False(report.Samples[0].Fixed)
+	s.shardDBs[0].AssertNotCalled(s.T(), "DeleteCurrentWorkflowExecution", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestDanglingCurrentExecution_Fixed() {
+	s.fixEnabled = true
+	s.setupCurrentExecutions(0, s.currentExecution())
+	s.shardDBs[0].On("GetWorkflowExecution", mock.Anything).Return(nil, &shared.EntityNotExistsError{})
+	s.shardDBs[0].On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID: testDomainID, WorkflowID: testWorkflowID, RunID: testRunID,
+	}).Return(nil).Once()
+
+	report := s.runScavenger()
+	s.Equal(int64(1), report.Fixed[CorruptionTypeDanglingCurrentExecution.String()])
+	s.shardDBs[0].AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestRetentionExpired_Fixed() {
+	s.fixEnabled = true
+	execution := s.openExecution(time.Now().Add(-10 * 24 * time.Hour))
+	execution.State = p.WorkflowStateCompleted
+	execution.EventStoreVersion = 0
+	execution.BranchToken = nil
+	execution.LastUpdatedTime = time.Now().Add(-5 * 24 * time.Hour)
+	s.setupConcreteExecutions(0, execution)
+	s.historyDB.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+	s.shardDBs[0].On("DeleteWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.shardDBs[0].On("DeleteCurrentWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	report := s.runScavenger()
+	s.Equal(int64(1), report.Corrupted[CorruptionTypeRetentionExpired.String()])
+	s.Equal(int64(1), report.Fixed[CorruptionTypeRetentionExpired.String()])
+	s.historyDB.AssertExpectations(s.T())
+	s.shardDBs[0].AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestWithinRetention() {
+	execution := s.openExecution(time.Now().Add(-10 * 24 * time.Hour))
+	execution.State = p.WorkflowStateCompleted
+	execution.LastUpdatedTime = time.Now().Add(-time.Hour)
+	s.setupConcreteExecutions(0, execution)
+
+	report := s.runScavenger()
+	s.Equal(int64(1), report.ExecutionsScanned)
+	s.Empty(report.Corrupted)
+}
+
+func (s *ScavengerTestSuite) TestMissingVisibility_OnlyReported() {
+	s.fixEnabled = true
+	s.setupConcreteExecutions(0, s.openExecution(time.Now().Add(-2*visibilityGracePeriod)))
+	s.historyV2DB.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{{EventId: common.Int64Ptr(common.FirstEventID)}},
+	}, nil)
+	s.setupOpenVisibility(false)
+
+	report := s.runScavenger()
+	s.Equal(int64(1), report.Corrupted[CorruptionTypeMissingVisibility.String()])
+	s.Empty(report.Fixed)
+	s.shardDBs[0].AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestRefreshTasks() {
+	s.refreshTasks = true
+	s.setupConcreteExecutions(0, s.openExecution(time.Now()))
+	s.historyV2DB.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{{EventId: common.Int64Ptr(common.FirstEventID)}},
+	}, nil)
+	s.historyClient.On("RefreshWorkflowTasks", mock.Anything, &history.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(testDomainID),
+		Request: &shared.RefreshWorkflowTasksRequest{
+			Domain: common.StringPtr(testDomainName),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(testWorkflowID),
+				RunId:      common.StringPtr(testRunID),
+			},
+		},
+	}).Return(nil).Once()
+
+	report := s.runScavenger()
+	s.Equal(int64(1), report.TasksRefreshed)
+	s.Empty(report.Corrupted)
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestRefreshTasks_DomainNotActive() {
+	s.refreshTasks = true
+	s.setupConcreteExecutions(0, s.openExecution(time.Now()))
+	s.historyV2DB.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*shared.HistoryEvent{{EventId: common.Int64Ptr(common.FirstEventID)}},
+	}, nil)
+	s.historyClient.On("RefreshWorkflowTasks", mock.Anything, mock.Anything).Return(&shared.DomainNotActiveError{}).Once()
+
+	report := s.runScavenger()
+	s.Equal(int64(0), report.TasksRefreshed)
+	s.historyClient.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestRefreshTasks_SkipsClosedExecutions() {
+	s.refreshTasks = true
+	execution := s.openExecution(time.Now().Add(-time.Hour))
+	execution.State = p.WorkflowStateCompleted
+	s.setupConcreteExecutions(0, execution)
+
+	report := s.runScavenger()
+	s.Equal(int64(0), report.TasksRefreshed)
+	s.historyClient.AssertNotCalled(s.T(), "RefreshWorkflowTasks", mock.Anything, mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestShardFailure() {
+	s.shardDBs[0].On("ListConcreteExecutions", mock.Anything).Return(nil, &shared.BadRequestError{})
+
+	report := s.runScavenger()
+	s.Equal(1, report.ShardsFailed)
+	s.Equal(int64(0), report.ExecutionsScanned)
+}
+
+func (s *ScavengerTestSuite) runScavenger() Report {
+	// shards without any executions set up return empty pages
+	for _, db := range s.shardDBs {
+		db.On("ListConcreteExecutions", mock.Anything).Return(&p.ListConcreteExecutionsResponse{}, nil)
+		db.On("ListCurrentExecutions", mock.Anything).Return(&p.ListCurrentExecutionsResponse{}, nil)
+	}
+	scvgr := NewScavenger(&ScavengerParams{
+		NumShards:           testNumShards,
+		ExecutionDBs:        s.executionDBs,
+		HistoryDB:           s.historyDB,
+		HistoryV2DB:         s.historyV2DB,
+		VisibilityDB:        s.visibilityDB,
+		DomainDB:            s.domainDB,
+		FixEnabled:          dynamicconfig.GetBoolPropertyFn(s.fixEnabled),
+		HistoryClient:       s.historyClient,
+		RefreshTasksEnabled: dynamicconfig.GetBoolPropertyFn(s.refreshTasks),
+		MetricsClient:       metrics.NewClient(tally.NoopScope, metrics.Worker),
+		Logger:              bark.NewLoggerFromLogrus(logrus.New()),
+	})
+	scvgr.Start()
+	timer := time.NewTimer(5 * time.Second)
+	defer timer.Stop()
+	for scvgr.Alive() {
+		select {
+		case <-timer.C:
+			s.Fail("timed out waiting for scavenger to finish")
+			scvgr.Stop()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	return scvgr.Report()
+}
+
+func (s *ScavengerTestSuite) openExecution(startTime time.Time) *p.ConcreteExecutionInfo {
+	return &p.ConcreteExecutionInfo{
+		DomainID:          testDomainID,
+		WorkflowID:        testWorkflowID,
+		RunID:             testRunID,
+		State:             p.WorkflowStateRunning,
+		NextEventID:       10,
+		EventStoreVersion: p.EventStoreVersionV2,
+		BranchToken:       []byte{1, 2, 3},
+		StartTimestamp:    startTime,
+		LastUpdatedTime:   startTime,
+	}
+}
+
+func (s *ScavengerTestSuite) currentExecution() *p.CurrentExecutionInfo {
+	return &p.CurrentExecutionInfo{
+		DomainID:     testDomainID,
+		WorkflowID:   testWorkflowID,
+		CurrentRunID: testRunID,
+		State:        p.WorkflowStateRunning,
+	}
+}
+
+func (s *ScavengerTestSuite) setupConcreteExecutions(shardID int, executions ...*p.ConcreteExecutionInfo) {
+	db := s.shardDBs[shardID]
+	db.On("ListConcreteExecutions", &p.ListConcreteExecutionsRequest{PageSize: executionBatchSize}).
+		Return(&p.ListConcreteExecutionsResponse{Executions: executions, NextPageToken: []byte{1}}, nil).Once()
+	db.On("ListConcreteExecutions", &p.ListConcreteExecutionsRequest{PageSize: executionBatchSize, PageToken: []byte{1}}).
+		Return(&p.ListConcreteExecutionsResponse{}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) setupCurrentExecutions(shardID int, executions ...*p.CurrentExecutionInfo) {
+	db := s.shardDBs[shardID]
+	db.On("ListCurrentExecutions", &p.ListCurrentExecutionsRequest{PageSize: executionBatchSize}).
+		Return(&p.ListCurrentExecutionsResponse{Executions: executions}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) setupOpenVisibility(exists bool) {
+	resp := &p.ListWorkflowExecutionsResponse{}
+	if exists {
+		resp.Executions = []*shared.WorkflowExecutionInfo{{
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(testWorkflowID),
+				RunId:      common.StringPtr(testRunID),
+			},
+		}}
+	}
+	s.visibilityDB.On("ListOpenWorkflowExecutionsByWorkflowID", mock.Anything).Return(resp, nil)
+}
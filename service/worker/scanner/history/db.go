@@ -0,0 +1,230 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/payload"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/archiver"
+)
+
+type (
+	// executionDBCache holds on to the execution managers of
+	// the shards for the duration of a single run
+	executionDBCache struct {
+		sync.Mutex
+		factory p.ExecutionManagerFactory
+		dbs     map[int]p.ExecutionManager
+	}
+)
+
+var (
+	retryForeverPolicy = newRetryForeverPolicy()
+	thriftEncoder      = codec.NewThriftRWEncoder()
+	blobstoreTimeout   = 30 * time.Second
+)
+
+func (s *Scavenger) listBranches(pageToken []byte) (*p.GetAllHistoryTreeBranchesResponse, error) {
+	var err error
+	var resp *p.GetAllHistoryTreeBranchesResponse
+	s.retryForever(func() error {
+		resp, err = s.HistoryV2DB.GetAllHistoryTreeBranches(&p.GetAllHistoryTreeBranchesRequest{
+			PageSize:      branchBatchSize,
+			NextPageToken: pageToken,
+		})
+		return err
+	})
+	return resp, err
+}
+
+// isOrphaned returns true if the given run does not exist or is not using the given branch,
+// runExists is false when the run does not exist
+func (s *Scavenger) isOrphaned(
+	db p.ExecutionManager,
+	domainID string,
+	workflowID string,
+	runID string,
+	branchID string,
+) (orphaned bool, runExists bool, err error) {
+	var resp *p.GetWorkflowExecutionResponse
+	err = s.retryForever(func() error {
+		var err error
+		resp, err = db.GetWorkflowExecution(&p.GetWorkflowExecutionRequest{
+			DomainID: domainID,
+			Execution: shared.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(runID),
+			},
+		})
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return true, false, nil
+		}
+		return false, false, err
+	}
+
+	branchToken := resp.State.ExecutionInfo.GetCurrentBranch()
+	if len(branchToken) == 0 {
+		return true, true, nil
+	}
+	var branch shared.HistoryBranch
+	if err := thriftEncoder.Decode(branchToken, &branch); err != nil {
+		return false, true, err
+	}
+	return branch.GetBranchID() != branchID, true, nil
+}
+
+// isAwaitingArchival returns true if the history of the given closed run is going to be archived
+// and has not been archived yet, the archiver deletes such history once it has been archived
+func (s *Scavenger) isAwaitingArchival(domainID string, workflowID string, runID string) (bool, error) {
+	if s.ClusterMetadata.ArchivalConfig().GetArchivalStatus() != cluster.ArchivalEnabled {
+		return false, nil
+	}
+	var resp *p.GetDomainResponse
+	err := s.retryForever(func() error {
+		var err error
+		resp, err = s.DomainDB.GetDomain(&p.GetDomainRequest{ID: domainID})
+		return err
+	})
+	if err != nil {
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	if resp.Config.ArchivalStatus != shared.ArchivalStatusEnabled {
+		return false, nil
+	}
+	if s.Blobstore == nil {
+		return true, nil
+	}
+	key, err := archiver.NewHistoryBlobKey(domainID, workflowID, runID, common.FirstBlobPageToken)
+	if err != nil {
+		return false, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), blobstoreTimeout)
+	defer cancel()
+	exists, err := s.Blobstore.Exists(ctx, resp.Config.ArchivalBucket, key)
+	if err != nil {
+		return false, err
+	}
+	return !exists, nil
+}
+
+// deleteBranch deletes the given branch, the part of the
+// branch shared with other branches of the tree is retained
+// and so are the payloads offloaded for the tree
+func (s *Scavenger) deleteBranch(domainID string, detail *p.HistoryBranchDetail) error {
+	var resp *p.GetHistoryTreeResponse
+	err := s.retryForever(func() error {
+		var err error
+		resp, err = s.HistoryV2DB.GetHistoryTree(&p.GetHistoryTreeRequest{
+			TreeID: detail.TreeID,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, branch := range resp.Branches {
+		if branch.GetBranchID() != detail.BranchID {
+			continue
+		}
+		branchToken, err := p.NewHistoryBranchTokenByBranch(branch)
+		if err != nil {
+			return err
+		}
+		err = s.retryForever(func() error {
+			return p.DeleteWorkflowExecutionHistoryV2(s.HistoryV2DB, branchToken, s.Logger)
+		})
+		if err != nil || s.PayloadOffloader == nil {
+			return err
+		}
+		return backoff.Retry(func() error {
+			return payload.DeleteHistoryTreePayloads(s.PayloadOffloader, s.HistoryV2DB, domainID, branchToken)
+		}, retryForeverPolicy, s.isPayloadDeletionRetryable)
+	}
+	// the branch is already gone
+	return nil
+}
+
+func (s *Scavenger) retryForever(op func() error) error {
+	return backoff.Retry(op, retryForeverPolicy, s.isRetryable)
+}
+
+func (s *Scavenger) isPayloadDeletionRetryable(err error) bool {
+	return s.isRetryable(err) || (s.Alive() && s.Blobstore != nil && s.Blobstore.IsRetryableError(err))
+}
+
+func newRetryForeverPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(250 * time.Millisecond)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	policy.SetMaximumInterval(30 * time.Second)
+	return policy
+}
+
+func (s *Scavenger) isRetryable(err error) bool {
+	return s.Alive() && common.IsPersistenceTransientError(err)
+}
+
+func newExecutionDBCache(factory p.ExecutionManagerFactory) *executionDBCache {
+	return &executionDBCache{
+		factory: factory,
+		dbs:     make(map[int]p.ExecutionManager),
+	}
+}
+
+// get returns the execution manager for the given shard
+func (c *executionDBCache) get(shardID int) (p.ExecutionManager, error) {
+	c.Lock()
+	defer c.Unlock()
+	if db, ok := c.dbs[shardID]; ok {
+		return db, nil
+	}
+	db, err := c.factory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	c.dbs[shardID] = db
+	return db, nil
+}
+
+// close closes all of the execution managers
+func (c *executionDBCache) close() {
+	c.Lock()
+	defer c.Unlock()
+	for shardID, db := range c.dbs {
+		db.Close()
+		delete(c.dbs, shardID)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/service/worker/scanner/executor"
+)
+
+type handlerStatus = executor.TaskStatus
+
+const (
+	handlerStatusDone = executor.TaskStatusDone
+	handlerStatusErr  = executor.TaskStatusErr
+)
+
+// validateHandler validates a single history branch against the mutable state of the
+// run that owns it, and deletes the branch when it turns out to be orphaned
+func (s *Scavenger) validateHandler(branch *p.HistoryBranchDetail) handlerStatus {
+	if time.Now().Sub(branch.ForkTime) < s.orphanAge {
+		return handlerStatusDone
+	}
+
+	logger := s.Logger.WithFields(bark.Fields{
+		logging.TagTreeID:   branch.TreeID,
+		logging.TagBranchID: branch.BranchID,
+	})
+
+	domainID, workflowID, runID, err := p.SplitHistoryGarbageCleanupInfo(branch.Info)
+	if err != nil {
+		s.MetricsClient.IncCounter(metrics.HistoryScavengerScope, metrics.HistoryBranchMalformedCount)
+		logger.WithFields(bark.Fields{logging.TagErr: err}).Warn("skipping history branch with malformed info")
+		return handlerStatusDone
+	}
+	logger = logger.WithFields(bark.Fields{
+		logging.TagDomainID:            domainID,
+		logging.TagWorkflowExecutionID: workflowID,
+		logging.TagWorkflowRunID:       runID,
+	})
+
+	db, err := s.executionDBs.get(common.WorkflowIDToHistoryShard(workflowID, s.NumShards))
+	if err != nil {
+		logger.WithFields(bark.Fields{logging.TagErr: err}).Error("failed to create execution manager")
+		return handlerStatusErr
+	}
+
+	orphaned, runExists, err := s.isOrphaned(db, domainID, workflowID, runID, branch.BranchID)
+	if err != nil {
+		logger.WithFields(bark.Fields{logging.TagErr: err}).Error("failed to validate history branch")
+		return handlerStatusErr
+	}
+	if !orphaned {
+		return handlerStatusDone
+	}
+	if !runExists {
+		awaitingArchival, err := s.isAwaitingArchival(domainID, workflowID, runID)
+		if err != nil {
+			logger.WithFields(bark.Fields{logging.TagErr: err}).Error("failed to check archival of history branch")
+			return handlerStatusErr
+		}
+		if awaitingArchival {
+			atomic.AddInt64(&s.stats.nArchival, 1)
+			return handlerStatusDone
+		}
+	}
+
+	atomic.AddInt64(&s.stats.nOrphaned, 1)
+	logger.WithFields(bark.Fields{
+		logging.TagDryRun: s.dryRun,
+	}).Info("found orphaned history branch")
+	if s.dryRun {
+		return handlerStatusDone
+	}
+
+	if err := s.deleteBranch(domainID, branch); err != nil {
+		s.MetricsClient.IncCounter(metrics.HistoryScavengerScope, metrics.HistoryBranchDeleteFailedCount)
+		logger.WithFields(bark.Fields{logging.TagErr: err}).Error("failed to delete orphaned history branch")
+		return handlerStatusErr
+	}
+	atomic.AddInt64(&s.stats.nDeleted, 1)
+	return handlerStatusDone
+}
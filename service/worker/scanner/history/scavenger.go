@@ -0,0 +1,239 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/uber-common/bark"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/scanner/executor"
+)
+
+type (
+	// ScavengerParams contains the set of dependencies needed by the history scavenger
+	ScavengerParams struct {
+		// NumShards is the total number of history shards in the cluster
+		NumShards int
+		// ExecutionDBs creates the execution manager for each of the shards
+		ExecutionDBs p.ExecutionManagerFactory
+		// HistoryV2DB is the history manager for events v2
+		HistoryV2DB p.HistoryV2Manager
+		// DomainDB is the metadata manager used to look up the archival config of domains
+		DomainDB p.MetadataManager
+		// ClusterMetadata contains the metadata for this cluster
+		ClusterMetadata cluster.Metadata
+		// Blobstore is the client of the archival blobstore, nil if no blobstore is configured
+		Blobstore blobstore.Client
+		// PayloadOffloader deletes the payloads offloaded for the history trees the scavenger empties,
+		// nil if payloads are not offloaded
+		PayloadOffloader payload.Offloader
+		// DryRun indicates if the orphaned branches should only be reported and not deleted
+		DryRun dynamicconfig.BoolPropertyFn
+		// OrphanAge is the minimum age of a branch before it is considered for deletion
+		OrphanAge dynamicconfig.DurationPropertyFn
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		// Logger is an instance of bark logger
+		Logger bark.Logger
+	}
+
+	// Scavenger is the type that holds the state for history scavenger daemon
+	Scavenger struct {
+		ScavengerParams
+		executor     executor.Executor
+		executionDBs *executionDBCache
+		dryRun       bool
+		orphanAge    time.Duration
+		stats        stats
+		status       int32
+		stopC        chan struct{}
+		stopWG       sync.WaitGroup
+	}
+
+	stats struct {
+		nProcessed int64
+		nOrphaned  int64
+		nDeleted   int64
+		nArchival  int64
+	}
+
+	// executorTask is a runnable task that adheres to the executor.Task interface
+	// for the scavenger, each of this task validates a single history branch
+	executorTask struct {
+		p.HistoryBranchDetail
+		scvg *Scavenger
+	}
+)
+
+var (
+	branchBatchSize          = 100 // number of branches we read from persistence in one call
+	nWorkers                 = 16  // number of go routines validating branches
+	executorPollInterval     = time.Minute
+	executorMaxDeferredTasks = 10000
+)
+
+// NewScavenger returns an instance of history scavenger daemon
+// The Scavenger can be started by calling the Start() method on the
+// returned object. Calling the Start() method will result in one
+// complete iteration over all of the history branches in the system.
+// Each branch older than OrphanAge is validated against the mutable
+// state of the run that owns it, the branch is orphaned when
+//   - the run does not exist (or)
+//   - the run is using a different branch
+//
+// Such branches are left behind by failed forks, aborted resets and
+// executions deleted without their history. When DryRun is false, the
+// orphaned branches are deleted, otherwise they are only reported
+//
+// The history of a closed run is deleted by the archiver after it has been
+// archived, so the branches of runs that no longer exist are left alone
+// while their domain has archival enabled and the history has not been
+// archived yet
+//
+// The scavenger will retry on all transient persistence errors infinitely
+// and will only stop under two conditions
+//   - either all branches are processed (or)
+//   - Stop() method is called to stop the scavenger
+func NewScavenger(params *ScavengerParams) *Scavenger {
+	taskExecutor := executor.NewFixedSizePoolExecutor(
+		nWorkers, executorMaxDeferredTasks, params.MetricsClient, metrics.HistoryScavengerScope)
+	return &Scavenger{
+		ScavengerParams: *params,
+		executor:        taskExecutor,
+		executionDBs:    newExecutionDBCache(params.ExecutionDBs),
+		stopC:           make(chan struct{}),
+	}
+}
+
+// Start starts the scavenger
+func (s *Scavenger) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	s.dryRun = s.DryRun()
+	s.orphanAge = s.OrphanAge()
+	s.Logger.WithFields(bark.Fields{
+		logging.TagDryRun: s.dryRun,
+	}).Info("History scavenger starting")
+	s.stopWG.Add(1)
+	s.executor.Start()
+	go s.run()
+	s.MetricsClient.IncCounter(metrics.HistoryScavengerScope, metrics.StartedCount)
+	s.Logger.Info("History scavenger started")
+}
+
+// Stop stops the scavenger
+func (s *Scavenger) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	s.MetricsClient.IncCounter(metrics.HistoryScavengerScope, metrics.StoppedCount)
+	s.Logger.Info("History scavenger stopping")
+	close(s.stopC)
+	s.executor.Stop()
+	s.stopWG.Wait()
+	s.executionDBs.close()
+	s.Logger.Info("History scavenger stopped")
+}
+
+// Alive returns true if the scavenger is still running
+func (s *Scavenger) Alive() bool {
+	return atomic.LoadInt32(&s.status) == common.DaemonStatusStarted
+}
+
+// run does a single run over all history branches
+func (s *Scavenger) run() {
+	defer func() {
+		s.emitStats()
+		go s.Stop()
+		s.stopWG.Done()
+	}()
+
+	var pageToken []byte
+	for {
+		resp, err := s.listBranches(pageToken)
+		if err != nil {
+			s.Logger.WithFields(bark.Fields{logging.TagErr: err}).Error("listBranches error")
+			return
+		}
+
+		for _, branch := range resp.Branches {
+			atomic.AddInt64(&s.stats.nProcessed, 1)
+			if !s.executor.Submit(s.newTask(branch)) {
+				return
+			}
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	s.awaitExecutor()
+}
+
+// process is a callback function that gets invoked from within the executor.Run() method
+func (s *Scavenger) process(branch *p.HistoryBranchDetail) executor.TaskStatus {
+	return s.validateHandler(branch)
+}
+
+func (s *Scavenger) awaitExecutor() {
+	outstanding := s.executor.TaskCount()
+	for outstanding > 0 {
+		select {
+		case <-time.After(executorPollInterval):
+			outstanding = s.executor.TaskCount()
+		case <-s.stopC:
+			return
+		}
+	}
+}
+
+func (s *Scavenger) emitStats() {
+	s.MetricsClient.UpdateGauge(metrics.HistoryScavengerScope, metrics.HistoryBranchProcessedCount, float64(atomic.LoadInt64(&s.stats.nProcessed)))
+	s.MetricsClient.UpdateGauge(metrics.HistoryScavengerScope, metrics.HistoryBranchOrphanedCount, float64(atomic.LoadInt64(&s.stats.nOrphaned)))
+	s.MetricsClient.UpdateGauge(metrics.HistoryScavengerScope, metrics.HistoryBranchDeletedCount, float64(atomic.LoadInt64(&s.stats.nDeleted)))
+	s.MetricsClient.UpdateGauge(metrics.HistoryScavengerScope, metrics.HistoryBranchAwaitingArchivalCount, float64(atomic.LoadInt64(&s.stats.nArchival)))
+}
+
+// newTask returns a new instance of an executable task which will validate a single history branch
+func (s *Scavenger) newTask(branch p.HistoryBranchDetail) executor.Task {
+	return &executorTask{
+		HistoryBranchDetail: branch,
+		scvg:                s,
+	}
+}
+
+// Run runs the task
+func (t *executorTask) Run() executor.TaskStatus {
+	return t.scvg.process(&t.HistoryBranchDetail)
+}
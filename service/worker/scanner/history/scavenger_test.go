@@ -0,0 +1,324 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/mocks"
+	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	ScavengerTestSuite struct {
+		suite.Suite
+		executionDBs *mocks.ExecutionManagerFactory
+		shardDBs     []*mocks.ExecutionManager
+		historyV2DB  *mocks.HistoryV2Manager
+		domainDB     *mocks.MetadataManager
+		blobstore    *mocks.BlobstoreClient
+		archival     cluster.ArchivalStatus
+		dryRun       bool
+	}
+)
+
+const (
+	testNumShards  = 4
+	testDomainID   = "test-domain-id"
+	testWorkflowID = "test:workflow:id"
+	testRunID      = "test-run-id"
+	testTreeID     = "test-tree-id"
+	testBranchID   = "test-branch-id"
+	testOrphanAge  = time.Hour
+	testBucket     = "test-bucket"
+)
+
+func TestScavengerTestSuite(t *testing.T) {
+	suite.Run(t, new(ScavengerTestSuite))
+}
+
+func (s *ScavengerTestSuite) SetupTest() {
+	s.executionDBs = &mocks.ExecutionManagerFactory{}
+	s.historyV2DB = &mocks.HistoryV2Manager{}
+	s.domainDB = &mocks.MetadataManager{}
+	s.blobstore = &mocks.BlobstoreClient{}
+	s.archival = cluster.ArchivalDisabled
+	s.dryRun = false
+	s.shardDBs = nil
+	for i := 0; i < testNumShards; i++ {
+		db := &mocks.ExecutionManager{}
+		db.On("Close").Return()
+		s.executionDBs.On("NewExecutionManager", i).Return(db, nil)
+		s.shardDBs = append(s.shardDBs, db)
+	}
+	executorPollInterval = time.Millisecond * 10
+}
+
+func (s *ScavengerTestSuite) TestYoungBranch() {
+	s.setupBranches(nil, s.branchDetail(time.Now()))
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nProcessed)
+	s.Equal(int64(0), stats.nOrphaned)
+	s.executionDBs.AssertNotCalled(s.T(), "NewExecutionManager", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestBranchInUse() {
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(s.mutableState(testBranchID), nil)
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nProcessed)
+	s.Equal(int64(0), stats.nOrphaned)
+	s.historyV2DB.AssertNotCalled(s.T(), "GetHistoryTree", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestMissingExecution_DryRun() {
+	s.dryRun = true
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(nil, &shared.EntityNotExistsError{})
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nOrphaned)
+	s.Equal(int64(0), stats.nDeleted)
+	s.historyV2DB.AssertNotCalled(s.T(), "GetHistoryTree", mock.Anything)
+	s.historyV2DB.AssertNotCalled(s.T(), "DeleteHistoryBranch", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestMissingExecution_Deleted() {
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(nil, &shared.EntityNotExistsError{})
+	s.setupDeleteBranch()
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nOrphaned)
+	s.Equal(int64(1), stats.nDeleted)
+	s.historyV2DB.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestMissingExecution_AwaitingArchival() {
+	s.archival = cluster.ArchivalEnabled
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(nil, &shared.EntityNotExistsError{})
+	s.setupDomain(shared.ArchivalStatusEnabled)
+	s.blobstore.On("Exists", mock.Anything, testBucket, mock.Anything).Return(false, nil)
+
+	stats := s.runScavenger()
+	s.Equal(int64(0), stats.nOrphaned)
+	s.Equal(int64(1), stats.nArchival)
+	s.historyV2DB.AssertNotCalled(s.T(), "GetHistoryTree", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestMissingExecution_Archived() {
+	s.archival = cluster.ArchivalEnabled
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(nil, &shared.EntityNotExistsError{})
+	s.setupDomain(shared.ArchivalStatusEnabled)
+	s.blobstore.On("Exists", mock.Anything, testBucket, mock.Anything).Return(true, nil)
+	s.setupDeleteBranch()
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nOrphaned)
+	s.Equal(int64(1), stats.nDeleted)
+	s.historyV2DB.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestMissingExecution_DomainArchivalDisabled() {
+	s.archival = cluster.ArchivalEnabled
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(nil, &shared.EntityNotExistsError{})
+	s.setupDomain(shared.ArchivalStatusDisabled)
+	s.setupDeleteBranch()
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nOrphaned)
+	s.Equal(int64(1), stats.nDeleted)
+	s.blobstore.AssertNotCalled(s.T(), "Exists", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestStaleBranch_Deleted() {
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(s.mutableState("another-branch-id"), nil)
+	s.setupDeleteBranch()
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nOrphaned)
+	s.Equal(int64(1), stats.nDeleted)
+	s.historyV2DB.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) TestBranchAlreadyDeleted() {
+	s.setupBranches(nil, s.branchDetail(time.Now().Add(-2*testOrphanAge)))
+	s.ownerDB().On("GetWorkflowExecution", s.getExecutionRequest()).Return(nil, &shared.EntityNotExistsError{})
+	s.historyV2DB.On("GetHistoryTree", &p.GetHistoryTreeRequest{TreeID: testTreeID}).Return(&p.GetHistoryTreeResponse{}, nil)
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nOrphaned)
+	s.Equal(int64(1), stats.nDeleted)
+	s.historyV2DB.AssertNotCalled(s.T(), "DeleteHistoryBranch", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestMalformedInfo() {
+	branch := s.branchDetail(time.Now().Add(-2 * testOrphanAge))
+	branch.Info = "malformed"
+	s.setupBranches(nil, branch)
+
+	stats := s.runScavenger()
+	s.Equal(int64(1), stats.nProcessed)
+	s.Equal(int64(0), stats.nOrphaned)
+	s.executionDBs.AssertNotCalled(s.T(), "NewExecutionManager", mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestPagination() {
+	young := s.branchDetail(time.Now())
+	s.historyV2DB.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
+		PageSize: branchBatchSize,
+	}).Return(&p.GetAllHistoryTreeBranchesResponse{
+		Branches:      []p.HistoryBranchDetail{young, young},
+		NextPageToken: []byte("next"),
+	}, nil).Once()
+	s.setupBranches([]byte("next"), young)
+
+	stats := s.runScavenger()
+	s.Equal(int64(3), stats.nProcessed)
+	s.historyV2DB.AssertExpectations(s.T())
+}
+
+func (s *ScavengerTestSuite) runScavenger() stats {
+	clusterMetadata := &mocks.ClusterMetadata{}
+	if s.archival == cluster.ArchivalDisabled {
+		clusterMetadata.On("ArchivalConfig").Return(cluster.NewArchivalConfig(s.archival, "", false))
+	} else {
+		clusterMetadata.On("ArchivalConfig").Return(cluster.NewArchivalConfig(s.archival, testBucket, false))
+	}
+	scvgr := NewScavenger(&ScavengerParams{
+		NumShards:       testNumShards,
+		ExecutionDBs:    s.executionDBs,
+		HistoryV2DB:     s.historyV2DB,
+		DomainDB:        s.domainDB,
+		ClusterMetadata: clusterMetadata,
+		Blobstore:       s.blobstore,
+		DryRun:          dynamicconfig.GetBoolPropertyFn(s.dryRun),
+		OrphanAge:       dynamicconfig.GetDurationPropertyFn(testOrphanAge),
+		MetricsClient:   metrics.NewClient(tally.NoopScope, metrics.Worker),
+		Logger:          bark.NewLoggerFromLogrus(logrus.New()),
+	})
+	scvgr.Start()
+	timer := time.NewTimer(5 * time.Second)
+	defer timer.Stop()
+	for scvgr.Alive() {
+		select {
+		case <-timer.C:
+			s.Fail("timed out waiting for scavenger to finish")
+			scvgr.Stop()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	return scvgr.stats
+}
+
+func (s *ScavengerTestSuite) setupDomain(archivalStatus shared.ArchivalStatus) {
+	s.domainDB.On("GetDomain", &p.GetDomainRequest{ID: testDomainID}).Return(&p.GetDomainResponse{
+		Info: &p.DomainInfo{ID: testDomainID},
+		Config: &p.DomainConfig{
+			ArchivalStatus: archivalStatus,
+			ArchivalBucket: testBucket,
+		},
+	}, nil)
+}
+
+func (s *ScavengerTestSuite) setupBranches(pageToken []byte, branches ...p.HistoryBranchDetail) {
+	s.historyV2DB.On("GetAllHistoryTreeBranches", &p.GetAllHistoryTreeBranchesRequest{
+		PageSize:      branchBatchSize,
+		NextPageToken: pageToken,
+	}).Return(&p.GetAllHistoryTreeBranchesResponse{
+		Branches: branches,
+	}, nil).Once()
+}
+
+func (s *ScavengerTestSuite) setupDeleteBranch() {
+	branch := &shared.HistoryBranch{
+		TreeID:    common.StringPtr(testTreeID),
+		BranchID:  common.StringPtr(testBranchID),
+		Ancestors: []*shared.HistoryBranchRange{},
+	}
+	s.historyV2DB.On("GetHistoryTree", &p.GetHistoryTreeRequest{TreeID: testTreeID}).Return(&p.GetHistoryTreeResponse{
+		Branches: []*shared.HistoryBranch{
+			{TreeID: common.StringPtr(testTreeID), BranchID: common.StringPtr("another-branch-id")},
+			branch,
+		},
+	}, nil)
+	branchToken, err := p.NewHistoryBranchTokenByBranch(branch)
+	s.NoError(err)
+	s.historyV2DB.On("DeleteHistoryBranch", &p.DeleteHistoryBranchRequest{BranchToken: branchToken}).Return(nil).Once()
+}
+
+func (s *ScavengerTestSuite) branchDetail(forkTime time.Time) p.HistoryBranchDetail {
+	return p.HistoryBranchDetail{
+		TreeID:   testTreeID,
+		BranchID: testBranchID,
+		ForkTime: forkTime,
+		Info:     p.BuildHistoryGarbageCleanupInfo(testDomainID, testWorkflowID, testRunID),
+	}
+}
+
+func (s *ScavengerTestSuite) ownerDB() *mocks.ExecutionManager {
+	return s.shardDBs[common.WorkflowIDToHistoryShard(testWorkflowID, testNumShards)]
+}
+
+func (s *ScavengerTestSuite) getExecutionRequest() *p.GetWorkflowExecutionRequest {
+	return &p.GetWorkflowExecutionRequest{
+		DomainID: testDomainID,
+		Execution: shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(testWorkflowID),
+			RunId:      common.StringPtr(testRunID),
+		},
+	}
+}
+
+func (s *ScavengerTestSuite) mutableState(branchID string) *p.GetWorkflowExecutionResponse {
+	branchToken, err := p.NewHistoryBranchTokenByBranch(&shared.HistoryBranch{
+		TreeID:   common.StringPtr(testTreeID),
+		BranchID: common.StringPtr(branchID),
+	})
+	s.NoError(err)
+	return &p.GetWorkflowExecutionResponse{
+		State: &p.WorkflowMutableState{
+			ExecutionInfo: &p.WorkflowExecutionInfo{
+				DomainID:    testDomainID,
+				WorkflowID:  testWorkflowID,
+				RunID:       testRunID,
+				BranchToken: branchToken,
+			},
+		},
+	}
+}
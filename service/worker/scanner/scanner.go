@@ -27,12 +27,15 @@ import (
 
 	"github.com/uber-common/bark"
 	"github.com/uber-go/tally"
+	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/public"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
 	p "github.com/uber/cadence/common/persistence"
 	pfactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service/config"
@@ -52,6 +55,18 @@ type (
 		Persistence *config.Persistence
 		// ClusterMetadata contains the metadata for this cluster
 		ClusterMetadata cluster.Metadata
+		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
+		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// ExecutionsScannerFixEnabled indicates if executions scanner should fix the corruptions it finds
+		ExecutionsScannerFixEnabled dynamicconfig.BoolPropertyFn
+		// ExecutionsScannerRefreshTasksEnabled indicates if executions scanner should regenerate the tasks of open executions
+		ExecutionsScannerRefreshTasksEnabled dynamicconfig.BoolPropertyFn
+		// HistoryScannerEnabled indicates if history scanner should be started as part of scanner
+		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
+		// HistoryScannerDryRun indicates if history scanner should only report the orphaned branches it finds
+		HistoryScannerDryRun dynamicconfig.BoolPropertyFn
+		// HistoryScannerOrphanAge is the minimum age of a history branch before it can be deleted by history scanner
+		HistoryScannerOrphanAge dynamicconfig.DurationPropertyFn
 	}
 
 	// BootstrapParams contains the set of params needed to bootstrap
@@ -61,6 +76,12 @@ type (
 		Config Config
 		// SDKClient is an instance of cadence sdk client
 		SDKClient public.Client
+		// HistoryClient is the client used to call the history service
+		HistoryClient history.Client
+		// BlobstoreClient is the client of the archival blobstore, nil if no blobstore is configured
+		BlobstoreClient blobstore.Client
+		// PayloadOffloadConfig is the config of the payloads offloaded to the blobstore, nil if payloads are not offloaded
+		PayloadOffloadConfig *payload.Config
 		// MetricsClient is an instance of metrics object for emitting stats
 		MetricsClient metrics.Client
 		// Logger is an instance of bark logger
@@ -74,6 +95,13 @@ type (
 	scannerContext struct {
 		taskDB        p.TaskManager
 		domainDB      p.MetadataManager
+		executionDBs  p.ExecutionManagerFactory
+		historyDB     p.HistoryManager
+		historyV2DB   p.HistoryV2Manager
+		visibilityDB  p.VisibilityManager
+		historyClient history.Client
+		blobstore     blobstore.Client
+		payloadConfig *payload.Config
 		cfg           Config
 		sdkClient     public.Client
 		metricsClient metrics.Client
@@ -106,6 +134,9 @@ func New(params *BootstrapParams) *Scanner {
 		context: scannerContext{
 			cfg:           cfg,
 			sdkClient:     params.SDKClient,
+			historyClient: params.HistoryClient,
+			blobstore:     params.BlobstoreClient,
+			payloadConfig: params.PayloadOffloadConfig,
 			metricsClient: params.MetricsClient,
 			logger:        params.Logger,
 			tallyScope:    params.TallyScope,
@@ -126,35 +157,66 @@ func (s *Scanner) Start() error {
 		MaxConcurrentDecisionTaskExecutionSize: maxConcurrentDecisionTaskExecutionSize,
 		BackgroundActivityContext:              context.WithValue(context.Background(), scannerContextKey, s.context),
 	}
-	go s.startWorkflowWithRetry()
-	worker := worker.New(s.context.sdkClient, common.SystemDomainName, tlScannerTaskListName, workerOpts)
-	return worker.Start()
+	if s.context.cfg.taskListScannerEnabled() {
+		go s.startWorkflowWithRetry(tlScannerWFStartOptions, tlScannerWFTypeName)
+		worker := worker.New(s.context.sdkClient, common.SystemDomainName, tlScannerTaskListName, workerOpts)
+		if err := worker.Start(); err != nil {
+			return err
+		}
+	}
+	if s.context.cfg.ExecutionsScannerEnabled() {
+		go s.startWorkflowWithRetry(executionsScannerWFStartOptions, executionsScannerWFTypeName)
+		worker := worker.New(s.context.sdkClient, common.SystemDomainName, executionsScannerTaskListName, workerOpts)
+		if err := worker.Start(); err != nil {
+			return err
+		}
+	}
+	if s.context.cfg.HistoryScannerEnabled() {
+		go s.startWorkflowWithRetry(historyScannerWFStartOptions, historyScannerWFTypeName)
+		worker := worker.New(s.context.sdkClient, common.SystemDomainName, historyScannerTaskListName, workerOpts)
+		if err := worker.Start(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Enabled returns true if at least one of the scanner workflows
+// is enabled for the given configuration
+func (cfg *Config) Enabled() bool {
+	return cfg.taskListScannerEnabled() || cfg.ExecutionsScannerEnabled() || cfg.HistoryScannerEnabled()
+}
+
+// taskListScannerEnabled returns true if the task list scanner can
+// run against the configured persistence store
+func (cfg *Config) taskListScannerEnabled() bool {
+	return cfg.Persistence.DefaultStoreType() == config.StoreTypeSQL
 }
 
-func (s *Scanner) startWorkflowWithRetry() error {
+func (s *Scanner) startWorkflowWithRetry(options cclient.StartWorkflowOptions, workflowType string) error {
 	client := cclient.NewClient(s.context.sdkClient, common.SystemDomainName, &cclient.Options{})
 	policy := backoff.NewExponentialRetryPolicy(time.Second)
 	policy.SetMaximumInterval(time.Minute)
 	policy.SetExpirationInterval(backoff.NoInterval)
 	return backoff.Retry(func() error {
-		return s.startWorkflow(client)
+		return s.startWorkflow(client, options, workflowType)
 	}, policy, func(err error) bool {
 		return true
 	})
 }
 
-func (s *Scanner) startWorkflow(client cclient.Client) error {
+func (s *Scanner) startWorkflow(client cclient.Client, options cclient.StartWorkflowOptions, workflowType string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
-	_, err := client.StartWorkflow(ctx, tlScannerWFStartOptions, tlScannerWFTypeName)
+	_, err := client.StartWorkflow(ctx, options, workflowType)
 	cancel()
 	if err != nil {
 		if _, ok := err.(*shared.WorkflowExecutionAlreadyStartedError); ok {
 			return nil
 		}
-		s.context.logger.WithFields(bark.Fields{logging.TagErr: err}).Error("error starting scanner workflow")
+		s.context.logger.WithFields(bark.Fields{logging.TagErr: err}).Errorf("error starting scanner workflow %v", workflowType)
 		return err
 	}
-	s.context.logger.Info("Scanner workflow successfully started")
+	s.context.logger.Infof("Scanner workflow %v successfully started", workflowType)
 	return nil
 }
 
@@ -171,5 +233,27 @@ func (s *Scanner) buildContext() error {
 	}
 	s.context.taskDB = taskDB
 	s.context.domainDB = domainDB
+	if !cfg.ExecutionsScannerEnabled() && !cfg.HistoryScannerEnabled() {
+		return nil
+	}
+	historyV2DB, err := pFactory.NewHistoryV2Manager()
+	if err != nil {
+		return err
+	}
+	s.context.executionDBs = pFactory
+	s.context.historyV2DB = historyV2DB
+	if !cfg.ExecutionsScannerEnabled() {
+		return nil
+	}
+	historyDB, err := pFactory.NewHistoryManager()
+	if err != nil {
+		return err
+	}
+	visibilityDB, err := pFactory.NewVisibilityManager()
+	if err != nil {
+		return err
+	}
+	s.context.historyDB = historyDB
+	s.context.visibilityDB = visibilityDB
 	return nil
 }
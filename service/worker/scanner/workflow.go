@@ -29,6 +29,9 @@ import (
 	cclient "go.uber.org/cadence/client"
 	"go.uber.org/cadence/workflow"
 
+	"github.com/uber/cadence/common/payload"
+	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/uber/cadence/service/worker/scanner/history"
 	"github.com/uber/cadence/service/worker/scanner/tasklist"
 )
 
@@ -45,6 +48,20 @@ const (
 	tlScannerWFTypeName           = "cadence-sys-tl-scanner-workflow"
 	tlScannerTaskListName         = "cadence-sys-tl-scanner-tasklist-0"
 	taskListScavengerActivityName = "cadence-sys-tl-scanner-scvg-activity"
+
+	// ExecutionsScannerWFID is the workflow id of the executions scanner
+	ExecutionsScannerWFID = "cadence-sys-executions-scanner"
+	// ExecutionsScannerReportQuery is the query type that returns the report of an executions scanner run
+	ExecutionsScannerReportQuery = "report"
+
+	executionsScannerWFTypeName     = "cadence-sys-executions-scanner-workflow"
+	executionsScannerTaskListName   = "cadence-sys-executions-scanner-tasklist-0"
+	executionsScavengerActivityName = "cadence-sys-executions-scanner-scvg-activity"
+
+	historyScannerWFID           = "cadence-sys-history-scanner"
+	historyScannerWFTypeName     = "cadence-sys-history-scanner-workflow"
+	historyScannerTaskListName   = "cadence-sys-history-scanner-tasklist-0"
+	historyScavengerActivityName = "cadence-sys-history-scanner-scvg-activity"
 )
 
 var (
@@ -63,11 +80,29 @@ var (
 		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
 		CronSchedule:                 "0 */12 * * *",
 	}
+	executionsScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           ExecutionsScannerWFID,
+		TaskList:                     executionsScannerTaskListName,
+		ExecutionStartToCloseTimeout: 5 * 24 * time.Hour,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 0 * * *",
+	}
+	historyScannerWFStartOptions = cclient.StartWorkflowOptions{
+		ID:                           historyScannerWFID,
+		TaskList:                     historyScannerTaskListName,
+		ExecutionStartToCloseTimeout: 5 * 24 * time.Hour,
+		WorkflowIDReusePolicy:        cclient.WorkflowIDReusePolicyAllowDuplicate,
+		CronSchedule:                 "0 12 * * *",
+	}
 )
 
 func init() {
 	workflow.RegisterWithOptions(TaskListScannerWorkflow, workflow.RegisterOptions{Name: tlScannerWFTypeName})
 	activity.RegisterWithOptions(TaskListScavengerActivity, activity.RegisterOptions{Name: taskListScavengerActivityName})
+	workflow.RegisterWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+	activity.RegisterWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+	workflow.RegisterWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
+	activity.RegisterWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 }
 
 // TaskListScannerWorkflow is the workflow that runs the task-list scanner background daemon
@@ -99,3 +134,100 @@ func TaskListScavengerActivity(aCtx context.Context) error {
 	}
 	return nil
 }
+
+// ExecutionsScannerWorkflow is the workflow that runs the executions scanner background daemon,
+// the report of the run can be retrieved by querying the workflow with ExecutionsScannerReportQuery
+func ExecutionsScannerWorkflow(ctx workflow.Context) error {
+	var report *executions.Report
+	err := workflow.SetQueryHandler(ctx, ExecutionsScannerReportQuery, func() (*executions.Report, error) {
+		return report, nil
+	})
+	if err != nil {
+		return err
+	}
+	opts := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &tlScavengerActivityRetryPolicy,
+	}
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), executionsScavengerActivityName)
+	return future.Get(ctx, &report)
+}
+
+// ExecutionsScavengerActivity is the activity that runs executions scavenger
+func ExecutionsScavengerActivity(aCtx context.Context) (*executions.Report, error) {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	scavenger := executions.NewScavenger(&executions.ScavengerParams{
+		NumShards:           ctx.cfg.Persistence.NumHistoryShards,
+		ExecutionDBs:        ctx.executionDBs,
+		HistoryDB:           ctx.historyDB,
+		HistoryV2DB:         ctx.historyV2DB,
+		VisibilityDB:        ctx.visibilityDB,
+		DomainDB:            ctx.domainDB,
+		FixEnabled:          ctx.cfg.ExecutionsScannerFixEnabled,
+		HistoryClient:       ctx.historyClient,
+		RefreshTasksEnabled: ctx.cfg.ExecutionsScannerRefreshTasksEnabled,
+		MetricsClient:       ctx.metricsClient,
+		Logger:              ctx.logger,
+	})
+	ctx.logger.Info("Starting executions scavenger")
+	scavenger.Start()
+	for scavenger.Alive() {
+		activity.RecordHeartbeat(aCtx)
+		if aCtx.Err() != nil {
+			ctx.logger.Infof("activity context error, stopping scavenger: %v", aCtx.Err())
+			scavenger.Stop()
+			return nil, aCtx.Err()
+		}
+		time.Sleep(tlScavengerHBInterval)
+	}
+	report := scavenger.Report()
+	return &report, nil
+}
+
+// HistoryScannerWorkflow is the workflow that runs the history scanner background daemon
+func HistoryScannerWorkflow(ctx workflow.Context) error {
+	opts := workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    infiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &tlScavengerActivityRetryPolicy,
+	}
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, opts), historyScavengerActivityName)
+	return future.Get(ctx, nil)
+}
+
+// HistoryScavengerActivity is the activity that runs history scavenger
+func HistoryScavengerActivity(aCtx context.Context) error {
+	ctx := aCtx.Value(scannerContextKey).(scannerContext)
+	var payloadOffloader payload.Offloader
+	if ctx.blobstore != nil && ctx.payloadConfig != nil {
+		payloadOffloader = payload.NewOffloader(ctx.blobstore, ctx.payloadConfig)
+	}
+	scavenger := history.NewScavenger(&history.ScavengerParams{
+		NumShards:        ctx.cfg.Persistence.NumHistoryShards,
+		ExecutionDBs:     ctx.executionDBs,
+		HistoryV2DB:      ctx.historyV2DB,
+		DomainDB:         ctx.domainDB,
+		ClusterMetadata:  ctx.cfg.ClusterMetadata,
+		Blobstore:        ctx.blobstore,
+		PayloadOffloader: payloadOffloader,
+		DryRun:           ctx.cfg.HistoryScannerDryRun,
+		OrphanAge:        ctx.cfg.HistoryScannerOrphanAge,
+		MetricsClient:    ctx.metricsClient,
+		Logger:           ctx.logger,
+	})
+	ctx.logger.Info("Starting history scavenger")
+	scavenger.Start()
+	for scavenger.Alive() {
+		activity.RecordHeartbeat(aCtx)
+		if aCtx.Err() != nil {
+			ctx.logger.Infof("activity context error, stopping scavenger: %v", aCtx.Err())
+			scavenger.Stop()
+			return aCtx.Err()
+		}
+		time.Sleep(tlScavengerHBInterval)
+	}
+	return nil
+}
@@ -33,6 +33,9 @@ import (
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/scanner/executions"
 	"go.uber.org/cadence/testsuite"
 	"go.uber.org/cadence/worker"
 	"go.uber.org/zap"
@@ -73,3 +76,73 @@ func (s *scannerWorkflowTestSuite) TestScavengerActivity() {
 	_, err := env.ExecuteActivity(taskListScavengerActivityName)
 	s.NoError(err)
 }
+
+func (s *scannerWorkflowTestSuite) TestExecutionsScannerWorkflow() {
+	env := s.NewTestWorkflowEnvironment()
+	report := &executions.Report{NumShards: 4, ExecutionsScanned: 10, Corrupted: map[string]int64{"MissingHistory": 1}}
+	env.OnActivity(executionsScavengerActivityName, mock.Anything).Return(report, nil)
+	env.ExecuteWorkflow(executionsScannerWFTypeName)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+
+	result, err := env.QueryWorkflow(ExecutionsScannerReportQuery)
+	s.NoError(err)
+	var queried *executions.Report
+	s.NoError(result.Get(&queried))
+	s.Equal(report, queried)
+}
+
+func (s *scannerWorkflowTestSuite) TestExecutionsScavengerActivity() {
+	env := s.NewTestActivityEnvironment()
+	ctx := scannerContext{
+		cfg: Config{
+			Persistence:                 &config.Persistence{NumHistoryShards: 0},
+			ExecutionsScannerFixEnabled: dynamicconfig.GetBoolPropertyFn(false),
+		},
+		executionDBs:  &mocks.ExecutionManagerFactory{},
+		historyDB:     &mocks.HistoryManager{},
+		historyV2DB:   &mocks.HistoryV2Manager{},
+		visibilityDB:  &mocks.VisibilityManager{},
+		domainDB:      &mocks.MetadataManager{},
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		zapLogger:     zap.NewNop(),
+		logger:        bark.NewLoggerFromLogrus(logrus.New()),
+	}
+	env.SetTestTimeout(time.Second * 5)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), scannerContextKey, ctx),
+	})
+	tlScavengerHBInterval = time.Millisecond * 10
+	result, err := env.ExecuteActivity(executionsScavengerActivityName)
+	s.NoError(err)
+	var report *executions.Report
+	s.NoError(result.Get(&report))
+	s.Equal(0, report.ShardsFailed)
+	s.Equal(int64(0), report.ExecutionsScanned)
+}
+
+func (s *scannerWorkflowTestSuite) TestHistoryScavengerActivity() {
+	env := s.NewTestActivityEnvironment()
+	historyV2DB := &mocks.HistoryV2Manager{}
+	historyV2DB.On("GetAllHistoryTreeBranches", mock.Anything).Return(&p.GetAllHistoryTreeBranchesResponse{}, nil)
+	ctx := scannerContext{
+		cfg: Config{
+			Persistence:             &config.Persistence{NumHistoryShards: 1},
+			HistoryScannerDryRun:    dynamicconfig.GetBoolPropertyFn(true),
+			HistoryScannerOrphanAge: dynamicconfig.GetDurationPropertyFn(time.Hour),
+		},
+		executionDBs:  &mocks.ExecutionManagerFactory{},
+		historyV2DB:   historyV2DB,
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		zapLogger:     zap.NewNop(),
+		logger:        bark.NewLoggerFromLogrus(logrus.New()),
+	}
+	env.SetTestTimeout(time.Second * 5)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), scannerContextKey, ctx),
+	})
+	tlScavengerHBInterval = time.Millisecond * 10
+	_, err := env.ExecuteActivity(historyScavengerActivityName)
+	s.NoError(err)
+	historyV2DB.AssertExpectations(s.T())
+}
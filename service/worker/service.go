@@ -30,11 +30,13 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/blobstore"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/payload"
+	"github.com/uber/cadence/common/persistence"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
 	"github.com/uber/cadence/common/service"
-	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 	"github.com/uber/cadence/service/worker/indexer"
@@ -64,11 +66,12 @@ type (
 
 	// Config contains all the service config for worker
 	Config struct {
-		ReplicationCfg  *replicator.Config
-		ArchiverConfig  *archiver.Config
-		IndexerCfg      *indexer.Config
-		ScannerCfg      *scanner.Config
-		ThrottledLogRPS dynamicconfig.IntPropertyFn
+		ReplicationCfg       *replicator.Config
+		ArchiverConfig       *archiver.Config
+		PayloadOffloadConfig *payload.Config
+		IndexerCfg           *indexer.Config
+		ScannerCfg           *scanner.Config
+		ThrottledLogRPS      dynamicconfig.IntPropertyFn
 	}
 )
 
@@ -103,6 +106,13 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ArchiverConcurrency:                       dc.GetIntProperty(dynamicconfig.WorkerArchiverConcurrency, 50),
 			ArchivalsPerIteration:                     dc.GetIntProperty(dynamicconfig.WorkerArchivalsPerIteration, 1000),
 			DeterministicConstructionCheckProbability: dc.GetFloat64Property(dynamicconfig.WorkerDeterministicConstructionCheckProbability, 0.002),
+			NumArchiveSystemWorkflows:                 dc.GetIntProperty(dynamicconfig.NumArchiveSystemWorkflows, 1000),
+			EnableArchivalVerification:                dc.GetBoolProperty(dynamicconfig.WorkerEnableArchivalVerification, false),
+			ArchivalVerificationLookback:              dc.GetDurationProperty(dynamicconfig.WorkerArchivalVerificationLookback, 7*24*time.Hour),
+		},
+		PayloadOffloadConfig: &payload.Config{
+			Bucket:    dc.GetStringProperty(dynamicconfig.PayloadOffloadBucket, ""),
+			Threshold: dc.GetIntPropertyFilteredByDomain(dynamicconfig.PayloadOffloadThreshold, 0),
 		},
 		IndexerCfg: &indexer.Config{
 			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 1000),
@@ -112,9 +122,15 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.WorkerESProcessorFlushInterval, 10*time.Second),
 		},
 		ScannerCfg: &scanner.Config{
-			PersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
-			Persistence:       &params.PersistenceConfig,
-			ClusterMetadata:   params.ClusterMetadata,
+			PersistenceMaxQPS:                    dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS, 100),
+			Persistence:                          &params.PersistenceConfig,
+			ClusterMetadata:                      params.ClusterMetadata,
+			ExecutionsScannerEnabled:             dc.GetBoolProperty(dynamicconfig.ExecutionsScannerEnabled, false),
+			ExecutionsScannerFixEnabled:          dc.GetBoolProperty(dynamicconfig.ExecutionsScannerFixEnabled, false),
+			ExecutionsScannerRefreshTasksEnabled: dc.GetBoolProperty(dynamicconfig.ExecutionsScannerRefreshTasksEnabled, false),
+			HistoryScannerEnabled:                dc.GetBoolProperty(dynamicconfig.HistoryScannerEnabled, false),
+			HistoryScannerDryRun:                 dc.GetBoolProperty(dynamicconfig.HistoryScannerDryRun, true),
+			HistoryScannerOrphanAge:              dc.GetDurationProperty(dynamicconfig.HistoryScannerOrphanAge, 7*24*time.Hour),
 		},
 		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 	}
@@ -159,9 +175,9 @@ func (s *Service) Stop() {
 }
 
 func (s *Service) startScanner(base service.Service) {
-	storeType := s.config.ScannerCfg.Persistence.DefaultStoreType()
-	if storeType != config.StoreTypeSQL {
-		s.logger.Infof("Scanner not started: incompatible persistence store type %v", storeType)
+	if !s.config.ScannerCfg.Enabled() {
+		storeType := s.config.ScannerCfg.Persistence.DefaultStoreType()
+		s.logger.Infof("Scanner not started: incompatible persistence store type %v and executions scanner disabled", storeType)
 		return
 	}
 	sdkClient := public.NewRetryableClient(
@@ -172,10 +188,15 @@ func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
 		Config:        *s.config.ScannerCfg,
 		SDKClient:     sdkClient,
+		HistoryClient: base.GetClientBean().GetHistoryClient(),
 		MetricsClient: s.metricsClient,
 		Logger:        s.logger,
 		TallyScope:    s.params.MetricScope,
 	}
+	if s.params.BlobstoreClient != nil {
+		params.BlobstoreClient = s.newBlobstoreClient()
+		params.PayloadOffloadConfig = s.config.PayloadOffloadConfig
+	}
 	scanner := scanner.New(params)
 	if err := scanner.Start(); err != nil {
 		s.logger.Fatalf("error starting scanner:%v", err)
@@ -189,6 +210,8 @@ func (s *Service) startReplicator(base service.Service, pFactory persistencefact
 	}
 	domainCache := cache.NewDomainCache(metadataV2Mgr, base.GetClusterMetadata(), s.metricsClient, s.logger)
 	domainCache.Start()
+	s.registerHealthChecks(base, metadataV2Mgr, domainCache)
+	base.GetHealthRegistry().Register(healthcheck.KafkaCheckName, healthcheck.KafkaCheck(base.GetMessagingClient()))
 
 	replicator := replicator.NewReplicator(
 		base.GetClusterMetadata(),
@@ -217,6 +240,16 @@ func (s *Service) startIndexer(base service.Service) {
 		indexer.Stop()
 		s.logger.Fatalf("fail to start indexer: %v", err)
 	}
+	base.GetHealthRegistry().Register(healthcheck.KafkaCheckName, healthcheck.KafkaCheck(base.GetMessagingClient()))
+	base.GetHealthRegistry().Register(healthcheck.ElasticSearchCheckName, healthcheck.ElasticSearchCheck(s.params.ESClient))
+}
+
+// registerHealthChecks reports the state of the database and of the domain cache
+// shared by the workers, the checks of the last started worker are kept
+func (s *Service) registerHealthChecks(base service.Service, metadataMgr persistence.MetadataManager, domainCache cache.DomainCache) {
+	base.GetHealthRegistry().Register(healthcheck.PersistenceCheckName, healthcheck.PersistenceCheck(metadataMgr))
+	base.GetHealthRegistry().Register(healthcheck.DomainCacheCheckName,
+		healthcheck.DomainCacheCheck(domainCache, healthcheck.DefaultDomainCacheMaxAge))
 }
 
 func (s *Service) startArchiver(base service.Service, pFactory persistencefactory.Factory) {
@@ -241,22 +274,21 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 	}
 	domainCache := cache.NewDomainCache(metadataMgr, s.params.ClusterMetadata, s.metricsClient, s.logger)
 	domainCache.Start()
+	s.registerHealthChecks(base, metadataMgr, domainCache)
 
-	blobstoreClient := blobstore.NewRetryableClient(
-		blobstore.NewMetricClient(s.params.BlobstoreClient, s.metricsClient),
-		s.params.BlobstoreClient.GetRetryPolicy(),
-		s.params.BlobstoreClient.IsRetryableError)
+	blobstoreClient := s.newBlobstoreClient()
 
 	bc := &archiver.BootstrapContainer{
-		PublicClient:     publicClient,
-		MetricsClient:    s.metricsClient,
-		Logger:           s.logger,
-		ClusterMetadata:  base.GetClusterMetadata(),
-		HistoryManager:   historyManager,
-		HistoryV2Manager: historyV2Manager,
-		Blobstore:        blobstoreClient,
-		DomainCache:      domainCache,
-		Config:           s.config.ArchiverConfig,
+		PublicClient:         publicClient,
+		MetricsClient:        s.metricsClient,
+		Logger:               s.logger,
+		ClusterMetadata:      base.GetClusterMetadata(),
+		HistoryManager:       historyManager,
+		HistoryV2Manager:     historyV2Manager,
+		Blobstore:            blobstoreClient,
+		DomainCache:          domainCache,
+		Config:               s.config.ArchiverConfig,
+		PayloadOffloadConfig: s.config.PayloadOffloadConfig,
 	}
 	clientWorker := archiver.NewClientWorker(bc)
 	if err := clientWorker.Start(); err != nil {
@@ -265,6 +297,13 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 	}
 }
 
+func (s *Service) newBlobstoreClient() blobstore.Client {
+	return blobstore.NewRetryableClient(
+		blobstore.NewMetricClient(s.params.BlobstoreClient, s.metricsClient),
+		s.params.BlobstoreClient.GetRetryPolicy(),
+		s.params.BlobstoreClient.IsRetryableError)
+}
+
 func (s *Service) waitForFrontendStart(publicClient public.Client) {
 	request := &shared.DescribeDomainRequest{
 		Name: common.StringPtr(common.SystemDomainName),
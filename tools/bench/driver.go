@@ -0,0 +1,184 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/pborman/uuid"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/client"
+	"golang.org/x/time/rate"
+)
+
+const (
+	decisionTimeout  = 10 * time.Second
+	rpcTimeout       = 10 * time.Second
+	progressInterval = 10 * time.Second
+)
+
+type (
+	// DriverConfig is the load generated by the driver
+	DriverConfig struct {
+		TaskList string
+		// Rate is the number of workflows started per second
+		Rate     float64
+		Duration time.Duration
+		Shape    WorkflowShape
+		// HistorySampleRate is the ratio of workflows
+		// whose history is read for the schedule to start latencies
+		HistorySampleRate float64
+	}
+
+	driver struct {
+		client client.Client
+		config DriverConfig
+		out    io.Writer
+		report report
+	}
+)
+
+// runDriver starts workflows at the configured rate for the configured
+// duration, then waits for all of them to complete and prints the report
+func runDriver(client client.Client, config DriverConfig, out io.Writer) *report {
+	d := &driver{client: client, config: config, out: out}
+	limiter := rate.NewLimiter(rate.Limit(config.Rate), 1)
+	ctx, cancel := context.WithTimeout(context.Background(), config.Duration)
+	defer cancel()
+
+	start := time.Now()
+	doneC := make(chan struct{})
+	go func() {
+		progress := time.NewTicker(progressInterval)
+		defer progress.Stop()
+		for {
+			select {
+			case <-progress.C:
+				d.report.printProgress(out, time.Since(start))
+			case <-doneC:
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for limiter.Wait(ctx) == nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			d.runWorkflow()
+		}()
+	}
+	wg.Wait()
+	close(doneC)
+
+	d.report.print(out, time.Since(start))
+	return &d.report
+}
+
+// runWorkflow starts a workflow, sends its signals and waits for its completion
+func (d *driver) runWorkflow() {
+	shape := d.config.Shape
+	options := client.StartWorkflowOptions{
+		ID:                              "cadence-bench-" + uuid.New(),
+		TaskList:                        d.config.TaskList,
+		ExecutionStartToCloseTimeout:    shape.ExecutionTimeout,
+		DecisionTaskStartToCloseTimeout: decisionTimeout,
+	}
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+	run, err := d.client.ExecuteWorkflow(ctx, options, workflowTypeName, shape)
+	cancel()
+	if err != nil {
+		d.workflowFailed(options.ID, err)
+		return
+	}
+	d.report.startLatency.record(time.Since(start))
+	d.report.workflowStarted()
+
+	for i := 0; i < shape.Signals; i++ {
+		ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+		err := d.client.SignalWorkflow(ctx, run.GetID(), run.GetRunID(), signalName, nil)
+		cancel()
+		if err != nil {
+			d.workflowFailed(options.ID, err)
+			return
+		}
+	}
+
+	// every run of the chain can take up to the execution timeout
+	ctx, cancel = context.WithTimeout(context.Background(), time.Duration(shape.ContinueAsNew+1)*shape.ExecutionTimeout+rpcTimeout)
+	defer cancel()
+	if err := run.Get(ctx, nil); err != nil {
+		d.workflowFailed(options.ID, err)
+		return
+	}
+	d.report.endToEndLatency.record(time.Since(start))
+	d.report.workflowCompleted()
+
+	if rand.Float64() < d.config.HistorySampleRate {
+		if err := d.recordScheduleToStart(ctx, run.GetID(), run.GetRunID()); err != nil {
+			fmt.Fprintf(d.out, "error reading history of %v: %v\n", run.GetID(), err)
+		}
+	}
+}
+
+func (d *driver) workflowFailed(workflowID string, err error) {
+	d.report.workflowFailed()
+	fmt.Fprintf(d.out, "workflow %v failed: %v\n", workflowID, err)
+}
+
+// recordScheduleToStart records the decision and activity schedule to start
+// latencies of all the runs of the workflow, from the timestamps of its history
+func (d *driver) recordScheduleToStart(ctx context.Context, workflowID string, runID string) error {
+	for len(runID) > 0 {
+		scheduled := make(map[int64]int64)
+		nextRunID := ""
+
+		iter := d.client.GetWorkflowHistory(ctx, workflowID, runID, false, shared.HistoryEventFilterTypeAllEvent)
+		for iter.HasNext() {
+			event, err := iter.Next()
+			if err != nil {
+				return err
+			}
+			switch event.GetEventType() {
+			case shared.EventTypeDecisionTaskScheduled, shared.EventTypeActivityTaskScheduled:
+				scheduled[event.GetEventId()] = event.GetTimestamp()
+			case shared.EventTypeDecisionTaskStarted:
+				scheduledID := event.DecisionTaskStartedEventAttributes.GetScheduledEventId()
+				d.report.decisionScheduleToStart.record(time.Duration(event.GetTimestamp() - scheduled[scheduledID]))
+			case shared.EventTypeActivityTaskStarted:
+				scheduledID := event.ActivityTaskStartedEventAttributes.GetScheduledEventId()
+				d.report.activityScheduleToStart.record(time.Duration(event.GetTimestamp() - scheduled[scheduledID]))
+			case shared.EventTypeWorkflowExecutionContinuedAsNew:
+				nextRunID = event.WorkflowExecutionContinuedAsNewEventAttributes.GetNewExecutionRunId()
+			}
+		}
+		runID = nextRunID
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	cadencecli "github.com/uber/cadence/tools/cli"
+	"github.com/urfave/cli"
+	"go.uber.org/cadence/client"
+	"go.uber.org/cadence/worker"
+)
+
+const (
+	cliFlagTaskList            = "tasklist"
+	cliFlagActivityConcurrency = "activity-concurrency"
+	cliFlagDecisionConcurrency = "decision-concurrency"
+	cliFlagRate                = "rate"
+	cliFlagDuration            = "duration"
+	cliFlagActivities          = "activities"
+	cliFlagActivityDuration    = "activity-duration"
+	cliFlagTimers              = "timers"
+	cliFlagTimerDuration       = "timer-duration"
+	cliFlagSignals             = "signals"
+	cliFlagChildren            = "children"
+	cliFlagContinueAsNew       = "continue-as-new"
+	cliFlagWorkflowTimeout     = "workflow-timeout"
+	cliFlagHistorySampleRate   = "history-sample-rate"
+)
+
+// RunTool runs the cadence-bench command line tool
+func RunTool(args []string) error {
+	app := buildCLIOptions()
+	return app.Run(args)
+}
+
+// root handler for all cli commands
+func cliHandler(c *cli.Context, handler func(c *cli.Context) error) {
+	if err := handler(c); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func workerHandler(c *cli.Context) error {
+	service := cadencecli.NewClientFactory().ClientFrontendClient(c)
+	options := worker.Options{
+		MaxConcurrentActivityExecutionSize:     c.Int(cliFlagActivityConcurrency),
+		MaxConcurrentDecisionTaskExecutionSize: c.Int(cliFlagDecisionConcurrency),
+	}
+	return runWorker(service, c.GlobalString(cadencecli.FlagDomain), c.GlobalString(cliFlagTaskList), options)
+}
+
+func runHandler(c *cli.Context) error {
+	config := DriverConfig{
+		TaskList:          c.GlobalString(cliFlagTaskList),
+		Rate:              c.Float64(cliFlagRate),
+		Duration:          c.Duration(cliFlagDuration),
+		HistorySampleRate: c.Float64(cliFlagHistorySampleRate),
+		Shape: WorkflowShape{
+			Activities:       c.Int(cliFlagActivities),
+			ActivityDuration: c.Duration(cliFlagActivityDuration),
+			Timers:           c.Int(cliFlagTimers),
+			TimerDuration:    c.Duration(cliFlagTimerDuration),
+			Signals:          c.Int(cliFlagSignals),
+			Children:         c.Int(cliFlagChildren),
+			ContinueAsNew:    c.Int(cliFlagContinueAsNew),
+			ExecutionTimeout: c.Duration(cliFlagWorkflowTimeout),
+		},
+	}
+	if config.Rate <= 0 {
+		return fmt.Errorf("%v must be positive", cliFlagRate)
+	}
+	if config.Shape.ExecutionTimeout <= 0 {
+		return fmt.Errorf("%v must be positive", cliFlagWorkflowTimeout)
+	}
+
+	service := cadencecli.NewClientFactory().ClientFrontendClient(c)
+	runDriver(client.NewClient(service, c.GlobalString(cadencecli.FlagDomain), nil), config, os.Stdout)
+	return nil
+}
+
+func buildCLIOptions() *cli.App {
+
+	app := cli.NewApp()
+	app.Name = "cadence-bench"
+	app.Usage = "Command line tool to generate load against a cadence cluster"
+	app.Version = "0.0.1"
+
+	app.Flags = []cli.Flag{
+		cli.StringFlag{
+			Name:   cadencecli.FlagAddress,
+			Value:  "",
+			Usage:  "host:port for cadence frontend service",
+			EnvVar: "CADENCE_CLI_ADDRESS",
+		},
+		cli.StringFlag{
+			Name:   cadencecli.FlagDomain,
+			Value:  "default",
+			Usage:  "cadence workflow domain, it must be registered",
+			EnvVar: "CADENCE_CLI_DOMAIN",
+		},
+		cli.StringFlag{
+			Name:  cliFlagTaskList,
+			Value: "cadence-bench",
+			Usage: "task list of the bench workflows and activities",
+		},
+	}
+
+	app.Commands = []cli.Command{
+		{
+			Name:  "worker",
+			Usage: "run the workflows and activities started by the driver, until interrupted",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  cliFlagActivityConcurrency,
+					Value: 1000,
+					Usage: "max number of activities executed at the same time",
+				},
+				cli.IntFlag{
+					Name:  cliFlagDecisionConcurrency,
+					Value: 1000,
+					Usage: "max number of decision tasks executed at the same time",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, workerHandler)
+			},
+		},
+		{
+			Name:    "run",
+			Aliases: []string{"driver"},
+			Usage:   "start workflows at a fixed rate and report throughput and latencies",
+			Flags: []cli.Flag{
+				cli.Float64Flag{
+					Name:  cliFlagRate,
+					Value: 10,
+					Usage: "number of workflows started per second",
+				},
+				cli.DurationFlag{
+					Name:  cliFlagDuration,
+					Value: time.Minute,
+					Usage: "how long workflows are started for",
+				},
+				cli.IntFlag{
+					Name:  cliFlagActivities,
+					Value: 1,
+					Usage: "number of activities each workflow runs in parallel",
+				},
+				cli.DurationFlag{
+					Name:  cliFlagActivityDuration,
+					Usage: "how long each activity takes",
+				},
+				cli.IntFlag{
+					Name:  cliFlagTimers,
+					Usage: "number of timers each workflow waits for, one after the other",
+				},
+				cli.DurationFlag{
+					Name:  cliFlagTimerDuration,
+					Value: time.Second,
+					Usage: "duration of each timer",
+				},
+				cli.IntFlag{
+					Name:  cliFlagSignals,
+					Usage: "number of signals sent to each workflow",
+				},
+				cli.IntFlag{
+					Name:  cliFlagChildren,
+					Usage: "number of child workflows each workflow runs in parallel, with the same activities and timers",
+				},
+				cli.IntFlag{
+					Name:  cliFlagContinueAsNew,
+					Usage: "number of times each workflow continues as new",
+				},
+				cli.DurationFlag{
+					Name:  cliFlagWorkflowTimeout,
+					Value: 5 * time.Minute,
+					Usage: "execution timeout of each workflow run",
+				},
+				cli.Float64Flag{
+					Name:  cliFlagHistorySampleRate,
+					Value: 0.1,
+					Usage: "ratio of the completed workflows whose history is read for the schedule to start latencies",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, runHandler)
+			},
+		},
+	}
+
+	return app
+}
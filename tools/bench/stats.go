@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// latencies collects the samples of a latency,
+	// it's safe for concurrent use
+	latencies struct {
+		sync.Mutex
+		samples []time.Duration
+	}
+
+	// percentiles summarizes the samples of a latency
+	percentiles struct {
+		Count int
+		P50   time.Duration
+		P90   time.Duration
+		P99   time.Duration
+		Max   time.Duration
+	}
+
+	// report is the outcome of a bench run
+	report struct {
+		started   int64
+		completed int64
+		failed    int64
+
+		startLatency            latencies
+		endToEndLatency         latencies
+		decisionScheduleToStart latencies
+		activityScheduleToStart latencies
+	}
+)
+
+func (l *latencies) record(d time.Duration) {
+	l.Lock()
+	defer l.Unlock()
+	l.samples = append(l.samples, d)
+}
+
+func (l *latencies) percentiles() percentiles {
+	l.Lock()
+	sorted := make([]time.Duration, len(l.samples))
+	copy(sorted, l.samples)
+	l.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return percentiles{
+		Count: len(sorted),
+		P50:   percentile(sorted, 50),
+		P90:   percentile(sorted, 90),
+		P99:   percentile(sorted, 99),
+		Max:   percentile(sorted, 100),
+	}
+}
+
+// percentile returns the nearest rank percentile of the sorted samples
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+func (r *report) workflowStarted() {
+	atomic.AddInt64(&r.started, 1)
+}
+
+func (r *report) workflowCompleted() {
+	atomic.AddInt64(&r.completed, 1)
+}
+
+func (r *report) workflowFailed() {
+	atomic.AddInt64(&r.failed, 1)
+}
+
+// printProgress writes the workflow counts so far
+func (r *report) printProgress(w io.Writer, elapsed time.Duration) {
+	fmt.Fprintf(w, "%v: started %v, completed %v, failed %v\n",
+		elapsed.Truncate(time.Second), atomic.LoadInt64(&r.started), atomic.LoadInt64(&r.completed), atomic.LoadInt64(&r.failed))
+}
+
+// print writes the throughput over the elapsed time and the latency percentiles
+func (r *report) print(w io.Writer, elapsed time.Duration) {
+	started := atomic.LoadInt64(&r.started)
+	completed := atomic.LoadInt64(&r.completed)
+	seconds := elapsed.Seconds()
+
+	fmt.Fprintf(w, "duration:   %v\n", elapsed.Truncate(time.Millisecond))
+	fmt.Fprintf(w, "started:    %v (%.2f/s)\n", started, float64(started)/seconds)
+	fmt.Fprintf(w, "completed:  %v (%.2f/s)\n", completed, float64(completed)/seconds)
+	fmt.Fprintf(w, "failed:     %v\n", atomic.LoadInt64(&r.failed))
+	fmt.Fprintf(w, "\n%-28v %8v %10v %10v %10v %10v\n", "latency", "count", "p50", "p90", "p99", "max")
+	for _, l := range []struct {
+		name string
+		l    *latencies
+	}{
+		{"start workflow", &r.startLatency},
+		{"end to end", &r.endToEndLatency},
+		{"decision schedule to start", &r.decisionScheduleToStart},
+		{"activity schedule to start", &r.activityScheduleToStart},
+	} {
+		p := l.l.percentiles()
+		fmt.Fprintf(w, "%-28v %8v %10v %10v %10v %10v\n", l.name, p.Count,
+			roundLatency(p.P50), roundLatency(p.P90), roundLatency(p.P99), roundLatency(p.Max))
+	}
+}
+
+func roundLatency(d time.Duration) time.Duration {
+	return d.Round(100 * time.Microsecond)
+}
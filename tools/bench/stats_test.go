@@ -0,0 +1,87 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	statsSuite struct {
+		suite.Suite
+		*require.Assertions
+	}
+)
+
+func TestStatsSuite(t *testing.T) {
+	suite.Run(t, new(statsSuite))
+}
+
+func (s *statsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *statsSuite) TestPercentiles() {
+	var l latencies
+	for i := 100; i > 0; i-- {
+		l.record(time.Duration(i) * time.Millisecond)
+	}
+	p := l.percentiles()
+	s.Equal(100, p.Count)
+	s.Equal(50*time.Millisecond, p.P50)
+	s.Equal(90*time.Millisecond, p.P90)
+	s.Equal(99*time.Millisecond, p.P99)
+	s.Equal(100*time.Millisecond, p.Max)
+}
+
+func (s *statsSuite) TestPercentilesFewSamples() {
+	var l latencies
+	s.Equal(percentiles{}, l.percentiles())
+
+	l.record(time.Second)
+	l.record(2 * time.Second)
+	p := l.percentiles()
+	s.Equal(2, p.Count)
+	s.Equal(time.Second, p.P50)
+	s.Equal(2*time.Second, p.P90)
+	s.Equal(2*time.Second, p.Max)
+}
+
+func (s *statsSuite) TestPrint() {
+	var r report
+	r.workflowStarted()
+	r.workflowStarted()
+	r.workflowCompleted()
+	r.workflowFailed()
+	r.endToEndLatency.record(1500 * time.Millisecond)
+
+	var out bytes.Buffer
+	r.print(&out, 2*time.Second)
+	s.Contains(out.String(), "started:    2 (1.00/s)")
+	s.Contains(out.String(), "completed:  1 (0.50/s)")
+	s.Contains(out.String(), "failed:     1")
+	s.Regexp(`end to end\s+1\s+1.5s\s+1.5s\s+1.5s\s+1.5s`, out.String())
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+)
+
+const (
+	workflowTypeName = "cadence-bench-workflow"
+	activityTypeName = "cadence-bench-activity"
+	signalName       = "cadence-bench-signal"
+
+	activityTimeout = time.Minute
+)
+
+type (
+	// WorkflowShape describes what a bench workflow does before it completes.
+	// The activities and child workflows run in parallel, the timers fire one
+	// after the other and the signals are waited for by the first run only.
+	WorkflowShape struct {
+		Activities       int
+		ActivityDuration time.Duration
+		Timers           int
+		TimerDuration    time.Duration
+		Signals          int
+		Children         int
+		ContinueAsNew    int
+		// ExecutionTimeout is the timeout of the workflow and of each of its children
+		ExecutionTimeout time.Duration
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(benchWorkflow, workflow.RegisterOptions{Name: workflowTypeName})
+	activity.RegisterWithOptions(benchActivity, activity.RegisterOptions{Name: activityTypeName})
+}
+
+// childShape returns the shape of the children of a workflow,
+// they only run activities and timers
+func (s WorkflowShape) childShape() WorkflowShape {
+	child := s
+	child.Signals = 0
+	child.Children = 0
+	child.ContinueAsNew = 0
+	return child
+}
+
+// benchWorkflow runs the given shape, continuing as new
+// as long as there are continue-as-new runs left
+func benchWorkflow(ctx workflow.Context, shape WorkflowShape) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		ScheduleToStartTimeout: activityTimeout,
+		StartToCloseTimeout:    activityTimeout + shape.ActivityDuration,
+	})
+
+	var futures []workflow.Future
+	for i := 0; i < shape.Activities; i++ {
+		futures = append(futures, workflow.ExecuteActivity(ctx, benchActivity, shape.ActivityDuration))
+	}
+
+	childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		ExecutionStartToCloseTimeout: shape.ExecutionTimeout,
+		TaskStartToCloseTimeout:      10 * time.Second,
+	})
+	for i := 0; i < shape.Children; i++ {
+		futures = append(futures, workflow.ExecuteChildWorkflow(childCtx, benchWorkflow, shape.childShape()))
+	}
+
+	for i := 0; i < shape.Timers; i++ {
+		if err := workflow.NewTimer(ctx, shape.TimerDuration).Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	signalCh := workflow.GetSignalChannel(ctx, signalName)
+	for i := 0; i < shape.Signals; i++ {
+		signalCh.Receive(ctx, nil)
+	}
+
+	for _, future := range futures {
+		if err := future.Get(ctx, nil); err != nil {
+			return err
+		}
+	}
+
+	if shape.ContinueAsNew > 0 {
+		next := shape
+		next.Signals = 0
+		next.ContinueAsNew--
+		return workflow.NewContinueAsNewError(ctx, benchWorkflow, next)
+	}
+	return nil
+}
+
+// benchActivity stands for the work of an activity by sleeping for the given duration
+func benchActivity(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(duration):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/workflow"
+)
+
+type (
+	workflowSuite struct {
+		suite.Suite
+		*require.Assertions
+		testsuite.WorkflowTestSuite
+		env *testsuite.TestWorkflowEnvironment
+	}
+)
+
+func TestWorkflowSuite(t *testing.T) {
+	suite.Run(t, new(workflowSuite))
+}
+
+func (s *workflowSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *workflowSuite) TearDownTest() {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *workflowSuite) TestActivitiesAndTimers() {
+	s.env.OnActivity(benchActivity, mock.Anything, time.Second).Return(nil).Times(3)
+
+	s.env.ExecuteWorkflow(benchWorkflow, WorkflowShape{
+		Activities:       3,
+		ActivityDuration: time.Second,
+		Timers:           2,
+		TimerDuration:    time.Minute,
+		ExecutionTimeout: time.Hour,
+	})
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *workflowSuite) TestSignals() {
+	for i := 1; i <= 2; i++ {
+		s.env.RegisterDelayedCallback(func() {
+			s.env.SignalWorkflow(signalName, nil)
+		}, time.Duration(i)*time.Minute)
+	}
+
+	s.env.ExecuteWorkflow(benchWorkflow, WorkflowShape{Signals: 2, ExecutionTimeout: time.Hour})
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *workflowSuite) TestChildren() {
+	// the activity of the workflow and the ones of its two children
+	s.env.OnActivity(benchActivity, mock.Anything, time.Duration(0)).Return(nil).Times(3)
+
+	s.env.ExecuteWorkflow(benchWorkflow, WorkflowShape{
+		Activities:       1,
+		Children:         2,
+		ExecutionTimeout: time.Hour,
+	})
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *workflowSuite) TestContinueAsNew() {
+	s.env.ExecuteWorkflow(benchWorkflow, WorkflowShape{ContinueAsNew: 2, ExecutionTimeout: time.Hour})
+	s.True(s.env.IsWorkflowCompleted())
+
+	err, ok := s.env.GetWorkflowError().(*workflow.ContinueAsNewError)
+	s.True(ok)
+	s.NotNil(err)
+}
@@ -22,7 +22,8 @@ package cassandra
 
 import (
 	"fmt"
-	"regexp"
+
+	"github.com/uber/cadence/tools/common/schema"
 )
 
 type (
@@ -42,19 +43,14 @@ type (
 	// params for executing a UpdateSchemaTask
 	UpdateSchemaConfig struct {
 		BaseConfig
-		TargetVersion string
-		SchemaDir     string
-		IsDryRun      bool
+		schema.UpdateConfig
 	}
 
 	// SetupSchemaConfig holds the config
 	// params need by the SetupSchemaTask
 	SetupSchemaConfig struct {
 		BaseConfig
-		SchemaFilePath    string
-		InitialVersion    string
-		Overwrite         bool // overwrite previous data
-		DisableVersioning bool // do not use schema versioning
+		schema.SetupConfig
 	}
 
 	// CreateKeyspaceConfig holds the config
@@ -106,8 +102,6 @@ const (
 	cliFlagQuiet             = cliOptQuiet + ", q"
 )
 
-var rmspaceRegex = regexp.MustCompile("\\s+")
-
 func newConfigError(msg string) error {
 	return &ConfigError{msg: msg}
 }
@@ -21,12 +21,9 @@
 package cassandra
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
-	"io"
 	"log"
-	"os"
 	"strings"
 	"time"
 
@@ -52,6 +49,8 @@ type (
 		DropType(name string) error
 		// DropKeyspace drops a keyspace
 		DropKeyspace(keyspace string) error
+		// DropAllTables drops all the tables and types in the keyspace
+		DropAllTables() error
 		// CreateSchemaVersionTables sets up the schema version tables
 		CreateSchemaVersionTables() error
 		// ReadSchemaVersion returns the current schema version for the keyspace
@@ -73,7 +72,6 @@ var errNoHosts = errors.New("Cassandra hosts list is empty or malformed")
 var errGetSchemaVersion = errors.New("Failed to get current schema version from cassandra")
 
 const (
-	newLineDelim         = '\n'
 	defaultTimeout       = 30    // timeout in seconds
 	cqlProtoVersion      = 4     // default CQL protocol version
 	defaultConsistency   = "ALL" // schema updates must always be ALL
@@ -197,6 +195,12 @@ func (client *cqlClient) DropKeyspace(keyspace string) error {
 	return client.Exec(fmt.Sprintf("DROP KEYSPACE %v", keyspace))
 }
 
+// DropAllTables drops all the tables and types in the keyspace
+func (client *cqlClient) DropAllTables() error {
+	dropAllTablesTypes(client)
+	return nil
+}
+
 // CreateSchemaVersionTables sets up the schema version tables
 func (client *cqlClient) CreateSchemaVersionTables() error {
 	if err := client.Exec(createSchemaVersionTableCQL); err != nil {
@@ -256,51 +260,6 @@ func parseHosts(input string) []string {
 	return hosts
 }
 
-// ParseCQLFile takes a cql file path as input
-// and returns an array of cql statements on
-// success.
-func ParseCQLFile(filePath string) ([]string, error) {
-
-	f, err := os.Open(filePath)
-	if err != nil {
-		return nil, err
-	}
-
-	reader := bufio.NewReader(f)
-
-	var line string
-	var currStmt string
-	var stmts = make([]string, 0, 4)
-
-	for err == nil {
-
-		line, err = reader.ReadString(newLineDelim)
-		line = strings.TrimSpace(line)
-		if len(line) < 1 {
-			continue
-		}
-
-		// Filter out the comment lines, the
-		// only recognized comment line format
-		// is any line that starts with double dashes
-		tokens := strings.Split(line, "--")
-		if len(tokens) > 0 && len(tokens[0]) > 0 {
-			currStmt += tokens[0]
-			// semi-colon is the end of statement delim
-			if strings.HasSuffix(currStmt, ";") {
-				stmts = append(stmts, currStmt)
-				currStmt = ""
-			}
-		}
-	}
-
-	if err == io.EOF {
-		return stmts, nil
-	}
-
-	return nil, err
-}
-
 // dropAllTablesTypes deletes all tables/types in the
 // keyspace without deleting the keyspace
 func dropAllTablesTypes(client CQLClient) {
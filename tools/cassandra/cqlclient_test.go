@@ -22,9 +22,7 @@ package cassandra
 
 import (
 	"fmt"
-	"io/ioutil"
 	"math/rand"
-	"os"
 	"testing"
 	"time"
 
@@ -78,21 +76,6 @@ func (s *CQLClientTestSuite) TearDownSuite() {
 	s.client.Close()
 }
 
-func (s *CQLClientTestSuite) TestParseCQLFile() {
-	rootDir, err := ioutil.TempDir("", "cqlClientTestDir")
-	s.Nil(err)
-	defer os.Remove(rootDir)
-
-	cqlFile, err := ioutil.TempFile(rootDir, "parseCQLTest")
-	s.Nil(err)
-	defer os.Remove(cqlFile.Name())
-
-	cqlFile.WriteString(createTestCQLFileContent())
-	stmts, err := ParseCQLFile(cqlFile.Name())
-	s.Nil(err)
-	s.Equal(2, len(stmts), "wrong number of cql statements")
-}
-
 func (s *CQLClientTestSuite) testUpdate(client CQLClient) {
 	// Update / Read schema version test
 	err := client.UpdateSchemaVersion("10.0", "5.0")
@@ -21,7 +21,7 @@
 package cassandra
 
 import (
-	"log"
+	"github.com/uber/cadence/tools/common/schema"
 )
 
 // SetupSchemaTask represents a task
@@ -46,56 +46,8 @@ func newSetupSchemaTask(config *SetupSchemaConfig) (*SetupSchemaTask, error) {
 
 // run executes the task
 func (task *SetupSchemaTask) run() error {
-
-	config := task.config
-
 	defer func() {
 		task.client.Close()
 	}()
-
-	log.Printf("Starting schema setup, config=%+v\n", config)
-
-	if config.Overwrite {
-		dropAllTablesTypes(task.client)
-	}
-
-	if !config.DisableVersioning {
-		log.Printf("Setting up version tables\n")
-		if err := task.client.CreateSchemaVersionTables(); err != nil {
-			return err
-		}
-	}
-
-	if len(config.SchemaFilePath) > 0 {
-		stmts, err := ParseCQLFile(config.SchemaFilePath)
-		if err != nil {
-			return err
-		}
-
-		log.Println("----- Creating types and tables -----")
-		for _, stmt := range stmts {
-			log.Println(rmspaceRegex.ReplaceAllString(stmt, " "))
-			if err := task.client.Exec(stmt); err != nil {
-				return err
-			}
-		}
-		log.Println("----- Done -----")
-	}
-
-	if !config.DisableVersioning {
-		log.Printf("Setting initial schema version to %v\n", config.InitialVersion)
-		err := task.client.UpdateSchemaVersion(config.InitialVersion, config.InitialVersion)
-		if err != nil {
-			return err
-		}
-		log.Printf("Updating schema update log\n")
-		err = task.client.WriteSchemaUpdateLog("0", config.InitialVersion, "", "initial version")
-		if err != nil {
-			return err
-		}
-	}
-
-	log.Println("Schema setup complete")
-
-	return nil
+	return schema.NewSetupSchemaTask(task.client, &task.config.SetupConfig).Run()
 }
@@ -21,14 +21,9 @@
 package cassandra
 
 import (
-	"crypto/md5"
-	"encoding/hex"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"log"
-	"sort"
-	"strings"
+
+	"github.com/uber/cadence/tools/common/schema"
 )
 
 type (
@@ -38,40 +33,11 @@ type (
 		client CQLClient
 		config *UpdateSchemaConfig
 	}
-
-	// manifest is a value type that represents
-	// the deserialized manifest.json file within
-	// a schema version directory
-	manifest struct {
-		CurrVersion          string
-		MinCompatibleVersion string
-		Description          string
-		SchemaUpdateCqlFiles []string
-		md5                  string
-	}
-
-	// changeSet represents all the changes
-	// corresponding to a single schema version
-	changeSet struct {
-		version  string
-		manifest *manifest
-		cqlStmts []string
-	}
-
-	// byVersion is a comparator type
-	// for sorting a set of version
-	// strings
-	byVersion []string
 )
 
 const (
-	dryrunKeyspace   = "dryrun_"
-	systemKeyspace   = "system"
-	manifestFileName = "manifest.json"
-)
-
-var (
-	whitelistedCQLPrefixes = [3]string{"CREATE", "ALTER", "INSERT"}
+	dryrunKeyspace = "dryrun_"
+	systemKeyspace = "system"
 )
 
 // NewUpdateSchemaTask returns a new instance of UpdateSchemaTask
@@ -110,249 +76,7 @@ func (task *UpdateSchemaTask) run() error {
 		task.client.Close()
 	}()
 
-	log.Printf("UpdateSchemeTask started, config=%+v\n", config)
-
-	currVer, err := task.client.ReadSchemaVersion()
-	if err != nil {
-		return fmt.Errorf("error reading current schema version:%v", err.Error())
-	}
-
-	updates, err := task.buildChangeSet(currVer)
-	if err != nil {
-		return err
-	}
-
-	err = task.executeUpdates(currVer, updates)
-	if err != nil {
-		return err
-	}
-
-	log.Printf("UpdateSchemeTask done\n")
-
-	return nil
-}
-
-func (task *UpdateSchemaTask) executeUpdates(currVer string, updates []changeSet) error {
-
-	for _, cs := range updates {
-
-		err := task.execCQLStmts(cs.version, cs.cqlStmts)
-		if err != nil {
-			return err
-		}
-		err = task.updateSchemaVersion(currVer, &cs)
-		if err != nil {
-			return err
-		}
-
-		log.Printf("Schema updated from %v to %v\n", currVer, cs.version)
-		currVer = cs.version
-	}
-
-	return nil
-}
-
-func (task *UpdateSchemaTask) execCQLStmts(ver string, stmts []string) error {
-	log.Printf("---- Executing updates for version %v ----\n", ver)
-	for _, stmt := range stmts {
-		log.Println(rmspaceRegex.ReplaceAllString(stmt, " "))
-		e := task.client.Exec(stmt)
-		if e != nil {
-			return fmt.Errorf("error executing CQL statement:%v", e)
-		}
-	}
-	log.Printf("---- Done ----\n")
-	return nil
-}
-
-func (task *UpdateSchemaTask) updateSchemaVersion(oldVer string, cs *changeSet) error {
-
-	err := task.client.UpdateSchemaVersion(cs.version, cs.manifest.MinCompatibleVersion)
-	if err != nil {
-		return fmt.Errorf("failed to update schema_version table, err=%v", err.Error())
-	}
-
-	err = task.client.WriteSchemaUpdateLog(oldVer, cs.manifest.CurrVersion, cs.manifest.md5, cs.manifest.Description)
-	if err != nil {
-		return fmt.Errorf("failed to add entry to schema_update_history, err=%v", err.Error())
-	}
-
-	return nil
-}
-
-func (task *UpdateSchemaTask) buildChangeSet(currVer string) ([]changeSet, error) {
-
-	config := task.config
-
-	verDirs, err := readSchemaDir(config.SchemaDir, currVer, config.TargetVersion)
-	if err != nil {
-		return nil, fmt.Errorf("error listing schema dir:%v", err.Error())
-	}
-	if len(verDirs) == 0 {
-		return nil, fmt.Errorf("no schema dirs in version range [%v-%v]", currVer, config.TargetVersion)
-	}
-
-	var result []changeSet
-
-	for _, vd := range verDirs {
-
-		dirPath := config.SchemaDir + "/" + vd
-
-		m, e := readManifest(dirPath)
-		if e != nil {
-			return nil, fmt.Errorf("error processing manifest for version %v:%v", vd, e.Error())
-		}
-
-		if m.CurrVersion != dirToVersion(vd) {
-			return nil, fmt.Errorf("manifest version doesn't match with dirname, dir=%v,manifest.version=%v",
-				vd, m.CurrVersion)
-		}
-
-		stmts, e := parseCQLStmts(dirPath, m)
-		if e != nil {
-			return nil, e
-		}
-
-		e = validateCQLStmts(stmts)
-		if e != nil {
-			return nil, fmt.Errorf("error processing version %v:%v", vd, e.Error())
-		}
-
-		cs := changeSet{}
-		cs.manifest = m
-		cs.cqlStmts = stmts
-		cs.version = m.CurrVersion
-		result = append(result, cs)
-	}
-
-	return result, nil
-}
-
-func parseCQLStmts(dir string, manifest *manifest) ([]string, error) {
-
-	result := make([]string, 0, 4)
-
-	for _, file := range manifest.SchemaUpdateCqlFiles {
-		path := dir + "/" + file
-		stmts, err := ParseCQLFile(path)
-		if err != nil {
-			return nil, fmt.Errorf("error parsing file %v, err=%v", path, err)
-		}
-		result = append(result, stmts...)
-	}
-
-	if len(result) == 0 {
-		return nil, fmt.Errorf("found 0 updates in dir %v", dir)
-	}
-
-	return result, nil
-}
-
-func validateCQLStmts(stmts []string) error {
-	for _, stmt := range stmts {
-		valid := false
-		for _, prefix := range whitelistedCQLPrefixes {
-			if strings.HasPrefix(stmt, prefix) {
-				valid = true
-				break
-			}
-		}
-		if !valid {
-			return fmt.Errorf("CQL prefix not in whitelist, stmt=%v", stmt)
-		}
-	}
-	return nil
-}
-
-func readManifest(dirPath string) (*manifest, error) {
-
-	filePath := dirPath + "/" + manifestFileName
-	jsonStr, err := ioutil.ReadFile(filePath)
-	if err != nil {
-		return nil, err
-	}
-
-	jsonBlob := []byte(jsonStr)
-
-	var manifest manifest
-	err = json.Unmarshal(jsonBlob, &manifest)
-	if err != nil {
-		return nil, err
-	}
-
-	currVer, err := parseValidateVersion(manifest.CurrVersion)
-	if err != nil {
-		return nil, fmt.Errorf("invalid CurrVersion in manifest")
-	}
-	manifest.CurrVersion = currVer
-
-	minVer, err := parseValidateVersion(manifest.MinCompatibleVersion)
-	if len(manifest.MinCompatibleVersion) == 0 {
-		return nil, fmt.Errorf("invalid MinCompatibleVersion in manifest")
-	}
-	manifest.MinCompatibleVersion = minVer
-
-	if len(manifest.SchemaUpdateCqlFiles) == 0 {
-		return nil, fmt.Errorf("manifest missing SchemaUpdateCqlFiles")
-	}
-
-	md5Bytes := md5.Sum(jsonBlob)
-	manifest.md5 = hex.EncodeToString(md5Bytes[:])
-
-	return &manifest, nil
-}
-
-// readSchemaDir returns a sorted list of subdir names that hold
-// the schema changes for versions in the range [startVer - endVer]
-// this method has an assumption that the subdirs containing the
-// schema changes will be of the form vx.x, where x.x is the version
-func readSchemaDir(dir string, startVer string, endVer string) ([]string, error) {
-
-	subdirs, err := ioutil.ReadDir(dir)
-	if err != nil {
-		return nil, err
-	}
-
-	var endFound bool
-	var result []string
-
-	hasEndVer := len(endVer) > 0
-
-	for _, dir := range subdirs {
-
-		if !dir.IsDir() {
-			continue
-		}
-
-		dirname := dir.Name()
-
-		if !versionStrRegex.MatchString(dirname) {
-			continue
-		}
-
-		ver := dirToVersion(dirname)
-
-		highcmp := 0
-		lowcmp := cmpVersion(ver, startVer)
-		if hasEndVer {
-			highcmp = cmpVersion(ver, endVer)
-		}
-
-		if lowcmp <= 0 || highcmp > 0 {
-			continue // out of range
-		}
-
-		endFound = endFound || (highcmp == 0)
-		result = append(result, dirname)
-	}
-
-	if !endFound {
-		return nil, fmt.Errorf("version dir not found for target version %v", endVer)
-	}
-
-	sort.Sort(byVersion(result))
-
-	return result, nil
+	return schema.NewUpdateSchemaTask(task.client, &config.UpdateConfig).Run()
 }
 
 // sets up a temporary dryrun keyspace for
@@ -376,8 +100,10 @@ func setupDryrunKeyspace(config *UpdateSchemaConfig) error {
 			CassPort:     config.CassPort,
 			CassKeyspace: dryrunKeyspace,
 		},
-		Overwrite:      true,
-		InitialVersion: "0.0",
+		SetupConfig: schema.SetupConfig{
+			Overwrite:      true,
+			InitialVersion: "0.0",
+		},
 	}
 
 	setupTask, err := newSetupSchemaTask(setupConfig)
@@ -387,21 +113,3 @@ func setupDryrunKeyspace(config *UpdateSchemaConfig) error {
 
 	return setupTask.run()
 }
-
-func dirToVersion(dir string) string {
-	return dir[1:]
-}
-
-func (v byVersion) Len() int {
-	return len(v)
-}
-
-func (v byVersion) Less(i, j int) bool {
-	v1 := dirToVersion(v[i])
-	v2 := dirToVersion(v[j])
-	return cmpVersion(v1, v2) < 0
-}
-
-func (v byVersion) Swap(i, j int) {
-	v[i], v[j] = v[j], v[i]
-}
@@ -100,6 +100,46 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDescribeWorkflow(c)
 			},
 		},
+		{
+			Name:    "refresh_tasks",
+			Aliases: []string{"rt"},
+			Usage:   "Regenerate the transfer and timer tasks of workflow execution from its mutable state",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRefreshWorkflowTasks(c)
+			},
+		},
+		{
+			Name:    "rebuild",
+			Aliases: []string{"rb"},
+			Usage:   "Rebuild the mutable state of workflow execution from its history and print the differences",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.BoolFlag{
+					Name:  FlagApply,
+					Usage: "Overwrite the stored mutable state with the rebuilt one, otherwise only the differences are printed",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRebuildMutableState(c)
+			},
+		},
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
@@ -525,3 +565,302 @@ func newAdminTaskListCommands() []cli.Command {
 		},
 	}
 }
+
+func newAdminArchivalCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "verify",
+			Aliases: []string{"v"},
+			Usage:   "Verify archived history of workflow runs, and optionally re-archive runs whose archived history is missing or corrupted",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID, if not provided all workflow runs closed within the close time range are verified",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, required if WorkflowID is provided",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTimeWithAlias,
+					Usage: "EarliestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTimeWithAlias,
+					Usage: "LatestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagServerConfigDir,
+					Value: "config",
+					Usage: "Config directory of cadence server, the blobstore is built from the archival section of server config",
+				},
+				cli.StringFlag{
+					Name:  FlagServerEnv,
+					Value: "development",
+					Usage: "Runtime environment of cadence server config",
+				},
+				cli.StringFlag{
+					Name:  FlagServerZone,
+					Usage: "Availability zone of cadence server config",
+				},
+				cli.BoolFlag{
+					Name:  FlagReArchive,
+					Usage: "Re-archive workflow runs whose archived history is missing or corrupted if their history still exists in cassandra",
+				},
+				cli.IntFlag{
+					Name:  FlagNumArchiveSystemWorkflows,
+					Value: defaultNumArchiveSystemWorkflows,
+					Usage: "Number of archival system workflows (see dynamic config history.numArchiveSystemWorkflows)",
+				},
+
+				// for cassandra connection, only required to re-archive
+				cli.StringFlag{
+					Name:  FlagAddress,
+					Usage: "cassandra host address",
+				},
+				cli.IntFlag{
+					Name:  FlagPort,
+					Usage: "cassandra port for the host (default is 9042)",
+				},
+				cli.StringFlag{
+					Name:  FlagUsername,
+					Usage: "cassandra username",
+				},
+				cli.StringFlag{
+					Name:  FlagPassword,
+					Usage: "cassandra password",
+				},
+				cli.StringFlag{
+					Name:  FlagKeyspace,
+					Usage: "cassandra keyspace",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminVerifyArchival(c)
+			},
+		},
+	}
+}
+
+func newAdminExecutionsCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "scan-report",
+			Aliases: []string{"sr"},
+			Usage:   "Show the corrupted executions found by a run of the executions scanner",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID of the executions scanner workflow, if not provided the latest completed run is used",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminExecutionsScanReport(c)
+			},
+		},
+	}
+}
+
+func newAdminTaskDLQCommands() []cli.Command {
+	dlqTaskFlags := []cli.Flag{
+		cli.IntFlag{
+			Name:  FlagShardIDWithAlias,
+			Usage: "ShardID",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskCategoryWithAlias,
+			Usage: "Task category: transfer or timer",
+		},
+		cli.Int64Flag{
+			Name:  FlagTaskIDWithAlias,
+			Usage: "TaskID of the task in the DLQ",
+		},
+	}
+
+	return []cli.Command{
+		{
+			Name:    "list",
+			Aliases: []string{"l"},
+			Usage:   "List tasks in the task DLQ of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskCategoryWithAlias,
+					Usage: "Task category: transfer or timer",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultPageSizeForList,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListDLQTasks(c)
+			},
+		},
+		{
+			Name:    "retry",
+			Aliases: []string{"r"},
+			Usage:   "Process a task in the task DLQ again, removing it from the DLQ on success",
+			Flags:   dlqTaskFlags,
+			Action: func(c *cli.Context) {
+				AdminRetryDLQTask(c)
+			},
+		},
+		{
+			Name:    "drop",
+			Aliases: []string{"d"},
+			Usage:   "Remove a task from the task DLQ without processing it",
+			Flags:   dlqTaskFlags,
+			Action: func(c *cli.Context) {
+				AdminDropDLQTask(c)
+			},
+		},
+	}
+}
+
+func newAdminShardCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe ack levels, read levels, failover levels and outstanding task counts of the queues of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeShard(c)
+			},
+		},
+		{
+			Name:    "list_tasks",
+			Aliases: []string{"lt"},
+			Usage:   "List pending tasks of a queue of a shard, default to the tasks between the ack level and the max read level",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Queue type: transfer, timer or replication",
+				},
+				cli.Int64Flag{
+					Name:  FlagMinLevel,
+					Usage: "Exclusive min task ID for transfer and replication queues, inclusive min Unix Nano timestamp for timer queue",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxLevel,
+					Usage: "Inclusive max task ID for transfer and replication queues, exclusive max Unix Nano timestamp for timer queue",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: defaultPageSizeForList,
+					Usage: "Result page size",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListShardQueueTasks(c)
+			},
+		},
+		{
+			Name:    "remove_task",
+			Aliases: []string{"rmt"},
+			Usage:   "Remove a task from a queue of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Queue type: transfer, timer or replication",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskIDWithAlias,
+					Usage: "TaskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagVisibilityTimestamp,
+					Usage: "Visibility timestamp of the task in Unix Nano, required for timer tasks",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRemoveShardQueueTask(c)
+			},
+		},
+		{
+			Name:  "close",
+			Usage: "Unload a shard from its owning history host, the shard is loaded again on the next request routed to it",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCloseShard(c)
+			},
+		},
+		{
+			Name:  "reload",
+			Usage: "Unload a shard from its owning history host and load it again",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminReloadShard(c)
+			},
+		},
+	}
+}
+
+func newAdminEncryptionCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "rotate_key",
+			Aliases: []string{"rotate"},
+			Usage:   "Generate a new master key and make it the current key of the key file, creating the file if needed. Old keys are kept to read existing data. Only the local file is rewritten, copy it to every host before any host reloads it",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagKeyFile,
+					Usage: "Path of the key file shared by the cadence hosts",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRotateEncryptionKey(c)
+			},
+		},
+		{
+			Name:    "describe_keys",
+			Aliases: []string{"desc"},
+			Usage:   "Show the IDs of the master keys in the key file and which one is current",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagKeyFile,
+					Usage: "Path of the key file shared by the cadence hosts",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeEncryptionKeys(c)
+			},
+		},
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber-common/bark"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/blobstore"
+	"github.com/uber/cadence/common/blobstore/filestore"
+	"github.com/uber/cadence/common/blobstore/s3store"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	cassp "github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/service/worker/archiver"
+	"github.com/urfave/cli"
+)
+
+const (
+	defaultNumArchiveSystemWorkflows = 1000
+	reArchiveHistoryPageSize         = 250
+	verifyArchivalTimeout            = time.Minute
+)
+
+type archivalReArchiver struct {
+	archiverClient   archiver.Client
+	historyManager   persistence.HistoryManager
+	historyV2Manager persistence.HistoryV2Manager
+}
+
+// AdminVerifyArchival verifies the archived history of workflow runs of a domain.
+// Either a single workflow run is verified, or all workflow runs whose visibility records were archived within the close time range.
+func AdminVerifyArchival(c *cli.Context) {
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := c.String(FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	if len(wid) != 0 && len(rid) == 0 {
+		ErrorAndExit("run_id is required when workflow_id is specified", nil)
+	}
+
+	frontendClient := cFactory.ServerFrontendClient(c)
+	ctx, cancel := newContext(c)
+	resp, err := frontendClient.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+		Name: common.StringPtr(domain),
+	})
+	cancel()
+	if err != nil {
+		ErrorAndExit("Operation DescribeDomain failed.", err)
+	}
+	domainID := resp.DomainInfo.GetUUID()
+	bucket := resp.Configuration.GetArchivalBucketName()
+	if len(bucket) == 0 {
+		ErrorAndExit(fmt.Sprintf("Domain %v has never been enabled for archival.", domain), nil)
+	}
+
+	blobstoreClient := newArchivalBlobstoreClient(c)
+	var reArchiver *archivalReArchiver
+	if c.Bool(FlagReArchive) {
+		reArchiver = newArchivalReArchiver(c)
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Workflow ID", "Run ID", "Status", "Pages", "Last Event ID", "Reason", "Re-Archived"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	counts := make(map[archiver.HistoryVerificationStatus]int)
+	verify := func(workflowID, runID string) {
+		ctx, cancel := context.WithTimeout(context.Background(), verifyArchivalTimeout)
+		defer cancel()
+		result, err := archiver.VerifyArchivedHistory(ctx, blobstoreClient, bucket, domainID, workflowID, runID)
+		if err != nil {
+			ErrorAndExit(fmt.Sprintf("Failed to verify archived history of workflow %v run %v.", workflowID, runID), err)
+		}
+		counts[result.Status]++
+		reArchived := ""
+		if result.Status != archiver.HistoryVerificationStatusValid && reArchiver != nil {
+			reArchived = reArchiver.reArchive(ctx, blobstoreClient, bucket, domainID, workflowID, runID)
+		}
+		table.Append([]string{workflowID, runID, result.Status.String(), strconv.Itoa(result.NumPages),
+			strconv.FormatInt(result.LastEventID, 10), result.Reason, reArchived})
+	}
+
+	if len(wid) != 0 {
+		verify(wid, rid)
+	} else {
+		query := &archiver.ArchivedVisibilityQuery{
+			DomainID:          domainID,
+			EarliestCloseTime: parseTime(c.String(FlagEarliestTime), 0),
+			LatestCloseTime:   parseTime(c.String(FlagLatestTime), time.Now().UnixNano()),
+			PageSize:          defaultPageSizeForList,
+		}
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), verifyArchivalTimeout)
+			result, err := archiver.QueryArchivedVisibility(ctx, blobstoreClient, bucket, query)
+			cancel()
+			if err != nil {
+				ErrorAndExit("Failed to query archived visibility records.", err)
+			}
+			for _, record := range result.Records {
+				verify(*record.WorkflowID, *record.RunID)
+			}
+			if len(result.NextPageToken) == 0 {
+				break
+			}
+			query.NextPageToken = result.NextPageToken
+		}
+	}
+	table.Render()
+	fmt.Printf("valid: %v, missing: %v, corrupted: %v\n",
+		counts[archiver.HistoryVerificationStatusValid],
+		counts[archiver.HistoryVerificationStatusMissing],
+		counts[archiver.HistoryVerificationStatusCorrupted])
+}
+
+// newArchivalBlobstoreClient builds the blobstore client described by the archival section of server config
+func newArchivalBlobstoreClient(c *cli.Context) blobstore.Client {
+	var cfg config.Config
+	if err := config.Load(c.String(FlagServerEnv), c.String(FlagServerConfigDir), c.String(FlagServerZone), &cfg); err != nil {
+		ErrorAndExit("Failed to load server config.", err)
+	}
+	var blobstoreClient blobstore.Client
+	var err error
+	if cfg.Archival.S3store != nil {
+		blobstoreClient, err = s3store.NewClient(cfg.Archival.S3store)
+	} else {
+		blobstoreClient, err = filestore.NewClient(&cfg.Archival.Filestore)
+	}
+	if err != nil {
+		ErrorAndExit("Failed to create blobstore client.", err)
+	}
+	return blobstore.NewRetryableClient(blobstoreClient, blobstoreClient.GetRetryPolicy(), blobstoreClient.IsRetryableError)
+}
+
+func newArchivalReArchiver(c *cli.Context) *archivalReArchiver {
+	session := connectToCassandra(c)
+	logger := bark.NewNopLogger()
+	numWorkflows := c.Int(FlagNumArchiveSystemWorkflows)
+	if numWorkflows <= 0 {
+		numWorkflows = defaultNumArchiveSystemWorkflows
+	}
+	return &archivalReArchiver{
+		archiverClient: archiver.NewClient(
+			metrics.NewClient(tally.NoopScope, metrics.Worker),
+			logger,
+			cFactory.ClientFrontendClient(c),
+			dynamicconfig.GetIntPropertyFn(numWorkflows),
+		),
+		historyManager:   persistence.NewHistoryManagerImpl(cassp.NewHistoryPersistenceFromSession(session, logger), logger),
+		historyV2Manager: persistence.NewHistoryV2ManagerImpl(cassp.NewHistoryV2PersistenceFromSession(session, logger), logger),
+	}
+}
+
+// reArchive archives the workflow run again and returns a description of the outcome
+func (r *archivalReArchiver) reArchive(
+	ctx context.Context,
+	blobstoreClient blobstore.Client,
+	bucket string,
+	domainID string,
+	workflowID string,
+	runID string,
+) string {
+
+	err := archiver.ReArchiveHistory(
+		ctx,
+		r.archiverClient,
+		blobstoreClient,
+		bucket,
+		r.historyManager,
+		r.historyV2Manager,
+		domainID,
+		workflowID,
+		runID,
+		reArchiveHistoryPageSize,
+	)
+	if err == archiver.ErrSourceHistoryNotExists {
+		return "no, source history deleted"
+	}
+	if err != nil {
+		return fmt.Sprintf("no, %v", err)
+	}
+	return "yes"
+}
@@ -99,7 +99,7 @@ func AdminShowWorkflow(c *cli.Context) {
 	totalSize := 0
 	for idx, b := range history {
 		totalSize += len(b.Data)
-		fmt.Printf("======== batch %v, blob len: %v ======\n", idx+1, len(b.Data))
+		fmt.Printf("======== batch %v, blob len: %v, encoding: %v ======\n", idx+1, len(b.Data), b.Encoding)
 		historyBatch, err := serializer.DeserializeBatchEvents(b)
 		if err != nil {
 			ErrorAndExit("DeserializeBatchEvents err", err)
@@ -175,6 +175,65 @@ func describeMutableState(c *cli.Context) *admin.DescribeWorkflowExecutionRespon
 	return resp
 }
 
+// AdminRefreshWorkflowTasks regenerates the transfer and timer tasks of a workflow execution from its mutable state
+func AdminRefreshWorkflowTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.RefreshWorkflowTasks(ctx, &shared.RefreshWorkflowTasksRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Refresh workflow tasks failed", err)
+	}
+	fmt.Println("Workflow tasks refreshed.")
+}
+
+// AdminRebuildMutableState rebuilds the mutable state of a workflow execution from its history
+func AdminRebuildMutableState(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	resp, err := adminClient.RebuildMutableState(ctx, &shared.RebuildMutableStateRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+		DryRun: common.BoolPtr(!c.Bool(FlagApply)),
+	})
+	if err != nil {
+		ErrorAndExit("Rebuild mutable state failed", err)
+	}
+
+	if len(resp.Diff) == 0 {
+		fmt.Println("Mutable state matches workflow history.")
+		return
+	}
+	for _, line := range resp.Diff {
+		fmt.Println(line)
+	}
+	if resp.GetApplied() {
+		fmt.Println("Mutable state rebuilt.")
+	}
+}
+
 // AdminDeleteWorkflow describe a new workflow execution for admin
 func AdminDeleteWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
@@ -250,7 +309,11 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	}
 	fmt.Println("delete mutableState row successfully")
 
-	err = exeStore.DeleteWorkflowCurrentRow(req)
+	err = exeStore.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: wid,
+		RunID:      rid,
+	})
 	if err != nil {
 		if skipError {
 			fmt.Println("delete current row failed, ", err)
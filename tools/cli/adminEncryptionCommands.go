@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/common/encryption"
+	"github.com/urfave/cli"
+)
+
+// AdminRotateEncryptionKey generates a new master key and makes it current. Hosts pick it up
+// on the next reload of the key file and wrap new data keys with it from then on.
+// Only the local key file is rewritten: the new file has to be distributed to every host
+// before any of them reloads it, otherwise hosts still on the old file cannot unwrap the
+// data keys wrapped with the new master key.
+func AdminRotateEncryptionKey(c *cli.Context) {
+	keyFile := getRequiredOption(c, FlagKeyFile)
+	keyID, err := encryption.RotateKeyFile(keyFile)
+	if err != nil {
+		ErrorAndExit("Failed to rotate encryption key.", err)
+	}
+	fmt.Printf("Rotated current encryption key to %v.\n", keyID)
+}
+
+// AdminDescribeEncryptionKeys shows the master keys of the key file, without the key material
+func AdminDescribeEncryptionKeys(c *cli.Context) {
+	keyFile, err := encryption.ReadKeyFile(getRequiredOption(c, FlagKeyFile))
+	if err != nil {
+		ErrorAndExit("Failed to read key file.", err)
+	}
+
+	keyIDs := make([]string, 0, len(keyFile.Keys))
+	for keyID := range keyFile.Keys {
+		keyIDs = append(keyIDs, keyID)
+	}
+	sort.Strings(keyIDs)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Key ID", "Current"})
+	for _, keyID := range keyIDs {
+		table.Append([]string{keyID, fmt.Sprintf("%v", keyID == keyFile.CurrentKey)})
+	}
+	table.Render()
+}
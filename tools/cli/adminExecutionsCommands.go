@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/scanner/executions"
+	"github.com/urfave/cli"
+)
+
+// AdminExecutionsScanReport displays the report of a run of the executions scanner system workflow.
+// If run_id is not provided, the report of the latest completed run is displayed.
+func AdminExecutionsScanReport(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	rid := c.String(FlagRunID)
+	if len(rid) == 0 {
+		ctx, cancel := newContext(c)
+		resp, err := frontendClient.ListClosedWorkflowExecutions(ctx, &shared.ListClosedWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(common.SystemDomainName),
+			MaximumPageSize: common.Int32Ptr(1),
+			StartTimeFilter: &shared.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(time.Now().UnixNano()),
+			},
+			ExecutionFilter: &shared.WorkflowExecutionFilter{WorkflowId: common.StringPtr(scanner.ExecutionsScannerWFID)},
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListClosedWorkflowExecutions failed.", err)
+		}
+		if len(resp.Executions) == 0 {
+			ErrorAndExit(colorMagenta("No completed run of the executions scanner found."), nil)
+		}
+		rid = resp.Executions[0].Execution.GetRunId()
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	queryResponse, err := frontendClient.QueryWorkflow(ctx, &shared.QueryWorkflowRequest{
+		Domain: common.StringPtr(common.SystemDomainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(scanner.ExecutionsScannerWFID),
+			RunId:      common.StringPtr(rid),
+		},
+		Query: &shared.WorkflowQuery{
+			QueryType: common.StringPtr(scanner.ExecutionsScannerReportQuery),
+		},
+	})
+	if err != nil {
+		ErrorAndExit("Query executions scanner failed.", err)
+	}
+	var report *executions.Report
+	if err := json.Unmarshal(queryResponse.QueryResult, &report); err != nil {
+		ErrorAndExit("Failed to decode executions scanner report.", err)
+	}
+	if report == nil {
+		ErrorAndExit(colorMagenta("Executions scanner run "+rid+" has not completed yet."), nil)
+	}
+	printExecutionsScanReport(rid, report)
+}
+
+func printExecutionsScanReport(runID string, report *executions.Report) {
+	fmt.Printf("Run ID: %v\n", runID)
+	fmt.Printf("Start Time: %v, End Time: %v\n", report.StartTime.Format(time.RFC3339), report.EndTime.Format(time.RFC3339))
+	fmt.Printf("Fix Enabled: %v\n", report.FixEnabled)
+	fmt.Printf("Shards: %v, Failed Shards: %v\n", report.NumShards, report.ShardsFailed)
+	fmt.Printf("Executions Scanned: %v, Current Executions Scanned: %v\n\n",
+		report.ExecutionsScanned, report.CurrentExecutionsScanned)
+
+	types := make([]string, 0, len(report.Corrupted))
+	for corruptionType := range report.Corrupted {
+		types = append(types, corruptionType)
+	}
+	sort.Strings(types)
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Corruption Type", "Corrupted", "Fixed"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, corruptionType := range types {
+		table.Append([]string{corruptionType,
+			strconv.FormatInt(report.Corrupted[corruptionType], 10),
+			strconv.FormatInt(report.Fixed[corruptionType], 10)})
+	}
+	table.Render()
+
+	if len(report.Samples) == 0 {
+		return
+	}
+	fmt.Printf("\nSamples:\n")
+	table = tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Shard ID", "Domain ID", "Workflow ID", "Run ID", "Corruption Type", "Fixed"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, sample := range report.Samples {
+		table.Append([]string{strconv.Itoa(sample.ShardID), sample.DomainID, sample.WorkflowID, sample.RunID,
+			sample.Type, strconv.FormatBool(sample.Fixed)})
+	}
+	table.Render()
+}
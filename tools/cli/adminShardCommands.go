@@ -0,0 +1,197 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminDescribeShard describes the queues of a shard
+func AdminDescribeShard(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.DescribeShardRequest{
+		ShardId: common.Int32Ptr(getRequiredShardID(c)),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeShard(ctx, request)
+	if err != nil {
+		ErrorAndExit("Operation DescribeShard failed.", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// AdminListShardQueueTasks lists the pending tasks of a queue of a shard
+func AdminListShardQueueTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	queueType := getRequiredShardQueueType(c)
+	more := c.Bool(FlagMore)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+
+	request := &shared.ListShardQueueTasksRequest{
+		ShardId:         common.Int32Ptr(getRequiredShardID(c)),
+		QueueType:       queueType.Ptr(),
+		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+	}
+	if c.IsSet(FlagMinLevel) {
+		request.MinLevel = common.Int64Ptr(c.Int64(FlagMinLevel))
+	}
+	if c.IsSet(FlagMaxLevel) {
+		request.MaxLevel = common.Int64Ptr(c.Int64(FlagMaxLevel))
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := []string{"Task ID", "Task Type", "Domain ID", "Workflow ID", "Run ID", "Visibility Time", "Version", "Event ID"}
+	headerColor := []tablewriter.Colors{}
+	for range header {
+		headerColor = append(headerColor, tableHeaderBlue)
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.ListShardQueueTasks(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListShardQueueTasks failed.", err)
+		}
+
+		for _, task := range response.Tasks {
+			visibilityTime := ""
+			if task.VisibilityTimestamp != nil {
+				visibilityTime = convertTime(task.GetVisibilityTimestamp(), false)
+			}
+			table.Append([]string{
+				strconv.FormatInt(task.GetTaskId(), 10),
+				strconv.Itoa(int(task.GetTaskType())),
+				task.GetDomainId(),
+				task.GetWorkflowId(),
+				task.GetRunId(),
+				visibilityTime,
+				strconv.FormatInt(task.GetVersion(), 10),
+				strconv.FormatInt(task.GetEventId(), 10),
+			})
+		}
+		table.Render()
+		table.ClearRows()
+
+		if !more || len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
+// AdminRemoveShardQueueTask removes a task from a queue of a shard
+func AdminRemoveShardQueueTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	queueType := getRequiredShardQueueType(c)
+	if !c.IsSet(FlagTaskID) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagTaskID), nil)
+	}
+
+	request := &shared.RemoveShardQueueTaskRequest{
+		ShardId:   common.Int32Ptr(getRequiredShardID(c)),
+		QueueType: queueType.Ptr(),
+		TaskId:    common.Int64Ptr(c.Int64(FlagTaskID)),
+	}
+	if queueType == shared.ShardQueueTypeTimer {
+		if !c.IsSet(FlagVisibilityTimestamp) {
+			ErrorAndExit(fmt.Sprintf("Option %s is required for timer tasks", FlagVisibilityTimestamp), nil)
+		}
+		request.VisibilityTimestamp = common.Int64Ptr(c.Int64(FlagVisibilityTimestamp))
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.RemoveShardQueueTask(ctx, request); err != nil {
+		ErrorAndExit("Operation RemoveShardQueueTask failed.", err)
+	}
+	fmt.Println("Task removed.")
+}
+
+// AdminCloseShard unloads a shard from its owning history host
+func AdminCloseShard(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.CloseShardRequest{
+		ShardId: common.Int32Ptr(getRequiredShardID(c)),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.CloseShard(ctx, request); err != nil {
+		ErrorAndExit("Operation CloseShard failed.", err)
+	}
+	fmt.Println("Shard closed.")
+}
+
+// AdminReloadShard unloads a shard from its owning history host and loads it again
+func AdminReloadShard(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.ReloadShardRequest{
+		ShardId: common.Int32Ptr(getRequiredShardID(c)),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.ReloadShard(ctx, request); err != nil {
+		ErrorAndExit("Operation ReloadShard failed.", err)
+	}
+	fmt.Println("Shard reloaded.")
+}
+
+func getRequiredShardQueueType(c *cli.Context) shared.ShardQueueType {
+	switch strings.ToLower(getRequiredOption(c, FlagQueueType)) {
+	case "transfer":
+		return shared.ShardQueueTypeTransfer
+	case "timer":
+		return shared.ShardQueueTypeTimer
+	case "replication":
+		return shared.ShardQueueTypeReplication
+	default:
+		ErrorAndExit(fmt.Sprintf("Option %s must be transfer, timer or replication", FlagQueueType), nil)
+	}
+	return shared.ShardQueueTypeTransfer
+}
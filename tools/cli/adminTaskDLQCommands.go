@@ -0,0 +1,160 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminListDLQTasks lists the tasks in the task DLQ of a shard
+func AdminListDLQTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	shardID := getRequiredShardID(c)
+	category := getRequiredDLQTaskCategory(c)
+	more := c.Bool(FlagMore)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+
+	request := &shared.ListDLQTasksRequest{
+		ShardId:         common.Int32Ptr(shardID),
+		Category:        category.Ptr(),
+		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	header := []string{"Task ID", "Task Type", "Domain ID", "Workflow ID", "Run ID", "Visibility Time", "Version", "Event ID"}
+	headerColor := []tablewriter.Colors{}
+	for range header {
+		headerColor = append(headerColor, tableHeaderBlue)
+	}
+	table.SetHeader(header)
+	table.SetHeaderColor(headerColor...)
+	table.SetHeaderLine(false)
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.ListDLQTasks(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListDLQTasks failed.", err)
+		}
+
+		for _, task := range response.Tasks {
+			table.Append([]string{
+				strconv.FormatInt(task.GetTaskId(), 10),
+				strconv.Itoa(int(task.GetTaskType())),
+				task.GetDomainId(),
+				task.GetWorkflowId(),
+				task.GetRunId(),
+				convertTime(task.GetVisibilityTimestamp(), false),
+				strconv.FormatInt(task.GetVersion(), 10),
+				strconv.FormatInt(task.GetEventId(), 10),
+			})
+		}
+		table.Render()
+		table.ClearRows()
+
+		if !more || len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
+// AdminRetryDLQTask processes a task in the task DLQ of a shard again
+func AdminRetryDLQTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.RetryDLQTaskRequest{
+		ShardId:  common.Int32Ptr(getRequiredShardID(c)),
+		Category: getRequiredDLQTaskCategory(c).Ptr(),
+		TaskId:   common.Int64Ptr(getRequiredDLQTaskID(c)),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.RetryDLQTask(ctx, request); err != nil {
+		ErrorAndExit("Operation RetryDLQTask failed.", err)
+	}
+	fmt.Println("Task processed and removed from DLQ.")
+}
+
+// AdminDropDLQTask removes a task from the task DLQ of a shard without processing it
+func AdminDropDLQTask(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	request := &shared.DropDLQTaskRequest{
+		ShardId:  common.Int32Ptr(getRequiredShardID(c)),
+		Category: getRequiredDLQTaskCategory(c).Ptr(),
+		TaskId:   common.Int64Ptr(getRequiredDLQTaskID(c)),
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if err := adminClient.DropDLQTask(ctx, request); err != nil {
+		ErrorAndExit("Operation DropDLQTask failed.", err)
+	}
+	fmt.Println("Task removed from DLQ.")
+}
+
+func getRequiredShardID(c *cli.Context) int32 {
+	if !c.IsSet(FlagShardID) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagShardID), nil)
+	}
+	return int32(c.Int(FlagShardID))
+}
+
+func getRequiredDLQTaskID(c *cli.Context) int64 {
+	if !c.IsSet(FlagTaskID) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagTaskID), nil)
+	}
+	return c.Int64(FlagTaskID)
+}
+
+func getRequiredDLQTaskCategory(c *cli.Context) shared.DLQTaskCategory {
+	switch strings.ToLower(getRequiredOption(c, FlagTaskCategory)) {
+	case "transfer":
+		return shared.DLQTaskCategoryTransfer
+	case "timer":
+		return shared.DLQTaskCategoryTimer
+	default:
+		ErrorAndExit(fmt.Sprintf("Option %s must be transfer or timer", FlagTaskCategory), nil)
+	}
+	return shared.DLQTaskCategoryTransfer
+}
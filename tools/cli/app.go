@@ -113,6 +113,36 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on taskList",
 					Subcommands: newAdminTaskListCommands(),
 				},
+				{
+					Name:        "archival",
+					Aliases:     []string{"arc"},
+					Usage:       "Run admin operation on archival",
+					Subcommands: newAdminArchivalCommands(),
+				},
+				{
+					Name:        "executions",
+					Aliases:     []string{"exec"},
+					Usage:       "Run admin operation on workflow executions",
+					Subcommands: newAdminExecutionsCommands(),
+				},
+				{
+					Name:        "taskdlq",
+					Aliases:     []string{"tdlq"},
+					Usage:       "Run admin operation on tasks moved into the per-shard task DLQ",
+					Subcommands: newAdminTaskDLQCommands(),
+				},
+				{
+					Name:        "shard",
+					Aliases:     []string{"sh"},
+					Usage:       "Run admin operation on history shard",
+					Subcommands: newAdminShardCommands(),
+				},
+				{
+					Name:        "encryption",
+					Aliases:     []string{"enc"},
+					Usage:       "Run admin operation on the keys encrypting history events and payloads at rest",
+					Subcommands: newAdminEncryptionCommands(),
+				},
 			},
 		},
 	}
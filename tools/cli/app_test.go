@@ -491,6 +491,35 @@ func (s *cliAppSuite) TestListWorkflow_Open_WithWorkflowType() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestListArchivedWorkflow() {
+	resp := &serverShared.ListArchivedWorkflowExecutionsResponse{
+		Executions: []*serverShared.WorkflowExecutionInfo{
+			{
+				Execution: &serverShared.WorkflowExecution{
+					WorkflowId: common.StringPtr("test-list-archived-workflow-id"),
+					RunId:      common.StringPtr(uuid.New()),
+				},
+				Type: &serverShared.WorkflowType{
+					Name: common.StringPtr("test-list-archived-workflow-type"),
+				},
+				StartTime:     common.Int64Ptr(time.Now().UnixNano()),
+				CloseTime:     common.Int64Ptr(time.Now().Add(time.Hour).UnixNano()),
+				HistoryLength: common.Int64Ptr(12),
+			},
+		},
+	}
+	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "listarchived"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListArchivedWorkflow_WithWorkflowTypeAndStatus() {
+	resp := &serverShared.ListArchivedWorkflowExecutionsResponse{}
+	s.serverFrontendClient.EXPECT().ListArchivedWorkflowExecutions(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "listarchived", "-wt", "no-type", "-s", "completed"})
+	s.Nil(err)
+}
+
 var describeTaskListResponse = &shared.DescribeTaskListResponse{
 	Pollers: []*shared.PollerInfo{
 		{
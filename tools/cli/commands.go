@@ -769,6 +769,73 @@ func ListAllWorkflow(c *cli.Context) {
 	table.Render()
 }
 
+// ListArchivedWorkflow list closed workflow executions from archival based on filters
+func ListArchivedWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	more := c.Bool(FlagMore)
+	printRawTime := c.Bool(FlagPrintRawTime)
+	printDateTime := c.Bool(FlagPrintDateTime)
+	pageSize := c.Int(FlagPageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSizeForList
+	}
+
+	request := &shared.ListArchivedWorkflowExecutionsRequest{
+		Domain:          common.StringPtr(domain),
+		MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+		CloseTimeFilter: &shared.CloseTimeFilter{
+			EarliestTime: common.Int64Ptr(parseTime(c.String(FlagEarliestTime), 0)),
+			LatestTime:   common.Int64Ptr(parseTime(c.String(FlagLatestTime), time.Now().UnixNano())),
+		},
+	}
+	if workflowType := c.String(FlagWorkflowType); len(workflowType) > 0 {
+		request.TypeFilter = &shared.WorkflowTypeFilter{Name: common.StringPtr(workflowType)}
+	}
+	if c.IsSet(FlagWorkflowStatus) {
+		request.StatusFilter = shared.WorkflowExecutionCloseStatus(getWorkflowStatus(c.String(FlagWorkflowStatus))).Ptr()
+	}
+
+	table := createTableForListWorkflow(false, false)
+	for {
+		ctx, cancel := newContextForLongPoll(c)
+		response, err := frontendClient.ListArchivedWorkflowExecutions(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Failed to list archived workflow.", err)
+		}
+
+		for _, e := range response.Executions {
+			var startTime, executionTime, closeTime string
+			if printRawTime {
+				startTime = fmt.Sprintf("%d", e.GetStartTime())
+				executionTime = fmt.Sprintf("%d", e.GetExecutionTime())
+				closeTime = fmt.Sprintf("%d", e.GetCloseTime())
+			} else {
+				startTime = convertTime(e.GetStartTime(), !printDateTime)
+				executionTime = convertTime(e.GetExecutionTime(), !printDateTime)
+				closeTime = convertTime(e.GetCloseTime(), !printDateTime)
+			}
+			table.Append([]string{trimWorkflowType(e.Type.GetName()), e.Execution.GetWorkflowId(), e.Execution.GetRunId(), startTime, executionTime, closeTime})
+		}
+		table.Render()
+		table.ClearRows()
+
+		if !more || len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
 // DescribeWorkflow show information about the specified workflow execution
 func DescribeWorkflow(c *cli.Context) {
 	wid := getRequiredOption(c, FlagWorkflowID)
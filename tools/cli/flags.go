@@ -145,6 +145,22 @@ const (
 	FlagIndex                       = "index"
 	FlagBatchSize                   = "batch_size"
 	FlagBatchSizeWithAlias          = FlagBatchSize + ", bs"
+	FlagReArchive                   = "rearchive"
+	FlagNumArchiveSystemWorkflows   = "num_archive_system_workflows"
+	FlagServerConfigDir             = "server_config_dir"
+	FlagServerEnv                   = "server_env"
+	FlagServerZone                  = "server_zone"
+	FlagTaskID                      = "task_id"
+	FlagTaskIDWithAlias             = FlagTaskID + ", tid"
+	FlagTaskCategory                = "task_category"
+	FlagTaskCategoryWithAlias       = FlagTaskCategory + ", tc"
+	FlagQueueType                   = "queue_type"
+	FlagQueueTypeWithAlias          = FlagQueueType + ", q"
+	FlagMinLevel                    = "min_level"
+	FlagMaxLevel                    = "max_level"
+	FlagVisibilityTimestamp         = "visibility_timestamp"
+	FlagApply                       = "apply"
+	FlagKeyFile                     = "key_file"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -278,6 +294,44 @@ func getFlagsForList() []cli.Flag {
 	return flagsForList
 }
 
+func getFlagsForListArchived() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  FlagMoreWithAlias,
+			Usage: "List more pages, default is to list one page of default page size 10",
+		},
+		cli.IntFlag{
+			Name:  FlagPageSizeWithAlias,
+			Value: 10,
+			Usage: "Result page size",
+		},
+		cli.StringFlag{
+			Name:  FlagEarliestTimeWithAlias,
+			Usage: "EarliestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagLatestTimeWithAlias,
+			Usage: "LatestTime of close time, supported formats are '2006-01-02T15:04:05Z07:00' and raw UnixNano",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowStatusWithAlias,
+			Usage: "Closed workflow status [completed, failed, canceled, terminated, continueasnew, timedout]",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintRawTimeWithAlias,
+			Usage: "Print raw time stamp",
+		},
+		cli.BoolFlag{
+			Name:  FlagPrintDateTimeWithAlias,
+			Usage: "Print full date time in '2006-01-02T15:04:05Z07:00' format",
+		},
+	}
+}
+
 func getFlagsForListAll() []cli.Flag {
 	return []cli.Flag{
 		cli.BoolFlag{
@@ -143,6 +143,15 @@ func newWorkflowCommands() []cli.Command {
 				ListAllWorkflow(c)
 			},
 		},
+		{
+			Name:        "listarchived",
+			Usage:       "list closed workflow executions from archival",
+			Description: "list one page (default size 10 items) by default, use flag --pagesize to change page size",
+			Flags:       getFlagsForListArchived(),
+			Action: func(c *cli.Context) {
+				ListArchivedWorkflow(c)
+			},
+		},
 		{
 			Name:  "query",
 			Usage: "query workflow execution",
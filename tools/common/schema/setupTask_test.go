@@ -0,0 +1,136 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	SetupTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+
+	// fakeDB is an in-memory DB that records
+	// the statements and version updates it sees
+	fakeDB struct {
+		version       string
+		minVersion    string
+		stmts         []string
+		updateLog     []string
+		versionTables bool
+		dropped       bool
+	}
+)
+
+func TestSetupTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(SetupTaskTestSuite))
+}
+
+func (s *SetupTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *SetupTaskTestSuite) TestSetupSchema() {
+
+	tmpDir, err := ioutil.TempDir("", "setup_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	schemaFile := tmpDir + "/schema.sql"
+	s.Nil(ioutil.WriteFile(schemaFile, []byte(createTestSchemaFileContent()), os.FileMode(0600)))
+
+	db := newFakeDB("")
+	task := NewSetupSchemaTask(db, &SetupConfig{SchemaFilePath: schemaFile, InitialVersion: "0.3", Overwrite: true})
+	s.Nil(task.Run())
+	s.True(db.dropped)
+	s.True(db.versionTables)
+	s.Equal(2, len(db.stmts))
+	s.Equal("0.3", db.version)
+	s.Equal("0.3", db.minVersion)
+	s.Equal([]string{"0.3"}, db.updateLog)
+}
+
+func (s *SetupTaskTestSuite) TestSetupSchema_DisableVersioning() {
+
+	tmpDir, err := ioutil.TempDir("", "setup_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	schemaFile := tmpDir + "/schema.sql"
+	s.Nil(ioutil.WriteFile(schemaFile, []byte(createTestSchemaFileContent()), os.FileMode(0600)))
+
+	db := newFakeDB("")
+	task := NewSetupSchemaTask(db, &SetupConfig{SchemaFilePath: schemaFile, DisableVersioning: true})
+	s.Nil(task.Run())
+	s.False(db.dropped)
+	s.False(db.versionTables)
+	s.Equal(2, len(db.stmts))
+	s.Equal("", db.version)
+	s.Equal(0, len(db.updateLog))
+}
+
+func newFakeDB(version string) *fakeDB {
+	return &fakeDB{version: version}
+}
+
+func (db *fakeDB) Exec(stmt string) error {
+	db.stmts = append(db.stmts, stmt)
+	return nil
+}
+
+func (db *fakeDB) DropAllTables() error {
+	db.dropped = true
+	db.stmts = nil
+	return nil
+}
+
+func (db *fakeDB) CreateSchemaVersionTables() error {
+	db.versionTables = true
+	return nil
+}
+
+func (db *fakeDB) ReadSchemaVersion() (string, error) {
+	if len(db.version) == 0 {
+		return "", errors.New("schema version not found")
+	}
+	return db.version, nil
+}
+
+func (db *fakeDB) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	db.version = newVersion
+	db.minVersion = minCompatibleVersion
+	return nil
+}
+
+func (db *fakeDB) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	db.updateLog = append(db.updateLog, newVersion)
+	return nil
+}
+
+func (db *fakeDB) Close() {}
@@ -0,0 +1,76 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import "fmt"
+
+type (
+	// DB is the database interface that's required to be implemented
+	// for the schema-tool to work
+	DB interface {
+		// Exec executes a DDL/DML statement
+		Exec(stmt string) error
+		// DropAllTables drops all the tables (and types) in the keyspace / database
+		DropAllTables() error
+		// CreateSchemaVersionTables sets up the schema version tables
+		CreateSchemaVersionTables() error
+		// ReadSchemaVersion returns the current schema version for the keyspace / database
+		ReadSchemaVersion() (string, error)
+		// UpdateSchemaVersion updates the schema version for the keyspace / database
+		UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error
+		// WriteSchemaUpdateLog adds an entry to the schema update history table
+		WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error
+		// Close gracefully closes the client object
+		Close()
+	}
+
+	// UpdateConfig holds the config
+	// params for executing a UpdateTask
+	UpdateConfig struct {
+		TargetVersion string
+		SchemaDir     string
+		IsDryRun      bool
+	}
+
+	// SetupConfig holds the config
+	// params need by the SetupTask
+	SetupConfig struct {
+		SchemaFilePath    string
+		InitialVersion    string
+		Overwrite         bool // overwrite previous data
+		DisableVersioning bool // do not use schema versioning
+	}
+
+	// ConfigError is an error type that
+	// represents a problem with the config
+	ConfigError struct {
+		msg string
+	}
+)
+
+// NewConfigError creates and returns an instance of ConfigError
+func NewConfigError(msg string) error {
+	return &ConfigError{msg: msg}
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("Config Error:%v", e.msg)
+}
@@ -0,0 +1,226 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	UpdateTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestUpdateTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateTaskTestSuite))
+}
+
+func (s *UpdateTaskTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *UpdateTaskTestSuite) TestUpdateSchema() {
+
+	tmpDir, err := ioutil.TempDir("", "update_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	s.makeSchemaVersionDir(tmpDir, "0.1", "CREATE TABLE a (id INT);")
+	s.makeSchemaVersionDir(tmpDir, "0.2", "ALTER TABLE a ADD name TEXT;")
+	s.makeSchemaVersionDir(tmpDir, "0.3", "CREATE TABLE b (id INT);")
+
+	db := newFakeDB("0.0")
+	task := NewUpdateSchemaTask(db, &UpdateConfig{SchemaDir: tmpDir, TargetVersion: "0.2"})
+	s.Nil(task.Run())
+	s.Equal("0.2", db.version)
+	s.Equal([]string{"CREATE TABLE a (id INT);", "ALTER TABLE a ADD name TEXT;"}, db.stmts)
+	s.Equal(2, len(db.updateLog))
+
+	task = NewUpdateSchemaTask(db, &UpdateConfig{SchemaDir: tmpDir})
+	s.Nil(task.Run())
+	s.Equal("0.3", db.version)
+	s.Equal(3, len(db.stmts))
+	s.Equal(3, len(db.updateLog))
+}
+
+func (s *UpdateTaskTestSuite) TestUpdateSchema_InvalidStmt() {
+
+	tmpDir, err := ioutil.TempDir("", "update_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	s.makeSchemaVersionDir(tmpDir, "0.1", "DROP TABLE a;")
+
+	db := newFakeDB("0.0")
+	task := NewUpdateSchemaTask(db, &UpdateConfig{SchemaDir: tmpDir, TargetVersion: "0.1"})
+	s.NotNil(task.Run())
+	s.Equal("0.0", db.version)
+	s.Equal(0, len(db.stmts))
+}
+
+func (s *UpdateTaskTestSuite) TestVersionedSchemaDirs() {
+
+	_, filename, _, ok := runtime.Caller(0)
+	s.True(ok)
+	root := path.Dir(path.Dir(path.Dir(path.Dir(filename))))
+
+	dirs := []string{
+		"schema/cassandra/cadence/versioned",
+		"schema/cassandra/visibility/versioned",
+		"schema/mysql/v57/cadence/versioned",
+		"schema/mysql/v57/visibility/versioned",
+	}
+
+	for _, dir := range dirs {
+		schemaDir := path.Join(root, dir)
+		expected, err := GetExpectedVersion(schemaDir)
+		s.Nil(err, dir)
+
+		db := newFakeDB("0.0")
+		task := NewUpdateSchemaTask(db, &UpdateConfig{SchemaDir: schemaDir})
+		s.Nil(task.Run(), dir)
+		s.Equal(expected, db.version, dir)
+		s.True(len(db.stmts) > 0, dir)
+	}
+}
+
+func (s *UpdateTaskTestSuite) TestReadManifest() {
+
+	tmpDir, err := ioutil.TempDir("", "update_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	input := `{
+		"CurrVersion": "0.4",
+		"MinCompatibleVersion": "0.1",
+		"Description": "base version of schema",
+		"SchemaUpdateCqlFiles": ["base1.cql", "base2.cql", "base3.cql"]
+	}`
+	files := []string{"base1.cql", "base2.cql", "base3.cql"}
+	s.runReadManifestTest(tmpDir, input, "0.4", "0.1", "base version of schema", files, false)
+
+	errInputs := []string{
+		`{
+			"MinCompatibleVersion": "0.1",
+			"Description": "base",
+			"SchemaUpdateCqlFiles": ["base1.cql"]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"Description": "base version of schema",
+			"SchemaUpdateCqlFiles": ["base1.cql", "base2.cql", "base3.cql"]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "0.1",
+			"Description": "base version of schema",
+		 }`,
+		`{
+			"CurrVersion": "",
+			"MinCompatibleVersion": "0.1",
+			"Description": "base version of schema",
+			"SchemaUpdateCqlFiles": ["base1.cql", "base2.cql", "base3.cql"]
+		 }`,
+		`{
+			"CurrVersion": "0.4",
+			"MinCompatibleVersion": "",
+			"Description": "base version of schema",
+			"SchemaUpdateCqlFiles": ["base1.cql", "base2.cql", "base3.cql"]
+		 }`,
+		`{
+			"CurrVersion": "",
+			"MinCompatibleVersion": "0.1",
+			"Description": "base version of schema",
+			"SchemaUpdateCqlFiles": []
+		 }`,
+	}
+
+	for _, in := range errInputs {
+		s.runReadManifestTest(tmpDir, in, "", "", "", nil, true)
+	}
+}
+
+func (s *UpdateTaskTestSuite) TestReadSchemaDir() {
+
+	tmpDir, err := ioutil.TempDir("", "update_schema_test")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+
+	subDirs := []string{"v0.5", "v1.5", "v2.5", "v3.5", "v10.2", "abc", "2.0", "3.0"}
+	for _, d := range subDirs {
+		os.Mkdir(tmpDir+"/"+d, os.FileMode(0444))
+	}
+
+	_, err = readSchemaDir(tmpDir, "11.0", "11.2")
+	s.NotNil(err)
+	_, err = readSchemaDir(tmpDir, "0.5", "10.3")
+	s.NotNil(err)
+
+	ans, err := readSchemaDir(tmpDir, "0.4", "10.2")
+	s.Nil(err)
+	s.Equal([]string{"v0.5", "v1.5", "v2.5", "v3.5", "v10.2"}, ans)
+
+	ans, err = readSchemaDir(tmpDir, "0.5", "3.5")
+	s.Nil(err)
+	s.Equal([]string{"v1.5", "v2.5", "v3.5"}, ans)
+}
+
+func (s *UpdateTaskTestSuite) runReadManifestTest(dir, input, currVer, minVer, desc string,
+	files []string, isErr bool) {
+
+	file := dir + "/manifest.json"
+	err := ioutil.WriteFile(file, []byte(input), os.FileMode(0644))
+	s.Nil(err)
+
+	m, err := readManifest(dir)
+	if isErr {
+		s.NotNil(err)
+		return
+	}
+	s.Nil(err)
+	s.Equal(currVer, m.CurrVersion)
+	s.Equal(minVer, m.MinCompatibleVersion)
+	s.Equal(desc, m.Description)
+	s.True(len(m.md5) > 0)
+	s.Equal(files, m.SchemaUpdateCqlFiles)
+}
+
+func (s *UpdateTaskTestSuite) makeSchemaVersionDir(rootDir string, version string, stmt string) {
+	dir := rootDir + "/v" + version
+	s.Nil(os.Mkdir(dir, os.FileMode(0700)))
+	manifest := `{
+		"CurrVersion": "` + version + `",
+		"MinCompatibleVersion": "0.1",
+		"Description": "test update",
+		"SchemaUpdateCqlFiles": ["update.sql"]
+	}`
+	s.Nil(ioutil.WriteFile(dir+"/manifest.json", []byte(manifest), os.FileMode(0600)))
+	s.Nil(ioutil.WriteFile(dir+"/update.sql", []byte(stmt), os.FileMode(0600)))
+}
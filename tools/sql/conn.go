@@ -0,0 +1,168 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"time"
+
+	// load the mysql driver
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	// connection is the connection to a sql database
+	// through which the schema tasks are executed
+	connection struct {
+		database string
+		db       *sqlx.DB
+	}
+)
+
+const (
+	defaultDriverName = "mysql"
+	defaultSQLPort    = 3306
+
+	dataSourceName = "%s:%s@%v(%v)/%s?multiStatements=true&parseTime=true&clientFoundRows=true"
+)
+
+const (
+	readSchemaVersionSQL        = `SELECT curr_version from schema_version where db_name=?`
+	writeSchemaVersionSQL       = `REPLACE into schema_version(db_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
+	writeSchemaUpdateHistorySQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
+
+	createSchemaVersionTableSQL = `CREATE TABLE schema_version(db_name VARCHAR(255) not null PRIMARY KEY, ` +
+		`creation_time DATETIME(6), ` +
+		`curr_version VARCHAR(64), ` +
+		`min_compatible_version VARCHAR(64));`
+
+	createSchemaUpdateHistoryTableSQL = `CREATE TABLE schema_update_history(` +
+		`year int not null, ` +
+		`month int not null, ` +
+		`update_time DATETIME(6) not null, ` +
+		`description VARCHAR(255), ` +
+		`manifest_md5 VARCHAR(64), ` +
+		`new_version VARCHAR(64), ` +
+		`old_version VARCHAR(64), ` +
+		`PRIMARY KEY (year, month, update_time));`
+
+	createDatabaseSQL = "CREATE DATABASE IF NOT EXISTS %v"
+	dropDatabaseSQL   = "DROP DATABASE IF EXISTS %v"
+)
+
+// newConnection returns a new connection to the database
+// named in the config, an empty database name connects
+// to the server without selecting a database
+func newConnection(cfg *BaseConfig) (*connection, error) {
+	driverName := cfg.DriverName
+	if len(driverName) == 0 {
+		driverName = defaultDriverName
+	}
+	addr := fmt.Sprintf("%v:%v", cfg.Host, cfg.Port)
+	db, err := sqlx.Connect(driverName, fmt.Sprintf(dataSourceName, cfg.User, cfg.Password, "tcp", addr, cfg.Database))
+	if err != nil {
+		return nil, err
+	}
+	return &connection{
+		database: cfg.Database,
+		db:       db,
+	}, nil
+}
+
+// CreateDatabase creates a database if it doesn't exist
+func (c *connection) CreateDatabase(name string) error {
+	return c.Exec(fmt.Sprintf(createDatabaseSQL, name))
+}
+
+// DropDatabase drops a database
+func (c *connection) DropDatabase(name string) error {
+	return c.Exec(fmt.Sprintf(dropDatabaseSQL, name))
+}
+
+// ListTables lists the table names in the database
+func (c *connection) ListTables() ([]string, error) {
+	var names []string
+	if err := c.db.Select(&names, "SHOW TABLES"); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// DropTable drops a given table from the database
+func (c *connection) DropTable(name string) error {
+	return c.Exec(fmt.Sprintf("DROP TABLE %v", name))
+}
+
+// DropAllTables drops all the tables in the database
+func (c *connection) DropAllTables() error {
+	tables, err := c.ListTables()
+	if err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := c.DropTable(table); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateSchemaVersionTables sets up the schema version tables
+func (c *connection) CreateSchemaVersionTables() error {
+	if err := c.Exec(createSchemaVersionTableSQL); err != nil {
+		return err
+	}
+	return c.Exec(createSchemaUpdateHistoryTableSQL)
+}
+
+// ReadSchemaVersion returns the current schema version for the database
+func (c *connection) ReadSchemaVersion() (string, error) {
+	var version string
+	err := c.db.Get(&version, readSchemaVersionSQL, c.database)
+	return version, err
+}
+
+// UpdateSchemaVersion updates the schema version for the database
+func (c *connection) UpdateSchemaVersion(newVersion string, minCompatibleVersion string) error {
+	_, err := c.db.Exec(writeSchemaVersionSQL, c.database, time.Now(), newVersion, minCompatibleVersion)
+	return err
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history table
+func (c *connection) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	now := time.Now().UTC()
+	_, err := c.db.Exec(writeSchemaUpdateHistorySQL, now.Year(), int(now.Month()), now, oldVersion, newVersion, manifestMD5, desc)
+	return err
+}
+
+// Exec executes a sql statement
+func (c *connection) Exec(stmt string) error {
+	_, err := c.db.Exec(stmt)
+	return err
+}
+
+// Close closes the sql client
+func (c *connection) Close() {
+	if c.db != nil {
+		c.db.Close()
+	}
+}
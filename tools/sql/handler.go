@@ -0,0 +1,270 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/uber/cadence/tools/common/schema"
+	"github.com/urfave/cli"
+)
+
+const dryrunDatabase = "dryrun_"
+
+// setupSchema executes the setupSchemaTask
+// using the given command line arguments
+// as input
+func setupSchema(cli *cli.Context) error {
+	config, err := newSetupSchemaConfig(cli)
+	if err != nil {
+		return handleErr(newConfigError(err.Error()))
+	}
+	if err := handleSetupSchema(config); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// updateSchema executes the updateSchemaTask
+// using the given command line args as input
+func updateSchema(cli *cli.Context) error {
+	config, err := newUpdateSchemaConfig(cli)
+	if err != nil {
+		return handleErr(newConfigError(err.Error()))
+	}
+	if err := handleUpdateSchema(config); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+// createDatabase creates a sql database
+func createDatabase(cli *cli.Context) error {
+	config, err := newCreateDatabaseConfig(cli)
+	if err != nil {
+		return handleErr(err)
+	}
+	if err := handleCreateDatabase(config); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
+func handleSetupSchema(config *SetupSchemaConfig) error {
+	conn, err := newConnection(&config.BaseConfig)
+	if err != nil {
+		return fmt.Errorf("error creating sql connection, err=%v", err)
+	}
+	defer conn.Close()
+	if err := schema.NewSetupSchemaTask(conn, &config.SetupConfig).Run(); err != nil {
+		return fmt.Errorf("error setting up schema, err=%v", err)
+	}
+	return nil
+}
+
+func handleUpdateSchema(config *UpdateSchemaConfig) error {
+	baseConfig := config.BaseConfig
+	if config.IsDryRun {
+		if err := setupDryrunDatabase(config); err != nil {
+			return fmt.Errorf("error creating dryrun database:%v", err)
+		}
+		defer dropDryrunDatabase(config)
+		baseConfig.Database = dryrunDatabase
+	}
+	conn, err := newConnection(&baseConfig)
+	if err != nil {
+		return fmt.Errorf("error creating sql connection, err=%v", err)
+	}
+	defer conn.Close()
+	if err := schema.NewUpdateSchemaTask(conn, &config.UpdateConfig).Run(); err != nil {
+		return fmt.Errorf("error updating schema, err=%v", err)
+	}
+	return nil
+}
+
+func handleCreateDatabase(config *CreateDatabaseConfig) error {
+	database := config.Database
+	config.Database = ""
+	conn, err := newConnection(&config.BaseConfig)
+	if err != nil {
+		return fmt.Errorf("error creating sql connection, err=%v", err)
+	}
+	defer conn.Close()
+	if err := conn.CreateDatabase(database); err != nil {
+		return fmt.Errorf("error creating database:%v", err)
+	}
+	return nil
+}
+
+// sets up a temporary dryrun database for
+// executing the sql schema update
+func setupDryrunDatabase(config *UpdateSchemaConfig) error {
+	createConfig := &CreateDatabaseConfig{BaseConfig: config.BaseConfig}
+	createConfig.Database = dryrunDatabase
+	if err := handleCreateDatabase(createConfig); err != nil {
+		return err
+	}
+	setupConfig := &SetupSchemaConfig{
+		BaseConfig: config.BaseConfig,
+		SetupConfig: schema.SetupConfig{
+			Overwrite:      true,
+			InitialVersion: "0.0",
+		},
+	}
+	setupConfig.Database = dryrunDatabase
+	return handleSetupSchema(setupConfig)
+}
+
+func dropDryrunDatabase(config *UpdateSchemaConfig) {
+	baseConfig := config.BaseConfig
+	baseConfig.Database = ""
+	conn, err := newConnection(&baseConfig)
+	if err != nil {
+		log.Printf("error dropping dryrun database, err=%v\n", err)
+		return
+	}
+	defer conn.Close()
+	if err := conn.DropDatabase(dryrunDatabase); err != nil {
+		log.Printf("error dropping dryrun database, err=%v\n", err)
+	}
+}
+
+func validateBaseConfig(config *BaseConfig) error {
+	if len(config.Host) == 0 {
+		return newConfigError("missing sql endpoint argument " + flag(cliOptEndpoint))
+	}
+	if config.Port == 0 {
+		config.Port = defaultSQLPort
+	}
+	if len(config.DriverName) == 0 {
+		config.DriverName = defaultDriverName
+	}
+	if len(config.Database) == 0 {
+		return newConfigError("missing " + flag(cliOptDatabase) + " argument ")
+	}
+	return nil
+}
+
+func validateSetupSchemaConfig(config *SetupSchemaConfig) error {
+	if err := validateBaseConfig(&config.BaseConfig); err != nil {
+		return err
+	}
+	if len(config.SchemaFilePath) == 0 && config.DisableVersioning {
+		return newConfigError("missing schemaFilePath " + flag(cliOptSchemaFile))
+	}
+	if (config.DisableVersioning && len(config.InitialVersion) > 0) ||
+		(!config.DisableVersioning && len(config.InitialVersion) == 0) {
+		return newConfigError("either " + flag(cliOptDisableVersioning) + " or " +
+			flag(cliOptVersion) + " but not both must be specified")
+	}
+	if !config.DisableVersioning {
+		ver, err := schema.ParseValidateVersion(config.InitialVersion)
+		if err != nil {
+			return newConfigError("invalid " + flag(cliOptVersion) + " argument:" + err.Error())
+		}
+		config.InitialVersion = ver
+	}
+	return nil
+}
+
+func newSetupSchemaConfig(cli *cli.Context) (*SetupSchemaConfig, error) {
+
+	config := new(SetupSchemaConfig)
+	config.BaseConfig = newBaseConfig(cli)
+	config.SchemaFilePath = cli.String(cliOptSchemaFile)
+	config.InitialVersion = cli.String(cliOptVersion)
+	config.DisableVersioning = cli.Bool(cliOptDisableVersioning)
+	config.Overwrite = cli.Bool(cliOptOverwrite)
+
+	if err := validateSetupSchemaConfig(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func validateUpdateSchemaConfig(config *UpdateSchemaConfig) error {
+	if err := validateBaseConfig(&config.BaseConfig); err != nil {
+		return err
+	}
+	if len(config.SchemaDir) == 0 {
+		return newConfigError("missing " + flag(cliOptSchemaDir) + " argument ")
+	}
+	if len(config.TargetVersion) > 0 {
+		ver, err := schema.ParseValidateVersion(config.TargetVersion)
+		if err != nil {
+			return newConfigError("invalid " + flag(cliOptTargetVersion) + " argument:" + err.Error())
+		}
+		config.TargetVersion = ver
+	}
+	return nil
+}
+
+func newUpdateSchemaConfig(cli *cli.Context) (*UpdateSchemaConfig, error) {
+
+	config := new(UpdateSchemaConfig)
+	config.BaseConfig = newBaseConfig(cli)
+	config.SchemaDir = cli.String(cliOptSchemaDir)
+	config.IsDryRun = cli.Bool(cliOptDryrun)
+	config.TargetVersion = cli.String(cliOptTargetVersion)
+
+	if err := validateUpdateSchemaConfig(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func newCreateDatabaseConfig(cli *cli.Context) (*CreateDatabaseConfig, error) {
+	config := new(CreateDatabaseConfig)
+	config.BaseConfig = newBaseConfig(cli)
+	config.Database = cli.String(cliOptDatabase)
+
+	if err := validateCreateDatabaseConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func validateCreateDatabaseConfig(config *CreateDatabaseConfig) error {
+	return validateBaseConfig(&config.BaseConfig)
+}
+
+func newBaseConfig(cli *cli.Context) BaseConfig {
+	return BaseConfig{
+		Host:       cli.GlobalString(cliOptEndpoint),
+		Port:       cli.GlobalInt(cliOptPort),
+		User:       cli.GlobalString(cliOptUser),
+		Password:   cli.GlobalString(cliOptPassword),
+		Database:   cli.GlobalString(cliOptDatabase),
+		DriverName: cli.GlobalString(cliOptDriverName),
+	}
+}
+
+func flag(opt string) string {
+	return "(-" + opt + ")"
+}
+
+func handleErr(err error) error {
+	log.Println(err)
+	return err
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sql
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/environment"
+	"github.com/uber/cadence/tools/common/schema"
+)
+
+type (
+	SchemaTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+		database string
+		conn     *connection
+	}
+)
+
+func TestSchemaTestSuite(t *testing.T) {
+	suite.Run(t, new(SchemaTestSuite))
+}
+
+func (s *SchemaTestSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *SchemaTestSuite) SetupSuite() {
+	rand := rand.New(rand.NewSource(time.Now().UnixNano()))
+	s.database = fmt.Sprintf("sql_schema_test_%v", rand.Int63())
+
+	err := RunTool([]string{"./tool", "-ep", environment.GetMySQLAddress(), "-p", fmt.Sprint(environment.GetMySQLPort()),
+		"-u", "uber", "-pw", "uber", "-q", "create", "-db", s.database})
+	s.Require().Nil(err)
+
+	conn, err := newConnection(s.baseConfig(s.database))
+	s.Require().Nil(err)
+	s.conn = conn
+}
+
+func (s *SchemaTestSuite) TearDownSuite() {
+	s.conn.DropDatabase(s.database)
+	s.conn.Close()
+}
+
+func (s *SchemaTestSuite) TestSetupAndUpdateSchema() {
+
+	_, filename, _, ok := runtime.Caller(0)
+	s.True(ok)
+	root := path.Dir(path.Dir(path.Dir(filename)))
+	schemaDir := path.Join(root, "schema/mysql/v57/cadence/versioned")
+
+	setupConfig := &SetupSchemaConfig{
+		BaseConfig:  *s.baseConfig(s.database),
+		SetupConfig: schema.SetupConfig{InitialVersion: "0.0", Overwrite: true},
+	}
+	s.Nil(SetupSchema(setupConfig))
+
+	ver, err := s.conn.ReadSchemaVersion()
+	s.Nil(err)
+	s.Equal("0.0", ver)
+
+	updateConfig := &UpdateSchemaConfig{
+		BaseConfig:   *s.baseConfig(s.database),
+		UpdateConfig: schema.UpdateConfig{SchemaDir: schemaDir},
+	}
+	s.Nil(validateUpdateSchemaConfig(updateConfig))
+	s.Nil(handleUpdateSchema(updateConfig))
+
+	expected, err := schema.GetExpectedVersion(schemaDir)
+	s.Nil(err)
+	ver, err = s.conn.ReadSchemaVersion()
+	s.Nil(err)
+	s.Equal(expected, ver)
+
+	tables, err := s.conn.ListTables()
+	s.Nil(err)
+	s.Contains(tables, "domains")
+	s.Contains(tables, "schema_version")
+	s.Contains(tables, "schema_update_history")
+
+	// overwrite drops everything and starts over without versioning
+	tmpDir, err := ioutil.TempDir("", "sqlSchemaTestDir")
+	s.Nil(err)
+	defer os.RemoveAll(tmpDir)
+	schemaFile := tmpDir + "/schema.sql"
+	s.Nil(ioutil.WriteFile(schemaFile, []byte("CREATE TABLE foo (id INT PRIMARY KEY);"), os.FileMode(0600)))
+
+	setupConfig.InitialVersion = ""
+	setupConfig.DisableVersioning = true
+	setupConfig.SchemaFilePath = schemaFile
+	s.Nil(SetupSchema(setupConfig))
+	tables, err = s.conn.ListTables()
+	s.Nil(err)
+	s.Equal([]string{"foo"}, tables)
+}
+
+func (s *SchemaTestSuite) baseConfig(database string) *BaseConfig {
+	return &BaseConfig{
+		Host:     environment.GetMySQLAddress(),
+		Port:     environment.GetMySQLPort(),
+		User:     "uber",
+		Password: "uber",
+		Database: database,
+	}
+}